@@ -0,0 +1,281 @@
+package dbutil
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact fixed-point decimal number, the Go type for ddl.Decimal columns.
+// It is stored as an arbitrary precision integer and a scale (number of digits after the
+// decimal point), so "12.50" is kept as 1250 with a scale of 2 and is never converted
+// to floating point.  The zero value is 0.
+//
+// In the database it is a string ("12.50"), which works with DECIMAL/NUMERIC and TEXT columns.
+// A NULL database value scans as zero.  In JSON it is also a string, since JavaScript numbers
+// are floating point, but it can be unmarshaled from either a string or a number.
+type Decimal struct {
+	unscaled *big.Int // nil means 0, never modified once set
+	scale    int
+}
+
+var bigTen = big.NewInt(10)
+
+// decimalMaxExp limits the exponent and the resulting scale accepted by ParseDecimal, so
+// input like "1e1000000000" cannot make it build enormous numbers.
+const decimalMaxExp = 1000
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// NewDecimal returns unscaled * 10^-scale, e.g. NewDecimal(1250, 2) is 12.50.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// DecimalFromRat returns r rounded to scale digits after the decimal point.
+func DecimalFromRat(r *big.Rat, scale int) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	return Decimal{unscaled: quoRound(num, r.Denom()), scale: scale}
+}
+
+// ParseDecimal parses a decimal number in the form "-123.45", with an optional exponent ("1.2e3").
+// The scale is the number of digits after the decimal point, so "1.50" has a scale of 2.
+func ParseDecimal(s string) (Decimal, error) {
+
+	orig := s
+	s = strings.TrimSpace(s)
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.Atoi(s[i+1:])
+		if err != nil || exp > decimalMaxExp || exp < -decimalMaxExp {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+		}
+		s = s[:i]
+	}
+
+	neg := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+		}
+	}
+
+	unscaled, _ := new(big.Int).SetString("0"+intPart+fracPart, 10)
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	scale := len(fracPart) - exp
+	if scale > decimalMaxExp {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+
+	return Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on error.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int { return d.scale }
+
+// Sign returns -1, 0 or 1 depending on the sign of d.
+func (d Decimal) Sign() int { return d.int().Sign() }
+
+// IsZero returns true if d is 0 (at any scale).
+func (d Decimal) IsZero() bool { return d.Sign() == 0 }
+
+// Rat returns d as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(d.scale))
+}
+
+// String returns d in plain notation with Scale() digits after the decimal point.
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if len(s) <= d.scale {
+			s = strings.Repeat("0", d.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if d.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// rescale returns the unscaled value of d at a scale larger than or equal to its own.
+func (d Decimal) rescale(scale int) *big.Int {
+	if scale == d.scale {
+		return d.int()
+	}
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+func maxScale(a, b Decimal) int {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+// Cmp compares d and o and returns -1, 0 or 1, regardless of scale ("1.50" equals "1.5").
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d, o)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Add returns d + o, with the larger of the two scales.
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Sub returns d - o, with the larger of the two scales.
+func (d Decimal) Sub(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Sub(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Mul returns d * o exactly, the scale is the sum of the two scales.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Round returns d with scale digits after the decimal point, rounding half away from zero.
+func (d Decimal) Round(scale int) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if scale >= d.scale {
+		return Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	return Decimal{unscaled: quoRound(d.int(), pow10(d.scale-scale)), scale: scale}
+}
+
+// quoRound returns n/m rounded half away from zero, m must be positive.
+func quoRound(n, m *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, m, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(m) >= 0 {
+		if n.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+func (d *Decimal) Scan(value interface{}) error {
+
+	var s string
+
+	switch v := value.(type) {
+	case nil:
+		*d = Decimal{}
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		*d = NewDecimal(v, 0)
+		return nil
+	case float64:
+		// some drivers return floats for numeric columns, use the shortest exact representation
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("cannot convert from sql driver type %T to Decimal", value)
+	}
+
+	ret, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = ret
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	ret, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = ret
+	return nil
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		b = []byte(s)
+	}
+	return d.UnmarshalText(b)
+}
@@ -0,0 +1,105 @@
+package dbutil
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDecimal(t *testing.T) {
+
+	assert := assert.New(t)
+
+	for in, out := range map[string]string{
+		"0":         "0",
+		"12.50":     "12.50",
+		"-0.05":     "-0.05",
+		".5":        "0.5",
+		"+3.":       "3",
+		"1.2e3":     "1200",
+		"1.25E-3":   "0.00125",
+		"0012.3400": "12.3400",
+		"123456789012345678901234567890.123456789": "123456789012345678901234567890.123456789",
+	} {
+		d, err := ParseDecimal(in)
+		assert.NoError(err, in)
+		assert.Equal(out, d.String(), in)
+	}
+	for _, in := range []string{"", "-", "1.2.3", "abc", "1e", "0x10", "1,5",
+		"1e1000000000", "1e-1000000000", "-5E+99999999999", "0." + strings.Repeat("0", 2000) + "1"} {
+		_, err := ParseDecimal(in)
+		assert.Error(err, in)
+	}
+	assert.Error(json.Unmarshal([]byte(`1e1000000000`), new(Decimal)))
+	assert.Error(json.Unmarshal([]byte(`"1e-1000000000"`), new(Decimal)))
+
+	assert.Equal("0", Decimal{}.String())
+	assert.Equal("12.50", NewDecimal(1250, 2).String())
+	assert.Equal("1200", NewDecimal(12, -2).String())
+
+	// no floating point error
+	a, b := MustParseDecimal("0.1"), MustParseDecimal("0.2")
+	assert.Equal("0.3", a.Add(b).String())
+	assert.Equal(0, a.Add(b).Cmp(MustParseDecimal("0.30")))
+	assert.Equal("-0.1", a.Sub(b).String())
+	assert.Equal("0.02", a.Mul(b).String())
+	assert.Equal(-1, a.Cmp(b))
+
+	assert.Equal("2.35", MustParseDecimal("2.345").Round(2).String())
+	assert.Equal("-2.35", MustParseDecimal("-2.345").Round(2).String())
+	assert.Equal("2.34", MustParseDecimal("2.3449").Round(2).String())
+	assert.Equal("2.3450", MustParseDecimal("2.345").Round(4).String())
+
+	assert.Equal("0.3333", DecimalFromRat(big.NewRat(1, 3), 4).String())
+	assert.Equal("-0.6667", DecimalFromRat(big.NewRat(-2, 3), 4).String())
+	assert.Equal(big.NewRat(5, 4), MustParseDecimal("1.250").Rat())
+
+	// JSON
+	var v struct {
+		A Decimal `json:"a"`
+		B Decimal `json:"b"`
+		C Decimal `json:"c"`
+	}
+	assert.NoError(json.Unmarshal([]byte(`{"a":"10.10","b":0.1,"c":null}`), &v))
+	assert.Equal("10.10", v.A.String())
+	assert.Equal("0.1", v.B.String())
+	assert.True(v.C.IsZero())
+	bs, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"a":"10.10","b":"0.1","c":"0"}`, string(bs))
+	assert.Error(json.Unmarshal([]byte(`{"a":"ten"}`), &v))
+
+}
+
+func TestDecimalSQL(t *testing.T) {
+
+	assert := assert.New(t)
+
+	db, err := sql.Open("sqlite3", `file:TestDecimalSQL?mode=memory&cache=shared`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE amounts (id INTEGER, amount TEXT NULL)`)
+	assert.NoError(err)
+
+	amt := MustParseDecimal("98765432109876543210.0123456789")
+	_, err = db.Exec(`INSERT INTO amounts (id, amount) VALUES (1, ?), (2, NULL), (3, 42)`, amt)
+	assert.NoError(err)
+
+	var d Decimal
+	assert.NoError(db.QueryRow(`SELECT amount FROM amounts WHERE id = 1`).Scan(&d))
+	assert.Equal(amt.String(), d.String())
+	assert.NoError(db.QueryRow(`SELECT amount FROM amounts WHERE id = 2`).Scan(&d))
+	assert.True(d.IsZero())
+	assert.NoError(db.QueryRow(`SELECT CAST(amount AS INTEGER) FROM amounts WHERE id = 3`).Scan(&d))
+	assert.Equal("42", d.String())
+
+}
@@ -1,24 +1,739 @@
 // Tooling to take flat files and synchronize them with a sqlite database for easy access use of
 // large amounts of static data, generally versioned with the website project.
+//
+// A Table is given a Go struct type and a path.  If the path is a directory each YAML or JSON
+// file in it is one record, otherwise the path is a single file containing a list of records.
+// The records are loaded into a sqlite table (in memory by default) with columns made from the
+// struct fields, using the same "db" tags and naming as dbr, so the data can be queried like
+// any other table.  Fields of types such as dbutil.StringValueList and dbutil.StringObjMap,
+// which implement driver.Valuer, are stored in TEXT columns and work transparently.
+// Optionally the files are watched for changes and reloaded, and writes through the Table
+// update both the database and the file the record came from.
 package file2sqlite
 
-// developer provides a struct, can be unmarshaled from yaml or json or other
-// struct also maps to db columns - using sqlx
-// one way is one file per record
-// another is one file with an array with a bunch of records
-// either way it loads into in mrmory or tempfile sqlite db and then becomes
-// queryable with sqlx
-// the idea is it gets run at startup and you can make flat files into db
-// tables easily
-// optional writing allows writes to update sqlite and also update backing file
-// - see if there's a way to do this transparently but don't die over it -
-//   think through how this would be used with a controller; maybe deserving
-//   of some template generation stuff
-// think about how this works with the page index - will scan view files
-// reading the meta blocks and index them in a table like this - will need
-// to be able to customize that; and then use the same approach as if
-// things were fully database driven - this means that we probably don't
-// want to mix and match ORMs - using the same one here will help us a lot;
-// or possibly this package works completely with database/sql and/or sqlx
-// but then if someone uses a gorm object on top of it that will work just
-// fine - that could work well too
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gocaveman/caveman/dbutil"
+	"github.com/gocaveman/caveman/filesystem"
+	"github.com/gocraft/dbr"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var ErrNotFound = dbutil.ErrNotFound
+
+// FileColumn is the name of the extra column which records the file each record was loaded from.
+const FileColumn = "_file"
+
+// Table loads the records in a directory of files (or a single file) into a sqlite table.
+// Call Open before use and Close when done.
+type Table struct {
+	FileSystem filesystem.FileSystem // where the files are
+	Path       string                // directory with one file per record, or a single file with a list of records
+	Record     interface{}           // struct value (or pointer to one) of the record type, e.g. Page{}
+	TableName  string                // name of the sqlite table
+	KeyColumn  string                // primary key column, required for writes
+	DBDSN      string                // sqlite3 data source, defaults to a shared in-memory database named after TableName
+
+	PollInterval time.Duration // if > 0, how often to check the files for changes and reload
+	Writable     bool          // allow Insert, Update and Delete
+	FileExt      string        // extension for new files in a directory, defaults to ".yaml"
+
+	conn       *dbr.Connection
+	recordType reflect.Type
+	columns    []column
+	rwmu       sync.RWMutex // held for writing while loading or writing files
+	stamps     map[string]fileStamp
+	stopPoll   chan struct{}
+}
+
+type column struct {
+	name    string
+	index   []int // field index path for reflect.Value.FieldByIndex
+	sqlType string
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// Open creates the table, loads the files and starts polling for changes if PollInterval is set.
+func (t *Table) Open() error {
+
+	if t.FileSystem == nil || t.Path == "" || t.Record == nil || t.TableName == "" {
+		return fmt.Errorf("file2sqlite: FileSystem, Path, Record and TableName are required")
+	}
+	if t.Writable && t.KeyColumn == "" {
+		return fmt.Errorf("file2sqlite: KeyColumn is required for a Writable table")
+	}
+	if t.FileExt == "" {
+		t.FileExt = ".yaml"
+	}
+	if t.DBDSN == "" {
+		t.DBDSN = "file:file2sqlite_" + t.TableName + "?mode=memory&cache=shared"
+	}
+
+	t.recordType = reflect.TypeOf(t.Record)
+	if t.recordType.Kind() == reflect.Ptr {
+		t.recordType = t.recordType.Elem()
+	}
+	if t.recordType.Kind() != reflect.Struct {
+		return fmt.Errorf("file2sqlite: Record must be a struct, not %v", t.recordType)
+	}
+	t.columns = structColumns(t.recordType, nil)
+	if t.KeyColumn != "" && t.column(t.KeyColumn) == nil {
+		return fmt.Errorf("file2sqlite: KeyColumn %q is not a column of %v", t.KeyColumn, t.recordType)
+	}
+
+	var err error
+	t.conn, err = dbr.Open("sqlite3", t.DBDSN, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.conn.Exec(t.createTableSQL())
+	if err != nil {
+		return err
+	}
+
+	err = t.Reload()
+	if err != nil {
+		return err
+	}
+
+	if t.PollInterval > 0 {
+		t.stopPoll = make(chan struct{})
+		go t.pollLoop(t.stopPoll)
+	}
+
+	return nil
+}
+
+// Close stops polling and closes the database connection.
+func (t *Table) Close() error {
+	if t.stopPoll != nil {
+		close(t.stopPoll)
+		t.stopPoll = nil
+	}
+	if t.conn != nil {
+		return t.conn.Close()
+	}
+	return nil
+}
+
+// Conn returns the database connection.
+func (t *Table) Conn() *dbr.Connection {
+	return t.conn
+}
+
+// Session returns a new dbr.Session for queries, e.g.
+// t.Session().Select("*").From(t.TableName).Where("path=?", p).LoadOne(&page)
+func (t *Table) Session() *dbr.Session {
+	return t.conn.NewSession(nil)
+}
+
+func (t *Table) column(name string) *column {
+	for i := range t.columns {
+		if t.columns[i].name == name {
+			return &t.columns[i]
+		}
+	}
+	return nil
+}
+
+func (t *Table) columnNames() []string {
+	ret := make([]string, 0, len(t.columns))
+	for _, c := range t.columns {
+		ret = append(ret, c.name)
+	}
+	return ret
+}
+
+var (
+	typeValuer = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	typeTime   = reflect.TypeOf(time.Time{})
+)
+
+// structColumns returns the columns for a struct type, following the same rules as dbr.
+func structColumns(rt reflect.Type, index []int) []column {
+	var ret []column
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if f.PkgPath != "" && !f.Anonymous { // unexported
+			continue
+		}
+		tag := f.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		fidx := append(append([]int(nil), index...), i)
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct && !reflect.PtrTo(f.Type).Implements(typeValuer) {
+			ret = append(ret, structColumns(f.Type, fidx)...)
+			continue
+		}
+		if tag == "" {
+			tag = dbr.NameMapping(f.Name)
+		}
+		ret = append(ret, column{name: tag, index: fidx, sqlType: sqlType(f.Type)})
+	}
+	return ret
+}
+
+func sqlType(ft reflect.Type) string {
+	if ft.Implements(typeValuer) || reflect.PtrTo(ft).Implements(typeValuer) {
+		return "TEXT"
+	}
+	if ft == typeTime {
+		return "DATETIME"
+	}
+	if ft.Kind() == reflect.Ptr {
+		return sqlType(ft.Elem())
+	}
+	switch ft.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "INTEGER"
+	case reflect.Float32, reflect.Float64:
+		return "REAL"
+	case reflect.Slice:
+		if ft.Elem().Kind() == reflect.Uint8 {
+			return "BLOB"
+		}
+	}
+	return "TEXT"
+}
+
+func (t *Table) createTableSQL() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE TABLE IF NOT EXISTS %s (\n", quoteIdent(t.TableName))
+	for _, c := range t.columns {
+		fmt.Fprintf(&buf, "\t%s %s,\n", quoteIdent(c.name), c.sqlType)
+	}
+	fmt.Fprintf(&buf, "\t%s TEXT", quoteIdent(FileColumn))
+	if t.KeyColumn != "" {
+		fmt.Fprintf(&buf, ",\n\tPRIMARY KEY (%s)", quoteIdent(t.KeyColumn))
+	}
+	buf.WriteString("\n)")
+	return buf.String()
+}
+
+func quoteIdent(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+// isDir returns true if Path is a directory of record files.
+func (t *Table) isDir() (bool, error) {
+	fi, err := t.FileSystem.Stat(t.Path)
+	if err != nil {
+		return false, err
+	}
+	return fi.IsDir(), nil
+}
+
+func isRecordFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// recordFiles returns the file names to load, sorted.
+func (t *Table) recordFiles() ([]string, error) {
+	dir, err := t.isDir()
+	if err != nil {
+		return nil, err
+	}
+	if !dir {
+		return []string{t.Path}, nil
+	}
+	f, err := t.FileSystem.Open(t.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fis, err := f.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, fi := range fis {
+		if fi.IsDir() || !isRecordFile(fi.Name()) {
+			continue
+		}
+		ret = append(ret, path.Join(t.Path, fi.Name()))
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (t *Table) readFile(name string) ([]byte, error) {
+	f, err := t.FileSystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+func isJSON(name string) bool {
+	return strings.ToLower(path.Ext(name)) == ".json"
+}
+
+// decodeRecords reads the records from a file, a list unless it is one file per record.
+func (t *Table) decodeRecords(name string, list bool) ([]reflect.Value, error) {
+
+	b, err := t.readFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	unmarshal := func(b []byte, v interface{}) error {
+		err := yaml.Unmarshal(b, v)
+		normalizeYAML(reflect.ValueOf(v))
+		return err
+	}
+	if isJSON(name) {
+		unmarshal = json.Unmarshal
+	}
+
+	if !list {
+		rv := reflect.New(t.recordType)
+		err = unmarshal(b, rv.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("file2sqlite: error reading %q: %v", name, err)
+		}
+		return []reflect.Value{rv.Elem()}, nil
+	}
+
+	lv := reflect.New(reflect.SliceOf(t.recordType))
+	err = unmarshal(b, lv.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("file2sqlite: error reading %q: %v", name, err)
+	}
+	ret := make([]reflect.Value, 0, lv.Elem().Len())
+	for i := 0; i < lv.Elem().Len(); i++ {
+		ret = append(ret, lv.Elem().Index(i))
+	}
+	return ret, nil
+}
+
+// Reload replaces the contents of the table with what is in the files.
+func (t *Table) Reload() error {
+
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	dir, err := t.isDir()
+	if err != nil {
+		return err
+	}
+	names, err := t.recordFiles()
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.Session().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.DeleteFrom(t.TableName).Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		recs, err := t.decodeRecords(name, !dir)
+		if err != nil {
+			return err
+		}
+		for _, rv := range recs {
+			err = t.insertRow(tx, rv, name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	t.stamps, err = t.takeStamps()
+	return err
+}
+
+func (t *Table) insertRow(tx *dbr.Tx, rv reflect.Value, fileName string) error {
+	vals := make([]interface{}, 0, len(t.columns)+1)
+	for _, c := range t.columns {
+		vals = append(vals, rv.FieldByIndex(c.index).Interface())
+	}
+	vals = append(vals, fileName)
+	_, err := tx.InsertInto(t.TableName).
+		Columns(append(t.columnNames(), FileColumn)...).
+		Values(vals...).
+		Exec()
+	return err
+}
+
+func (t *Table) takeStamps() (map[string]fileStamp, error) {
+	names, err := t.recordFiles()
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]fileStamp, len(names))
+	for _, name := range names {
+		fi, err := t.FileSystem.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		ret[name] = fileStamp{modTime: fi.ModTime(), size: fi.Size()}
+	}
+	return ret, nil
+}
+
+// Changed returns true if the files have changed since they were last loaded.
+func (t *Table) Changed() (bool, error) {
+	stamps, err := t.takeStamps()
+	if err != nil {
+		return false, err
+	}
+	t.rwmu.RLock()
+	defer t.rwmu.RUnlock()
+	return !reflect.DeepEqual(stamps, t.stamps), nil
+}
+
+func (t *Table) pollLoop(stop chan struct{}) {
+	tk := time.NewTicker(t.PollInterval)
+	defer tk.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-tk.C:
+			changed, err := t.Changed()
+			if err == nil && changed {
+				err = t.Reload()
+			}
+			if err != nil {
+				log.Printf("file2sqlite: error reloading table %q from %q: %v", t.TableName, t.Path, err)
+			}
+		}
+	}
+}
+
+// ErrNotWritable is returned by write methods if Writable is not set.
+var ErrNotWritable = fmt.Errorf("file2sqlite: table is not writable")
+
+// recordValue returns the struct value of rec, which must be the record type or a pointer to it.
+func (t *Table) recordValue(rec interface{}) (reflect.Value, error) {
+	rv := reflect.Indirect(reflect.ValueOf(rec))
+	if rv.Type() != t.recordType {
+		return rv, fmt.Errorf("file2sqlite: record is %v, expected %v", rv.Type(), t.recordType)
+	}
+	return rv, nil
+}
+
+func (t *Table) keyValue(rv reflect.Value) interface{} {
+	return rv.FieldByIndex(t.column(t.KeyColumn).index).Interface()
+}
+
+// fileOf returns the file the record with key was loaded from, ErrNotFound if no such record.
+func (t *Table) fileOf(sess dbr.SessionRunner, key interface{}) (string, error) {
+	var fileName string
+	err := sess.Select(quoteIdent(FileColumn)).From(t.TableName).
+		Where(quoteIdent(t.KeyColumn)+"=?", key).LoadOne(&fileName)
+	if err == dbr.ErrNotFound {
+		return "", ErrNotFound
+	}
+	return fileName, err
+}
+
+// Insert adds a record.  If Path is a directory it is written to a new file named after the key,
+// otherwise it is appended to the file.
+func (t *Table) Insert(rec interface{}) error {
+
+	if !t.Writable {
+		return ErrNotWritable
+	}
+	rv, err := t.recordValue(rec)
+	if err != nil {
+		return err
+	}
+
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	dir, err := t.isDir()
+	if err != nil {
+		return err
+	}
+	fileName := t.Path
+	if dir {
+		key := fmt.Sprint(t.keyValue(rv))
+		if key == "" || strings.ContainsAny(key, `/\`) || strings.Contains(key, "..") {
+			return fmt.Errorf("file2sqlite: key %q cannot be used as a file name", key)
+		}
+		fileName = path.Join(t.Path, key+t.FileExt)
+	}
+
+	tx, err := t.Session().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	err = t.insertRow(tx, rv, fileName)
+	if err != nil {
+		return err
+	}
+	b, err := t.encodeFile(tx, fileName, !dir)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return t.writeFile(fileName, b)
+}
+
+// Update replaces the record with the same key, in the database and in the file it came from.
+// ErrNotFound is returned if there is no such record.
+func (t *Table) Update(rec interface{}) error {
+
+	if !t.Writable {
+		return ErrNotWritable
+	}
+	rv, err := t.recordValue(rec)
+	if err != nil {
+		return err
+	}
+
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	dir, err := t.isDir()
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.Session().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	key := t.keyValue(rv)
+	fileName, err := t.fileOf(tx, key)
+	if err != nil {
+		return err
+	}
+
+	stmt := tx.Update(t.TableName).Where(quoteIdent(t.KeyColumn)+"=?", key)
+	for _, c := range t.columns {
+		stmt = stmt.Set(c.name, rv.FieldByIndex(c.index).Interface())
+	}
+	_, err = stmt.Exec()
+	if err != nil {
+		return err
+	}
+
+	b, err := t.encodeFile(tx, fileName, !dir)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return t.writeFile(fileName, b)
+}
+
+// Delete removes the record with key, removing its file or rewriting the list it was in.
+// ErrNotFound is returned if there is no such record.
+func (t *Table) Delete(key interface{}) error {
+
+	if !t.Writable {
+		return ErrNotWritable
+	}
+
+	t.rwmu.Lock()
+	defer t.rwmu.Unlock()
+
+	dir, err := t.isDir()
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.Session().Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	fileName, err := t.fileOf(tx, key)
+	if err != nil {
+		return err
+	}
+	_, err = tx.DeleteFrom(t.TableName).Where(quoteIdent(t.KeyColumn)+"=?", key).Exec()
+	if err != nil {
+		return err
+	}
+
+	if dir {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+		delete(t.stamps, fileName)
+		return t.FileSystem.Remove(fileName)
+	}
+
+	b, err := t.encodeFile(tx, fileName, true)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return t.writeFile(fileName, b)
+}
+
+// encodeFile returns the contents of a file from its records in the database, must be called
+// with rwmu locked.  The file is written with writeFile once the transaction is committed, so
+// the file is not changed if the commit fails.
+func (t *Table) encodeFile(tx *dbr.Tx, fileName string, list bool) ([]byte, error) {
+
+	lv := reflect.New(reflect.SliceOf(t.recordType))
+	cols := make([]string, 0, len(t.columns))
+	for _, c := range t.columns {
+		cols = append(cols, quoteIdent(c.name))
+	}
+	_, err := tx.Select(cols...).From(t.TableName).
+		Where(quoteIdent(FileColumn)+"=?", fileName).
+		OrderAsc("rowid").
+		Load(lv.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{} = lv.Interface()
+	if !list {
+		if lv.Elem().Len() != 1 {
+			return nil, fmt.Errorf("file2sqlite: expected 1 record for %q, found %d", fileName, lv.Elem().Len())
+		}
+		out = lv.Elem().Index(0).Interface()
+	}
+
+	if isJSON(fileName) {
+		return json.MarshalIndent(out, "", "\t")
+	}
+	return yaml.Marshal(out)
+}
+
+// writeFile replaces the contents of a file, via a temporary file which is renamed over it,
+// must be called with rwmu locked.
+func (t *Table) writeFile(fileName string, b []byte) error {
+
+	tmpName := path.Join(path.Dir(fileName), "."+path.Base(fileName)+".tmp")
+	f, err := t.FileSystem.OpenFile(tmpName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if err != nil {
+		f.Close()
+		t.FileSystem.Remove(tmpName)
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		t.FileSystem.Remove(tmpName)
+		return err
+	}
+	err = t.FileSystem.Rename(tmpName, fileName)
+	if err != nil {
+		t.FileSystem.Remove(tmpName)
+		return err
+	}
+
+	// record the new stamp so polling does not reload our own change
+	fi, err := t.FileSystem.Stat(fileName)
+	if err != nil {
+		return err
+	}
+	if t.stamps == nil {
+		t.stamps = make(map[string]fileStamp)
+	}
+	t.stamps[fileName] = fileStamp{modTime: fi.ModTime(), size: fi.Size()}
+	return nil
+}
+
+// normalizeYAML converts the map[interface{}]interface{} values yaml.v2 produces for
+// nested maps in interface{} fields to map[string]interface{}, so they can be encoded
+// as JSON (e.g. by dbutil.StringObjMap).
+func normalizeYAML(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			normalizeYAML(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				normalizeYAML(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			normalizeYAML(v.Index(i))
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Interface {
+			return
+		}
+		for _, k := range v.MapKeys() {
+			v.SetMapIndex(k, reflect.ValueOf(normalizeYAMLValue(v.MapIndex(k).Interface())))
+		}
+	case reflect.Interface:
+		if !v.IsNil() && v.CanSet() {
+			v.Set(reflect.ValueOf(normalizeYAMLValue(v.Interface())))
+		}
+	}
+}
+
+func normalizeYAMLValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, mv := range t {
+			m[fmt.Sprint(k)] = normalizeYAMLValue(mv)
+		}
+		return m
+	case []interface{}:
+		for i := range t {
+			t[i] = normalizeYAMLValue(t[i])
+		}
+		return t
+	}
+	return v
+}
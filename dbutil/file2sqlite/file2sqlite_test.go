@@ -2,63 +2,183 @@ package file2sqlite
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gocaveman/caveman/dbutil"
-	"github.com/jinzhu/gorm"
+	"github.com/gocaveman/caveman/filesystem/aferofs"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
-// need a pattern of building Page (or whatever) structs out of pages - Meta should probably
-// go in as a JSON field (to be figured out - marshaling is a bit difficult, since Meta should
-// also be able to be replaced if needed), Page struct
-// should of course have a default but also dev should be able to completely replace struct
-// with no issues - if they want more fields or to change stuff, default should implement
-// category and tags but this is just a basic ref impl - user could embed this struct and
-// add more fields or as above just replace entirely - Go template code would rely on field
-// names so if no Category field then those bits that reference it would fail, but otherwise
-// things should work correctly; this is a special case but is really just a complex example
-// of real life usage of file2sqlite
-
+// Page is the kind of thing that would be built from the meta of each page, in practice
+// the developer provides whatever struct makes sense for their data.
 type Page struct {
-	ID    int
-	Title string
-	Path  string
-	Tags  dbutil.StringValueList `gorm:"type:text"`
+	ID    int                    `db:"id" yaml:"id" json:"id"`
+	Title string                 `db:"title" yaml:"title" json:"title"`
+	Path  string                 `db:"path" yaml:"path" json:"path"`
+	Tags  dbutil.StringValueList `db:"tags" yaml:"tags" json:"tags"`
+	Meta  dbutil.StringObjMap    `db:"meta" yaml:"meta" json:"meta"`
 }
 
-func TestF2S(t *testing.T) {
+func TestTableDir(t *testing.T) {
+
+	assert := assert.New(t)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(fs.MkdirAll("/data/pages", 0755))
+	assert.NoError(afero.WriteFile(fs, "/data/pages/1.yaml", []byte(`
+id: 1
+title: Example 1
+path: /example1
+tags: [tag1, tag2]
+meta:
+  author: joe
+  extra:
+    nested: true
+`), 0644))
+	assert.NoError(afero.WriteFile(fs, "/data/pages/2.json", []byte(`{"id":2,"title":"Example 2","path":"/example2","tags":["tag2"]}`), 0644))
+
+	tbl := &Table{
+		FileSystem:   aferofs.New(fs),
+		Path:         "/data/pages",
+		Record:       Page{},
+		TableName:    "page",
+		KeyColumn:    "id",
+		DBDSN:        "file:TestTableDir?mode=memory&cache=shared",
+		PollInterval: 10 * time.Millisecond,
+		Writable:     true,
+	}
+	assert.NoError(tbl.Open())
+	defer tbl.Close()
+
+	var p Page
+	assert.NoError(tbl.Session().Select("*").From("page").Where("path=?", "/example1").LoadOne(&p))
+	assert.Equal("Example 1", p.Title)
+	assert.Equal(dbutil.StringValueList{"tag1", "tag2"}, p.Tags)
+	assert.Equal("joe", p.Meta["author"])
+	assert.Equal(map[string]interface{}{"nested": true}, p.Meta["extra"])
+
+	var pages []Page
+	_, err := tbl.Session().Select("*").From("page").Where("tags LIKE ?", `%"tag2"%`).OrderAsc("id").Load(&pages)
+	assert.NoError(err)
+	assert.Len(pages, 2)
 
-	connstr := `file:file2sqlite?mode=memory&cache=shared`
+	// writes go to the file the record came from
+	pages[1].Title = "Example 2 Updated"
+	assert.NoError(tbl.Update(&pages[1]))
+	b, err := afero.ReadFile(fs, "/data/pages/2.json")
+	assert.NoError(err)
+	assert.Contains(string(b), "Example 2 Updated")
 
-	// rawdb, err := sql.Open("sqlite3", connstr)
-	// if err != nil {
-	// 	t.Fatal(err)
-	// }
-	// defer rawdb.Close()
+	assert.NoError(tbl.Insert(Page{ID: 3, Title: "Example 3", Path: "/example3"}))
+	b, err = afero.ReadFile(fs, "/data/pages/3.yaml")
+	assert.NoError(err)
+	assert.Contains(string(b), "title: Example 3")
 
-	// _, err = rawdb.Exec(`CREATE TABLE foo (id INTEGER NOT NULL PRIMARY KEY, name TEXT)`)
-	// if err != nil {
-	// 	t.Fatal(err)
-	// }
+	assert.NoError(tbl.Delete(1))
+	_, err = fs.Stat("/data/pages/1.yaml")
+	assert.Error(err)
+	assert.Equal(ErrNotFound, tbl.Delete(1))
 
-	db, err := gorm.Open("sqlite3", connstr)
-	if err != nil {
-		t.Fatal(err)
+	// changes to the files are picked up
+	assert.NoError(afero.WriteFile(fs, "/data/pages/4.yaml", []byte("id: 4\ntitle: Example 4\n"), 0644))
+	var count int
+	for i := 0; i < 100; i++ {
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(tbl.Session().Select("count(*)").From("page").LoadOne(&count))
+		if count == 3 {
+			break
+		}
 	}
-	defer db.Close()
+	assert.Equal(3, count)
 
-	if errs := db.AutoMigrate(&Page{}).GetErrors(); len(errs) > 0 {
-		t.Fatal(errs)
+}
+
+func TestTableList(t *testing.T) {
+
+	assert := assert.New(t)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(afero.WriteFile(fs, "/pages.yaml", []byte(`
+- id: 1
+  title: Example 1
+- id: 2
+  title: Example 2
+`), 0644))
+
+	tbl := &Table{
+		FileSystem: aferofs.New(fs),
+		Path:       "/pages.yaml",
+		Record:     Page{},
+		TableName:  "page",
+		KeyColumn:  "id",
+		DBDSN:      "file:TestTableList?mode=memory&cache=shared",
 	}
+	assert.NoError(tbl.Open())
+	defer tbl.Close()
+
+	var titles []string
+	_, err := tbl.Session().Select("title").From("page").OrderAsc("id").Load(&titles)
+	assert.NoError(err)
+	assert.Equal([]string{"Example 1", "Example 2"}, titles)
 
-	if errs := db.Create(&Page{ID: 0, Title: "example 123", Path: "/example123", Tags: []string{"tag1", "tag2"}}).GetErrors(); len(errs) > 0 {
-		t.Fatal(errs)
+	assert.Equal(ErrNotWritable, tbl.Delete(1))
+	tbl.Writable = true
+
+	assert.NoError(tbl.Delete(1))
+	assert.NoError(tbl.Insert(Page{ID: 5, Title: "Example 5"}))
+	assert.NoError(tbl.Reload())
+
+	titles = nil
+	_, err = tbl.Session().Select("title").From("page").OrderAsc("id").Load(&titles)
+	assert.NoError(err)
+	assert.Equal([]string{"Example 2", "Example 5"}, titles)
+
+}
+
+func TestTableDirKeys(t *testing.T) {
+
+	assert := assert.New(t)
+
+	type Snippet struct {
+		Name string `db:"name" yaml:"name" json:"name"`
+		Body string `db:"body" yaml:"body" json:"body"`
+	}
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(fs.MkdirAll("/data/snippets", 0755))
+
+	tbl := &Table{
+		FileSystem: aferofs.New(fs),
+		Path:       "/data/snippets",
+		Record:     Snippet{},
+		TableName:  "snippet",
+		KeyColumn:  "name",
+		DBDSN:      "file:TestTableDirKeys?mode=memory&cache=shared",
+		Writable:   true,
 	}
+	assert.NoError(tbl.Open())
+	defer tbl.Close()
 
-	p := Page{}
-	db.First(&p, 1)
+	// keys which would put the file somewhere else are refused
+	for _, name := range []string{"../x", "a/b", `a\b`, "..", ""} {
+		assert.Error(tbl.Insert(Snippet{Name: name}), name)
+	}
+	_, err := fs.Stat("/data/x.yaml")
+	assert.Error(err)
 
-	t.Logf("p = %#v", p)
+	// and no temporary files are left behind
+	assert.NoError(tbl.Insert(Snippet{Name: "footer", Body: "(c)"}))
+	assert.NoError(tbl.Update(Snippet{Name: "footer", Body: "(c) 2018"}))
+	names, err := afero.ReadDir(fs, "/data/snippets")
+	assert.NoError(err)
+	if assert.Len(names, 1) {
+		assert.Equal("footer.yaml", names[0].Name())
+	}
+	var count int
+	assert.NoError(tbl.Session().Select("count(*)").From("snippet").LoadOne(&count))
+	assert.Equal(1, count)
 
 }
@@ -0,0 +1,11 @@
+package dbutil
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// LikePrefix returns a LIKE pattern which matches strings starting with prefix.
+// The wildcards in prefix are escaped with "!", so the query must say ESCAPE '!'.
+func LikePrefix(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
@@ -48,3 +48,162 @@ func (s *AlterTableAddStmt) CaseSensitive() *AlterTableAddStmt {
 	s.DataTypeDef.CaseSensitiveValue = true
 	return s
 }
+
+// Precision sets the total number of digits of a Decimal column.
+func (s *AlterTableAddStmt) Precision(precision int) *AlterTableAddStmt {
+	s.DataTypeDef.PrecisionValue = precision
+	return s
+}
+
+// Scale sets the number of digits after the decimal point of a Decimal column.
+func (s *AlterTableAddStmt) Scale(scale int) *AlterTableAddStmt {
+	s.DataTypeDef.ScaleValue = scale
+	s.DataTypeDef.ScaleSet = true
+	return s
+}
+
+// AlterTableDropColumnStmt drops a column from a table.
+type AlterTableDropColumnStmt struct {
+	*Builder
+
+	NameValue   string // table name
+	ColumnValue string
+}
+
+func (s *AlterTableDropColumnStmt) IsStmt() {}
+
+// AlterTableRenameColumnStmt renames a column.
+type AlterTableRenameColumnStmt struct {
+	*Builder
+
+	NameValue      string // table name
+	OldColumnValue string
+	NewColumnValue string
+}
+
+func (s *AlterTableRenameColumnStmt) IsStmt() {}
+
+// AlterTableModifyStmt changes the definition (type, null, default, etc.) of an existing
+// column.  The new definition replaces the old one entirely, like MySQL's MODIFY COLUMN.
+type AlterTableModifyStmt struct {
+	*Builder
+
+	NameValue string // table name
+
+	DataTypeDef DataTypeDef
+}
+
+func (s *AlterTableModifyStmt) IsStmt() {}
+
+func (s *AlterTableModifyStmt) Column(name string, dataType DataType) *AlterTableModifyStmt {
+	s.DataTypeDef = DataTypeDef{NameValue: name, DataTypeValue: dataType}
+	return s
+}
+
+func (s *AlterTableModifyStmt) ColumnCustom(name, customSQL string) *AlterTableModifyStmt {
+	s.DataTypeDef = DataTypeDef{NameValue: name, DataTypeValue: Custom, CustomSQLValue: customSQL}
+	return s
+}
+
+func (s *AlterTableModifyStmt) Null() *AlterTableModifyStmt {
+	s.DataTypeDef.NullValue = true
+	return s
+}
+
+func (s *AlterTableModifyStmt) Default(value interface{}) *AlterTableModifyStmt {
+	s.DataTypeDef.DefaultValue = value
+	return s
+}
+
+func (s *AlterTableModifyStmt) Length(length int) *AlterTableModifyStmt {
+	s.DataTypeDef.LengthValue = length
+	return s
+}
+
+func (s *AlterTableModifyStmt) CaseSensitive() *AlterTableModifyStmt {
+	s.DataTypeDef.CaseSensitiveValue = true
+	return s
+}
+
+func (s *AlterTableModifyStmt) Precision(precision int) *AlterTableModifyStmt {
+	s.DataTypeDef.PrecisionValue = precision
+	return s
+}
+
+func (s *AlterTableModifyStmt) Scale(scale int) *AlterTableModifyStmt {
+	s.DataTypeDef.ScaleValue = scale
+	s.DataTypeDef.ScaleSet = true
+	return s
+}
+
+// AlterTableAddForeignKeyStmt adds a named foreign key constraint.
+type AlterTableAddForeignKeyStmt struct {
+	*Builder
+
+	NameValue string // table name
+
+	ForeignKey CreateTableFKDef
+}
+
+func (s *AlterTableAddForeignKeyStmt) IsStmt() {}
+
+// Column sets the column in this table which refers to the other table.
+func (s *AlterTableAddForeignKeyStmt) Column(name string) *AlterTableAddForeignKeyStmt {
+	s.ForeignKey.ColumnValue = name
+	return s
+}
+
+// References sets the table and column referred to.
+func (s *AlterTableAddForeignKeyStmt) References(otherTable, otherColumn string) *AlterTableAddForeignKeyStmt {
+	s.ForeignKey.OtherTableValue = otherTable
+	s.ForeignKey.OtherColumnValue = otherColumn
+	return s
+}
+
+// OnDelete sets the ON DELETE action.
+func (s *AlterTableAddForeignKeyStmt) OnDelete(action FKAction) *AlterTableAddForeignKeyStmt {
+	s.ForeignKey.OnDeleteValue = action
+	return s
+}
+
+// OnUpdate sets the ON UPDATE action.
+func (s *AlterTableAddForeignKeyStmt) OnUpdate(action FKAction) *AlterTableAddForeignKeyStmt {
+	s.ForeignKey.OnUpdateValue = action
+	return s
+}
+
+// AlterTableDropForeignKeyStmt drops a named foreign key constraint.
+type AlterTableDropForeignKeyStmt struct {
+	*Builder
+
+	NameValue           string // table name
+	ForeignKeyNameValue string
+}
+
+func (s *AlterTableDropForeignKeyStmt) IsStmt() {}
+
+// AlterTableAddUniqueStmt adds a named unique constraint.
+type AlterTableAddUniqueStmt struct {
+	*Builder
+
+	NameValue string // table name
+
+	Unique UniqueDef
+}
+
+func (s *AlterTableAddUniqueStmt) IsStmt() {}
+
+func (s *AlterTableAddUniqueStmt) Columns(name ...string) *AlterTableAddUniqueStmt {
+	s.Unique.ColumnNames = append(s.Unique.ColumnNames, name...)
+	return s
+}
+
+// AlterTableDropUniqueStmt drops a named unique constraint.
+type AlterTableDropUniqueStmt struct {
+	*Builder
+
+	NameValue       string // table name
+	UniqueNameValue string
+}
+
+func (s *AlterTableDropUniqueStmt) IsStmt() {}
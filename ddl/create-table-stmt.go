@@ -1,5 +1,10 @@
 package ddl
 
+import (
+	"fmt"
+	"strings"
+)
+
 // By convention, the struct members are public and end with "Value", so we
 // can use the name without any suffix as a builder method, e.g.
 // IfNotExistsValue is set with the IfNotExists() method.  We make an exception
@@ -17,12 +22,64 @@ type CreateTableStmt struct {
 	PrimaryKeys []string
 
 	ForeignKeys []*CreateTableFKDef
+
+	Uniques []*UniqueDef
+
+	Checks []*CheckDef
+
+	// table options, each is ignored by the formatters for other databases
+	MySQLEngineValue         string // e.g. "InnoDB"
+	MySQLCharsetValue        string // e.g. "utf8mb4", default is utf8mb4
+	MySQLCollationValue      string // e.g. "utf8mb4_unicode_ci"
+	SQLite3WithoutRowidValue bool   // WITHOUT ROWID, also implied by a VarCharPK column or a composite primary key
+	SQLite3StrictValue       bool   // STRICT typing, requires SQLite 3.37
 }
 
 type CreateTableFKDef struct {
+	NameValue        string // constraint name, optional (needed to drop it later)
 	ColumnValue      string
 	OtherTableValue  string
 	OtherColumnValue string
+	OnDeleteValue    FKAction // optional, default is the database's (NO ACTION or RESTRICT)
+	OnUpdateValue    FKAction
+}
+
+// FKAction is what a foreign key does when the row it refers to is deleted or its key updated.
+type FKAction string
+
+const (
+	Cascade  FKAction = "CASCADE"   // delete or update the referring rows too
+	SetNull  FKAction = "SET NULL"  // set the referring column to NULL (it must be nullable)
+	Restrict FKAction = "RESTRICT"  // prevent the change
+	NoAction FKAction = "NO ACTION" // prevent the change, checked at the end of the statement
+)
+
+// ParseFKAction converts text such as "cascade", "set null" or "SetNull" to an FKAction.
+func ParseFKAction(s string) (FKAction, error) {
+	switch strings.ToUpper(strings.Replace(strings.Replace(s, " ", "", -1), "_", "", -1)) {
+	case "CASCADE":
+		return Cascade, nil
+	case "SETNULL":
+		return SetNull, nil
+	case "RESTRICT":
+		return Restrict, nil
+	case "NOACTION":
+		return NoAction, nil
+	}
+	return "", fmt.Errorf("unknown foreign key action %q", s)
+}
+
+// CheckDef is a CHECK constraint.  The expression is SQL and is output as is, so it should
+// only use syntax common to the databases it is used with.
+type CheckDef struct {
+	NameValue string // constraint name, optional
+	ExprValue string
+}
+
+// UniqueDef is a named unique constraint on one or more columns.
+type UniqueDef struct {
+	NameValue   string
+	ColumnNames []string
 }
 
 type CreateTableColDef struct {
@@ -75,9 +132,22 @@ func (def *CreateTableColDef) CaseSensitive() *CreateTableColDef {
 	return def
 }
 
+// Precision sets the total number of digits of a Decimal column.
+func (def *CreateTableColDef) Precision(precision int) *CreateTableColDef {
+	def.DataTypeDef.PrecisionValue = precision
+	return def
+}
+
+// Scale sets the number of digits after the decimal point of a Decimal column.
+func (def *CreateTableColDef) Scale(scale int) *CreateTableColDef {
+	def.DataTypeDef.ScaleValue = scale
+	def.DataTypeDef.ScaleSet = true
+	return def
+}
+
 func (def *CreateTableColDef) ForiegnKey(otherTable, otherColumn string) *CreateTableColDef {
 	def.CreateTableStmt.ForeignKeys = append(def.CreateTableStmt.ForeignKeys, &CreateTableFKDef{
-		ColumnValue:      def.CreateTableStmt.NameValue,
+		ColumnValue:      def.DataTypeDef.NameValue,
 		OtherTableValue:  otherTable,
 		OtherColumnValue: otherColumn,
 	})
@@ -89,6 +159,88 @@ func (def *CreateTableColDef) PrimaryKey() *CreateTableColDef {
 	return def
 }
 
+// PrimaryKeyColumns sets the primary key to the columns given, in that order.  This is
+// an alternative to calling PrimaryKey() on each column, for when the order of the
+// primary key is not the order of the columns.
+func (s *CreateTableStmt) PrimaryKeyColumns(names ...string) *CreateTableStmt {
+	s.PrimaryKeys = append([]string(nil), names...)
+	return s
+}
+
+// Unique adds a named unique constraint on one or more columns.
+func (s *CreateTableStmt) Unique(name string, columns ...string) *CreateTableStmt {
+	s.Uniques = append(s.Uniques, &UniqueDef{NameValue: name, ColumnNames: columns})
+	return s
+}
+
+// ForeignKey adds a named foreign key constraint.  Unlike ForiegnKey on a column, the
+// name allows it to be dropped later.
+func (s *CreateTableStmt) ForeignKey(name, column, otherTable, otherColumn string) *CreateTableStmt {
+	s.ForeignKeys = append(s.ForeignKeys, &CreateTableFKDef{
+		NameValue:        name,
+		ColumnValue:      column,
+		OtherTableValue:  otherTable,
+		OtherColumnValue: otherColumn,
+	})
+	return s
+}
+
+// OnDelete sets the ON DELETE action of the foreign key added last.
+func (s *CreateTableStmt) OnDelete(action FKAction) *CreateTableStmt {
+	s.lastFK("OnDelete").OnDeleteValue = action
+	return s
+}
+
+// OnUpdate sets the ON UPDATE action of the foreign key added last.
+func (s *CreateTableStmt) OnUpdate(action FKAction) *CreateTableStmt {
+	s.lastFK("OnUpdate").OnUpdateValue = action
+	return s
+}
+
+func (s *CreateTableStmt) lastFK(method string) *CreateTableFKDef {
+	if len(s.ForeignKeys) == 0 {
+		panic(fmt.Sprintf("ddl: %s called on table %q before any foreign key was added", method, s.NameValue))
+	}
+	return s.ForeignKeys[len(s.ForeignKeys)-1]
+}
+
+// Check adds a CHECK constraint, the name is optional.
+func (s *CreateTableStmt) Check(name, expr string) *CreateTableStmt {
+	s.Checks = append(s.Checks, &CheckDef{NameValue: name, ExprValue: expr})
+	return s
+}
+
+// MySQLEngine sets the storage engine for MySQL.
+func (s *CreateTableStmt) MySQLEngine(engine string) *CreateTableStmt {
+	s.MySQLEngineValue = engine
+	return s
+}
+
+// MySQLCharset sets the default character set of the table for MySQL.
+func (s *CreateTableStmt) MySQLCharset(charset string) *CreateTableStmt {
+	s.MySQLCharsetValue = charset
+	return s
+}
+
+// MySQLCollation sets the default collation of the table for MySQL.
+func (s *CreateTableStmt) MySQLCollation(collation string) *CreateTableStmt {
+	s.MySQLCollationValue = collation
+	return s
+}
+
+// SQLite3WithoutRowid makes a WITHOUT ROWID table in SQLite3.
+func (s *CreateTableStmt) SQLite3WithoutRowid() *CreateTableStmt {
+	s.SQLite3WithoutRowidValue = true
+	return s
+}
+
+// SQLite3Strict makes a STRICT table in SQLite3, column types are then the strict
+// equivalents (e.g. TEXT instead of VARCHAR).
+func (s *CreateTableStmt) SQLite3Strict() *CreateTableStmt {
+	s.SQLite3StrictValue = true
+	return s
+}
+
 type DropTableStmt struct {
 	*Builder
 
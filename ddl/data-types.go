@@ -22,11 +22,16 @@ const (
 	Bool
 	Text
 	Blob
-	// TODO: We actually need to add a DECIMAL type - in MySQL in can be DECIMAL/NUMERIC
-	// and in SQLite3 it can be just a string.  This is vital for financial and other
-	// calculations where rounding errors and the general imprecision of floating points
-	// have big bad consequences.  It will be important to also think about what the recommended
-	// corresponding type(s) are in Go, e.g. big.Rat or something else.
+	// Decimal is an exact fixed-point number, for money and other values where floating
+	// point rounding is not acceptable.  It is DECIMAL in MySQL, NUMERIC in Postgres and TEXT
+	// in SQLite3 (which has no exact numeric type).  The corresponding Go type is dbutil.Decimal.
+	Decimal
+)
+
+// Default precision and scale for Decimal columns which do not specify one.
+const (
+	DefaultDecimalPrecision = 19
+	DefaultDecimalScale     = 4
 )
 
 // DataTypeDef describes a column.  It includes the name and various common options.
@@ -38,6 +43,9 @@ type DataTypeDef struct {
 	DefaultValue       interface{} // if you want a DEFAULT included
 	LengthValue        int         // for types that support a length part
 	CaseSensitiveValue bool        // for cases where a string should be forced to be case sensitive
+	PrecisionValue     int         // for Decimal, total number of digits
+	ScaleValue         int         // for Decimal, number of digits after the decimal point
+	ScaleSet           bool        // ScaleValue was given, so a 0 is used rather than the default
 }
 
 type DataTypeDefPtrList []*DataTypeDef
@@ -4,9 +4,9 @@ package ddl
 
 import "strconv"
 
-const _DataType_name = "InvalidCustomVarCharPKBigIntAutoPKVarCharFKBigIntFKIntIntUBigIntBigIntUDoubleDateTimeVarCharBoolTextBlob"
+const _DataType_name = "InvalidCustomVarCharPKBigIntAutoPKVarCharFKBigIntFKIntIntUBigIntBigIntUDoubleDateTimeVarCharBoolTextBlobDecimal"
 
-var _DataType_index = [...]uint8{0, 7, 13, 22, 34, 43, 51, 54, 58, 64, 71, 77, 85, 92, 96, 100, 104}
+var _DataType_index = [...]uint8{0, 7, 13, 22, 34, 43, 51, 54, 58, 64, 71, 77, 85, 92, 96, 100, 104, 111}
 
 func (i DataType) String() string {
 	if i < 0 || i >= DataType(len(_DataType_index)-1) {
@@ -0,0 +1,190 @@
+package ddl
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestAlterSQL checks the ALTER TABLE SQL generated for MySQL and Postgres.
+func TestAlterSQL(t *testing.T) {
+
+	assert := assert.New(t)
+
+	b := New()
+	b.AlterTableDropColumn("widget", "color")
+	b.AlterTableRenameColumn("widget", "name", "title")
+	b.AlterTableModify("widget").Column("price", Decimal).Precision(10).Scale(2).Null()
+	b.AlterTableAddForeignKey("widget", "widget_maker_fk").Column("maker_id").References("maker", "maker_id")
+	b.AlterTableDropForeignKey("widget", "widget_maker_fk")
+	b.AlterTableAddUnique("widget", "widget_sku_u").Columns("maker_id", "sku")
+	b.AlterTableDropUnique("widget", "widget_sku_u")
+
+	up, _, err := b.MakeSQL(NewMySQLFormatter(true))
+	assert.NoError(err)
+	assert.Equal([]string{
+		"ALTER TABLE `{{.TablePrefix}}widget` DROP COLUMN `color`",
+		"ALTER TABLE `{{.TablePrefix}}widget` RENAME COLUMN `name` TO `title`",
+		"ALTER TABLE `{{.TablePrefix}}widget` MODIFY COLUMN `price` DECIMAL(10,2) NULL",
+		"ALTER TABLE `{{.TablePrefix}}widget` ADD CONSTRAINT `{{.TablePrefix}}widget_maker_fk` FOREIGN KEY(`maker_id`) REFERENCES `{{.TablePrefix}}maker`(`maker_id`)",
+		"ALTER TABLE `{{.TablePrefix}}widget` DROP FOREIGN KEY `{{.TablePrefix}}widget_maker_fk`",
+		"ALTER TABLE `{{.TablePrefix}}widget` ADD CONSTRAINT `{{.TablePrefix}}widget_sku_u` UNIQUE(`maker_id`,`sku`)",
+		"ALTER TABLE `{{.TablePrefix}}widget` DROP INDEX `{{.TablePrefix}}widget_sku_u`",
+	}, up)
+
+	up, _, err = b.MakeSQL(NewPostgresFormatter(false))
+	assert.NoError(err)
+	assert.Equal([]string{
+		`ALTER TABLE "widget" DROP COLUMN "color"`,
+		`ALTER TABLE "widget" RENAME COLUMN "name" TO "title"`,
+		`ALTER TABLE "widget" ALTER COLUMN "price" TYPE NUMERIC(10,2) USING "price"::NUMERIC(10,2), ALTER COLUMN "price" DROP NOT NULL, ALTER COLUMN "price" DROP DEFAULT`,
+		`ALTER TABLE "widget" ADD CONSTRAINT "widget_maker_fk" FOREIGN KEY("maker_id") REFERENCES "maker"("maker_id")`,
+		`ALTER TABLE "widget" DROP CONSTRAINT "widget_maker_fk"`,
+		`ALTER TABLE "widget" ADD CONSTRAINT "widget_sku_u" UNIQUE("maker_id","sku")`,
+		`ALTER TABLE "widget" DROP CONSTRAINT "widget_sku_u"`,
+	}, up)
+
+	up, _, err = b.Reset().AlterTableModify("widget").Column("name", VarChar).Default("x").MakeSQL(NewPostgresFormatter(false))
+	assert.NoError(err)
+	assert.Equal([]string{
+		`ALTER TABLE "widget" ALTER COLUMN "name" TYPE VARCHAR(128) USING "name"::VARCHAR(128), ALTER COLUMN "name" SET NOT NULL, ALTER COLUMN "name" SET DEFAULT 'x'`,
+	}, up)
+
+	// SQLite3 needs to know the table to rebuild it
+	_, _, err = b.Reset().AlterTableDropColumn("widget", "color").MakeSQL(NewSQLite3Formatter(false))
+	assert.Error(err)
+
+}
+
+// TestSQLite3Alter runs each ALTER TABLE statement against SQLite3, including the ones
+// emulated by rebuilding the table, and checks the data and indexes survive.
+func TestSQLite3Alter(t *testing.T) {
+
+	assert := assert.New(t)
+
+	db, err := sql.Open("sqlite3", `file:TestSQLite3Alter?mode=memory&cache=shared`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	// keep a connection open so the in-memory database lives between migrations
+	assert.NoError(db.Ping())
+
+	f := NewSQLite3Formatter(true)
+
+	run := func(ml DDLTmplMigrationList, err error) {
+		if !assert.NoError(err) {
+			return
+		}
+		for _, m := range ml {
+			for _, s := range m.UpSQL {
+				t.Logf("Running SQL: %s", s)
+			}
+			assert.NoError(m.ExecUp(`file:TestSQLite3Alter?mode=memory&cache=shared`))
+		}
+	}
+	indexes := func(table string) (ret []string) {
+		rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = ? AND sql IS NOT NULL ORDER BY name`, table)
+		assert.NoError(err)
+		defer rows.Close()
+		for rows.Next() {
+			var n string
+			assert.NoError(rows.Scan(&n))
+			ret = append(ret, n)
+		}
+		return
+	}
+
+	b := New().SetCategory("test")
+
+	b.SetVersion("0001")
+	b.CreateTable("maker").
+		Column("maker_id", VarCharPK).PrimaryKey()
+	b.CreateTable("widget").
+		Column("widget_id", BigIntAutoPK).PrimaryKey().
+		Column("maker_id", VarCharFK).
+		Column("name", VarChar).
+		Column("color", VarChar).Null().
+		Column("price", Text).Null()
+	b.CreateIndex("widget_name", "widget").Columns("name")
+	run(b.Migrations(f))
+
+	_, err = db.Exec(`INSERT INTO maker (maker_id) VALUES ('m1')`)
+	assert.NoError(err)
+	_, err = db.Exec(`INSERT INTO widget (maker_id, name, color, price) VALUES ('m1', 'Sprocket', 'red', '1.50'), ('m1', 'Gear', NULL, NULL)`)
+	assert.NoError(err)
+
+	b.SetVersion("0002")
+	b.AlterTableAddUnique("widget", "widget_maker_name").Columns("maker_id", "name")
+	b.AlterTableDropColumn("widget", "color")
+	b.AlterTableRenameColumn("widget", "name", "title")
+	b.AlterTableModify("widget").Column("price", Decimal).Default("0")
+	b.AlterTableAddForeignKey("widget", "widget_maker_fk").Column("maker_id").References("maker", "maker_id")
+	run(b.Migrations(f))
+
+	var title, price string
+	var n int
+	assert.NoError(db.QueryRow(`SELECT title, price FROM widget WHERE widget_id = 2`).Scan(&title, &price))
+	assert.Equal("Gear", title)
+	assert.Equal("0", price) // NULL replaced by default when it became NOT NULL
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM widget WHERE title = 'sprocket' AND price = '1.50'`).Scan(&n))
+	assert.Equal(1, n)
+	_, err = db.Exec(`SELECT color FROM widget`)
+	assert.Error(err)
+	assert.Equal([]string{"widget_maker_name", "widget_name"}, indexes("widget"))
+	_, err = db.Exec(`INSERT INTO widget (maker_id, title) VALUES ('m1', 'GEAR')`)
+	assert.Error(err, "unique constraint should survive the rebuild")
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM pragma_foreign_key_list('widget')`).Scan(&n))
+	assert.Equal(1, n)
+
+	// auto increment continues
+	_, err = db.Exec(`INSERT INTO widget (maker_id, title) VALUES ('m1', 'Cog')`)
+	assert.NoError(err)
+	assert.NoError(db.QueryRow(`SELECT widget_id FROM widget WHERE title = 'Cog'`).Scan(&n))
+	assert.Equal(3, n)
+
+	b.SetVersion("0003")
+	b.AlterTableDropForeignKey("widget", "widget_maker_fk")
+	b.AlterTableDropUnique("widget", "widget_maker_name")
+	b.AlterTableRename("widget", "gadget")
+	b.AlterTableAdd("gadget").Column("weight", Double).Null()
+	b.AlterTableDropColumn("gadget", "weight")
+	run(b.Migrations(f))
+
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM pragma_foreign_key_list('gadget')`).Scan(&n))
+	assert.Equal(0, n)
+	assert.Equal([]string{"widget_name"}, indexes("gadget"))
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM gadget`).Scan(&n))
+	assert.Equal(3, n)
+
+	// errors from the table definition
+	_, err = b.AlterTableDropColumn("gadget", "title").Migrations(f)
+	assert.Error(err) // indexed
+	_, err = b.Reset().DefineTable("other").Column("a", Int).Builder.AlterTableDropColumn("other", "b").Migrations(f)
+	assert.Error(err) // no such column
+
+}
+
+func TestBuilderDependsOn(t *testing.T) {
+
+	assert := assert.New(t)
+
+	b := New().SetCategory("todo")
+	b.SetVersion("0002").DependsOn("users", "0001")
+	b.CreateTable("todo_item").Column("user_id", VarCharFK).ForiegnKey("users", "user_id")
+	ml, err := b.Migrations(NewSQLite3Formatter(true), NewMySQLFormatter(true))
+	assert.NoError(err)
+	for _, m := range ml {
+		assert.Equal(map[string]string{"users": "0001"}, m.DependsOn())
+	}
+
+	// cleared for the next migration
+	b.SetVersion("0003").CreateIndex("todo_item_user", "todo_item").Columns("user_id")
+	ml, err = b.Migrations(NewSQLite3Formatter(true))
+	assert.NoError(err)
+	assert.Nil(ml[0].DependsOn())
+
+}
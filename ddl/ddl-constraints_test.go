@@ -0,0 +1,186 @@
+package ddl
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestConstraintsSQL checks the SQL for composite keys, unique and check constraints, foreign
+// key actions and table options.
+func TestConstraintsSQL(t *testing.T) {
+
+	assert := assert.New(t)
+
+	b := New()
+	b.CreateTable("order_line").
+		Column("order_id", VarCharFK).
+		Column("line_no", Int).
+		Column("sku", VarChar).
+		Column("qty", Int).
+		PrimaryKeyColumns("order_id", "line_no").
+		Unique("order_line_sku", "order_id", "sku").
+		ForeignKey("order_line_order_fk", "order_id", "order", "order_id").OnDelete(Cascade).OnUpdate(Restrict).
+		Check("order_line_qty", "qty > 0").
+		Check("", "line_no >= 1").
+		MySQLEngine("InnoDB").MySQLCharset("utf8mb4").MySQLCollation("utf8mb4_unicode_ci").
+		SQLite3Strict()
+	b.AlterTableAddForeignKey("order_line", "order_line_sku_fk").Column("sku").References("product", "sku").OnDelete(SetNull)
+
+	up, _, err := b.MakeSQL(NewMySQLFormatter(false))
+	assert.NoError(err)
+	assert.Equal([]string{"CREATE TABLE `order_line` (\n" +
+		"    `order_id` VARCHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,\n" +
+		"    `line_no` INTEGER NOT NULL,\n" +
+		"    `sku` VARCHAR(128) NOT NULL,\n" +
+		"    `qty` INTEGER NOT NULL,\n" +
+		"    PRIMARY KEY(`order_id`,`line_no`),\n" +
+		"    CONSTRAINT `order_line_sku` UNIQUE(`order_id`,`sku`),\n" +
+		"    CONSTRAINT `order_line_order_fk` FOREIGN KEY(`order_id`) REFERENCES `order`(`order_id`) ON DELETE CASCADE ON UPDATE RESTRICT,\n" +
+		"    CONSTRAINT `order_line_qty` CHECK (qty > 0),\n" +
+		"    CHECK (line_no >= 1)\n" +
+		") ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+		"ALTER TABLE `order_line` ADD CONSTRAINT `order_line_sku_fk` FOREIGN KEY(`sku`) REFERENCES `product`(`sku`) ON DELETE SET NULL",
+	}, up)
+
+	up, _, err = b.MakeSQL(NewPostgresFormatter(false))
+	assert.NoError(err)
+	assert.Equal(`CREATE TABLE "order_line" (
+    "order_id" VARCHAR(64) NOT NULL,
+    "line_no" INTEGER NOT NULL,
+    "sku" VARCHAR(128) NOT NULL,
+    "qty" INTEGER NOT NULL,
+    PRIMARY KEY("order_id","line_no"),
+    CONSTRAINT "order_line_sku" UNIQUE("order_id","sku"),
+    CONSTRAINT "order_line_order_fk" FOREIGN KEY("order_id") REFERENCES "order"("order_id") ON DELETE CASCADE ON UPDATE RESTRICT,
+    CONSTRAINT "order_line_qty" CHECK (qty > 0),
+    CHECK (line_no >= 1)
+)`, up[0])
+
+	up, _, err = b.MakeSQL(NewSQLite3Formatter(false))
+	assert.NoError(err)
+	assert.Equal(`CREATE TABLE "order_line" (
+    "order_id" TEXT NOT NULL,
+    "line_no" INTEGER NOT NULL,
+    "sku" TEXT NOT NULL COLLATE NOCASE,
+    "qty" INTEGER NOT NULL,
+    PRIMARY KEY("order_id","line_no"),
+    CONSTRAINT "order_line_order_fk" FOREIGN KEY("order_id") REFERENCES "order"("order_id") ON DELETE CASCADE ON UPDATE RESTRICT,
+    CONSTRAINT "order_line_qty" CHECK (qty > 0),
+    CHECK (line_no >= 1)
+) WITHOUT ROWID, STRICT`, up[0])
+	assert.Equal(`CREATE UNIQUE INDEX "order_line_sku" ON "order_line"("order_id","sku")`, up[1])
+
+	// the MySQL default charset is used unless one is given
+	up, _, err = b.Reset().CreateTable("t").Column("a", Int).MySQLEngine("MyISAM").MakeSQL(NewMySQLFormatter(false))
+	assert.NoError(err)
+	assert.Equal("CREATE TABLE `t` (\n    `a` INTEGER NOT NULL\n) ENGINE=MyISAM /*!50508 CHARSET=utf8mb4 */", up[0])
+
+	assert.Panics(func() { New().CreateTable("t").OnDelete(Cascade) })
+
+	for in, out := range map[string]FKAction{"cascade": Cascade, "set null": SetNull, "SetNull": SetNull, "RESTRICT": Restrict, "no_action": NoAction} {
+		a, err := ParseFKAction(in)
+		assert.NoError(err)
+		assert.Equal(out, a)
+	}
+	_, err = ParseFKAction("explode")
+	assert.Error(err)
+
+}
+
+// TestSQLite3Constraints checks the constraints are enforced by SQLite3, survive a table
+// rebuild and are read back by Introspect.
+func TestSQLite3Constraints(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestSQLite3Constraints?mode=memory&cache=shared&_foreign_keys=0`
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1) // so the foreign_keys pragma applies to every statement
+	assert.NoError(db.Ping())
+
+	f := NewSQLite3Formatter(true)
+	run := func(ml DDLTmplMigrationList, err error) {
+		if !assert.NoError(err) {
+			return
+		}
+		for _, m := range ml {
+			assert.NoError(m.ExecUp(dsn))
+		}
+	}
+
+	b := New().SetCategory("test")
+	b.SetVersion("0001")
+	b.CreateTable("orders").
+		Column("order_id", VarCharPK).PrimaryKey()
+	b.CreateTable("order_line").
+		Column("order_id", VarCharFK).
+		Column("line_no", Int).
+		Column("sku", VarChar).
+		Column("qty", Int).
+		Column("note", Text).Null().
+		PrimaryKeyColumns("order_id", "line_no").
+		Unique("order_line_sku", "order_id", "sku").
+		ForeignKey("order_line_order_fk", "order_id", "orders", "order_id").OnDelete(Cascade).
+		Check("order_line_qty", "qty > 0").
+		SQLite3Strict()
+	run(b.Migrations(f))
+
+	// rebuild the table, everything should be kept
+	b.SetVersion("0002")
+	b.AlterTableDropColumn("order_line", "note")
+	tableDefs, err := b.TableDefs()
+	assert.NoError(err)
+	run(b.Migrations(f))
+
+	_, err = db.Exec(`PRAGMA foreign_keys = ON`)
+	assert.NoError(err)
+
+	_, err = db.Exec(`INSERT INTO orders (order_id) VALUES ('o1')`)
+	assert.NoError(err)
+	_, err = db.Exec(`INSERT INTO order_line (order_id, line_no, sku, qty) VALUES ('o1', 1, 'a', 1), ('o1', 2, 'b', 5)`)
+	assert.NoError(err)
+
+	_, err = db.Exec(`INSERT INTO order_line (order_id, line_no, sku, qty) VALUES ('o1', 3, 'c', 0)`)
+	assert.Error(err, "check constraint")
+	_, err = db.Exec(`INSERT INTO order_line (order_id, line_no, sku, qty) VALUES ('o1', 1, 'c', 1)`)
+	assert.Error(err, "composite primary key")
+	_, err = db.Exec(`INSERT INTO order_line (order_id, line_no, sku, qty) VALUES ('o1', 3, 'A', 1)`)
+	assert.Error(err, "unique constraint")
+	_, err = db.Exec(`INSERT INTO order_line (order_id, line_no, sku, qty) VALUES ('o1', 3, 'c', 'lots')`)
+	assert.Error(err, "strict table")
+
+	_, err = db.Exec(`DELETE FROM orders WHERE order_id = 'o1'`)
+	assert.NoError(err)
+	var n int
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM order_line`).Scan(&n))
+	assert.Equal(0, n, "on delete cascade")
+
+	_, err = db.Exec(`PRAGMA foreign_keys = OFF`)
+	assert.NoError(err)
+
+	// introspection sees the same definition
+	current, err := Introspect(db, "sqlite3", "")
+	assert.NoError(err)
+	ol := current["order_line"]
+	if assert.NotNil(ol) {
+		assert.Equal([]string{"order_id", "line_no"}, ol.Table.PrimaryKeys)
+		assert.True(ol.Table.SQLite3StrictValue)
+		assert.Equal([]*CheckDef{{NameValue: "order_line_qty", ExprValue: "qty > 0"}}, ol.Table.Checks)
+		if assert.Len(ol.Table.ForeignKeys, 1) {
+			assert.Equal(Cascade, ol.Table.ForeignKeys[0].OnDeleteValue)
+			assert.Equal("order_line_order_fk", ol.Table.ForeignKeys[0].NameValue)
+		}
+	}
+	b = New()
+	assert.NoError(b.Diff(current, tableDefs, NewSQLite3Formatter(false), true))
+	assert.Empty(b.UpStmtList)
+
+}
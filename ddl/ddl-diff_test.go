@@ -0,0 +1,196 @@
+package ddl
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestStructTableDef(t *testing.T) {
+
+	assert := assert.New(t)
+
+	type Base struct {
+		CreatedAt time.Time `db:"created_at"`
+	}
+	type Widget struct {
+		WidgetID int64   `db:"widget_id" tmeta:"pk"`
+		MakerID  string  `db:"maker_id" ddl:"fk=maker.maker_id"`
+		SKU      string  `ddl:"unique,casesensitive,length=32"`
+		Name     string  `db:"name" ddl:"index"`
+		Notes    *string `db:"notes" ddl:"type=Text"`
+		Price    float64 `db:"price" ddl:"default=1.5"`
+		InStock  bool    `db:"in_stock" ddl:"default=true"`
+		Internal string  `db:"-"`
+		Base
+		unexported int
+	}
+
+	d, err := StructTableDef("widget", &Widget{})
+	assert.NoError(err)
+	assert.Equal([]string{"widget_id"}, d.Table.PrimaryKeys)
+	assert.Equal(DataTypeDefPtrList{
+		{NameValue: "widget_id", DataTypeValue: BigIntAutoPK},
+		{NameValue: "maker_id", DataTypeValue: VarCharFK},
+		{NameValue: "sku", DataTypeValue: VarChar, CaseSensitiveValue: true, LengthValue: 32},
+		{NameValue: "name", DataTypeValue: VarChar},
+		{NameValue: "notes", DataTypeValue: Text, NullValue: true},
+		{NameValue: "price", DataTypeValue: Double, DefaultValue: 1.5},
+		{NameValue: "in_stock", DataTypeValue: Bool, DefaultValue: true},
+		{NameValue: "created_at", DataTypeValue: DateTime},
+	}, d.Table.Columns)
+	assert.Equal([]*CreateTableFKDef{{NameValue: "widget_maker_id_fk", ColumnValue: "maker_id", OtherTableValue: "maker", OtherColumnValue: "maker_id"}}, d.Table.ForeignKeys)
+	assert.Equal([]*UniqueDef{{NameValue: "widget_sku_unique", ColumnNames: []string{"sku"}}}, d.Table.Uniques)
+	if assert.Len(d.Indexes, 1) {
+		assert.Equal("widget_name", d.Indexes[0].NameValue)
+	}
+
+	// an explicit scale of 0 is not replaced by the default
+	d, err = StructTableDef("qty", struct {
+		Qty string `db:"qty" ddl:"type=Decimal,scale=0"`
+	}{})
+	assert.NoError(err)
+	assert.Equal(DataTypeDefPtrList{{NameValue: "qty", DataTypeValue: Decimal, ScaleSet: true}}, d.Table.Columns)
+	up, _, err := New().CreateTable("qty").Column("qty", Decimal).Scale(0).MakeSQL(NewMySQLFormatter(false))
+	assert.NoError(err)
+	assert.Contains(up[0], "`qty` DECIMAL(19,0) NOT NULL")
+
+	_, err = StructTableDef("bad", struct {
+		C complex128
+	}{})
+	assert.Error(err)
+	_, err = StructTableDef("bad", struct {
+		S string `ddl:"whatever"`
+	}{})
+	assert.Error(err)
+
+	assert.Equal("todo_item_id", SnakeCase("TodoItemID"))
+	assert.Equal("http_server", SnakeCase("HTTPServer"))
+	assert.Equal("name", SnakeCase("Name"))
+
+}
+
+// TestSQLite3Diff creates a schema, introspects it, migrates it to a new one using the
+// diff and checks that introspecting again matches each time.
+func TestSQLite3Diff(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestSQLite3Diff?mode=memory&cache=shared`
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	assert.NoError(db.Ping())
+
+	f := NewSQLite3Formatter(true)
+	const prefix = "pre_"
+	run := func(b *Builder) DDLTmplMigrationList {
+		ml, err := b.Migrations(f)
+		assert.NoError(err)
+		for i := range ml {
+			ml[i].TablePrefix = prefix
+			assert.NoError(ml[i].ExecUp(dsn))
+		}
+		return ml
+	}
+	// diff returns the builder with the changes from the database to desired
+	diff := func(desired TableDefMap, dropTables bool) *Builder {
+		current, err := Introspect(db, "sqlite3", prefix)
+		assert.NoError(err)
+		b := New().SetCategory("test").SetVersion("x")
+		assert.NoError(b.Diff(current, desired, f, dropTables))
+		return b
+	}
+
+	// v1
+	b := New().SetCategory("test").SetVersion("0001")
+	b.CreateTable("maker").
+		Column("maker_id", VarCharPK).PrimaryKey().
+		Column("name", VarChar).
+		Column("founded", DateTime).Null()
+	b.CreateTable("widget").
+		Column("widget_id", BigIntAutoPK).PrimaryKey().
+		Column("maker_id", VarCharFK).ForiegnKey("maker", "maker_id").
+		Column("name", VarChar).Default("unnamed").
+		Column("color", VarChar).Null().
+		Column("active", Bool).Default(false)
+	b.CreateIndex("widget_name", "widget").Columns("name")
+	b.CreateTable("obsolete").
+		Column("obsolete_id", VarCharPK).PrimaryKey()
+	v1, err := b.TableDefs()
+	assert.NoError(err)
+	run(b)
+
+	_, err = db.Exec(`INSERT INTO pre_maker (maker_id, name) VALUES ('m1', 'Acme')`)
+	assert.NoError(err)
+	_, err = db.Exec(`INSERT INTO pre_widget (maker_id, name, color) VALUES ('m1', 'Sprocket', 'red')`)
+	assert.NoError(err)
+
+	// no changes
+	b = diff(v1, true)
+	assert.Empty(b.UpStmtList)
+	assert.Empty(b.DownStmtList)
+
+	// v2, from structs
+	type Maker struct {
+		MakerID string     `db:"maker_id" tmeta:"pk"`
+		Name    string     `db:"name" ddl:"casesensitive"`
+		Founded *time.Time `db:"founded"`
+	}
+	type Widget struct {
+		WidgetID int64   `db:"widget_id" tmeta:"pk"`
+		MakerID  string  `db:"maker_id" ddl:"fk=maker.maker_id"`
+		Name     string  `db:"name" ddl:"default=unnamed,unique"`
+		Active   bool    `db:"active" ddl:"default=false"`
+		Weight   float64 `db:"weight" ddl:"default=0,index"`
+	}
+	type Part struct {
+		PartID   string `db:"part_id" tmeta:"pk"`
+		WidgetID int64  `db:"widget_id" ddl:"fk=widget.widget_id"`
+	}
+	v2 := make(TableDefMap)
+	for name, obj := range map[string]interface{}{"maker": Maker{}, "widget": Widget{}, "part": Part{}} {
+		d, err := StructTableDef(name, obj)
+		assert.NoError(err)
+		v2[name] = d
+	}
+
+	b = diff(v2, true)
+	assert.NotEmpty(b.UpStmtList)
+	up, _, err := b.MakeSQL(NewSQLite3Formatter(false))
+	assert.NoError(err)
+	for _, s := range up {
+		t.Logf("Up SQL: %s", s)
+	}
+	ml := run(b)
+
+	b = diff(v2, true)
+	assert.Empty(b.UpStmtList, "schema should match v2 after migrating")
+
+	var name string
+	assert.NoError(db.QueryRow(`SELECT name FROM pre_widget WHERE maker_id = 'm1'`).Scan(&name))
+	assert.Equal("Sprocket", name)
+	_, err = db.Exec(`INSERT INTO pre_part (part_id, widget_id) VALUES ('p1', 1)`)
+	assert.NoError(err)
+
+	// and back down
+	for _, m := range ml {
+		assert.NoError(m.ExecDown(dsn))
+	}
+	b = diff(v1, true)
+	assert.Empty(b.UpStmtList, "schema should match v1 after migrating down")
+
+	// primary key changes are not supported
+	v3 := v1.normalized()
+	v3["maker"].Table.PrimaryKeys = []string{"name"}
+	current, err := Introspect(db, "sqlite3", prefix)
+	assert.NoError(err)
+	assert.Error(New().Diff(current, v3, f, false))
+
+}
@@ -0,0 +1,270 @@
+package ddl
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPostgresSQL checks the SQL generated for each statement type.
+func TestPostgresSQL(t *testing.T) {
+
+	assert := assert.New(t)
+
+	f := NewPostgresFormatter(false)
+	b := New()
+
+	up, down, err := b.Reset().
+		CreateTable("table_types").
+		Column("table_types_id", VarCharPK).PrimaryKey().
+		ColumnCustom("test_custom", "TEXT NOT NULL").
+		Column("test_int", Int).
+		Column("test_intu", IntU).
+		Column("test_bigintu", BigIntU).
+		Column("test_double", Double).
+		Column("test_datetime", DateTime).
+		Column("test_varchar", VarChar).Length(255).
+		Column("test_bool", Bool).Default(false).
+		Column("test_text", Text).CaseSensitive().Null().
+		Column("test_blob", Blob).
+		Column("test_decimal", Decimal).
+		Column("test_decimal_ps", Decimal).Precision(10).Scale(0).
+		Column("test_decimal_s", Decimal).Scale(0).
+		Down().
+		DropTable("table_types").
+		MakeSQL(f)
+	assert.NoError(err)
+	assert.Equal([]string{
+		`CREATE TABLE "table_types" (
+    "table_types_id" VARCHAR(64) NOT NULL,
+    "test_custom" TEXT NOT NULL,
+    "test_int" INTEGER NOT NULL,
+    "test_intu" BIGINT NOT NULL CHECK ("test_intu" >= 0),
+    "test_bigintu" NUMERIC(20) NOT NULL CHECK ("test_bigintu" >= 0),
+    "test_double" DOUBLE PRECISION NOT NULL,
+    "test_datetime" TIMESTAMP(6) NOT NULL,
+    "test_varchar" VARCHAR(255) NOT NULL,
+    "test_bool" BOOLEAN NOT NULL DEFAULT false,
+    "test_text" TEXT NULL,
+    "test_blob" BYTEA NOT NULL,
+    "test_decimal" NUMERIC(19,4) NOT NULL,
+    "test_decimal_ps" NUMERIC(10,0) NOT NULL,
+    "test_decimal_s" NUMERIC(19,0) NOT NULL,
+    PRIMARY KEY("table_types_id")
+)`,
+	}, up)
+	assert.Equal([]string{`DROP TABLE "table_types"`}, down)
+
+	// identity pk, foreign key, no collation needed
+	up, _, err = b.Reset().
+		CreateTable("table_autoinc").IfNotExists().
+		Column("table_autoinc_id", BigIntAutoPK).PrimaryKey().
+		Column("table_types_id", VarCharFK).ForiegnKey("table_types", "table_types_id").
+		MakeSQL(f)
+	assert.NoError(err)
+	assert.Equal([]string{`CREATE TABLE IF NOT EXISTS "table_autoinc" (
+    "table_autoinc_id" BIGINT GENERATED BY DEFAULT AS IDENTITY,
+    "table_types_id" VARCHAR(64) NOT NULL,
+    PRIMARY KEY("table_autoinc_id"),
+    FOREIGN KEY("table_types_id") REFERENCES "table_types"("table_types_id")
+)`}, up)
+
+	// case insensitive collation when asked for
+	up, _, err = b.Reset().
+		AlterTableRename("public.table_null", "public.table_null2").
+		AlterTableAdd("table_existential").Column("other_cool_field", VarChar).Null().Default("moz'def").
+		AlterTableAdd("table_existential").Column("other_cs_field", VarChar).CaseSensitive().Null().
+		CreateIndex("table_existential_other", "table_existential").Unique().IfNotExists().Columns("other_cool_field", "id").
+		DropIndex("table_existential_other", "table_existential").
+		MakeSQL(&PostgresFormatter{NoCaseCollation: true})
+	assert.NoError(err)
+	assert.Equal([]string{
+		`ALTER TABLE "public"."table_null" RENAME TO "table_null2"`,
+		`CREATE COLLATION IF NOT EXISTS "caveman_nocase" (provider = icu, locale = 'und-u-ks-level2', deterministic = false)`,
+		`ALTER TABLE "table_existential" ADD COLUMN "other_cool_field" VARCHAR(128) COLLATE "caveman_nocase" NULL DEFAULT 'moz''def'`,
+		`ALTER TABLE "table_existential" ADD COLUMN "other_cs_field" VARCHAR(128) NULL`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "table_existential_other" ON "table_existential"("other_cool_field","id")`,
+		`DROP INDEX "table_existential_other"`,
+	}, up)
+
+	// template output
+	up, _, err = b.Reset().DropTable("a").MakeSQL(NewPostgresFormatter(true))
+	assert.NoError(err)
+	assert.Equal([]string{`DROP TABLE "{{.TablePrefix}}a"`}, up)
+
+}
+
+// TestPostgres tests each feature against a Postgres database to ensure syntax is correct.
+// It is skipped unless CAVEMAN_TEST_POSTGRES_DSN is set and a "postgres" driver is
+// registered (e.g. by building the tests with an import of github.com/lib/pq).
+func TestPostgres(t *testing.T) {
+
+	dsn := os.Getenv("CAVEMAN_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("CAVEMAN_TEST_POSTGRES_DSN not set")
+	}
+	hasDriver := false
+	for _, d := range sql.Drivers() {
+		hasDriver = hasDriver || d == "postgres"
+	}
+	if !hasDriver {
+		t.Skip("no postgres driver registered")
+	}
+
+	assert := assert.New(t)
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	f := NewPostgresFormatter(false)
+
+	b := New()
+	b.SetCategory("test")
+
+	runSQL := func(up, _ []string, err error) {
+		if err != nil {
+			assert.NoError(err)
+			return
+		}
+		for _, s := range up {
+			t.Logf("Running SQL: %s", s)
+			_, err = db.Exec(s)
+			assert.NoError(err)
+		}
+	}
+
+	// start clean
+	for _, tn := range []string{"table_types", "table_autoinc", "table_join", "table_existential", "table_null", "table_null2", "table_cs"} {
+		db.Exec(`DROP TABLE IF EXISTS ` + postgresQuoteIdent(tn) + ` CASCADE`)
+	}
+
+	// -- create table
+
+	// one of each type on it (except the integer pk)
+	runSQL(b.Reset().
+		CreateTable("table_types").
+		Column("table_types_id", VarCharPK).PrimaryKey().
+		ColumnCustom("test_custom", "TEXT NOT NULL").
+		Column("test_varcharfk", VarCharFK).Length(255).
+		Column("test_bigintfk", BigIntFK).
+		Column("test_int", Int).
+		Column("test_intu", IntU).
+		Column("test_bigint", BigInt).
+		Column("test_bigintu", BigIntU).
+		Column("test_double", Double).
+		Column("test_datetime", DateTime).
+		Column("test_varchar", VarChar).Length(255).
+		Column("test_bool", Bool).
+		Column("test_text", Text).
+		Column("test_blob", Blob).
+		Column("test_decimal", Decimal).
+		Column("test_decimal_ps", Decimal).Precision(10).Scale(2).
+		MakeSQL(f))
+
+	// integer autoinc pk with a foreign key
+	runSQL(b.Reset().
+		CreateTable("table_autoinc").
+		Column("table_autoinc_id", BigIntAutoPK).PrimaryKey().
+		Column("table_types_id", VarCharFK).ForiegnKey("table_types", "table_types_id").
+		Column("test_varchar", VarChar).
+		MakeSQL(f))
+
+	// mulitple pks
+	runSQL(b.Reset().
+		CreateTable("table_join").
+		Column("table_join_a_id", VarCharPK).PrimaryKey().
+		Column("table_join_b_id", VarCharPK).PrimaryKey().
+		MakeSQL(f))
+
+	// if not exists
+	runSQL(b.Reset().
+		CreateTable("table_existential").IfNotExists().
+		Column("table_existential_id", VarCharPK).PrimaryKey().
+		MakeSQL(f))
+
+	// null
+	runSQL(b.Reset().
+		CreateTable("table_null").
+		Column("table_null_id", VarCharPK).PrimaryKey().
+		Column("test_int", Int).Null().
+		Column("test_intu", IntU).Null().
+		Column("test_bigint", BigInt).Null().
+		Column("test_bigintu", BigIntU).Null().
+		Column("test_double", Double).Null().
+		Column("test_datetime", DateTime).Null().
+		Column("test_varchar", VarChar).Length(255).Null().
+		Column("test_bool", Bool).Null().
+		Column("test_text", Text).Null().
+		Column("test_blob", Blob).Null().
+		Column("test_decimal", Decimal).Null().
+		MakeSQL(f))
+
+	// case sensitive
+	runSQL(b.Reset().
+		CreateTable("table_cs").
+		Column("table_cs_id", VarCharPK).PrimaryKey().
+		Column("test_varchar_cs", VarChar).CaseSensitive().
+		Column("test_text_cs", Text).CaseSensitive().
+		MakeSQL(f))
+
+	// -- drop table
+	runSQL(b.Reset().
+		DropTable("table_cs").
+		MakeSQL(f))
+
+	// -- rename table
+	runSQL(b.Reset().
+		AlterTableRename("table_null", "table_null2").
+		MakeSQL(f))
+
+	// -- add column
+	runSQL(b.Reset().
+		AlterTableAdd("table_existential").
+		Column("other_cool_field", VarChar).Null().Default("mozdef").
+		MakeSQL(&PostgresFormatter{NoCaseCollation: true}))
+	runSQL(b.Reset().
+		AlterTableAdd("table_existential").
+		Column("other_plain_field", VarChar).Null().
+		MakeSQL(f))
+
+	// case insensitive comparison with the collation, and ILIKE without it
+	_, err = db.Exec(`INSERT INTO table_existential (table_existential_id, other_cool_field, other_plain_field) VALUES ('a', 'MozDef', 'MozDef')`)
+	assert.NoError(err)
+	var n int
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM table_existential WHERE other_cool_field = 'mozdef'`).Scan(&n))
+	assert.Equal(1, n)
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM table_existential WHERE other_plain_field ILIKE 'moz%'`).Scan(&n))
+	assert.Equal(1, n)
+
+	// -- create index
+	runSQL(b.Reset().
+		CreateIndex("table_existential_other", "table_existential").Columns("other_cool_field").
+		MakeSQL(f))
+
+	// -- drop index
+	runSQL(b.Reset().
+		DropIndex("table_existential_other", "table_existential").
+		MakeSQL(f))
+
+	// -- more create index
+
+	// unique
+	runSQL(b.Reset().
+		CreateIndex("table_existential_other", "table_existential").
+		Unique().
+		Columns("other_cool_field").
+		MakeSQL(f))
+
+	// if not exists
+	runSQL(b.Reset().
+		CreateIndex("table_existential_other", "table_existential").
+		IfNotExists().
+		Columns("other_cool_field").
+		MakeSQL(f))
+
+}
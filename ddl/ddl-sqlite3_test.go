@@ -55,6 +55,8 @@ func TestSQLite3(t *testing.T) {
 		Column("test_bool", Bool).
 		Column("test_text", Text).
 		Column("test_blob", Blob).
+		Column("test_decimal", Decimal).
+		Column("test_decimal_ps", Decimal).Precision(10).Scale(2).
 		MakeSQL(f))
 
 	// integer autoinc pk
@@ -91,6 +93,7 @@ func TestSQLite3(t *testing.T) {
 		Column("test_bool", Bool).Null().
 		Column("test_text", Text).Null().
 		Column("test_blob", Blob).Null().
+		Column("test_decimal", Decimal).Null().
 		MakeSQL(f))
 
 	// case sensitive
@@ -41,6 +41,7 @@ type DDLTmplMigration struct {
 	VersionValue    string
 	UpSQL           []string
 	DownSQL         []string
+	DependsOnValue  map[string]string // category -> minimum version, see migrate.DependentMigration
 
 	// a common reason to use DDLTmplMigration is be able to configure the table prefix
 	TablePrefix string `autowire:"db.TablePrefix,optional"`
@@ -48,40 +49,55 @@ type DDLTmplMigration struct {
 	Data interface{}
 }
 
-func (m *DDLTmplMigration) DriverName() string { return m.DriverNameValue }
-func (m *DDLTmplMigration) Category() string   { return m.CategoryValue }
-func (m *DDLTmplMigration) Version() string    { return m.VersionValue }
+func (m *DDLTmplMigration) DriverName() string           { return m.DriverNameValue }
+func (m *DDLTmplMigration) Category() string             { return m.CategoryValue }
+func (m *DDLTmplMigration) Version() string              { return m.VersionValue }
+func (m *DDLTmplMigration) DependsOn() map[string]string { return m.DependsOnValue }
 
-func (m *DDLTmplMigration) tmplExec(dsn string, stmts []string) error {
-
-	db, err := sql.Open(m.DriverNameValue, dsn)
-	if err != nil {
-		return err
-	}
-	defer db.Close()
+// render executes the statement templates.
+func (m *DDLTmplMigration) render(stmts []string) ([]string, error) {
 
+	ret := make([]string, 0, len(stmts))
 	for n, s := range stmts {
 
 		t := template.New("sql")
 		t, err := t.Parse(s)
 		if err != nil {
-			return fmt.Errorf("DDLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template parse on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
+			return nil, fmt.Errorf("DDLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template parse failed with error: %v\nSQL Statement:\n%s",
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, err, s)
 		}
 
 		var buf bytes.Buffer
 		err = t.Execute(&buf, m)
 		if err != nil {
-			return fmt.Errorf("DDLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template execute on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
+			return nil, fmt.Errorf("DDLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template execute failed with error: %v\nSQL Statement:\n%s",
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, err, s)
 		}
 
-		newS := buf.String()
+		ret = append(ret, buf.String())
+	}
+
+	return ret, nil
+}
+
+func (m *DDLTmplMigration) tmplExec(dsn string, stmts []string) error {
+
+	stmts, err := m.render(stmts)
+	if err != nil {
+		return err
+	}
 
-		_, err = db.Exec(newS)
+	db, err := sql.Open(m.DriverNameValue, dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for n, s := range stmts {
+		_, err = db.Exec(s)
 		if err != nil {
 			return fmt.Errorf("DDLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) Exec on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, newS)
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
 		}
 	}
 
@@ -97,3 +113,14 @@ func (m *DDLTmplMigration) ExecUp(dsn string) error {
 func (m *DDLTmplMigration) ExecDown(dsn string) error {
 	return m.tmplExec(dsn, m.DownSQL)
 }
+
+// UpStmts returns the up migration statements with the templates executed.
+// (It makes this a migrate.StmtMigration so it can be run in a transaction.)
+func (m *DDLTmplMigration) UpStmts() ([]string, error) {
+	return m.render(m.UpSQL)
+}
+
+// DownStmts returns the down migration statements with the templates executed.
+func (m *DDLTmplMigration) DownStmts() ([]string, error) {
+	return m.render(m.DownSQL)
+}
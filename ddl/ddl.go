@@ -5,9 +5,15 @@ and is an attempt to make the common operations easy to do (since the uncommon
 operations are usually database-specific and you can still just write them out
 by hand as SQL).  But most applications just want to create some tables and indexes
 add a column from time to time, and maybe some foriegn keys.  This package allows
-you to do that painlessly for SQLite3 and MySQL (Postgres not implemented, yet), with other outputs
+you to do that painlessly for SQLite3, MySQL and Postgres, with other outputs
 possible by implementing the Formatter interface.
 
+To help keep migrations in line with the real schema, Introspect reads the tables of a
+live SQLite3 or MySQL database and Builder.Diff produces the statements to change it to
+a desired schema, which can come from a Builder (see TableDefs) or from struct tags
+(see StructTableDef).  The "migration-diff" cavegen generator uses these to write a
+migration file.
+
 */
 package ddl
 
@@ -21,6 +27,12 @@ package ddl
 // ALTER TABLE MODIFY COLUMN (change type)
 // ALTER TABLE DROP COLUMN
 // ALTER TABLE RENAME COLUMN
+// ALTER TABLE ADD/DROP FOREIGN KEY
+// ALTER TABLE ADD/DROP UNIQUE
+//
+// SQLite3 cannot do most ALTER TABLE operations natively, so they are done by rebuilding the table
+// (https://www.sqlite.org/lang_altertable.html#otheralter).  That needs the full current definition
+// of the table, which the Builder works out from the statements it has seen, see PriorStmtList.
 
 // NOTE: MSSQL seems to support a ALTER INDEX statement but whatever, supporting create index
 // and drop index is fine for now; and we're looking for common functionality across DBs,
@@ -43,9 +55,17 @@ type Builder struct {
 	Category string
 	Version  string
 
+	// Dependencies is category -> minimum version of other categories the next migration
+	// depends on, see DependsOn.
+	Dependencies map[string]string
+
 	UpStmtList   StmtList
 	DownStmtList StmtList
 
+	// PriorStmtList is the up statements from previous calls to Migrations() (and tables
+	// declared with DefineTable), used to know the current definition of each table.
+	PriorStmtList StmtList
+
 	down bool // set to true when new statments are down, default is up
 }
 
@@ -99,8 +119,9 @@ func (b *Builder) MustMigrations(formatters ...Formatter) DDLTmplMigrationList {
 
 // Migrations will generate and return the migrations (compatible with migrate.Migration)
 // that correspond to each of the formatters provided.
-// This call will also clear the Builder of everything except for the category,
-// so subsequent calls can start fresh on the next migration.
+// This call will also clear the Builder of everything except for the category
+// (and the up statements are added to PriorStmtList), so subsequent calls can start
+// fresh on the next migration.
 func (b *Builder) Migrations(formatters ...Formatter) (ml DDLTmplMigrationList, err error) {
 
 	for _, f := range formatters {
@@ -109,6 +130,7 @@ func (b *Builder) Migrations(formatters ...Formatter) (ml DDLTmplMigrationList,
 		m.DriverNameValue = f.DriverName()
 		m.CategoryValue = b.Category
 		m.VersionValue = b.Version
+		m.DependsOnValue = b.Dependencies
 
 		for _, s := range b.UpStmtList {
 			sql, ferr := f.Format(s)
@@ -132,8 +154,8 @@ func (b *Builder) Migrations(formatters ...Formatter) (ml DDLTmplMigrationList,
 
 	}
 
-	// if successful, reset everything except category
-	*b = Builder{Category: b.Category}
+	// if successful, reset everything except category and the prior statements
+	*b = Builder{Category: b.Category, PriorStmtList: append(b.PriorStmtList, b.UpStmtList...)}
 
 	return
 }
@@ -173,6 +195,17 @@ func (b *Builder) SetVersion(version string) *Builder {
 	return b
 }
 
+// DependsOn records that the next migration needs another category to be at a minimum
+// version first, e.g. because it references a table created there.  Like the version this
+// is cleared after Migrations() is called.  See migrate.DependentMigration.
+func (b *Builder) DependsOn(category, version string) *Builder {
+	if b.Dependencies == nil {
+		b.Dependencies = make(map[string]string)
+	}
+	b.Dependencies[category] = version
+	return b
+}
+
 // CreateTable will start a CREATE TABLE definition.
 func (b *Builder) CreateTable(name string) *CreateTableStmt {
 	stmt := &CreateTableStmt{
@@ -225,6 +258,109 @@ func (b *Builder) CreateIndex(indexName, tableName string) *CreateIndexStmt {
 	return stmt
 }
 
+// AlterTableDropColumn will start a ALTER TABLE DROP COLUMN definition.
+func (b *Builder) AlterTableDropColumn(tableName, columnName string) *AlterTableDropColumnStmt {
+	stmt := &AlterTableDropColumnStmt{
+		Builder:     b,
+		NameValue:   tableName,
+		ColumnValue: columnName,
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableRenameColumn will start a ALTER TABLE RENAME COLUMN definition.
+func (b *Builder) AlterTableRenameColumn(tableName, oldColumnName, newColumnName string) *AlterTableRenameColumnStmt {
+	stmt := &AlterTableRenameColumnStmt{
+		Builder:        b,
+		NameValue:      tableName,
+		OldColumnValue: oldColumnName,
+		NewColumnValue: newColumnName,
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableModify will start a ALTER TABLE MODIFY COLUMN definition.  The column
+// is given its complete new definition.
+func (b *Builder) AlterTableModify(tableName string) *AlterTableModifyStmt {
+	stmt := &AlterTableModifyStmt{
+		Builder:   b,
+		NameValue: tableName,
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableAddForeignKey will start a definition to add a named foreign key constraint.
+func (b *Builder) AlterTableAddForeignKey(tableName, fkName string) *AlterTableAddForeignKeyStmt {
+	stmt := &AlterTableAddForeignKeyStmt{
+		Builder:    b,
+		NameValue:  tableName,
+		ForeignKey: CreateTableFKDef{NameValue: fkName},
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableDropForeignKey will start a definition to drop a named foreign key constraint.
+func (b *Builder) AlterTableDropForeignKey(tableName, fkName string) *AlterTableDropForeignKeyStmt {
+	stmt := &AlterTableDropForeignKeyStmt{
+		Builder:             b,
+		NameValue:           tableName,
+		ForeignKeyNameValue: fkName,
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableAddUnique will start a definition to add a named unique constraint.
+func (b *Builder) AlterTableAddUnique(tableName, uniqueName string) *AlterTableAddUniqueStmt {
+	stmt := &AlterTableAddUniqueStmt{
+		Builder:   b,
+		NameValue: tableName,
+		Unique:    UniqueDef{NameValue: uniqueName},
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// AlterTableDropUnique will start a definition to drop a named unique constraint.
+func (b *Builder) AlterTableDropUnique(tableName, uniqueName string) *AlterTableDropUniqueStmt {
+	stmt := &AlterTableDropUniqueStmt{
+		Builder:         b,
+		NameValue:       tableName,
+		UniqueNameValue: uniqueName,
+	}
+	b.pushStmt(stmt)
+	return stmt
+}
+
+// DefineTable tells the Builder about a table which already exists, e.g. one created
+// by hand-written SQL.  It does not produce any SQL.  Formatters which emulate
+// ALTER TABLE by rebuilding the table (SQLite3) need to know the table definition,
+// tables created with CreateTable are known automatically.
+func (b *Builder) DefineTable(name string) *CreateTableStmt {
+	stmt := &CreateTableStmt{
+		Builder:   b,
+		NameValue: name,
+	}
+	b.PriorStmtList = append(b.PriorStmtList, stmt)
+	return stmt
+}
+
+// DefineIndex tells the Builder about an index which already exists, like DefineTable.
+// It does not produce any SQL.
+func (b *Builder) DefineIndex(indexName, tableName string) *CreateIndexStmt {
+	stmt := &CreateIndexStmt{
+		Builder:        b,
+		NameValue:      indexName,
+		TableNameValue: tableName,
+	}
+	b.PriorStmtList = append(b.PriorStmtList, stmt)
+	return stmt
+}
+
 // DropIndex will start a DROP INDEX definition.
 func (b *Builder) DropIndex(indexName, tableName string) *DropIndexStmt {
 	stmt := &DropIndexStmt{
@@ -0,0 +1,346 @@
+package ddl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Diff adds the statements which change the schema from current to desired to the up list,
+// and the statements which change it back to the down list.  Current is usually from
+// Introspect and desired from TableDefs or StructTableDef.  Tables in current which are not
+// in desired are only dropped if dropTables is true.
+//
+// Columns are compared by the SQL f produces for them, so differences which mean nothing
+// to the database (e.g. BigInt and BigIntFK in SQLite3) are ignored.  Indexes and unique
+// constraints are matched by name and foreign keys by their columns and actions.  Renames
+// cannot be detected and come out as a drop and an add, and changing the primary key of a
+// table is not supported.  CHECK constraints and table options are not compared.
+//
+// The current tables are added to PriorStmtList, so formatters which need table definitions
+// for ALTER TABLE (SQLite3) have them.
+func (b *Builder) Diff(current, desired TableDefMap, f Formatter, dropTables bool) error {
+
+	current, desired = current.normalized(), desired.normalized()
+
+	for _, name := range current.sortedNames() {
+		d := current[name]
+		t := *d.Table
+		t.Builder = b
+		b.PriorStmtList = append(b.PriorStmtList, &t)
+		for _, idx := range d.Indexes {
+			i := *idx
+			i.Builder = b
+			b.PriorStmtList = append(b.PriorStmtList, &i)
+		}
+	}
+
+	// each step has its statements and the ones which undo them, the down list is
+	// the undo statements of the steps in reverse order
+	type step struct{ up, down StmtList }
+	var dropFKs, dropIndexes, dropCols, modifyCols, createTables, addCols, addIndexes, addFKs, dropTableSteps []step
+
+	for _, name := range desired.sortedNames() {
+		want := desired[name]
+		have := current[name]
+
+		if have == nil {
+			continue // created below
+		}
+
+		if !reflect.DeepEqual(have.Table.PrimaryKeys, want.Table.PrimaryKeys) {
+			return fmt.Errorf("Diff: primary key of table %q changed from %v to %v, this must be done by hand",
+				name, have.Table.PrimaryKeys, want.Table.PrimaryKeys)
+		}
+
+		// foreign keys
+		for _, fk := range have.Table.ForeignKeys {
+			if findSameFK(want, fk) >= 0 {
+				continue
+			}
+			if fk.NameValue == "" {
+				return fmt.Errorf("Diff: foreign key on %s.%s has no name and cannot be dropped", name, fk.ColumnValue)
+			}
+			dropFKs = append(dropFKs, step{
+				up:   StmtList{&AlterTableDropForeignKeyStmt{Builder: b, NameValue: name, ForeignKeyNameValue: fk.NameValue}},
+				down: StmtList{&AlterTableAddForeignKeyStmt{Builder: b, NameValue: name, ForeignKey: *fk}},
+			})
+		}
+		for _, fk := range want.Table.ForeignKeys {
+			if findSameFK(have, fk) >= 0 {
+				continue
+			}
+			fkDef := *fk
+			if fkDef.NameValue == "" {
+				fkDef.NameValue = name + "_" + fk.ColumnValue + "_fk"
+			}
+			addFKs = append(addFKs, step{
+				up:   StmtList{&AlterTableAddForeignKeyStmt{Builder: b, NameValue: name, ForeignKey: fkDef}},
+				down: StmtList{&AlterTableDropForeignKeyStmt{Builder: b, NameValue: name, ForeignKeyNameValue: fkDef.NameValue}},
+			})
+		}
+
+		// unique constraints
+		for _, u := range have.Table.Uniques {
+			if i := findUnique(want, u.NameValue); i >= 0 && reflect.DeepEqual(want.Table.Uniques[i].ColumnNames, u.ColumnNames) {
+				continue
+			}
+			dropIndexes = append(dropIndexes, step{
+				up:   StmtList{&AlterTableDropUniqueStmt{Builder: b, NameValue: name, UniqueNameValue: u.NameValue}},
+				down: StmtList{&AlterTableAddUniqueStmt{Builder: b, NameValue: name, Unique: *u}},
+			})
+		}
+		for _, u := range want.Table.Uniques {
+			if i := findUnique(have, u.NameValue); i >= 0 && reflect.DeepEqual(have.Table.Uniques[i].ColumnNames, u.ColumnNames) {
+				continue
+			}
+			addIndexes = append(addIndexes, step{
+				up:   StmtList{&AlterTableAddUniqueStmt{Builder: b, NameValue: name, Unique: *u}},
+				down: StmtList{&AlterTableDropUniqueStmt{Builder: b, NameValue: name, UniqueNameValue: u.NameValue}},
+			})
+		}
+
+		// indexes
+		for _, idx := range have.Indexes {
+			if findSameIndex(want, idx) != nil {
+				continue
+			}
+			dropIndexes = append(dropIndexes, step{
+				up:   StmtList{&DropIndexStmt{Builder: b, NameValue: idx.NameValue, TableNameValue: name}},
+				down: StmtList{indexStmt(b, idx)},
+			})
+		}
+		for _, idx := range want.Indexes {
+			if findSameIndex(have, idx) != nil {
+				continue
+			}
+			addIndexes = append(addIndexes, step{
+				up:   StmtList{indexStmt(b, idx)},
+				down: StmtList{&DropIndexStmt{Builder: b, NameValue: idx.NameValue, TableNameValue: name}},
+			})
+		}
+
+		// columns
+		for _, col := range have.Table.Columns {
+			if want.Column(col.NameValue) != nil {
+				continue
+			}
+			dropCols = append(dropCols, step{
+				up:   StmtList{&AlterTableDropColumnStmt{Builder: b, NameValue: name, ColumnValue: col.NameValue}},
+				down: StmtList{&AlterTableAddStmt{Builder: b, NameValue: name, DataTypeDef: *col}},
+			})
+		}
+		for _, col := range want.Table.Columns {
+			haveCol := have.Column(col.NameValue)
+			if haveCol == nil {
+				addCols = append(addCols, step{
+					up:   StmtList{&AlterTableAddStmt{Builder: b, NameValue: name, DataTypeDef: *col}},
+					down: StmtList{&AlterTableDropColumnStmt{Builder: b, NameValue: name, ColumnValue: col.NameValue}},
+				})
+				continue
+			}
+			same, err := sameColumn(f, have.Table, haveCol, col)
+			if err != nil {
+				return err
+			}
+			if !same {
+				modifyCols = append(modifyCols, step{
+					up:   StmtList{&AlterTableModifyStmt{Builder: b, NameValue: name, DataTypeDef: *col}},
+					down: StmtList{&AlterTableModifyStmt{Builder: b, NameValue: name, DataTypeDef: *haveCol}},
+				})
+			}
+		}
+
+	}
+
+	// new tables, ordered so referenced tables are created first
+	var newNames []string
+	for _, name := range desired.sortedNames() {
+		if current[name] == nil {
+			newNames = append(newNames, name)
+		}
+	}
+	for _, name := range desired.fkOrder(newNames) {
+		d := desired[name]
+		s := step{up: StmtList{createTableStmt(b, d.Table)}}
+		for _, idx := range d.Indexes {
+			s.up = append(s.up, indexStmt(b, idx))
+		}
+		s.down = StmtList{&DropTableStmt{Builder: b, NameValue: name}}
+		createTables = append(createTables, s)
+	}
+
+	// dropped tables, referencing tables dropped first
+	if dropTables {
+		var oldNames []string
+		for _, name := range current.sortedNames() {
+			if desired[name] == nil {
+				oldNames = append(oldNames, name)
+			}
+		}
+		order := current.fkOrder(oldNames)
+		for i := len(order) - 1; i >= 0; i-- {
+			d := current[order[i]]
+			s := step{up: StmtList{&DropTableStmt{Builder: b, NameValue: order[i]}}}
+			s.down = StmtList{createTableStmt(b, d.Table)}
+			for _, idx := range d.Indexes {
+				s.down = append(s.down, indexStmt(b, idx))
+			}
+			dropTableSteps = append(dropTableSteps, s)
+		}
+	}
+
+	var all []step
+	for _, steps := range [][]step{dropFKs, dropIndexes, dropCols, modifyCols, dropTableSteps, createTables, addCols, addIndexes, addFKs} {
+		all = append(all, steps...)
+	}
+	for _, s := range all {
+		b.UpStmtList = append(b.UpStmtList, s.up...)
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		b.DownStmtList = append(b.DownStmtList, all[i].down...)
+	}
+
+	return nil
+}
+
+// TableDefs returns the table definitions which result from PriorStmtList and UpStmtList.
+func (b *Builder) TableDefs() (TableDefMap, error) {
+	m := make(TableDefMap)
+	for _, list := range []StmtList{b.PriorStmtList, b.UpStmtList} {
+		for _, s := range list {
+			if err := m.Apply(s); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return m, nil
+}
+
+// normalized returns a copy of m with unique indexes as unique constraints, since
+// databases do not distinguish them and neither does Introspect.
+func (m TableDefMap) normalized() TableDefMap {
+	ret := make(TableDefMap, len(m))
+	for name, d := range m {
+		d = d.Clone()
+		idxs := d.Indexes[:0]
+		for _, idx := range d.Indexes {
+			if idx.UniqueValue {
+				d.Table.Uniques = append(d.Table.Uniques, &UniqueDef{NameValue: idx.NameValue, ColumnNames: idx.ColumnNames})
+				continue
+			}
+			idxs = append(idxs, idx)
+		}
+		d.Indexes = idxs
+		ret[name] = d
+	}
+	return ret
+}
+
+func (m TableDefMap) sortedNames() []string {
+	ret := make([]string, 0, len(m))
+	for name := range m {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// fkOrder returns names ordered so that tables come after the tables they have foreign keys to.
+// Tables in a cycle are left in the order given.
+func (m TableDefMap) fkOrder(names []string) []string {
+	var ret []string
+	done := make(map[string]bool)
+	for len(ret) < len(names) {
+		progress := false
+		for _, name := range names {
+			if done[name] {
+				continue
+			}
+			ready := true
+			for _, fk := range m[name].Table.ForeignKeys {
+				if fk.OtherTableValue != name && containsString(names, fk.OtherTableValue) && !done[fk.OtherTableValue] {
+					ready = false
+				}
+			}
+			if ready {
+				ret = append(ret, name)
+				done[name] = true
+				progress = true
+			}
+		}
+		if !progress { // cycle
+			for _, name := range names {
+				if !done[name] {
+					ret = append(ret, name)
+					done[name] = true
+				}
+			}
+		}
+	}
+	return ret
+}
+
+func findSameFK(d *TableDef, fk *CreateTableFKDef) int {
+	for i, f := range d.Table.ForeignKeys {
+		if f.ColumnValue == fk.ColumnValue && f.OtherTableValue == fk.OtherTableValue && f.OtherColumnValue == fk.OtherColumnValue &&
+			sameFKAction(f.OnDeleteValue, fk.OnDeleteValue) && sameFKAction(f.OnUpdateValue, fk.OnUpdateValue) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameFKAction compares foreign key actions, treating the ones which prevent the change
+// (and the default, which is one of those) as equal.  MySQL reports RESTRICT as its default
+// and the difference between RESTRICT and NO ACTION is only when the check is done.
+func sameFKAction(a, b FKAction) bool {
+	prevents := func(a FKAction) bool { return a == "" || a == NoAction || a == Restrict }
+	return a == b || (prevents(a) && prevents(b))
+}
+
+func findSameIndex(d *TableDef, idx *CreateIndexStmt) *CreateIndexStmt {
+	for _, i := range d.Indexes {
+		if i.NameValue == idx.NameValue && i.UniqueValue == idx.UniqueValue && reflect.DeepEqual(i.ColumnNames, idx.ColumnNames) {
+			return i
+		}
+	}
+	return nil
+}
+
+// sameColumn returns true if f formats columns a and b of table the same way.
+func sameColumn(f Formatter, table *CreateTableStmt, a, b *DataTypeDef) (bool, error) {
+	format := func(col *DataTypeDef) (string, error) {
+		// the table is given to a Builder since the column can depend on it (e.g. SQLite3 STRICT)
+		tb := &Builder{PriorStmtList: StmtList{table}}
+		st := &AlterTableAddStmt{Builder: tb, NameValue: table.NameValue, DataTypeDef: *col}
+		tb.UpStmtList = StmtList{st}
+		ret, err := f.Format(st)
+		return strings.Join(ret, ";"), err
+	}
+	as, err := format(a)
+	if err != nil {
+		return false, err
+	}
+	bs, err := format(b)
+	if err != nil {
+		return false, err
+	}
+	return as == bs, nil
+}
+
+func createTableStmt(b *Builder, t *CreateTableStmt) *CreateTableStmt {
+	d := (&TableDef{Table: t}).Clone()
+	d.Table.Builder = b
+	d.Table.IfNotExistsValue = false
+	return d.Table
+}
+
+func indexStmt(b *Builder, idx *CreateIndexStmt) *CreateIndexStmt {
+	i := *idx
+	i.Builder = b
+	i.IfNotExistsValue = false
+	i.ColumnNames = append([]string(nil), idx.ColumnNames...)
+	return &i
+}
@@ -62,19 +62,35 @@ func (f *MySQLFormatter) Format(stmt Stmt) ([]string, error) {
 			fmt.Fprintf(&buf, "),\n")
 		}
 
+		for _, u := range st.Uniques {
+			fmt.Fprintf(&buf, "    %s,\n", f.uniqueStr(u))
+		}
+
 		for _, fk := range st.ForeignKeys {
-			fmt.Fprintf(&buf, "    FOREIGN KEY(%s) REFERENCES %s(%s),",
-				mysqlQuoteIdent(fk.ColumnValue),
-				mysqlQuoteIdent(f.tmplPrefix()+fk.OtherTableValue),
-				mysqlQuoteIdent(fk.OtherColumnValue),
-			)
+			fmt.Fprintf(&buf, "    %s,\n", f.fkStr(fk))
+		}
+
+		// NOTE: CHECK constraints are parsed but ignored before MySQL 8.0.16
+		for _, c := range st.Checks {
+			fmt.Fprintf(&buf, "    %s,\n", checkStr(c, mysqlQuoteIdent(f.tmplPrefix()+c.NameValue)))
 		}
 
-		// Use utf8mb4 as the character set for everything not explicitly
-		// set on a column. Let the db choose the default collation, since
-		// it will use the most recent case-insensitive unicode comparision,
-		// which is usually exactly what you want.
-		tableSuffixStr := " /*!50508 CHARSET=utf8mb4 */"
+		tableSuffixStr := ""
+		if st.MySQLEngineValue != "" {
+			tableSuffixStr += " ENGINE=" + st.MySQLEngineValue
+		}
+		if st.MySQLCharsetValue != "" {
+			tableSuffixStr += " CHARSET=" + st.MySQLCharsetValue
+		} else {
+			// Use utf8mb4 as the character set for everything not explicitly
+			// set on a column. Let the db choose the default collation, since
+			// it will use the most recent case-insensitive unicode comparision,
+			// which is usually exactly what you want.
+			tableSuffixStr += " /*!50508 CHARSET=utf8mb4 */"
+		}
+		if st.MySQLCollationValue != "" {
+			tableSuffixStr += " COLLATE=" + st.MySQLCollationValue
+		}
 
 		// remove any trailing comma and close table definition
 		fullStr := strings.TrimSuffix(strings.TrimSpace(buf.String()), ",") + "\n)" +
@@ -103,6 +119,62 @@ func (f *MySQLFormatter) Format(stmt Stmt) ([]string, error) {
 		)
 		return []string{buf.String()}, nil
 
+	case *AlterTableDropColumnStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP COLUMN %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			mysqlQuoteIdent(st.ColumnValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableRenameColumnStmt:
+		// NOTE: requires MySQL 8.0 or MariaDB 10.5
+		fmt.Fprintf(&buf, `ALTER TABLE %s RENAME COLUMN %s TO %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			mysqlQuoteIdent(st.OldColumnValue),
+			mysqlQuoteIdent(st.NewColumnValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableModifyStmt:
+		colStr, err := mysqlColStr(&st.DataTypeDef)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `ALTER TABLE %s MODIFY COLUMN %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			colStr,
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableAddForeignKeyStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s ADD %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			f.fkStr(&st.ForeignKey),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableDropForeignKeyStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP FOREIGN KEY %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			mysqlQuoteIdent(f.tmplPrefix()+st.ForeignKeyNameValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableAddUniqueStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s ADD %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			f.uniqueStr(&st.Unique),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableDropUniqueStmt:
+		// unique constraints are indexes in MySQL
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP INDEX %s`,
+			mysqlQuoteIdent(f.tmplPrefix()+st.NameValue),
+			mysqlQuoteIdent(f.tmplPrefix()+st.UniqueNameValue),
+		)
+		return []string{buf.String()}, nil
+
 	case *CreateIndexStmt:
 		uniqueStr := ""
 		if st.UniqueValue {
@@ -144,6 +216,30 @@ func (f *MySQLFormatter) Format(stmt Stmt) ([]string, error) {
 	return nil, fmt.Errorf("unknown statement type %T", stmt)
 }
 
+// constraint names are prefixed like index names, foreign key names must be unique in the database
+
+func (f *MySQLFormatter) fkStr(fk *CreateTableFKDef) string {
+	constraintStr := ""
+	if fk.NameValue != "" {
+		constraintStr = "CONSTRAINT " + mysqlQuoteIdent(f.tmplPrefix()+fk.NameValue) + " "
+	}
+	return fmt.Sprintf("%sFOREIGN KEY(%s) REFERENCES %s(%s)%s",
+		constraintStr,
+		mysqlQuoteIdent(fk.ColumnValue),
+		mysqlQuoteIdent(f.tmplPrefix()+fk.OtherTableValue),
+		mysqlQuoteIdent(fk.OtherColumnValue),
+		fkActionsStr(fk),
+	)
+}
+
+func (f *MySQLFormatter) uniqueStr(u *UniqueDef) string {
+	colStr := ""
+	for _, colName := range u.ColumnNames {
+		colStr += mysqlQuoteIdent(colName) + ","
+	}
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE(%s)", mysqlQuoteIdent(f.tmplPrefix()+u.NameValue), strings.TrimRight(colStr, ","))
+}
+
 func mysqlQuoteIdent(ident string) string {
 	return quoteIdent(ident, "`")
 }
@@ -229,6 +325,9 @@ func mysqlColStr(col *DataTypeDef) (string, error) {
 			lengthStr = fmt.Sprintf("(%d)", col.LengthValue)
 		}
 		return fmt.Sprintf("%s BLOB%s%s%s", mysqlQuoteIdent(col.NameValue), lengthStr, nullStr, defaultStr), nil
+	case Decimal:
+		precision, scale := decimalPrecisionScale(col)
+		return fmt.Sprintf("%s DECIMAL(%d,%d)%s%s", mysqlQuoteIdent(col.NameValue), precision, scale, nullStr, defaultStr), nil
 
 	}
 
@@ -0,0 +1,370 @@
+package ddl
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// PostgresNoCaseCollation is the name of the collation used by PostgresFormatter for case
+// insensitive columns when NoCaseCollation is set.  Postgres compares strings case
+// sensitively and has no built-in case insensitive collation, so one is created (IF NOT
+// EXISTS) before any statement that needs it.  It is a nondeterministic ICU collation,
+// which requires Postgres built with ICU support, and LIKE on such columns is only
+// supported from Postgres 18 on (earlier versions give an error).
+const PostgresNoCaseCollation = "caveman_nocase"
+
+// PostgresFormatter makes SQL for Postgres.  By default columns which are not
+// CaseSensitive get no collation, so they compare case sensitively (unlike the SQLite3
+// and MySQL formatters), and case insensitive matching is done in queries with ILIKE
+// or lower().
+type PostgresFormatter struct {
+	Template        bool // set to true to enable template output (supports prefixes)
+	NoCaseCollation bool // set to true to give case insensitive columns PostgresNoCaseCollation (Postgres 18+ for LIKE)
+}
+
+// NewPostgresFormatter returns a new PostgresFormatter. If the template argument
+// is true then table prefixes (and any other templatable features)
+// will be output in Go template form, for use with migrations.  Passing false
+// will produce raw SQL that can be executed directly.
+func NewPostgresFormatter(template bool) *PostgresFormatter {
+	return &PostgresFormatter{Template: template}
+}
+
+func (f *PostgresFormatter) tmplPrefix() string {
+	if f.Template {
+		return "{{.TablePrefix}}"
+	}
+	return ""
+}
+
+func (f *PostgresFormatter) DriverName() string {
+	return "postgres"
+}
+
+func (f *PostgresFormatter) Format(stmt Stmt) ([]string, error) {
+
+	var buf bytes.Buffer
+
+	switch st := stmt.(type) {
+
+	case *CreateTableStmt:
+		ifNotExistsStr := ""
+		if st.IfNotExistsValue {
+			ifNotExistsStr = "IF NOT EXISTS "
+		}
+		fmt.Fprintf(&buf, `CREATE TABLE %s%s (`+"\n", ifNotExistsStr, postgresQuoteIdent(f.tmplPrefix()+st.NameValue))
+
+		for _, col := range st.Columns {
+
+			colstr, err := f.colStr(col)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&buf, "    %s,\n", colstr)
+		}
+
+		if len(st.PrimaryKeys) > 0 {
+			fmt.Fprintf(&buf, "    PRIMARY KEY(")
+			for idx, pk := range st.PrimaryKeys {
+				fmt.Fprintf(&buf, "%s", postgresQuoteIdent(pk))
+				if idx < len(st.PrimaryKeys)-1 {
+					fmt.Fprintf(&buf, ",")
+				}
+			}
+			fmt.Fprintf(&buf, "),\n")
+		}
+
+		for _, u := range st.Uniques {
+			fmt.Fprintf(&buf, "    %s,\n", f.uniqueStr(u))
+		}
+
+		for _, fk := range st.ForeignKeys {
+			fmt.Fprintf(&buf, "    %s,\n", f.fkStr(fk))
+		}
+
+		for _, c := range st.Checks {
+			fmt.Fprintf(&buf, "    %s,\n", checkStr(c, postgresQuoteIdent(f.tmplPrefix()+c.NameValue)))
+		}
+
+		// remove any trailing comma and close table definition
+		fullStr := strings.TrimSuffix(strings.TrimSpace(buf.String()), ",") + "\n)"
+		return f.withCollation([]string{fullStr}, st.Columns...), nil
+
+	case *DropTableStmt:
+		fmt.Fprintf(&buf, `DROP TABLE %s`, postgresQuoteIdent(f.tmplPrefix()+st.NameValue))
+		return []string{buf.String()}, nil
+
+	case *AlterTableRenameStmt:
+		// the new name cannot have a schema, the table stays in the same one
+		newName := st.NewNameValue
+		if i := strings.LastIndex(newName, "."); i >= 0 {
+			newName = newName[i+1:]
+		}
+		fmt.Fprintf(&buf, `ALTER TABLE %s RENAME TO %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.OldNameValue),
+			postgresQuoteIdent(f.tmplPrefix()+newName),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableAddStmt:
+		colStr, err := f.colStr(&st.DataTypeDef)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `ALTER TABLE %s ADD COLUMN %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			colStr,
+		)
+		return f.withCollation([]string{buf.String()}, &st.DataTypeDef), nil
+
+	case *AlterTableDropColumnStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP COLUMN %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			postgresQuoteIdent(st.ColumnValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableRenameColumnStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s RENAME COLUMN %s TO %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			postgresQuoteIdent(st.OldColumnValue),
+			postgresQuoteIdent(st.NewColumnValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableModifyStmt:
+		// type, null and default are each changed separately, in one statement
+		// NOTE: the CHECK constraint for unsigned types is not added or removed
+		col := &st.DataTypeDef
+		typ, collateStr, err := f.colType(col)
+		if err != nil {
+			return nil, fmt.Errorf("cannot modify column %q: %v", col.NameValue, err)
+		}
+		colName := postgresQuoteIdent(col.NameValue)
+		nullStr := "SET NOT NULL"
+		if col.NullValue {
+			nullStr = "DROP NOT NULL"
+		}
+		defaultStr := "DROP DEFAULT"
+		if col.DefaultValue != nil {
+			defaultStr = "SET DEFAULT " + postgresDefaultStr(col)
+		}
+		fmt.Fprintf(&buf, `ALTER TABLE %s ALTER COLUMN %s TYPE %s%s USING %s::%s, ALTER COLUMN %s %s, ALTER COLUMN %s %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			colName, typ, collateStr, colName, typ,
+			colName, nullStr,
+			colName, defaultStr,
+		)
+		return f.withCollation([]string{buf.String()}, col), nil
+
+	case *AlterTableAddForeignKeyStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s ADD %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			f.fkStr(&st.ForeignKey),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableDropForeignKeyStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP CONSTRAINT %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			postgresQuoteIdent(f.tmplPrefix()+st.ForeignKeyNameValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableAddUniqueStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s ADD %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			f.uniqueStr(&st.Unique),
+		)
+		return []string{buf.String()}, nil
+
+	case *AlterTableDropUniqueStmt:
+		fmt.Fprintf(&buf, `ALTER TABLE %s DROP CONSTRAINT %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			postgresQuoteIdent(f.tmplPrefix()+st.UniqueNameValue),
+		)
+		return []string{buf.String()}, nil
+
+	case *CreateIndexStmt:
+		uniqueStr := ""
+		if st.UniqueValue {
+			uniqueStr = " UNIQUE"
+		}
+		ifNotExistsStr := ""
+		if st.IfNotExistsValue {
+			ifNotExistsStr = " IF NOT EXISTS"
+		}
+		colStr := ""
+		for _, colName := range st.ColumnNames {
+			colStr += postgresQuoteIdent(colName) + ","
+		}
+		colStr = strings.TrimRight(colStr, ",")
+		fmt.Fprintf(&buf, `CREATE%s INDEX%s %s ON %s(%s)`,
+			uniqueStr,
+			ifNotExistsStr,
+			// NOTE: the index is always created in the table's schema, the name cannot have one
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			postgresQuoteIdent(f.tmplPrefix()+st.TableNameValue),
+			colStr,
+		)
+		return []string{buf.String()}, nil
+
+	case *DropIndexStmt:
+		fmt.Fprintf(&buf, `DROP INDEX %s`,
+			postgresQuoteIdent(f.tmplPrefix()+st.NameValue),
+			// NOTE: index names are unique per schema in Postgres, the table name is not used
+		)
+		return []string{buf.String()}, nil
+
+	}
+
+	return nil, fmt.Errorf("unknown statement type %T", stmt)
+}
+
+// constraint names are prefixed like index names, unique constraints are indexes
+// and their names must be unique in the schema
+
+func (f *PostgresFormatter) fkStr(fk *CreateTableFKDef) string {
+	constraintStr := ""
+	if fk.NameValue != "" {
+		constraintStr = "CONSTRAINT " + postgresQuoteIdent(f.tmplPrefix()+fk.NameValue) + " "
+	}
+	return fmt.Sprintf("%sFOREIGN KEY(%s) REFERENCES %s(%s)%s",
+		constraintStr,
+		postgresQuoteIdent(fk.ColumnValue),
+		postgresQuoteIdent(f.tmplPrefix()+fk.OtherTableValue),
+		postgresQuoteIdent(fk.OtherColumnValue),
+		fkActionsStr(fk),
+	)
+}
+
+func (f *PostgresFormatter) uniqueStr(u *UniqueDef) string {
+	colStr := ""
+	for _, colName := range u.ColumnNames {
+		colStr += postgresQuoteIdent(colName) + ","
+	}
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE(%s)", postgresQuoteIdent(f.tmplPrefix()+u.NameValue), strings.TrimRight(colStr, ","))
+}
+
+func postgresQuoteIdent(ident string) string {
+	return quoteIdent(ident, `"`)
+}
+
+// https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-SYNTAX-STRINGS
+// (assumes standard_conforming_strings, the default since 9.1)
+func postgresEncodeString(s string) string {
+	return `'` + strings.Replace(s, `'`, `''`, -1) + `'`
+}
+
+// needsCollation returns true if the column is declared case insensitive and
+// NoCaseCollation is set.
+func (f *PostgresFormatter) needsCollation(col *DataTypeDef) bool {
+	return f.NoCaseCollation && (col.DataTypeValue == VarChar || col.DataTypeValue == Text) && !col.CaseSensitiveValue
+}
+
+// withCollation prepends the statement creating PostgresNoCaseCollation if any
+// of cols need it.
+func (f *PostgresFormatter) withCollation(stmts []string, cols ...*DataTypeDef) []string {
+	for _, col := range cols {
+		if f.needsCollation(col) {
+			return append([]string{`CREATE COLLATION IF NOT EXISTS ` + postgresQuoteIdent(PostgresNoCaseCollation) +
+				` (provider = icu, locale = 'und-u-ks-level2', deterministic = false)`}, stmts...)
+		}
+	}
+	return stmts
+}
+
+func postgresDefaultStr(col *DataTypeDef) string {
+	if col.DefaultValue == nil {
+		return ""
+	}
+	if s, ok := col.DefaultValue.(string); ok {
+		return postgresEncodeString(s)
+	}
+	// FIXME: we should be more careful about what escaping and formatting is used here
+	// and the various possible data types
+	return fmt.Sprintf("%v", col.DefaultValue)
+}
+
+// colType returns the type of a column and the collation clause (if any).
+// Custom and BigIntAutoPK columns are not supported, their SQL is more than a type.
+func (f *PostgresFormatter) colType(col *DataTypeDef) (typ, collateStr string, err error) {
+
+	if f.needsCollation(col) {
+		collateStr = " COLLATE " + postgresQuoteIdent(PostgresNoCaseCollation)
+	}
+
+	switch col.DataTypeValue {
+	case VarCharPK, VarCharFK:
+		// always case sensitive
+		return "VARCHAR(64)", "", nil
+	case BigIntFK, BigInt:
+		return "BIGINT", "", nil
+	case Int:
+		return "INTEGER", "", nil
+	case IntU:
+		// there are no unsigned types, use the next size up (or NUMERIC for BIGINT), see colStr for the check
+		return "BIGINT", "", nil
+	case BigIntU:
+		return "NUMERIC(20)", "", nil
+	case Double:
+		return "DOUBLE PRECISION", "", nil
+	case DateTime:
+		// microsecond precision, same as MySQL
+		return "TIMESTAMP(6)", "", nil
+	case VarChar:
+		// same default length as MySQL, so the same data fits in either
+		if col.LengthValue > 0 {
+			return fmt.Sprintf("VARCHAR(%d)", col.LengthValue), collateStr, nil
+		}
+		return "VARCHAR(128)", collateStr, nil
+	case Text:
+		// TEXT has no length in Postgres
+		return "TEXT", collateStr, nil
+	case Bool:
+		return "BOOLEAN", "", nil
+	case Blob:
+		return "BYTEA", "", nil
+	case Decimal:
+		precision, scale := decimalPrecisionScale(col)
+		return fmt.Sprintf("NUMERIC(%d,%d)", precision, scale), "", nil
+	case Custom, BigIntAutoPK:
+		return "", "", fmt.Errorf("DataType %v has no separate type in Postgres", col.DataTypeValue)
+	}
+
+	return "", "", fmt.Errorf("unknown DataType: %v", col.DataTypeValue)
+}
+
+func (f *PostgresFormatter) colStr(col *DataTypeDef) (string, error) {
+
+	name := postgresQuoteIdent(col.NameValue)
+
+	switch col.DataTypeValue {
+	case Custom:
+		return fmt.Sprintf("%s %s", name, col.CustomSQLValue), nil
+	case BigIntAutoPK:
+		// identity columns are the standard replacement for SERIAL (Postgres 10+)
+		return fmt.Sprintf("%s BIGINT GENERATED BY DEFAULT AS IDENTITY", name), nil
+	}
+
+	typ, collateStr, err := f.colType(col)
+	if err != nil {
+		return "", err
+	}
+
+	defaultStr := ""
+	if col.DefaultValue != nil {
+		defaultStr = " DEFAULT " + postgresDefaultStr(col)
+	}
+	nullStr := " NOT NULL"
+	if col.NullValue {
+		nullStr = " NULL"
+	}
+	checkStr := ""
+	if col.DataTypeValue == IntU || col.DataTypeValue == BigIntU {
+		checkStr = fmt.Sprintf(" CHECK (%s >= 0)", name)
+	}
+
+	return fmt.Sprintf("%s %s%s%s%s%s", name, typ, collateStr, nullStr, defaultStr, checkStr), nil
+}
@@ -36,62 +36,16 @@ func (f *SQLite3Formatter) Format(stmt Stmt) ([]string, error) {
 	switch st := stmt.(type) {
 
 	case *CreateTableStmt:
-		ifNotExistsStr := ""
-		if st.IfNotExistsValue {
-			ifNotExistsStr = "IF NOT EXISTS "
-		}
-		fmt.Fprintf(&buf, `CREATE TABLE %s%s (`+"\n", ifNotExistsStr, sqlite3QuoteIdent(f.tmplPrefix()+st.NameValue))
-
-		skipPKBlock := false
-		for _, col := range st.Columns {
-
-			// due to syntactic funk, we need to declare the primary key on the column for
-			// autoincrement functionality and cannot have a separate PRIMARY KEY(field) block
-			if col.DataTypeValue == BigIntAutoPK {
-				skipPKBlock = true
-			}
-
-			colstr, err := sqlite3ColStr(col)
-			if err != nil {
-				return nil, err
-			}
-			fmt.Fprintf(&buf, "    %s,\n", colstr)
-		}
-
-		if (!skipPKBlock) && len(st.PrimaryKeys) > 0 {
-			fmt.Fprintf(&buf, "    PRIMARY KEY(")
-			for idx, pk := range st.PrimaryKeys {
-				fmt.Fprintf(&buf, "%s", sqlite3QuoteIdent(pk))
-				if idx < len(st.PrimaryKeys)-1 {
-					fmt.Fprintf(&buf, ",")
-				}
-			}
-			fmt.Fprintf(&buf, "),\n")
-		}
-
-		for _, fk := range st.ForeignKeys {
-			fmt.Fprintf(&buf, "    FOREIGN KEY(%s) REFERENCES %s(%s),",
-				sqlite3QuoteIdent(fk.ColumnValue),
-				sqlite3QuoteIdent(f.tmplPrefix()+fk.OtherTableValue),
-				sqlite3QuoteIdent(fk.OtherColumnValue),
-			)
-		}
-
-		withoutRowidStr := ""
-		for _, col := range st.Columns {
-			if col.DataTypeValue == VarCharPK { // varchar primary key triggers WITHOUT ROWID
-				withoutRowidStr = " WITHOUT ROWID"
-				break
-			}
+		createStr, err := f.createTableSQL(st.NameValue, st)
+		if err != nil {
+			return nil, err
 		}
-		if len(st.PrimaryKeys) > 1 { // multiple pks triggers WITHOUT ROWID
-			withoutRowidStr = " WITHOUT ROWID"
+		ret := []string{createStr}
+		// unique constraints are always unique indexes, so they can be dropped by name
+		for _, u := range st.Uniques {
+			ret = append(ret, f.uniqueIndexSQL(st.NameValue, u))
 		}
-
-		// remove any trailing comma and close table definition
-		fullStr := strings.TrimSuffix(strings.TrimSpace(buf.String()), ",") + "\n)" +
-			withoutRowidStr
-		return []string{fullStr}, nil
+		return ret, nil
 
 	case *DropTableStmt:
 		fmt.Fprintf(&buf, `DROP TABLE %s`, sqlite3QuoteIdent(f.tmplPrefix()+st.NameValue))
@@ -105,7 +59,7 @@ func (f *SQLite3Formatter) Format(stmt Stmt) ([]string, error) {
 		return []string{buf.String()}, nil
 
 	case *AlterTableAddStmt:
-		colStr, err := sqlite3ColStr(&st.DataTypeDef)
+		colStr, err := sqlite3ColStr(&st.DataTypeDef, f.isStrict(st.Builder, st, st.NameValue))
 		if err != nil {
 			return nil, err
 		}
@@ -116,28 +70,36 @@ func (f *SQLite3Formatter) Format(stmt Stmt) ([]string, error) {
 		return []string{buf.String()}, nil
 
 	case *CreateIndexStmt:
-		uniqueStr := ""
-		if st.UniqueValue {
-			uniqueStr = " UNIQUE"
-		}
-		ifNotExistsStr := ""
-		if st.IfNotExistsValue {
-			ifNotExistsStr = " IF NOT EXISTS"
-		}
-		colStr := ""
-		for _, colName := range st.ColumnNames {
-			colStr += sqlite3QuoteIdent(colName) + ","
-		}
-		colStr = strings.TrimRight(colStr, ",")
-		fmt.Fprintf(&buf, `CREATE%s INDEX%s %s ON %s(%s)`,
-			uniqueStr,
-			ifNotExistsStr,
+		return []string{f.createIndexSQL(st)}, nil
+
+	case *AlterTableRenameColumnStmt:
+		// supported natively since SQLite 3.25
+		fmt.Fprintf(&buf, `ALTER TABLE %s RENAME COLUMN %s TO %s`,
 			sqlite3QuoteIdent(f.tmplPrefix()+st.NameValue),
-			sqlite3QuoteIdent(f.tmplPrefix()+st.TableNameValue),
-			colStr,
+			sqlite3QuoteIdent(st.OldColumnValue),
+			sqlite3QuoteIdent(st.NewColumnValue),
 		)
 		return []string{buf.String()}, nil
 
+	case *AlterTableDropColumnStmt:
+		return f.rebuildTable(st.Builder, st, st.NameValue)
+
+	case *AlterTableModifyStmt:
+		return f.rebuildTable(st.Builder, st, st.NameValue)
+
+	case *AlterTableAddForeignKeyStmt:
+		return f.rebuildTable(st.Builder, st, st.NameValue)
+
+	case *AlterTableDropForeignKeyStmt:
+		return f.rebuildTable(st.Builder, st, st.NameValue)
+
+	case *AlterTableAddUniqueStmt:
+		return []string{f.uniqueIndexSQL(st.NameValue, &st.Unique)}, nil
+
+	case *AlterTableDropUniqueStmt:
+		fmt.Fprintf(&buf, `DROP INDEX %s`, sqlite3QuoteIdent(f.tmplPrefix()+st.UniqueNameValue))
+		return []string{buf.String()}, nil
+
 	case *DropIndexStmt:
 		fmt.Fprintf(&buf, `DROP INDEX %s`,
 			sqlite3QuoteIdent(f.tmplPrefix()+st.NameValue),
@@ -150,6 +112,208 @@ func (f *SQLite3Formatter) Format(stmt Stmt) ([]string, error) {
 	return nil, fmt.Errorf("unknown statement type %T", stmt)
 }
 
+// createTableSQL returns the CREATE TABLE statement for st, with the table called name.
+func (f *SQLite3Formatter) createTableSQL(name string, st *CreateTableStmt) (string, error) {
+
+	var buf bytes.Buffer
+
+	ifNotExistsStr := ""
+	if st.IfNotExistsValue {
+		ifNotExistsStr = "IF NOT EXISTS "
+	}
+	fmt.Fprintf(&buf, `CREATE TABLE %s%s (`+"\n", ifNotExistsStr, sqlite3QuoteIdent(f.tmplPrefix()+name))
+
+	skipPKBlock := false
+	for _, col := range st.Columns {
+
+		// due to syntactic funk, we need to declare the primary key on the column for
+		// autoincrement functionality and cannot have a separate PRIMARY KEY(field) block
+		if col.DataTypeValue == BigIntAutoPK {
+			skipPKBlock = true
+		}
+
+		colstr, err := sqlite3ColStr(col, st.SQLite3StrictValue)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&buf, "    %s,\n", colstr)
+	}
+
+	if (!skipPKBlock) && len(st.PrimaryKeys) > 0 {
+		fmt.Fprintf(&buf, "    PRIMARY KEY(")
+		for idx, pk := range st.PrimaryKeys {
+			fmt.Fprintf(&buf, "%s", sqlite3QuoteIdent(pk))
+			if idx < len(st.PrimaryKeys)-1 {
+				fmt.Fprintf(&buf, ",")
+			}
+		}
+		fmt.Fprintf(&buf, "),\n")
+	}
+
+	for _, fk := range st.ForeignKeys {
+		constraintStr := ""
+		if fk.NameValue != "" {
+			constraintStr = "CONSTRAINT " + sqlite3QuoteIdent(f.tmplPrefix()+fk.NameValue) + " "
+		}
+		fmt.Fprintf(&buf, "    %sFOREIGN KEY(%s) REFERENCES %s(%s)%s,\n",
+			constraintStr,
+			sqlite3QuoteIdent(fk.ColumnValue),
+			sqlite3QuoteIdent(f.tmplPrefix()+fk.OtherTableValue),
+			sqlite3QuoteIdent(fk.OtherColumnValue),
+			fkActionsStr(fk),
+		)
+	}
+
+	for _, c := range st.Checks {
+		fmt.Fprintf(&buf, "    %s,\n", checkStr(c, sqlite3QuoteIdent(f.tmplPrefix()+c.NameValue)))
+	}
+
+	var options []string
+	withoutRowid := st.SQLite3WithoutRowidValue
+	for _, col := range st.Columns {
+		if col.DataTypeValue == VarCharPK { // varchar primary key triggers WITHOUT ROWID
+			withoutRowid = true
+			break
+		}
+	}
+	if len(st.PrimaryKeys) > 1 { // multiple pks triggers WITHOUT ROWID
+		withoutRowid = true
+	}
+	if withoutRowid {
+		options = append(options, "WITHOUT ROWID")
+	}
+	if st.SQLite3StrictValue {
+		options = append(options, "STRICT")
+	}
+	optionsStr := ""
+	if len(options) > 0 {
+		optionsStr = " " + strings.Join(options, ", ")
+	}
+
+	// remove any trailing comma and close table definition
+	return strings.TrimSuffix(strings.TrimSpace(buf.String()), ",") + "\n)" +
+		optionsStr, nil
+}
+
+// isStrict returns true if the Builder knows table is a STRICT table just before stmt.
+func (f *SQLite3Formatter) isStrict(b *Builder, stmt Stmt, tableName string) bool {
+	if b == nil {
+		return false
+	}
+	defs, err := b.TableDefsBefore(stmt)
+	if err != nil || defs[tableName] == nil {
+		return false
+	}
+	return defs[tableName].Table.SQLite3StrictValue
+}
+
+func (f *SQLite3Formatter) createIndexSQL(st *CreateIndexStmt) string {
+	uniqueStr := ""
+	if st.UniqueValue {
+		uniqueStr = " UNIQUE"
+	}
+	ifNotExistsStr := ""
+	if st.IfNotExistsValue {
+		ifNotExistsStr = " IF NOT EXISTS"
+	}
+	colStr := ""
+	for _, colName := range st.ColumnNames {
+		colStr += sqlite3QuoteIdent(colName) + ","
+	}
+	colStr = strings.TrimRight(colStr, ",")
+	return fmt.Sprintf(`CREATE%s INDEX%s %s ON %s(%s)`,
+		uniqueStr,
+		ifNotExistsStr,
+		sqlite3QuoteIdent(f.tmplPrefix()+st.NameValue),
+		sqlite3QuoteIdent(f.tmplPrefix()+st.TableNameValue),
+		colStr,
+	)
+}
+
+func (f *SQLite3Formatter) uniqueIndexSQL(tableName string, u *UniqueDef) string {
+	return f.createIndexSQL(&CreateIndexStmt{
+		NameValue:      u.NameValue,
+		TableNameValue: tableName,
+		UniqueValue:    true,
+		ColumnNames:    u.ColumnNames,
+	})
+}
+
+// rebuildTable emulates an ALTER TABLE that SQLite3 does not support, using the procedure at
+// https://www.sqlite.org/lang_altertable.html#otheralter: create a new table with the changed
+// definition, copy the data, drop the old table, rename the new one and recreate the indexes.
+// The definition of the table comes from the statements the Builder has seen.
+//
+// NOTE: Foreign key enforcement should be off while this runs (the default for SQLite3 unless
+// "PRAGMA foreign_keys=ON" or the _foreign_keys DSN option is used), otherwise dropping the
+// old table can fail or cascade to rows which refer to it.
+func (f *SQLite3Formatter) rebuildTable(b *Builder, stmt Stmt, tableName string) ([]string, error) {
+
+	if b == nil {
+		return nil, fmt.Errorf("%T has no Builder, SQLite3 needs the table definition from the Builder", stmt)
+	}
+	defs, err := b.TableDefsBefore(stmt)
+	if err != nil {
+		return nil, err
+	}
+	oldDef := defs[tableName]
+	if oldDef == nil {
+		return nil, fmt.Errorf("definition of table %q is unknown, SQLite3 needs it to rebuild the table (see Builder.DefineTable)", tableName)
+	}
+	oldDef = oldDef.Clone()
+	if err := defs.Apply(stmt); err != nil {
+		return nil, err
+	}
+	newDef := defs[tableName]
+
+	tmpName := tableName + "__rebuild"
+	newTable := *newDef.Table
+	newTable.IfNotExistsValue = false
+	createStr, err := f.createTableSQL(tmpName, &newTable)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy each column which exists in both
+	var cols, exprs []string
+	for _, col := range newDef.Table.Columns {
+		oldCol := oldDef.Column(col.NameValue)
+		if oldCol == nil {
+			continue
+		}
+		expr := sqlite3QuoteIdent(col.NameValue)
+		if oldCol.NullValue && !col.NullValue && col.DefaultValue != nil {
+			// becoming NOT NULL, fill existing NULLs with the default
+			expr = fmt.Sprintf("COALESCE(%s,%s)", expr, sqlite3DefaultStr(col))
+		}
+		cols = append(cols, sqlite3QuoteIdent(col.NameValue))
+		exprs = append(exprs, expr)
+	}
+
+	ret := []string{
+		createStr,
+		fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM %s`,
+			sqlite3QuoteIdent(f.tmplPrefix()+tmpName),
+			strings.Join(cols, ","),
+			strings.Join(exprs, ","),
+			sqlite3QuoteIdent(f.tmplPrefix()+tableName)),
+		fmt.Sprintf(`DROP TABLE %s`, sqlite3QuoteIdent(f.tmplPrefix()+tableName)),
+		fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`,
+			sqlite3QuoteIdent(f.tmplPrefix()+tmpName),
+			sqlite3QuoteIdent(f.tmplPrefix()+tableName)),
+	}
+	for _, u := range newDef.Table.Uniques {
+		ret = append(ret, f.uniqueIndexSQL(tableName, u))
+	}
+	for _, idx := range newDef.Indexes {
+		i := *idx
+		i.IfNotExistsValue = false
+		ret = append(ret, f.createIndexSQL(&i))
+	}
+
+	return ret, nil
+}
+
 func sqlite3QuoteIdent(ident string) string {
 	return quoteIdent(ident, `"`)
 }
@@ -159,17 +323,22 @@ func sqlite3EncodeString(s string) string {
 	return `'` + strings.Replace(s, `'`, `''`, -1) + `'`
 }
 
-func sqlite3ColStr(col *DataTypeDef) (string, error) {
+func sqlite3DefaultStr(col *DataTypeDef) string {
+	if s, ok := col.DefaultValue.(string); ok {
+		return sqlite3EncodeString(s)
+	}
+	// FIXME: we should be more careful about what escaping and formatting is used here
+	// and the various possible data types
+	return fmt.Sprintf("%v", col.DefaultValue)
+}
+
+// sqlite3ColStr returns the definition of a column, strict is true for a column in
+// a STRICT table, which only allows the types INTEGER, REAL, TEXT, BLOB and ANY.
+func sqlite3ColStr(col *DataTypeDef, strict bool) (string, error) {
 
 	defaultStr := ""
 	if col.DefaultValue != nil {
-		if s, ok := col.DefaultValue.(string); ok {
-			defaultStr = fmt.Sprintf(" DEFAULT %s", sqlite3EncodeString(s))
-		} else {
-			// FIXME: we should be more careful about what escaping and formatting is used here
-			// and the various possible data types
-			defaultStr = fmt.Sprintf(" DEFAULT %v", col.DefaultValue)
-		}
+		defaultStr = " DEFAULT " + sqlite3DefaultStr(col)
 	}
 	// sqlite3 ignores lengths, don't bother: https://www.sqlite.org/datatype3.html
 	// lengthStr := func(defaultLen int) string {
@@ -190,41 +359,50 @@ func sqlite3ColStr(col *DataTypeDef) (string, error) {
 		caseSensitiveStr = "" // will default to binary
 	}
 
+	varcharStr, unsignedStr, boolStr := "VARCHAR", "UNSIGNED INTEGER", "BOOLEAN"
+	if strict {
+		varcharStr, unsignedStr, boolStr = "TEXT", "INTEGER", "INTEGER"
+	}
+
 	switch col.DataTypeValue {
 	case Custom:
 		return fmt.Sprintf("%s %s", sqlite3QuoteIdent(col.NameValue), col.CustomSQLValue), nil
 	case VarCharPK:
 		// always case sensitive
-		return fmt.Sprintf("%s VARCHAR%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s", sqlite3QuoteIdent(col.NameValue), varcharStr, nullStr, defaultStr), nil
 	case BigIntAutoPK:
 		return fmt.Sprintf("%s INTEGER PRIMARY KEY AUTOINCREMENT", sqlite3QuoteIdent(col.NameValue)), nil
 	case VarCharFK:
 		// always case sensitive
-		return fmt.Sprintf("%s VARCHAR%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s", sqlite3QuoteIdent(col.NameValue), varcharStr, nullStr, defaultStr), nil
 	case BigIntFK:
 		return fmt.Sprintf("%s INTEGER%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 	case Int:
 		return fmt.Sprintf("%s INTEGER%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 	case IntU:
-		return fmt.Sprintf("%s UNSIGNED INTEGER%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s", sqlite3QuoteIdent(col.NameValue), unsignedStr, nullStr, defaultStr), nil
 	case BigInt:
 		return fmt.Sprintf("%s INTEGER%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 	case BigIntU:
-		return fmt.Sprintf("%s UNSIGNED INTEGER%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s", sqlite3QuoteIdent(col.NameValue), unsignedStr, nullStr, defaultStr), nil
 	case Double:
 		return fmt.Sprintf("%s REAL%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 	case DateTime:
 		// datetime values need to be text for things to work correctly with SQLite3
 		return fmt.Sprintf("%s TEXT%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 	case VarChar:
-		return fmt.Sprintf("%s VARCHAR%s%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, caseSensitiveStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s%s", sqlite3QuoteIdent(col.NameValue), varcharStr, nullStr, caseSensitiveStr, defaultStr), nil
 	case Text:
 		return fmt.Sprintf("%s TEXT%s%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, caseSensitiveStr, defaultStr), nil
 	case Bool:
 		// same as INTEGER but whatever
-		return fmt.Sprintf("%s BOOLEAN%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+		return fmt.Sprintf("%s %s%s%s", sqlite3QuoteIdent(col.NameValue), boolStr, nullStr, defaultStr), nil
 	case Blob:
 		return fmt.Sprintf("%s BLOB%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
+	case Decimal:
+		// SQLite3 would convert DECIMAL/NUMERIC values to floating point, store the exact text
+		// instead (comparisons and sorting are then by text, not by value)
+		return fmt.Sprintf("%s TEXT%s%s", sqlite3QuoteIdent(col.NameValue), nullStr, defaultStr), nil
 
 	}
 
@@ -14,9 +14,49 @@ type Formatter interface {
 type FormatterList []Formatter
 
 func quoteIdent(s, quote string) string {
-	part := strings.SplitN(s, ".", 2)
-	if len(part) == 2 {
-		return quoteIdent(part[0], quote) + "." + quoteIdent(part[1], quote)
+	// a "." separates schema and name, but not the ones inside template actions ({{.TablePrefix}})
+	start := 0
+	if i := strings.LastIndex(s, "}}"); i >= 0 {
+		start = i + 2
+	}
+	if i := strings.Index(s[start:], "."); i >= 0 {
+		return quoteIdent(s[:start+i], quote) + "." + quoteIdent(s[start+i+1:], quote)
 	}
 	return quote + s + quote
 }
+
+// decimalPrecisionScale returns the precision and scale for a Decimal column, using
+// the defaults if not specified.  The default scale only applies if neither the precision
+// nor the scale was given, an explicit scale of 0 is kept.
+func decimalPrecisionScale(col *DataTypeDef) (precision, scale int) {
+	precision, scale = col.PrecisionValue, col.ScaleValue
+	if precision <= 0 {
+		precision = DefaultDecimalPrecision
+		if !col.ScaleSet && scale <= 0 {
+			scale = DefaultDecimalScale
+		}
+	}
+	return
+}
+
+// fkActionsStr returns the ON DELETE and ON UPDATE clauses of a foreign key, with a leading
+// space, the syntax is the same for all supported databases.
+func fkActionsStr(fk *CreateTableFKDef) string {
+	ret := ""
+	if fk.OnDeleteValue != "" {
+		ret += " ON DELETE " + string(fk.OnDeleteValue)
+	}
+	if fk.OnUpdateValue != "" {
+		ret += " ON UPDATE " + string(fk.OnUpdateValue)
+	}
+	return ret
+}
+
+// checkStr returns a CHECK constraint, the syntax is the same for all supported databases
+// except for how the name is quoted.
+func checkStr(c *CheckDef, quotedName string) string {
+	if c.NameValue == "" {
+		return "CHECK (" + c.ExprValue + ")"
+	}
+	return "CONSTRAINT " + quotedName + " CHECK (" + c.ExprValue + ")"
+}
@@ -0,0 +1,462 @@
+package ddl
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Introspect reads the tables, columns, indexes, unique constraints and foreign keys of a
+// live database into table definitions, so they can be compared with the desired schema
+// (see Builder.Diff).  Only tables whose name starts with tablePrefix are read, and the prefix
+// is removed from table, index and constraint names.  Supported drivers are "sqlite3" and "mysql".
+//
+// Column types are mapped back to the closest DataType, which is not always the one the table
+// was created with (e.g. SQLite3 stores DateTime as TEXT).  What matters is that a Formatter
+// produces the same column SQL for both, which is how Diff compares columns.
+func Introspect(db *sql.DB, driverName, tablePrefix string) (TableDefMap, error) {
+	switch driverName {
+	case "sqlite3":
+		return introspectSQLite3(db, tablePrefix)
+	case "mysql":
+		return introspectMySQL(db, tablePrefix)
+	}
+	return nil, fmt.Errorf("Introspect: unsupported driver %q", driverName)
+}
+
+// -- sqlite3
+
+var (
+	sqlite3CollateNocaseRE = regexp.MustCompile(`(?i)^\s*"?([^"\s]+)"?\s+[^,]*?COLLATE\s+NOCASE`)
+	sqlite3NamedFKRE       = regexp.MustCompile(`(?i)CONSTRAINT\s+"([^"]+)"\s+FOREIGN\s+KEY\s*\(\s*"?([^"\s)]+)"?\s*\)`)
+	sqlite3CheckRE         = regexp.MustCompile(`(?im)^\s*(?:CONSTRAINT\s+"([^"]+)"\s+)?CHECK\s*\((.*)\),?\s*$`)
+	sqlite3OptionsRE       = regexp.MustCompile(`(?i)\)\s*((?:WITHOUT\s+ROWID|STRICT)(?:\s*,\s*(?:WITHOUT\s+ROWID|STRICT))*)\s*$`)
+)
+
+func introspectSQLite3(db *sql.DB, tablePrefix string) (TableDefMap, error) {
+
+	type tableRow struct{ name, sql string }
+	var tables []tableRow
+	err := queryRows(db, func(rows *sql.Rows) error {
+		var r tableRow
+		if err := rows.Scan(&r.name, &r.sql); err != nil {
+			return err
+		}
+		if strings.HasPrefix(r.name, tablePrefix) {
+			tables = append(tables, r)
+		}
+		return nil
+	}, `SELECT name, sql FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(TableDefMap, len(tables))
+	for _, tr := range tables {
+
+		d := NewTableDef(strings.TrimPrefix(tr.name, tablePrefix))
+
+		// column definitions, one per line as written by SQLite3Formatter, but split on commas
+		// to cope with tables created by hand
+		nocase := make(map[string]bool)
+		for _, part := range strings.Split(tr.sql, ",") {
+			if i := strings.Index(part, "("); i >= 0 && strings.HasPrefix(strings.ToUpper(strings.TrimSpace(part)), "CREATE") {
+				part = part[i+1:]
+			}
+			if m := sqlite3CollateNocaseRE.FindStringSubmatch(part); m != nil {
+				nocase[m[1]] = true
+			}
+		}
+		autoinc := strings.Contains(strings.ToUpper(tr.sql), "AUTOINCREMENT")
+		withoutRowid := false
+		if m := sqlite3OptionsRE.FindStringSubmatch(tr.sql); m != nil {
+			opts := strings.ToUpper(m[1])
+			withoutRowid = strings.Contains(opts, "ROWID")
+			d.Table.SQLite3WithoutRowidValue = withoutRowid
+			d.Table.SQLite3StrictValue = strings.Contains(opts, "STRICT")
+		}
+		// CHECK constraints, one per line as written by SQLite3Formatter
+		for _, m := range sqlite3CheckRE.FindAllStringSubmatch(tr.sql, -1) {
+			d.Table.Checks = append(d.Table.Checks, &CheckDef{NameValue: strings.TrimPrefix(m[1], tablePrefix), ExprValue: m[2]})
+		}
+
+		type pkCol struct {
+			pos  int
+			name string
+		}
+		var pks []pkCol
+		err := queryRows(db, func(rows *sql.Rows) error {
+			var cid, notNull, pk int
+			var name, typ string
+			var dflt sql.NullString
+			if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+				return err
+			}
+			col := &DataTypeDef{NameValue: name, NullValue: notNull == 0}
+			switch strings.ToUpper(typ) {
+			case "INTEGER":
+				col.DataTypeValue = BigInt
+				if pk > 0 && autoinc {
+					col.DataTypeValue = BigIntAutoPK
+					col.NullValue = false
+				}
+			case "UNSIGNED INTEGER":
+				col.DataTypeValue = BigIntU
+			case "REAL":
+				col.DataTypeValue = Double
+			case "TEXT":
+				col.DataTypeValue = Text
+			case "VARCHAR":
+				col.DataTypeValue = VarChar
+				if pk > 0 && withoutRowid {
+					col.DataTypeValue = VarCharPK
+				}
+			case "BOOLEAN":
+				col.DataTypeValue = Bool
+			case "BLOB":
+				col.DataTypeValue = Blob
+			default:
+				col.DataTypeValue = Custom
+				col.CustomSQLValue = typ
+			}
+			if col.DataTypeValue == VarChar || col.DataTypeValue == Text {
+				col.CaseSensitiveValue = !nocase[name]
+			}
+			if dflt.Valid {
+				col.DefaultValue = introspectDefault(col.DataTypeValue, dflt.String)
+			}
+			if pk > 0 {
+				pks = append(pks, pkCol{pk, name})
+			}
+			d.Table.Columns = append(d.Table.Columns, col)
+			return nil
+		}, `SELECT cid, name, type, "notnull", dflt_value, pk FROM pragma_table_info(?) ORDER BY cid`, tr.name)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(pks, func(i, j int) bool { return pks[i].pos < pks[j].pos })
+		for _, pk := range pks {
+			d.Table.PrimaryKeys = append(d.Table.PrimaryKeys, pk.name)
+		}
+
+		// indexes (origin "c" is CREATE INDEX, the others are implied by the table definition)
+		type idxRow struct {
+			name   string
+			unique bool
+		}
+		var idxs []idxRow
+		err = queryRows(db, func(rows *sql.Rows) error {
+			var r idxRow
+			var origin string
+			if err := rows.Scan(&r.name, &r.unique, &origin); err != nil {
+				return err
+			}
+			if origin == "c" {
+				idxs = append(idxs, r)
+			}
+			return nil
+		}, `SELECT name, "unique", origin FROM pragma_index_list(?) ORDER BY name`, tr.name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ir := range idxs {
+			var cols []string
+			err := queryRows(db, func(rows *sql.Rows) error {
+				var c string
+				if err := rows.Scan(&c); err != nil {
+					return err
+				}
+				cols = append(cols, c)
+				return nil
+			}, `SELECT name FROM pragma_index_info(?) ORDER BY seqno`, ir.name)
+			if err != nil {
+				return nil, err
+			}
+			name := strings.TrimPrefix(ir.name, tablePrefix)
+			// SQLite3Formatter makes unique constraints into unique indexes, they are reported as
+			// unique constraints so they compare equal to the definition they came from
+			if ir.unique {
+				d.Table.Uniques = append(d.Table.Uniques, &UniqueDef{NameValue: name, ColumnNames: cols})
+				continue
+			}
+			d.Indexes = append(d.Indexes, &CreateIndexStmt{NameValue: name, TableNameValue: d.Table.NameValue, ColumnNames: cols})
+		}
+
+		// foreign keys, the names are only in the table SQL
+		fkNames := make(map[string]string)
+		for _, m := range sqlite3NamedFKRE.FindAllStringSubmatch(tr.sql, -1) {
+			fkNames[m[2]] = strings.TrimPrefix(m[1], tablePrefix)
+		}
+		err = queryRows(db, func(rows *sql.Rows) error {
+			var table, from, onUpdate, onDelete string
+			var to sql.NullString
+			if err := rows.Scan(&table, &from, &to, &onUpdate, &onDelete); err != nil {
+				return err
+			}
+			d.Table.ForeignKeys = append(d.Table.ForeignKeys, &CreateTableFKDef{
+				NameValue:        fkNames[from],
+				ColumnValue:      from,
+				OtherTableValue:  strings.TrimPrefix(table, tablePrefix),
+				OtherColumnValue: to.String,
+				OnDeleteValue:    introspectFKAction(onDelete),
+				OnUpdateValue:    introspectFKAction(onUpdate),
+			})
+			return nil
+		}, `SELECT "table", "from", "to", on_update, on_delete FROM pragma_foreign_key_list(?) ORDER BY id, seq`, tr.name)
+		if err != nil {
+			return nil, err
+		}
+
+		ret[d.Table.NameValue] = d
+	}
+
+	return ret, nil
+}
+
+// -- mysql
+
+var mysqlTypeRE = regexp.MustCompile(`^(\w+)(?:\((\d+)(?:,(\d+))?\))?(\s+unsigned)?`)
+
+func introspectMySQL(db *sql.DB, tablePrefix string) (TableDefMap, error) {
+
+	ret := make(TableDefMap)
+	like := strings.Replace(strings.Replace(tablePrefix, `_`, `\_`, -1), `%`, `\%`, -1) + "%"
+
+	err := queryRows(db, func(rows *sql.Rows) error {
+		var tableName, name, colType, isNullable, extra, columnKey string
+		var dflt, collation sql.NullString
+		if err := rows.Scan(&tableName, &name, &colType, &isNullable, &dflt, &extra, &collation, &columnKey); err != nil {
+			return err
+		}
+		t := strings.TrimPrefix(tableName, tablePrefix)
+		d := ret[t]
+		if d == nil {
+			d = NewTableDef(t)
+			ret[t] = d
+		}
+
+		col := &DataTypeDef{NameValue: name, NullValue: isNullable == "YES"}
+		colType = strings.ToLower(colType)
+		m := mysqlTypeRE.FindStringSubmatch(colType)
+		base, n1, n2, unsigned := "", 0, 0, false
+		if m != nil {
+			base, unsigned = m[1], m[4] != ""
+			n1, _ = strconv.Atoi(m[2])
+			n2, _ = strconv.Atoi(m[3])
+		}
+		cs := strings.HasSuffix(collation.String, "_bin")
+		switch {
+		case base == "varchar" && n1 == 64 && collation.String == "ascii_bin" && columnKey == "PRI":
+			col.DataTypeValue = VarCharPK
+		case base == "varchar" && n1 == 64 && collation.String == "ascii_bin":
+			col.DataTypeValue = VarCharFK
+		case base == "varchar":
+			col.DataTypeValue, col.LengthValue, col.CaseSensitiveValue = VarChar, n1, cs
+		case base == "text":
+			col.DataTypeValue, col.CaseSensitiveValue = Text, cs
+		case base == "blob":
+			col.DataTypeValue = Blob
+		case base == "int" && unsigned:
+			col.DataTypeValue = IntU
+		case base == "int":
+			col.DataTypeValue = Int
+		case base == "bigint" && strings.Contains(extra, "auto_increment"):
+			col.DataTypeValue = BigIntAutoPK
+		case base == "bigint" && unsigned:
+			col.DataTypeValue = BigIntU
+		case base == "bigint":
+			col.DataTypeValue = BigInt
+		case base == "double":
+			col.DataTypeValue = Double
+		case base == "datetime":
+			col.DataTypeValue = DateTime
+		case base == "tinyint" && n1 == 1:
+			col.DataTypeValue = Bool
+		case base == "decimal":
+			col.DataTypeValue, col.PrecisionValue, col.ScaleValue = Decimal, n1, n2
+		default:
+			col.DataTypeValue = Custom
+			col.CustomSQLValue = strings.ToUpper(colType)
+			if !col.NullValue {
+				col.CustomSQLValue += " NOT NULL"
+			}
+		}
+		if dflt.Valid {
+			col.DefaultValue = introspectDefault(col.DataTypeValue, dflt.String)
+		}
+		if columnKey == "PRI" {
+			d.Table.PrimaryKeys = append(d.Table.PrimaryKeys, name)
+		}
+		d.Table.Columns = append(d.Table.Columns, col)
+		return nil
+	}, `SELECT TABLE_NAME, COLUMN_NAME, COLUMN_TYPE, IS_NULLABLE, COLUMN_DEFAULT, EXTRA, COLLATION_NAME, COLUMN_KEY
+FROM information_schema.COLUMNS
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME LIKE ?
+ORDER BY TABLE_NAME, ORDINAL_POSITION`, like)
+	if err != nil {
+		return nil, err
+	}
+
+	// primary key order, the COLUMN_KEY above is in column order
+	for _, d := range ret {
+		d.Table.PrimaryKeys = nil
+	}
+	err = queryRows(db, func(rows *sql.Rows) error {
+		var tableName, colName string
+		if err := rows.Scan(&tableName, &colName); err != nil {
+			return err
+		}
+		if d := ret[strings.TrimPrefix(tableName, tablePrefix)]; d != nil {
+			d.Table.PrimaryKeys = append(d.Table.PrimaryKeys, colName)
+		}
+		return nil
+	}, `SELECT TABLE_NAME, COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME LIKE ? AND CONSTRAINT_NAME = 'PRIMARY'
+ORDER BY TABLE_NAME, ORDINAL_POSITION`, like)
+	if err != nil {
+		return nil, err
+	}
+
+	// foreign keys
+	fkIndexes := make(map[string]bool)
+	err = queryRows(db, func(rows *sql.Rows) error {
+		var tableName, name, colName, refTable, refCol, deleteRule, updateRule string
+		if err := rows.Scan(&tableName, &name, &colName, &refTable, &refCol, &deleteRule, &updateRule); err != nil {
+			return err
+		}
+		fkIndexes[tableName+"."+name] = true
+		if d := ret[strings.TrimPrefix(tableName, tablePrefix)]; d != nil {
+			d.Table.ForeignKeys = append(d.Table.ForeignKeys, &CreateTableFKDef{
+				NameValue:        mysqlFKName(name, tableName, tablePrefix),
+				ColumnValue:      colName,
+				OtherTableValue:  strings.TrimPrefix(refTable, tablePrefix),
+				OtherColumnValue: refCol,
+				OnDeleteValue:    introspectFKAction(deleteRule),
+				OnUpdateValue:    introspectFKAction(updateRule),
+			})
+		}
+		return nil
+	}, `SELECT k.TABLE_NAME, k.CONSTRAINT_NAME, k.COLUMN_NAME, k.REFERENCED_TABLE_NAME, k.REFERENCED_COLUMN_NAME, r.DELETE_RULE, r.UPDATE_RULE
+FROM information_schema.KEY_COLUMN_USAGE k
+JOIN information_schema.REFERENTIAL_CONSTRAINTS r
+ON r.CONSTRAINT_SCHEMA = k.CONSTRAINT_SCHEMA AND r.TABLE_NAME = k.TABLE_NAME AND r.CONSTRAINT_NAME = k.CONSTRAINT_NAME
+WHERE k.TABLE_SCHEMA = DATABASE() AND k.TABLE_NAME LIKE ? AND k.REFERENCED_TABLE_NAME IS NOT NULL
+ORDER BY k.TABLE_NAME, k.CONSTRAINT_NAME, k.ORDINAL_POSITION`, like)
+	if err != nil {
+		return nil, err
+	}
+
+	// indexes and unique constraints (which in MySQL are both indexes)
+	uniqueConstraints := make(map[string]bool)
+	err = queryRows(db, func(rows *sql.Rows) error {
+		var tableName, name string
+		if err := rows.Scan(&tableName, &name); err != nil {
+			return err
+		}
+		uniqueConstraints[tableName+"."+name] = true
+		return nil
+	}, `SELECT TABLE_NAME, CONSTRAINT_NAME FROM information_schema.TABLE_CONSTRAINTS
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME LIKE ? AND CONSTRAINT_TYPE = 'UNIQUE'`, like)
+	if err != nil {
+		return nil, err
+	}
+	err = queryRows(db, func(rows *sql.Rows) error {
+		var tableName, name, colName string
+		var nonUnique int
+		if err := rows.Scan(&tableName, &name, &nonUnique, &colName); err != nil {
+			return err
+		}
+		d := ret[strings.TrimPrefix(tableName, tablePrefix)]
+		// the PRIMARY key and the indexes MySQL creates for foreign keys are not separate definitions
+		if d == nil || name == "PRIMARY" || fkIndexes[tableName+"."+name] {
+			return nil
+		}
+		idxName := strings.TrimPrefix(name, tablePrefix)
+		// a unique index created by CREATE UNIQUE INDEX and a unique constraint are
+		// indistinguishable in information_schema, SQLite3Formatter and MySQLFormatter
+		// both produce unique indexes for unique constraints so they are reported as those
+		if uniqueConstraints[tableName+"."+name] {
+			if i := findUnique(d, idxName); i >= 0 {
+				d.Table.Uniques[i].ColumnNames = append(d.Table.Uniques[i].ColumnNames, colName)
+			} else {
+				d.Table.Uniques = append(d.Table.Uniques, &UniqueDef{NameValue: idxName, ColumnNames: []string{colName}})
+			}
+			return nil
+		}
+		for _, idx := range d.Indexes {
+			if idx.NameValue == idxName {
+				idx.ColumnNames = append(idx.ColumnNames, colName)
+				return nil
+			}
+		}
+		d.Indexes = append(d.Indexes, &CreateIndexStmt{
+			NameValue:      idxName,
+			TableNameValue: d.Table.NameValue,
+			UniqueValue:    nonUnique == 0,
+			ColumnNames:    []string{colName},
+		})
+		return nil
+	}, `SELECT TABLE_NAME, INDEX_NAME, NON_UNIQUE, COLUMN_NAME FROM information_schema.STATISTICS
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME LIKE ?
+ORDER BY TABLE_NAME, INDEX_NAME, SEQ_IN_INDEX`, like)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// mysqlFKName returns the name of a foreign key without the prefix, or empty if it is one MySQL
+// named automatically (i.e. it was created unnamed, as with CreateTableColDef.ForiegnKey).
+func mysqlFKName(name, tableName, tablePrefix string) string {
+	if strings.HasPrefix(name, tableName+"_ibfk_") {
+		return ""
+	}
+	return strings.TrimPrefix(name, tablePrefix)
+}
+
+// introspectFKAction converts a foreign key action as the database reports it to an FKAction,
+// NO ACTION is reported as empty since it is the default.
+func introspectFKAction(s string) FKAction {
+	if a, err := ParseFKAction(s); err == nil && a != NoAction {
+		return a
+	}
+	return ""
+}
+
+// introspectDefault converts a default value as the database reports it to the Go value
+// used in DataTypeDef.DefaultValue.
+func introspectDefault(dt DataType, s string) interface{} {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.Replace(s[1:len(s)-1], `''`, `'`, -1)
+	}
+	if strings.EqualFold(s, "NULL") {
+		return nil
+	}
+	if dt == Bool {
+		switch strings.ToLower(s) {
+		case "0", "false":
+			return false
+		case "1", "true":
+			return true
+		}
+	}
+	return ParseDefault(dt, s)
+}
+
+func queryRows(db *sql.DB, f func(rows *sql.Rows) error, query string, args ...interface{}) error {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := f(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
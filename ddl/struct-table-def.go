@@ -0,0 +1,328 @@
+package ddl
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// StructTableDef returns the definition of a table with a column for each field of a struct.
+// See AddField for how fields are mapped to columns.  Embedded structs have their fields
+// included.
+func StructTableDef(tableName string, obj interface{}) (*TableDef, error) {
+
+	t := reflect.TypeOf(obj)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("StructTableDef: %v is not a struct", t)
+	}
+
+	d := NewTableDef(tableName)
+	var addFields func(t reflect.Type) error
+	addFields = func(t reflect.Type) error {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("db") == "" {
+				if err := addFields(f.Type); err != nil {
+					return err
+				}
+				continue
+			}
+			if f.PkgPath != "" { // unexported
+				continue
+			}
+			if err := d.AddField(f.Name, goTypeString(f.Type), f.Tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := addFields(t); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// goTypeString returns a type as it would be written in source, e.g. "*time.Time".
+func goTypeString(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + goTypeString(t.Elem())
+	case reflect.Slice:
+		if t.Name() == "" {
+			if t.Elem().Kind() == reflect.Uint8 && t.Elem().Name() == "uint8" {
+				return "[]byte"
+			}
+			return "[]" + goTypeString(t.Elem())
+		}
+	}
+	return t.String()
+}
+
+// NewTableDef returns an empty table definition.
+func NewTableDef(tableName string) *TableDef {
+	return &TableDef{Table: &CreateTableStmt{NameValue: tableName}}
+}
+
+// AddField adds a column for a struct field, given the field name, its type as written in
+// Go source ("string", "*int64", "time.Time", "dbutil.Decimal", etc.) and its tag.
+//
+// The column name is from the `db` tag, or the field name in snake case if there is none,
+// and fields tagged `db:"-"` are skipped.  A `tmeta:"pk"` tag makes the column (part of)
+// the primary key.  The data type is deduced from the Go type, pointers and sql.Null* types
+// make it nullable.  A `ddl` tag with comma separated options overrides or adds to this:
+//
+//	type=Text         the DataType by name
+//	null, notnull     allow NULL or not
+//	length=N          length, e.g. for VarChar
+//	precision=N       precision of a Decimal
+//	scale=N           scale of a Decimal
+//	casesensitive     case sensitive string comparisons
+//	default=V         default value
+//	index             create an index named table_column
+//	unique            add a unique constraint named table_column_unique
+//	fk=table.column   add a foreign key named table_column_fk
+//	ondelete=A        ON DELETE action of the foreign key, e.g. cascade, "set null" (see ParseFKAction)
+//	onupdate=A        ON UPDATE action of the foreign key
+//	pk                same as `tmeta:"pk"`
+func (d *TableDef) AddField(fieldName, goType string, tag reflect.StructTag) error {
+
+	colName := tag.Get("db")
+	if colName == "-" {
+		return nil
+	}
+	if colName == "" {
+		colName = SnakeCase(fieldName)
+	}
+	colName = strings.Split(colName, ",")[0]
+
+	tableName := d.Table.NameValue
+	col := &DataTypeDef{NameValue: colName}
+
+	isPK := false
+	for _, v := range strings.Split(tag.Get("tmeta"), ",") {
+		if strings.TrimSpace(v) == "pk" {
+			isPK = true
+		}
+	}
+
+	var opts []string
+	if ddlTag := tag.Get("ddl"); ddlTag != "" {
+		opts = strings.Split(ddlTag, ",")
+	}
+	var fk *CreateTableFKDef
+	typeName := ""
+	var defaultStr *string
+	for _, opt := range opts {
+		k, v := opt, ""
+		if i := strings.Index(opt, "="); i >= 0 {
+			k, v = opt[:i], opt[i+1:]
+		}
+		switch strings.TrimSpace(k) {
+		case "pk":
+			isPK = true
+		case "fk":
+			parts := strings.SplitN(v, ".", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("field %s: fk must be in the form table.column", fieldName)
+			}
+			fk = &CreateTableFKDef{
+				NameValue:        tableName + "_" + colName + "_fk",
+				ColumnValue:      colName,
+				OtherTableValue:  parts[0],
+				OtherColumnValue: parts[1],
+			}
+		case "type":
+			typeName = v
+		case "default":
+			s := v
+			defaultStr = &s
+		}
+	}
+
+	// from the Go type
+	nullable := false
+	gt := goType
+	if strings.HasPrefix(gt, "*") {
+		nullable = true
+		gt = gt[1:]
+	}
+	switch gt {
+	case "sql.NullString":
+		nullable, gt = true, "string"
+	case "sql.NullInt64":
+		nullable, gt = true, "int64"
+	case "sql.NullFloat64":
+		nullable, gt = true, "float64"
+	case "sql.NullBool":
+		nullable, gt = true, "bool"
+	}
+	col.NullValue = nullable
+
+	switch gt {
+	case "string":
+		col.DataTypeValue = VarChar
+		if isPK {
+			col.DataTypeValue = VarCharPK
+		} else if fk != nil {
+			col.DataTypeValue = VarCharFK
+		}
+	case "int64":
+		col.DataTypeValue = BigInt
+		if isPK {
+			col.DataTypeValue = BigIntAutoPK
+		} else if fk != nil {
+			col.DataTypeValue = BigIntFK
+		}
+	case "int", "int32", "int16", "int8", "uint16", "uint8":
+		col.DataTypeValue = Int
+	case "uint", "uint32":
+		col.DataTypeValue = IntU
+	case "uint64":
+		col.DataTypeValue = BigIntU
+	case "float64", "float32":
+		col.DataTypeValue = Double
+	case "bool":
+		col.DataTypeValue = Bool
+	case "time.Time", "tmetautil.DBTime", "mysql.NullTime":
+		col.DataTypeValue = DateTime
+	case "[]byte":
+		col.DataTypeValue = Blob
+	case "dbutil.Decimal":
+		col.DataTypeValue = Decimal
+	case "dbutil.StringObjMap", "dbutil.StringValueList":
+		col.DataTypeValue = Text
+	}
+
+	if typeName != "" {
+		dt, ok := DataTypeByName(typeName)
+		if !ok || dt == Custom || dt == Invalid {
+			return fmt.Errorf("field %s: unknown type %q", fieldName, typeName)
+		}
+		col.DataTypeValue = dt
+	}
+	if col.DataTypeValue == Invalid {
+		return fmt.Errorf("field %s: cannot determine column type for Go type %q, use a `ddl:\"type=...\"` tag", fieldName, goType)
+	}
+
+	// the rest of the options, now that we know the type
+	for _, opt := range opts {
+		k, v := opt, ""
+		if i := strings.Index(opt, "="); i >= 0 {
+			k, v = opt[:i], opt[i+1:]
+		}
+		var err error
+		switch strings.TrimSpace(k) {
+		case "null":
+			col.NullValue = true
+		case "notnull":
+			col.NullValue = false
+		case "casesensitive":
+			col.CaseSensitiveValue = true
+		case "length":
+			col.LengthValue, err = strconv.Atoi(v)
+		case "precision":
+			col.PrecisionValue, err = strconv.Atoi(v)
+		case "scale":
+			col.ScaleValue, err = strconv.Atoi(v)
+			col.ScaleSet = true
+		case "index":
+			d.Indexes = append(d.Indexes, &CreateIndexStmt{
+				NameValue:      tableName + "_" + colName,
+				TableNameValue: tableName,
+				ColumnNames:    []string{colName},
+			})
+		case "unique":
+			d.Table.Uniques = append(d.Table.Uniques, &UniqueDef{
+				NameValue:   tableName + "_" + colName + "_unique",
+				ColumnNames: []string{colName},
+			})
+		case "ondelete", "onupdate":
+			if fk == nil {
+				err = fmt.Errorf("%s without fk", k)
+				break
+			}
+			var a FKAction
+			if a, err = ParseFKAction(v); err != nil {
+				break
+			}
+			if k == "ondelete" {
+				fk.OnDeleteValue = a
+			} else {
+				fk.OnUpdateValue = a
+			}
+		case "pk", "fk", "type", "default", "":
+		default:
+			err = fmt.Errorf("unknown option %q", k)
+		}
+		if err != nil {
+			return fmt.Errorf("field %s: ddl tag: %v", fieldName, err)
+		}
+	}
+
+	if defaultStr != nil {
+		col.DefaultValue = ParseDefault(col.DataTypeValue, *defaultStr)
+	}
+
+	d.Table.Columns = append(d.Table.Columns, col)
+	if isPK {
+		d.Table.PrimaryKeys = append(d.Table.PrimaryKeys, colName)
+	}
+	if fk != nil {
+		d.Table.ForeignKeys = append(d.Table.ForeignKeys, fk)
+	}
+
+	return nil
+}
+
+// DataTypeByName returns the DataType with a name, case insensitive ("VarChar", "bigint", etc.)
+func DataTypeByName(name string) (DataType, bool) {
+	for dt := Invalid; dt <= Decimal; dt++ {
+		if strings.EqualFold(dt.String(), name) {
+			return dt, true
+		}
+	}
+	return Invalid, false
+}
+
+// ParseDefault converts the text of a default value to the Go value for a column of
+// type dt, i.e. an int64, float64, bool or string.
+func ParseDefault(dt DataType, s string) interface{} {
+	switch dt {
+	case Int, IntU, BigInt, BigIntU, BigIntFK:
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return v
+		}
+	case Double:
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return v
+		}
+	case Bool:
+		if v, err := strconv.ParseBool(s); err == nil {
+			return v
+		}
+	}
+	return s
+}
+
+// SnakeCase converts a Go name to a column name, e.g. "TodoItemID" to "todo_item_id".
+func SnakeCase(name string) string {
+	rs := []rune(name)
+	var buf strings.Builder
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(rs[i-1]) || unicode.IsDigit(rs[i-1]) ||
+				(i+1 < len(rs) && unicode.IsLower(rs[i+1]) && unicode.IsUpper(rs[i-1]))) {
+				buf.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
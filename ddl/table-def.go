@@ -0,0 +1,328 @@
+package ddl
+
+import (
+	"fmt"
+)
+
+// TableDef is the complete definition of a table at some point in a sequence of statements,
+// i.e. its CREATE TABLE with every later change applied, plus its indexes.
+type TableDef struct {
+	Table   *CreateTableStmt
+	Indexes []*CreateIndexStmt
+}
+
+// TableDefMap is table definitions keyed by table name.
+type TableDefMap map[string]*TableDef
+
+// Clone returns a deep copy.
+func (d *TableDef) Clone() *TableDef {
+
+	t := *d.Table
+	t.Columns = make(DataTypeDefPtrList, 0, len(d.Table.Columns))
+	for _, col := range d.Table.Columns {
+		c := *col
+		t.Columns = append(t.Columns, &c)
+	}
+	t.PrimaryKeys = append([]string(nil), d.Table.PrimaryKeys...)
+	t.ForeignKeys = make([]*CreateTableFKDef, 0, len(d.Table.ForeignKeys))
+	for _, fk := range d.Table.ForeignKeys {
+		f := *fk
+		t.ForeignKeys = append(t.ForeignKeys, &f)
+	}
+	t.Uniques = make([]*UniqueDef, 0, len(d.Table.Uniques))
+	for _, u := range d.Table.Uniques {
+		t.Uniques = append(t.Uniques, &UniqueDef{NameValue: u.NameValue, ColumnNames: append([]string(nil), u.ColumnNames...)})
+	}
+	t.Checks = make([]*CheckDef, 0, len(d.Table.Checks))
+	for _, c := range d.Table.Checks {
+		cc := *c
+		t.Checks = append(t.Checks, &cc)
+	}
+
+	ret := &TableDef{Table: &t}
+	for _, idx := range d.Indexes {
+		i := *idx
+		i.ColumnNames = append([]string(nil), idx.ColumnNames...)
+		ret.Indexes = append(ret.Indexes, &i)
+	}
+	return ret
+}
+
+// Column returns the definition of the named column, or nil.
+func (d *TableDef) Column(name string) *DataTypeDef {
+	for _, col := range d.Table.Columns {
+		if col.NameValue == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// Apply updates the table definitions in m with the effect of stmt.  Statements on tables not
+// in m are ignored.  An error is returned if stmt cannot be applied, e.g. dropping a column
+// which does not exist.
+func (m TableDefMap) Apply(stmt Stmt) error {
+
+	switch st := stmt.(type) {
+
+	case *CreateTableStmt:
+		m[st.NameValue] = (&TableDef{Table: st}).Clone()
+
+	case *DropTableStmt:
+		delete(m, st.NameValue)
+
+	case *AlterTableRenameStmt:
+		d := m[st.OldNameValue]
+		if d == nil {
+			return nil
+		}
+		delete(m, st.OldNameValue)
+		d.Table.NameValue = st.NewNameValue
+		for _, idx := range d.Indexes {
+			idx.TableNameValue = st.NewNameValue
+		}
+		m[st.NewNameValue] = d
+		// references from other tables follow the rename
+		for _, other := range m {
+			for _, fk := range other.Table.ForeignKeys {
+				if fk.OtherTableValue == st.OldNameValue {
+					fk.OtherTableValue = st.NewNameValue
+				}
+			}
+		}
+
+	case *AlterTableAddStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		if d.Column(st.DataTypeDef.NameValue) != nil {
+			return fmt.Errorf("table %q already has column %q", st.NameValue, st.DataTypeDef.NameValue)
+		}
+		c := st.DataTypeDef
+		d.Table.Columns = append(d.Table.Columns, &c)
+
+	case *AlterTableDropColumnStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		if d.Column(st.ColumnValue) == nil {
+			return fmt.Errorf("table %q has no column %q", st.NameValue, st.ColumnValue)
+		}
+		for _, pk := range d.Table.PrimaryKeys {
+			if pk == st.ColumnValue {
+				return fmt.Errorf("cannot drop column %q, it is part of the primary key of %q", st.ColumnValue, st.NameValue)
+			}
+		}
+		for _, idx := range d.Indexes {
+			if containsString(idx.ColumnNames, st.ColumnValue) {
+				return fmt.Errorf("cannot drop column %q, it is used by index %q (drop the index first)", st.ColumnValue, idx.NameValue)
+			}
+		}
+		for _, u := range d.Table.Uniques {
+			if containsString(u.ColumnNames, st.ColumnValue) {
+				return fmt.Errorf("cannot drop column %q, it is used by unique constraint %q (drop the constraint first)", st.ColumnValue, u.NameValue)
+			}
+		}
+		for _, fk := range d.Table.ForeignKeys {
+			if fk.ColumnValue == st.ColumnValue {
+				return fmt.Errorf("cannot drop column %q, it is used by a foreign key (drop the foreign key first)", st.ColumnValue)
+			}
+		}
+		cols := d.Table.Columns[:0]
+		for _, col := range d.Table.Columns {
+			if col.NameValue != st.ColumnValue {
+				cols = append(cols, col)
+			}
+		}
+		d.Table.Columns = cols
+
+	case *AlterTableRenameColumnStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		col := d.Column(st.OldColumnValue)
+		if col == nil {
+			return fmt.Errorf("table %q has no column %q", st.NameValue, st.OldColumnValue)
+		}
+		if d.Column(st.NewColumnValue) != nil {
+			return fmt.Errorf("table %q already has column %q", st.NameValue, st.NewColumnValue)
+		}
+		col.NameValue = st.NewColumnValue
+		renameString(d.Table.PrimaryKeys, st.OldColumnValue, st.NewColumnValue)
+		for _, idx := range d.Indexes {
+			renameString(idx.ColumnNames, st.OldColumnValue, st.NewColumnValue)
+		}
+		for _, u := range d.Table.Uniques {
+			renameString(u.ColumnNames, st.OldColumnValue, st.NewColumnValue)
+		}
+		for _, fk := range d.Table.ForeignKeys {
+			if fk.ColumnValue == st.OldColumnValue {
+				fk.ColumnValue = st.NewColumnValue
+			}
+		}
+		for _, other := range m {
+			for _, fk := range other.Table.ForeignKeys {
+				if fk.OtherTableValue == st.NameValue && fk.OtherColumnValue == st.OldColumnValue {
+					fk.OtherColumnValue = st.NewColumnValue
+				}
+			}
+		}
+
+	case *AlterTableModifyStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		col := d.Column(st.DataTypeDef.NameValue)
+		if col == nil {
+			return fmt.Errorf("table %q has no column %q", st.NameValue, st.DataTypeDef.NameValue)
+		}
+		*col = st.DataTypeDef
+
+	case *AlterTableAddForeignKeyStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		if st.ForeignKey.NameValue == "" {
+			return fmt.Errorf("foreign key on %q has no name", st.NameValue)
+		}
+		if findFK(d, st.ForeignKey.NameValue) >= 0 {
+			return fmt.Errorf("table %q already has foreign key %q", st.NameValue, st.ForeignKey.NameValue)
+		}
+		fk := st.ForeignKey
+		d.Table.ForeignKeys = append(d.Table.ForeignKeys, &fk)
+
+	case *AlterTableDropForeignKeyStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		i := findFK(d, st.ForeignKeyNameValue)
+		if i < 0 {
+			return fmt.Errorf("table %q has no foreign key %q", st.NameValue, st.ForeignKeyNameValue)
+		}
+		d.Table.ForeignKeys = append(d.Table.ForeignKeys[:i:i], d.Table.ForeignKeys[i+1:]...)
+
+	case *AlterTableAddUniqueStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		if findUnique(d, st.Unique.NameValue) >= 0 {
+			return fmt.Errorf("table %q already has unique constraint %q", st.NameValue, st.Unique.NameValue)
+		}
+		d.Table.Uniques = append(d.Table.Uniques, &UniqueDef{NameValue: st.Unique.NameValue, ColumnNames: append([]string(nil), st.Unique.ColumnNames...)})
+
+	case *AlterTableDropUniqueStmt:
+		d := m[st.NameValue]
+		if d == nil {
+			return nil
+		}
+		i := findUnique(d, st.UniqueNameValue)
+		if i < 0 {
+			return fmt.Errorf("table %q has no unique constraint %q", st.NameValue, st.UniqueNameValue)
+		}
+		d.Table.Uniques = append(d.Table.Uniques[:i:i], d.Table.Uniques[i+1:]...)
+
+	case *CreateIndexStmt:
+		d := m[st.TableNameValue]
+		if d == nil {
+			return nil
+		}
+		idx := *st
+		idx.ColumnNames = append([]string(nil), st.ColumnNames...)
+		d.Indexes = append(d.Indexes, &idx)
+
+	case *DropIndexStmt:
+		d := m[st.TableNameValue]
+		if d == nil {
+			return nil
+		}
+		for i, idx := range d.Indexes {
+			if idx.NameValue == st.NameValue {
+				d.Indexes = append(d.Indexes[:i:i], d.Indexes[i+1:]...)
+				break
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func findFK(d *TableDef, name string) int {
+	for i, fk := range d.Table.ForeignKeys {
+		if fk.NameValue == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func findUnique(d *TableDef, name string) int {
+	for i, u := range d.Table.Uniques {
+		if u.NameValue == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsString(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func renameString(l []string, old, new string) {
+	for i := range l {
+		if l[i] == old {
+			l[i] = new
+		}
+	}
+}
+
+// TableDefsBefore returns the table definitions as they are just before stmt is executed,
+// by applying PriorStmtList and then the statements before stmt in UpStmtList (or, for a
+// down statement, all of UpStmtList and then the statements before it in DownStmtList).
+func (b *Builder) TableDefsBefore(stmt Stmt) (TableDefMap, error) {
+
+	var list StmtList
+	list = append(list, b.PriorStmtList...)
+
+	found := false
+	for _, s := range b.UpStmtList {
+		if s == stmt {
+			found = true
+			break
+		}
+		list = append(list, s)
+	}
+	if !found {
+		for _, s := range b.DownStmtList {
+			if s == stmt {
+				found = true
+				break
+			}
+			list = append(list, s)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("statement %T not found in builder", stmt)
+	}
+
+	m := make(TableDefMap)
+	for _, s := range list {
+		if err := m.Apply(s); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
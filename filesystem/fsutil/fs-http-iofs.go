@@ -0,0 +1,19 @@
+package fsutil
+
+import (
+	"fmt"
+	"io/fs"
+	"net/http"
+)
+
+// MustHTTPSubFS returns an http.FileSystem for a directory of an fs.FS, such as an embed.FS.
+// Names are opened relative to the directory, so "/index.gohtml" with dir "views" opens
+// "views/index.gohtml".  It panics if dir is not a valid path (see fs.ValidPath), a
+// directory which does not exist gives an http.FileSystem where nothing is found.
+func MustHTTPSubFS(fsys fs.FS, dir string) http.FileSystem {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(fmt.Errorf("Error trying to get sub directory %q of file system: %v", dir, err))
+	}
+	return http.FS(sub)
+}
@@ -0,0 +1,309 @@
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/spf13/pflag"
+)
+
+// The client is generated twice from the same template: as a TypeScript module (for
+// projects with a TypeScript build, which want the types) and as a plain script which
+// sets a global object, which is registered in uiregistry so pages can use it without
+// any build step, with {{define "require js:todo-item-api"}}{{end}}.
+
+// TODO: relations are not in the model type, since the fields with db:"-" are skipped;
+// they come back from fetch/search when requested with "related", typed as any for now
+
+func init() {
+	globalMapGenerator["api-client-ts"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		model := fset.String("model", "", "The model struct, in the form file.go:TypeName (required).")
+		apiPrefix := fset.String("api-prefix", "/api", "The prefix of the API generated by ctrl-api-crud.")
+		tsFile := fset.String("ts-file", "", "The TypeScript file to write (defaults to the target file with .ts instead of .go).")
+		uiName := fset.String("ui-name", "", "The name to register in uiregistry (defaults to 'js:' plus the model name plus '-api', e.g. 'js:todo-item-api').")
+		globalName := fset.String("global", "", "The name of the global object set by the plain script (defaults to the model name plus 'API', e.g. 'TodoItemAPI').")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		fields, err := viewModelData(s, data, *model, "", *apiPrefix, "")
+		if err != nil {
+			return err
+		}
+		modelName := data["ModelName"].(string)
+
+		var tsFields []map[string]interface{}
+		for _, f := range fields {
+			tsFields = append(tsFields, map[string]interface{}{
+				"JSONName": f.JSONName,
+				"TSType":   tsType(f),
+				"Label":    f.Label,
+				"ReadOnly": f.ReadOnly,
+			})
+		}
+		data["TSFields"] = tsFields
+
+		if *tsFile == "" {
+			*tsFile = strings.TrimSuffix(targetFile, ".go") + ".ts"
+		} else if filepath.IsAbs(*tsFile) {
+			*tsFile, err = s.RelativeToGOPATH(*tsFile)
+			if err != nil {
+				return err
+			}
+		} else {
+			*tsFile = filepath.Join(filepath.Dir(targetFile), *tsFile)
+		}
+		if *tsFile == targetFile {
+			return fmt.Errorf("unable to determine TypeScript file name for %q", targetFile)
+		}
+		if *uiName == "" {
+			*uiName = "js:" + data["ElementID"].(string) + "-api"
+		}
+		if *globalName == "" {
+			*globalName = modelName + "API"
+		}
+		data["TSFileName"] = filepath.Base(*tsFile)
+		data["UIName"] = *uiName
+		data["UIFileName"] = strings.TrimPrefix(*uiName, "js:") + ".js"
+		data["GlobalName"] = *globalName
+
+		data["TS"] = true
+		err = OutputTemplate(s, data, *tsFile, apiClientTmpl, false)
+		if err != nil {
+			return err
+		}
+
+		data["TS"] = false
+		js, err := ExecuteTemplate(data, apiClientTmpl)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(string(js), "`") {
+			return fmt.Errorf("generated JS contains a backquote, cannot be put in a Go raw string")
+		}
+		data["JS"] = string(js)
+
+		return OutputGoSrcTemplate(s, data, targetFile, `
+package {{.PackageName}}
+
+import (
+	"time"
+
+	"github.com/gocaveman/caveman/uifiles/uiregistry"
+	"github.com/gocaveman/caveman/webutil"
+)
+
+// {{.ModelNameL}}APIClientJS is the client for the {{.ModelName}} API as a plain script which sets
+// window.{{.GlobalName}} (the same as {{.TSFileName}}, without the types).  It is registered as
+// "{{.UIName}}", use {{"{{"}}define "require {{.UIName}}"{{"}}{{"}}end{{"}}"}} in a page to include it.
+const {{.ModelNameL}}APIClientJS = {{bq .JS}}
+
+func init() {
+	uiregistry.MustRegister("{{.UIName}}", nil,
+		webutil.NewBytesDataSource([]byte({{.ModelNameL}}APIClientJS), "{{.UIFileName}}", time.Now()))
+}
+`, false)
+
+	})
+}
+
+// tsType returns the TypeScript type for the JSON encoding of a model field.
+func tsType(f ModelField) string {
+
+	goType := f.GoType
+	nullable := strings.HasPrefix(goType, "*")
+	goType = strings.TrimPrefix(goType, "*")
+
+	var ret string
+	switch goType {
+	case "string", "[]byte", "time.Time", "tmetautil.DBTime", "dbutil.Decimal":
+		ret = "string"
+	case "bool":
+		ret = "boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		ret = "number"
+	case "[]string":
+		ret = "string[]"
+	default:
+		switch f.DataType {
+		case ddl.VarCharPK, ddl.VarCharFK, ddl.VarChar, ddl.Text, ddl.DateTime, ddl.Decimal:
+			ret = "string"
+		case ddl.BigIntAutoPK, ddl.BigIntFK, ddl.Int, ddl.IntU, ddl.BigInt, ddl.BigIntU, ddl.Double:
+			ret = "number"
+		case ddl.Bool:
+			ret = "boolean"
+		default:
+			ret = "any"
+		}
+	}
+
+	if nullable && ret != "any" {
+		ret += " | null"
+	}
+	return ret
+}
+
+const apiClientTmpl = `// Client for the [[.ModelName]] API ([[.APIPath]]), made by "cavegen api-client-ts".
+[[- if not .TS]]
+// This is the plain script version of [[.TSFileName]], it sets window.[[.GlobalName]].
+(function() {
+[[- end]]
+
+var apiPath = "[[.APIPath]]";
+[[if .TS]]
+/** A [[.ModelLabel]], as returned by the API. */
+export interface [[.ModelName]] {
+[[- range .TSFields]]
+	[[.JSONName]]: [[.TSType]];[[if .ReadOnly]] // read-only[[end]]
+[[- end]]
+	[related: string]: any; // related records, if requested
+}
+
+/** A search criterion, e.g. {field: "title", op: "like", value: "a%"}. */
+export interface Criterion {
+	field: string;
+	op: string;
+	value?: any;
+}
+
+/** A sort field, in order of precedence. */
+export interface OrderBy {
+	field: string;
+	desc?: boolean;
+}
+
+/** The parameters for search[[plural .ModelName]]. */
+export interface Search[[.ModelName]]Params {
+	criteria?: Criterion[];
+	order_by?: OrderBy[];
+	limit?: number; // default 100
+	offset?: number;
+	related?: string[];
+	return_count?: boolean;
+}
+
+/** The result of search[[plural .ModelName]]. */
+export interface Search[[.ModelName]]Result {
+	result_list: [[.ModelName]][];
+	result_length: number;
+	count?: number; // if return_count was set
+}
+
+/** Describes a field which failed validation, the items of APIError.data. */
+export interface ValidationMessage {
+	field_name?: string;
+	code?: any;
+	messsage?: string;
+	data?: any;
+}
+[[end]]
+/**
+ * The error for a failed call, with the code, message and data from the response
+ * (for validation errors data is an array of ValidationMessage).
+ */
+[[if .TS]]export class APIError extends Error {
+	status: number;
+	code: number;
+	data: any;
+	constructor(status: number, code: number, message: string, data: any) {
+		super(message);
+		this.status = status;
+		this.code = code;
+		this.data = data;
+	}
+}
+[[- else]]function APIError(status, code, message, data) {
+	this.name = "APIError";
+	this.status = status;
+	this.code = code;
+	this.message = message;
+	this.data = data;
+}
+APIError.prototype = Object.create(Error.prototype);
+APIError.prototype.constructor = APIError;
+[[- end]]
+
+[[if .TS]]export [[end]]function set[[.ModelName]]APIPath(path[[if .TS]]: string[[end]]) {
+	apiPath = path;
+}
+
+function call(method[[if .TS]]: string[[end]], path[[if .TS]]: string[[end]], body[[if .TS]]?: any[[end]])[[if .TS]]: Promise<any>[[end]] {
+	var headers[[if .TS]]: {[name: string]: string}[[end]] = {};
+	if (body !== undefined) {
+		headers["Content-Type"] = "application/json";
+	}
+	return fetch(apiPath + path, {
+		method: method,
+		credentials: "same-origin",
+		headers: headers,
+		body: body === undefined ? undefined : JSON.stringify(body)
+	}).then(function(res) {
+		return res.text().then(function(text) {
+			var data[[if .TS]]: any[[end]] = null;
+			try {
+				data = text ? JSON.parse(text) : null;
+			} catch (e) {
+				if (res.ok) {
+					throw e;
+				}
+			}
+			if (!res.ok) {
+				data = data || {};
+				throw new APIError(res.status, data.code || res.status, data.message || res.statusText, data.data);
+			}
+			return data;
+		});
+	});
+}
+
+function relatedQuery(related[[if .TS]]?: string[][[end]])[[if .TS]]: string[[end]] {
+	if (!related || !related.length) {
+		return "";
+	}
+	return "?" + related.map(function(r) { return "related=" + encodeURIComponent(r); }).join("&");
+}
+
+/** Creates a [[.ModelLabel]], resolving to the created record. */
+[[if .TS]]export [[end]]function create[[.ModelName]](o[[if .TS]]: Partial<[[.ModelName]]>[[end]])[[if .TS]]: Promise<[[.ModelName]]>[[end]] {
+	return call("POST", "", o);
+}
+
+/** Searches [[plural .ModelLabel]], criteria and order_by field names are the database names. */
+[[if .TS]]export [[end]]function search[[plural .ModelName]](params[[if .TS]]: Search[[.ModelName]]Params[[end]])[[if .TS]]: Promise<Search[[.ModelName]]Result>[[end]] {
+	return call("POST", "/search", params || {});
+}
+
+/** Fetches a [[.ModelLabel]] by ID, with the related records named. */
+[[if .TS]]export [[end]]function fetch[[.ModelName]](id[[if .TS]]: string[[end]], related[[if .TS]]?: string[][[end]])[[if .TS]]: Promise<[[.ModelName]]>[[end]] {
+	return call("GET", "/" + encodeURIComponent(id) + relatedQuery(related));
+}
+
+/** Updates the fields given of a [[.ModelLabel]], resolving to the updated record. */
+[[if .TS]]export [[end]]function update[[.ModelName]](id[[if .TS]]: string[[end]], o[[if .TS]]: Partial<[[.ModelName]]>[[end]])[[if .TS]]: Promise<[[.ModelName]]>[[end]] {
+	return call("PATCH", "/" + encodeURIComponent(id), o);
+}
+
+/** Deletes a [[.ModelLabel]]. */
+[[if .TS]]export [[end]]function delete[[.ModelName]](id[[if .TS]]: string[[end]])[[if .TS]]: Promise<boolean>[[end]] {
+	return call("DELETE", "/" + encodeURIComponent(id));
+}
+[[- if not .TS]]
+
+window.[[.GlobalName]] = {
+	APIError: APIError,
+	set[[.ModelName]]APIPath: set[[.ModelName]]APIPath,
+	create[[.ModelName]]: create[[.ModelName]],
+	search[[plural .ModelName]]: search[[plural .ModelName]],
+	fetch[[.ModelName]]: fetch[[.ModelName]],
+	update[[.ModelName]]: update[[.ModelName]],
+	delete[[.ModelName]]: delete[[.ModelName]]
+};
+
+})();
+[[- end]]
+`
@@ -1,22 +1,47 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"go/build"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 
 	"github.com/gocaveman/caveman/gen"
+	"github.com/gocaveman/caveman/migrate/migratecli"
 )
 
 func main() {
 
 	args := os.Args[1:]
 
+	if len(args) > 0 && args[0] == "migrate" {
+		err := migratecli.Run(args[1:], os.Stdout)
+		if err != nil {
+			log.Printf("migrate error: %v", err)
+			os.Exit(255)
+		}
+		return
+	}
+
+	// flags for all generators go before the generator name
+	fset := flag.NewFlagSet("cavegen", flag.ContinueOnError)
+	dryRun := fset.Bool("dry-run", false, "Don't write any files, print a diff of what would change instead.")
+	force := fset.Bool("force", false, "Overwrite files and generated sections which were modified by hand.")
+	err := fset.Parse(args)
+	if err != nil {
+		os.Exit(1)
+		return
+	}
+	args = fset.Args()
+
 	g := gen.GetRegistryMapGenerator()
 
 	if len(args) == 0 || g[args[0]] == nil {
-		fmt.Printf("Usage: cavegen [generator] [args...]\n\n")
+		fmt.Printf("Usage: cavegen [-dry-run] [-force] [generator] [args...]\n")
+		fmt.Printf("       cavegen migrate [flags] command [args...]\n\n")
 		fmt.Printf("  Generators:\n")
 		gnames := make([]string, 0, len(g))
 		for name := range g {
@@ -40,7 +65,8 @@ func main() {
 
 	name := args[0]
 
-	gopath := os.Getenv("GOPATH")
+	// the default GOPATH if not set (e.g. ~/go), only the first entry is used
+	gopath := filepath.SplitList(build.Default.GOPATH)[0]
 	if gopath == "" {
 		log.Printf("GOPATH not set, cannot continue")
 		os.Exit(1)
@@ -55,6 +81,8 @@ func main() {
 	s := &gen.Settings{
 		GOPATH:  gopath,
 		WorkDir: wd,
+		DryRun:  *dryRun,
+		Force:   *force,
 	}
 
 	err = g.Generate(s, name, args[1:]...)
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/gocaveman/caveman/ddl"
 	"github.com/spf13/pflag"
 )
 
@@ -20,9 +21,6 @@ import (
 // need a separate handler to loads its data - the common case of a single
 // record by ID is already handled here
 
-// update mulitple records with one PATCH/PUT call, so you can e.g. update a bunch of sequence numbers at once
-// See httpapi and fill stuff to properly implement PATCH
-
 // i18n for validation (and other?) error messages
 
 // callback methods, with interface and New method checks to see if we implement it -
@@ -43,12 +41,14 @@ func init() {
 
 		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
 		storeType := fset.String("store", "", "The type of the store to use for data access (defaults to '*store.Store' or '*Store', depending on package).")
+		storeImport := fset.String("store-import", "", "The import path of the store package, added to the imports (goimports can usually work it out).")
 		modelName := fset.String("model", "", "The model object name, if not specified default will be deduced from file name.")
 		// TODO: responder code should be an option - it's a fair amount of cruft and people shouldn't
 		// be forced to have it if they don't need it; default to off
 		// TODO: option for permission stuff - default to on
 		renderer := fset.Bool("renderer", true, "Output renderer integration for view/edit page.")
 		tests := fset.Bool("tests", true, "Create test file with test(s) for this controller.")
+		relations := fset.StringArray("relation", nil, relationFlagUsage+" Use the same relations as store-crud.")
 		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
 		if err != nil {
 			return err
@@ -60,8 +60,9 @@ func init() {
 			*modelName = NameSnakeToCamel(targetFileName, []string{"ctrl-"}, []string{"-api.go", ".go"})
 		}
 		data["ModelName"] = *modelName
-		// FIXME: this breaks on JSONThing -> jSONThing
-		data["ModelNameL"] = strings.ToLower((*modelName)[:1]) + (*modelName)[1:]
+		data["ModelNameL"] = NameLowerFirst(*modelName)
+
+		data["IDJSONName"] = ddl.SnakeCase(*modelName) + "_id"
 
 		data["ModelPathPart"] = strings.TrimPrefix(
 			strings.TrimSuffix(strings.TrimSuffix(targetFileName, ".go"), "-api"),
@@ -77,6 +78,23 @@ func init() {
 			}
 		}
 		data["StoreType"] = *storeType
+		data["StoreImport"] = *storeImport
+
+		rl, err := parseModelRelations(*modelName, *relations)
+		if err != nil {
+			return err
+		}
+		data["Relations"] = rl
+		var nested []ModelRelation
+		var relationNames []string
+		for _, r := range rl {
+			if r.Kind != "belongs_to" {
+				nested = append(nested, r)
+			}
+			relationNames = append(relationNames, r.Name)
+		}
+		data["NestedRelations"] = nested
+		data["RelationNames"] = strings.Join(relationNames, ", ")
 
 		data["Renderer"] = *renderer
 		data["Tests"] = *tests
@@ -85,7 +103,20 @@ func init() {
 package {{.PackageName}}
 
 import (
+{{- if .NestedRelations}}
+	"encoding/json"
+{{- end}}
 	"net/http"
+
+	"github.com/gocaveman/caveman/autowire"
+	"github.com/gocaveman/caveman/httpapi/openapi"
+	"github.com/gocaveman/caveman/httpapi/openapi/openapiregistry"
+	"github.com/gocaveman/caveman/weberrors"
+	"github.com/gocaveman/caveman/webutil/handlerregistry"
+{{- if .StoreImport}}
+
+	"{{.StoreImport}}"
+{{- end}}
 )
 
 const (
@@ -110,6 +141,12 @@ func init() {
 	permregistry.MustAddPerm("admin", {{.ModelName}}SearchPerm)
 	permregistry.MustAddPerm("admin", {{.ModelName}}UpdatePerm)
 	permregistry.MustAddPerm("admin", {{.ModelName}}DeletePerm)
+
+	h := &{{.ModelName}}APIRouter{Controller: &{{.ModelName}}APIController{}}
+	autowire.Populate(h)
+	autowire.Populate(h.Controller)
+	handlerregistry.MustRegister(handlerregistry.SeqCtrl, "{{.ModelName}}APIRouter", h)
+	openapiregistry.MustRegister("{{.ModelName}}APIRouter", h)
 }
 
 type {{.ModelName}}APIRouter struct {
@@ -140,6 +177,112 @@ func (h *{{.ModelName}}APIRouter) AfterWire() error {
 	return nil
 }
 
+// DescribeOpenAPI adds the {{.ModelName}} API to an OpenAPI document, the
+// schemas are reflected from the types (json and valid tags).
+func (h *{{.ModelName}}APIRouter) DescribeOpenAPI(doc *openapi.Document) error {
+
+	p := h.APIPrefix+h.ModelPrefix
+	tags := []string{"{{.ModelName}}"}
+	id := openapi.PathParam("id", "ID of the {{.ModelName}}")
+
+	obj := doc.AddSchema("{{.ModelName}}", {{.ModelTypeName}}{})
+	patch := doc.AddPatchSchema("{{.ModelName}}Patch", obj)
+	params := doc.AddSchema("Search{{.ModelName}}Params", search{{.ModelName}}Params{})
+	result := doc.AddSchema("Search{{.ModelName}}Result", search{{.ModelName}}Result{})
+	bulk := openapi.ArraySchema(patch)
+	bulkResult := openapi.ArraySchema(&openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"index": &openapi.Schema{Type: "integer"},
+			"id": &openapi.Schema{Type: "string"},
+			"result": obj,
+			"error": &openapi.Schema{},
+		},
+	})
+	related := doc.QueryParams(struct{
+		Related []string {{bq "json:\"related\""}}
+	}{})
+
+	ops := []struct{
+		method, path string
+		op *openapi.Operation
+	}{
+		{"POST", p, &openapi.Operation{
+			OperationID: "create{{.ModelName}}",
+			Summary: "Create {{.ModelName}}",
+			RequestBody: openapi.JSONRequestBody(obj),
+			Responses: openapi.Responses(201, "The created {{.ModelName}}", obj),
+		}},
+		{"GET", p, &openapi.Operation{
+			OperationID: "search{{plural .ModelName}}",
+			Summary: "Search {{plural .ModelName}}",
+			Parameters: doc.QueryParams(search{{.ModelName}}Params{}),
+			Responses: openapi.Responses(200, "The matching {{plural .ModelName}}", result),
+		}},
+		{"POST", p+"/search", &openapi.Operation{
+			OperationID: "search{{plural .ModelName}}JSON",
+			Summary: "Search {{plural .ModelName}} (JSON)",
+			Description: "The search parameters, including criteria and order_by, as a JSON object in the request body.",
+			RequestBody: openapi.JSONRequestBody(params),
+			Responses: openapi.Responses(200, "The matching {{plural .ModelName}}", result),
+		}},
+		{"GET", p+"/%s", &openapi.Operation{
+			OperationID: "fetch{{.ModelName}}",
+			Summary: "Fetch {{.ModelName}}",
+{{- if .Relations}}
+			Description: "The related records can be: {{.RelationNames}}.",
+{{- end}}
+			Parameters: append([]*openapi.Parameter{id}, related...),
+			Responses: openapi.Responses(200, "The {{.ModelName}}", obj),
+		}},
+		{"PATCH", p, &openapi.Operation{
+			OperationID: "bulkUpdate{{plural .ModelName}}",
+			Summary: "Update several {{plural .ModelName}}",
+			Description: "Each item is a merge patch including {{.IDJSONName}}.  Either all are saved or, if any fails, none are and the error data has the result of each item.",
+			RequestBody: openapi.JSONRequestBody(bulk),
+			Responses: openapi.Responses(200, "The result of each item", bulkResult),
+		}},
+		{"PATCH", p+"/%s", &openapi.Operation{
+			OperationID: "update{{.ModelName}}",
+			Summary: "Update {{.ModelName}}",
+			Description: "A merge patch (only the fields given are updated) or a JSON Patch, by content type.",
+			Parameters: []*openapi.Parameter{id},
+			RequestBody: openapi.PatchRequestBody(patch),
+			Responses: openapi.Responses(200, "The updated {{.ModelName}}", obj),
+		}},
+		{"PUT", p+"/%s", &openapi.Operation{
+			OperationID: "replace{{.ModelName}}",
+			Summary: "Update {{.ModelName}}",
+			Description: "Only the fields given are updated.",
+			Parameters: []*openapi.Parameter{id},
+			RequestBody: openapi.JSONRequestBody(patch),
+			Responses: openapi.Responses(200, "The updated {{.ModelName}}", obj),
+		}},
+		{"DELETE", p+"/%s", &openapi.Operation{
+			OperationID: "delete{{.ModelName}}",
+			Summary: "Delete {{.ModelName}}",
+			Parameters: []*openapi.Parameter{id},
+			Responses: openapi.Responses(200, "true", &openapi.Schema{Type: "boolean"}),
+		}},
+	}
+	for _, o := range ops {
+		o.op.Tags = tags
+		err := doc.AddOperation(o.method, o.path, o.op)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// search{{.ModelName}}Result is the response from a search.
+type search{{.ModelName}}Result struct {
+	ResultList []{{.ModelTypeName}} {{bq "json:\"result_list\""}}
+	ResultLength int {{bq "json:\"result_length\""}}
+	Count *int64 {{bq "json:\"count,omitempty\""}} // if return_count was set
+}
+
 // search{{.ModelName}}Params is the criteria for a search,
 // corresponding to URL parameters
 type search{{.ModelName}}Params struct {
@@ -156,6 +299,8 @@ func (h *{{.ModelName}}APIRouter) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	var {{.ModelNameL}} {{.ModelTypeName}}
 	var {{.ModelNameL}}ID string
 	var mapData map[string]interface{}
+	var patchData interface{}
+	var bulkData []map[string]interface{}
 
 	ar := httpapi.NewRequest(r)
 
@@ -206,6 +351,28 @@ func (h *{{.ModelName}}APIRouter) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		}
 		err = h.Controller.Search(w, r, ar, searchParams)
 
+	/**
+	 * @api {post} /api/{{.ModelPathPart}}/search Search {{plural .ModelName}} (JSON)
+	 * @apiGroup {{.ModelName}}
+	 * @apiName search-json-{{.ModelPathPart}}
+	 * @apiDescription List {{plural .ModelName}}, with the search parameters
+	 * (including criteria and order_by) as a JSON object in the request body.
+	 *
+	 * @apiSuccessExample {json} Success-Response:
+	 *     HTTP/1.1 200 OK
+	 *     Content-Type: application/json
+	 *
+	 *     {"result_list":[{
+	 *         // {{.ModelName}}
+	 *     }],"result_length":1}
+	 */
+	case ar.ParseRESTObjPath("POST", &searchParams, h.APIPrefix+h.ModelPrefix+"/search"):
+		err = ar.Err
+		if err != nil {
+			break
+		}
+		err = h.Controller.Search(w, r, ar, searchParams)
+
 	/**
 	 * @api {get} /api/{{.ModelPathPart}}/:id Fetch {{.ModelName}}
 	 * @apiGroup {{.ModelName}}
@@ -231,7 +398,7 @@ func (h *{{.ModelName}}APIRouter) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		err = h.Controller.Fetch(w, r, ar, {{.ModelNameL}}ID, searchParams.Related...)
 
 	/**
-	 * @api {patch} /api/{{.ModelPathPart}}/:id Update {{.ModelName}}
+	 * @api {put} /api/{{.ModelPathPart}}/:id Update {{.ModelName}}
 	 * @apiGroup {{.ModelName}}
 	 * @apiName update-{{.ModelPathPart}}
 	 * @apiDescription Update a {{.ModelName}} by ID.  Will return an error
@@ -248,11 +415,62 @@ func (h *{{.ModelName}}APIRouter) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	 *         // {{.ModelName}}
 	 *     }]
 	 */
-	case ar.ParseRESTObjPath("PUT", &mapData, h.APIPrefix+h.ModelPrefix+"/%s", &{{.ModelNameL}}ID) ||
-		ar.ParseRESTObjPath("PATCH", &mapData, h.APIPrefix+h.ModelPrefix+"/%s", &{{.ModelNameL}}ID):
-		{{.ModelNameL}}.{{.ModelName}}ID = {{.ModelNameL}}ID
+	case ar.ParseRESTObjPath("PUT", &mapData, h.APIPrefix+h.ModelPrefix+"/%s", &{{.ModelNameL}}ID):
+		err = ar.Err
+		if err != nil {
+			break
+		}
 		err = h.Controller.Update(w, r, ar, {{.ModelNameL}}ID, mapData)
 
+	/**
+	 * @api {patch} /api/{{.ModelPathPart}} Update several {{plural .ModelName}}
+	 * @apiGroup {{.ModelName}}
+	 * @apiName bulk-update-{{.ModelPathPart}}
+	 * @apiDescription Update several {{plural .ModelName}} in one transaction, the body
+	 * being a list of merge patches each including {{.IDJSONName}}.  If any item fails
+	 * nothing is saved and the error data has the result of each item.
+	 *
+	 * @apiSuccessExample {json} Success-Response:
+	 *     HTTP/1.1 200 OK
+	 *     Content-Type: application/json
+	 *
+	 *     [{"index":0,"id":"6cSAs4i2P3PsHq2s6PZi6V","result":{
+	 *         // {{.ModelName}}
+	 *     }}]
+	 */
+	case ar.ParseRESTObj("PATCH", &bulkData, h.APIPrefix+h.ModelPrefix):
+		err = ar.Err
+		if err != nil {
+			break
+		}
+		err = h.Controller.BulkUpdate(w, r, ar, bulkData)
+
+	/**
+	 * @api {patch} /api/{{.ModelPathPart}}/:id Patch {{.ModelName}}
+	 * @apiGroup {{.ModelName}}
+	 * @apiName patch-{{.ModelPathPart}}
+	 * @apiDescription Update a {{.ModelName}} by ID with a merge patch (RFC 7396,
+	 * content type application/json or application/merge-patch+json) or a
+	 * JSON Patch (RFC 6902, application/json-patch+json).  The updated object
+	 * will be returned.
+	 *
+	 * @apiParam {String} id ID of the {{.ModelName}} to update
+	 *
+	 * @apiSuccessExample {json} Success-Response:
+	 *     HTTP/1.1 200 OK
+	 *     Content-Type: application/json
+	 *
+	 *     [{
+	 *         // {{.ModelName}}
+	 *     }]
+	 */
+	case ar.ParseRESTObjPath("PATCH", &patchData, h.APIPrefix+h.ModelPrefix+"/%s", &{{.ModelNameL}}ID):
+		err = ar.Err
+		if err != nil {
+			break
+		}
+		err = h.Controller.Patch(w, r, ar, {{.ModelNameL}}ID, patchData)
+
 	/**
 	 * @api {delete} /api/{{.ModelPathPart}}/:id Delete {{.ModelName}}
 	 * @apiGroup {{.ModelName}}
@@ -334,12 +552,12 @@ func (h *{{.ModelName}}APIController) Search(w http.ResponseWriter, r *http.Requ
     if err != nil {
         return err
     }
-    err = h.Store.Meta.For({{.ModelTypeName}}{}).CheckRelationNames(params.Related...)
+    err = h.Store.Meta.For({{.ModelTypeName}}{}).CheckRelationNames({{if .Relations}}undeclared{{.ModelName}}Relations(params.Related){{else}}params.Related{{end}}...)
     if err != nil {
         return err
     }
 
-	ret := make(map[string]interface{}, 3)
+	var ret search{{.ModelName}}Result
 
 	if params.ReturnCount {
 		count, err := h.Store.Search{{.ModelName}}Count(queryCtx,
@@ -348,7 +566,7 @@ func (h *{{.ModelName}}APIController) Search(w http.ResponseWriter, r *http.Requ
 		if err != nil {
 			return err
 		}
-		ret["count"] = count
+		ret.Count = &count
 	}
 
 	resultList, err := h.Store.Search{{.ModelName}}(queryCtx,
@@ -358,8 +576,8 @@ func (h *{{.ModelName}}APIController) Search(w http.ResponseWriter, r *http.Requ
 	if err != nil {
 		return err
 	}
-	ret["result_list"] = resultList
-	ret["result_length"] = len(resultList)
+	ret.ResultList = resultList
+	ret.ResultLength = len(resultList)
 
 	{{/*
 	// TODO: It would be nice to sanely implement paging.  I think using
@@ -438,7 +656,7 @@ func (h *{{.ModelName}}APIController) Update(w http.ResponseWriter, r *http.Requ
 	}
 
 	// patch the fillable fields
-	err = httpapi.Fill(&{{.ModelNameL}}, mapData)
+	_, err = fill{{.ModelName}}(&{{.ModelNameL}}, mapData)
 	if err != nil {
 		return err
 	}
@@ -456,6 +674,192 @@ func (h *{{.ModelName}}APIController) Update(w http.ResponseWriter, r *http.Requ
 	return nil
 }
 
+// Patch applies a merge patch or JSON Patch (per the request content type) to a {{.ModelName}}.
+// Unlike Update, a patch which changes fields that cannot be updated is an error.
+func (h *{{.ModelName}}APIController) Patch(w http.ResponseWriter, r *http.Request, ar *httpapi.APIRequest, {{.ModelNameL}}ID string, patch interface{}) error {
+
+	if !userctrl.ReqUserHasPerm(r, {{.ModelName}}UpdatePerm) {
+		return &httpapi.ErrorDetail{Code: 403, Message: "access denied"}
+	}
+
+	var {{.ModelNameL}} {{.ModelTypeName}}
+	err := h.Store.Fetch{{.ModelName}}(r.Context(), &{{.ModelNameL}}, {{.ModelNameL}}ID)
+	if webutil.IsNotFound(err) {
+		return &httpapi.ErrorDetail{Code: 404, Message: "not found"}
+	}
+	if err != nil {
+		return err
+	}
+
+	err = patch{{.ModelName}}(&{{.ModelNameL}}, r.Header.Get("content-type"), patch)
+	if err != nil {
+		return err
+	}
+
+	err = h.Store.Update{{.ModelName}}(r.Context(), &{{.ModelNameL}})
+	if webutil.IsNotFound(err) {
+		return &httpapi.ErrorDetail{Code: 404, Message: "not found"}
+	}
+	if err != nil {
+		return err
+	}
+
+	ar.WriteResult(w, 200, {{.ModelNameL}})
+
+	return nil
+}
+
+// BulkUpdate applies a merge patch to each of several {{plural .ModelName}}, identified by
+// the {{.IDJSONName}} of each item, and saves them in one transaction.  If any item
+// fails nothing is saved and the error data has the result of each item.
+func (h *{{.ModelName}}APIController) BulkUpdate(w http.ResponseWriter, r *http.Request, ar *httpapi.APIRequest, items []map[string]interface{}) error {
+
+	if !userctrl.ReqUserHasPerm(r, {{.ModelName}}UpdatePerm) {
+		return &httpapi.ErrorDetail{Code: 403, Message: "access denied"}
+	}
+
+	maxItems := 1000 // never update more than this many records at once
+	if len(items) > maxItems {
+		return &httpapi.ErrorDetail{Code: 400, Message: "too many items"}
+	}
+
+	list := make([]*{{.ModelTypeName}}, 0, len(items))
+	results := make([]httpapi.BulkItemResult, len(items))
+	failed := false
+
+	for i, item := range items {
+
+		id, _ := item["{{.IDJSONName}}"].(string)
+		results[i] = httpapi.BulkItemResult{Index: i, ID: id}
+		if id == "" {
+			results[i] = httpapi.NewBulkItemError(i, id, &httpapi.ErrorDetail{Code: 400, Message: "{{.IDJSONName}} is required"})
+			failed = true
+			continue
+		}
+
+		patch := make(map[string]interface{}, len(item))
+		for k, v := range item {
+			if k != "{{.IDJSONName}}" {
+				patch[k] = v
+			}
+		}
+
+		var {{.ModelNameL}} {{.ModelTypeName}}
+		err := h.Store.Fetch{{.ModelName}}(r.Context(), &{{.ModelNameL}}, id)
+		if webutil.IsNotFound(err) {
+			err = &httpapi.ErrorDetail{Code: 404, Message: "not found"}
+		}
+		if err == nil {
+			err = patch{{.ModelName}}(&{{.ModelNameL}}, httpapi.MergePatchContentType, patch)
+		}
+		if err != nil {
+			results[i] = httpapi.NewBulkItemError(i, id, err)
+			failed = true
+			continue
+		}
+
+		list = append(list, &{{.ModelNameL}})
+	}
+
+	if !failed {
+		// list lines up with items when nothing failed above
+		i, err := h.Store.Update{{.ModelName}}List(r.Context(), list)
+		if i >= 0 {
+			results[i] = httpapi.NewBulkItemError(i, results[i].ID, err)
+			failed = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if failed {
+		return weberrors.New(fmt.Errorf("bulk update of {{plural .ModelName}} failed"), 422,
+			"one or more items could not be updated, nothing was saved", results, nil)
+	}
+
+	for i := range list {
+		results[i].Result = list[i]
+	}
+
+	ar.WriteResult(w, 200, results)
+
+	return nil
+}
+
+// fill{{.ModelName}} sets the fillable fields (see httpapi.Fill){{if .NestedRelations}} and the nested related
+// records (saved along with the {{.ModelName}}, see the store for how){{end}} from mapData, returning
+// the names of those which were set.
+func fill{{.ModelName}}({{.ModelNameL}} *{{.ModelTypeName}}, mapData map[string]interface{}) (httpapi.FilledFields, error) {
+
+	filled, err := httpapi.FillTracked({{.ModelNameL}}, mapData)
+	if err != nil {
+		return filled, &httpapi.ErrorDetail{Code: 400, Message: err.Error()}
+	}
+{{if .NestedRelations}}
+	for _, rel := range []struct {
+		name string
+		ptr interface{}
+	}{
+{{- range .NestedRelations}}
+		{"{{.Name}}", &{{$.ModelNameL}}.{{.Field}}},
+{{- end}}
+	} {
+		v, ok := mapData[rel.name]
+		if !ok {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return filled, err
+		}
+		err = json.Unmarshal(b, rel.ptr)
+		if err != nil {
+			return filled, &httpapi.ErrorDetail{Code: 400, Message: "invalid " + rel.name}
+		}
+		filled = append(filled, rel.name)
+	}
+{{end}}
+	return filled, nil
+}
+
+// patch{{.ModelName}} applies a patch to a {{.ModelName}}, see httpapi.PatchObj.  Every field the
+// patch changes must be one fill{{.ModelName}} can set.
+func patch{{.ModelName}}({{.ModelNameL}} *{{.ModelTypeName}}, contentType string, patch interface{}) error {
+
+	mapData, err := httpapi.PatchObj({{.ModelNameL}}, contentType, patch)
+	if err != nil {
+		return err
+	}
+
+	filled, err := fill{{.ModelName}}({{.ModelNameL}}, mapData)
+	if err != nil {
+		return err
+	}
+	for name := range mapData {
+		if !filled.Has(name) {
+			return &httpapi.ErrorDetail{Code: 422, Message: "field cannot be updated: " + name}
+		}
+	}
+
+	return nil
+}
+
+{{if .Relations -}}
+// undeclared{{.ModelName}}Relations returns the names which are not relations declared to
+// the generator (those are loaded by the store), for checking with tmeta.
+func undeclared{{.ModelName}}Relations(names []string) []string {
+	var ret []string
+	for _, name := range names {
+		switch name {
+		case {{range $i, $r := .Relations}}{{if $i}}, {{end}}"{{$r.Name}}"{{end}}:
+		default:
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+{{end -}}
 func (h *{{.ModelName}}APIController) Delete(w http.ResponseWriter, r *http.Request, ar *httpapi.APIRequest, {{.ModelNameL}}ID string) error {
 
 	if !userctrl.ReqUserHasPerm(r, {{.ModelName}}DeletePerm) {
@@ -1,4 +1,447 @@
 package gen
 
-// TODO: make a page controller for master(list) and detail stuff, providing data for pages
-// (updates/deletes can be done via REST API)
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/spf13/pflag"
+)
+
+// page controller for master(list) and detail pages, providing data for pages
+// (updates/deletes can be done via REST API, see ctrl-api-crud)
+
+// TODO: criteria from URL params for the listing page, once we have a safe
+// way to express which fields can be searched from a page
+
+func init() {
+	globalMapGenerator["ctrl-pages"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		storeType := fset.String("store", "", "The type of the store to use for data access (defaults to '*store.Store' or '*Store', depending on package).")
+		storeImport := fset.String("store-import", "", "The import path of the store package, added to the imports (goimports can usually work it out).")
+		modelName := fset.String("model", "", "The model object name, if not specified default will be deduced from file name.")
+		pagePrefix := fset.String("prefix", "", "The path prefix of the listing and detail pages (defaults to '/' plus the model part of the file name).")
+		genericMode := fset.Bool("generic", false, "The store was generated with store-crud --generic, so Fetch takes an interface{} instead of the model type.")
+		tests := fset.Bool("tests", true, "Create test file with test(s) for this controller.")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		_, targetFileName := filepath.Split(targetFile)
+
+		if *modelName == "" {
+			*modelName = NameSnakeToCamel(targetFileName, []string{"ctrl-"}, []string{"-pages.go", ".go"})
+		}
+		data["ModelName"] = *modelName
+		data["ModelNameL"] = NameLowerFirst(*modelName)
+
+		if *pagePrefix == "" {
+			*pagePrefix = "/" + strings.TrimPrefix(
+				strings.TrimSuffix(strings.TrimSuffix(targetFileName, ".go"), "-pages"),
+				"ctrl-")
+		}
+		data["PagePrefix"] = "/" + strings.Trim(*pagePrefix, "/")
+
+		if *storeType == "" {
+			if data["PackageName"].(string) == "main" {
+				*storeType = "*Store"
+				data["ModelTypeName"] = *modelName
+			} else {
+				*storeType = "*store.Store"
+				data["ModelTypeName"] = "store." + *modelName
+			}
+		} else {
+			data["ModelTypeName"] = *modelName
+		}
+		data["StoreType"] = *storeType
+		data["StoreImport"] = *storeImport
+		data["GenericMode"] = *genericMode
+
+		data["PKDBName"] = ddl.SnakeCase(*modelName) + "_id"
+
+		data["Tests"] = *tests
+
+		err = OutputGoSrcTemplate(s, data, targetFile, `
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gocaveman/caveman/autowire"
+	"github.com/gocaveman/caveman/httpapi"
+	"github.com/gocaveman/caveman/renderer"
+	"github.com/gocaveman/caveman/weberrors"
+	"github.com/gocaveman/caveman/webutil"
+	"github.com/gocaveman/caveman/webutil/handlerregistry"
+	"github.com/gocaveman/tmeta/tmetautil"
+{{- if .StoreImport}}
+
+	"{{.StoreImport}}"
+{{- end}}
+)
+
+// Context keys for the page data, use e.g. {{"{{"}}with .Value "{{.ModelNameL}}"{{"}}"}} in your template.
+const (
+	{{.ModelName}}PageKey = "{{.ModelNameL}}" // *{{.ModelTypeName}} on the detail page
+	{{.ModelName}}ListPageKey = "{{.ModelNameL}}List" // []{{.ModelTypeName}} on the listing page
+	{{.ModelName}}ListCountPageKey = "{{.ModelNameL}}ListCount" // total number of records, up to the max count
+	{{.ModelName}}ListParamsPageKey = "{{.ModelNameL}}ListParams" // the list{{.ModelName}}PageParams used
+)
+
+func init() {
+	h := &{{.ModelName}}PageRouter{}
+	autowire.Populate(h)
+	handlerregistry.MustRegister(handlerregistry.SeqCtrl, "{{.ModelName}}PageRouter", h)
+}
+
+// {{.ModelName}}PageStore is the subset of the store used to load page data.
+type {{.ModelName}}PageStore interface {
+	Fetch{{.ModelName}}(ctx context.Context, o {{if .GenericMode}}interface{}{{else}}*{{.ModelTypeName}}{{end}}, id string, related ...string) error
+	Search{{.ModelName}}Count(ctx context.Context, criteria tmetautil.Criteria, orderBy tmetautil.OrderByList, maxRows int64) (int64, error)
+	Search{{.ModelName}}(ctx context.Context, criteria tmetautil.Criteria, orderBy tmetautil.OrderByList, limit, offset int64, related ...string) ([]{{.ModelTypeName}}, error)
+}
+
+// {{.ModelName}}PageRouter matches the listing page ({{.PagePrefix}}) and the detail
+// pages ({{.PagePrefix}}/:id) and puts their data on the request context, so the
+// renderer which comes later in the handler list can use it.  Detail pages are
+// rendered with the template for DetailPagePath, {{.PagePrefix}}/new gives the same
+// page with no {{.ModelName}} loaded, for creating one.
+type {{.ModelName}}PageRouter struct {
+	PagePrefix string // default: "{{.PagePrefix}}"
+	DetailPagePath string // default: "{{.PagePrefix}}/detail"
+	NewID string // default: "new"
+	Store {{.StoreType}} {{bq "autowire:\"\""}}
+	Controller *{{.ModelName}}PageController // default: a {{.ModelName}}PageController using Store
+}
+
+// {{.ModelName}}PageController loads the data for the {{.ModelName}} pages.
+type {{.ModelName}}PageController struct {
+	Store {{.ModelName}}PageStore
+	DefaultLimit int64 // default: 20
+	MaxLimit int64 // default: 500
+	MaxCount int64 // default: 5000
+	SortFields []string // the field names the listing can be sorted by
+}
+
+func (h *{{.ModelName}}PageRouter) AfterWire() error {
+	if h.PagePrefix == "" {
+		h.PagePrefix = "{{.PagePrefix}}"
+	}
+	if h.DetailPagePath == "" {
+		h.DetailPagePath = h.PagePrefix + "/detail"
+	}
+	if h.NewID == "" {
+		h.NewID = "new"
+	}
+	if h.Controller == nil {
+		h.Controller = &{{.ModelName}}PageController{
+			Store: h.Store,
+			SortFields: h.Store.Meta.For({{.ModelTypeName}}{}).SQLFields(true),
+		}
+	}
+	return nil
+}
+
+// list{{.ModelName}}PageParams are the URL parameters of the listing page.
+type list{{.ModelName}}PageParams struct {
+	Limit int64 {{bq "json:\"limit\""}}
+	Offset int64 {{bq "json:\"offset\""}}
+	Sort string {{bq "json:\"sort\""}} // field name, prefixed with "-" for descending
+	Total int64 {{bq "json:\"-\""}} // set by List, the record count up to MaxCount
+}
+
+// SortBy returns the sort param for a column heading link: field, or -field if
+// already sorted by field (ascending).
+func (p list{{.ModelName}}PageParams) SortBy(field string) string {
+	if p.Sort == field {
+		return "-" + field
+	}
+	return field
+}
+
+func (p list{{.ModelName}}PageParams) HasPrev() bool { return p.Offset > 0 }
+func (p list{{.ModelName}}PageParams) HasNext() bool { return p.Offset+p.Limit < p.Total }
+func (p list{{.ModelName}}PageParams) NextOffset() int64 { return p.Offset + p.Limit }
+func (p list{{.ModelName}}PageParams) PageNum() int64 { return p.Offset/p.Limit + 1 }
+func (p list{{.ModelName}}PageParams) PageCount() int64 { return (p.Total + p.Limit - 1) / p.Limit }
+
+func (p list{{.ModelName}}PageParams) PrevOffset() int64 {
+	if p.Offset < p.Limit {
+		return 0
+	}
+	return p.Offset - p.Limit
+}
+
+func (h *{{.ModelName}}PageRouter) ServeHTTPChain(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, *http.Request) {
+
+	if r.Method != "GET" && r.Method != "HEAD" {
+		return w, r
+	}
+
+	p := r.URL.Path
+	var ctx context.Context
+	var err error
+
+	switch {
+
+	case p == h.PagePrefix || p == h.PagePrefix+"/":
+		var params list{{.ModelName}}PageParams
+		err = httpapi.FormUnmarshal(r.URL.Query(), &params)
+		if err != nil {
+			err = weberrors.New(err, 400, "invalid parameters", nil, nil)
+			break
+		}
+		ctx, err = h.Controller.List(r.Context(), params)
+
+	case strings.HasPrefix(p, h.PagePrefix+"/"):
+		{{.ModelNameL}}ID := strings.TrimPrefix(p, h.PagePrefix+"/")
+		if strings.Contains({{.ModelNameL}}ID, "/") {
+			return w, r
+		}
+		if {{.ModelNameL}}ID == h.NewID {
+			ctx = r.Context()
+		} else {
+			ctx, err = h.Controller.Detail(r.Context(), {{.ModelNameL}}ID)
+		}
+		if err == nil {
+			// render the detail page template, the ID is not part of the template name
+			u := *r.URL
+			u.Path = h.DetailPagePath
+			r = r.WithContext(ctx)
+			r.URL = &u
+			return w, r
+		}
+
+	default:
+		return w, r
+
+	}
+
+	if err != nil {
+		code := weberrors.ErrorCode(err)
+		if code == 0 {
+			code = 500
+		}
+		msg := weberrors.ErrorMessage(err)
+		if msg == "" {
+			msg = "error loading page data"
+		}
+		// writing the response stops the rest of the handler chain
+		webutil.HTTPError(w, r, err, msg, code)
+		return w, r
+	}
+
+	return w, r.WithContext(ctx)
+}
+
+// Detail loads the {{.ModelName}} with the ID given and returns a context with it
+// set as {{.ModelName}}PageKey.  The error has code 404 if it does not exist.
+func (h *{{.ModelName}}PageController) Detail(ctx context.Context, {{.ModelNameL}}ID string) (context.Context, error) {
+
+	var {{.ModelNameL}} {{.ModelTypeName}}
+	err := h.Store.Fetch{{.ModelName}}(ctx, &{{.ModelNameL}}, {{.ModelNameL}}ID)
+	if webutil.IsNotFound(err) {
+		return ctx, weberrors.New(err, 404, "not found", nil, nil)
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, {{.ModelName}}PageKey, &{{.ModelNameL}}), nil
+}
+
+// List loads a page of {{plural .ModelName}} and returns a context with it set
+// as {{.ModelName}}ListPageKey, along with the total count and the params used.
+func (h *{{.ModelName}}PageController) List(ctx context.Context, params list{{.ModelName}}PageParams) (context.Context, error) {
+
+	defaultLimit, maxLimit, maxCount := h.DefaultLimit, h.MaxLimit, h.MaxCount
+	if defaultLimit <= 0 {
+		defaultLimit = 20
+	}
+	if maxLimit <= 0 {
+		maxLimit = 500
+	}
+	if maxCount <= 0 {
+		maxCount = 5000
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultLimit
+	}
+	if params.Limit > maxLimit || params.Offset < 0 || params.Offset > maxCount {
+		return ctx, weberrors.New(fmt.Errorf("invalid limit %d / offset %d", params.Limit, params.Offset), 400, "invalid limit or offset", nil, nil)
+	}
+
+	var orderBy tmetautil.OrderByList
+	if params.Sort != "" {
+		sortField := strings.TrimPrefix(params.Sort, "-")
+		ok := false
+		for _, f := range h.SortFields {
+			ok = ok || f == sortField
+		}
+		if !ok {
+			return ctx, weberrors.New(fmt.Errorf("invalid sort %q", params.Sort), 400, "invalid sort field", nil, nil)
+		}
+		orderBy = append(orderBy, tmetautil.OrderBy{Field: sortField, Desc: strings.HasPrefix(params.Sort, "-")})
+	}
+
+	// a separate context with timeout so the queries don't run too long
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	count, err := h.Store.Search{{.ModelName}}Count(queryCtx, nil, orderBy, maxCount)
+	if err != nil {
+		return ctx, err
+	}
+
+	resultList, err := h.Store.Search{{.ModelName}}(queryCtx, nil, orderBy, params.Limit, params.Offset)
+	if err != nil {
+		return ctx, err
+	}
+
+	params.Total = count
+
+	return renderer.WithValueMap(ctx, map[interface{}]interface{}{
+		{{.ModelName}}ListPageKey: resultList,
+		{{.ModelName}}ListCountPageKey: count,
+		{{.ModelName}}ListParamsPageKey: params,
+	}), nil
+}
+
+`, false)
+
+		if err != nil {
+			return err
+		}
+
+		if *tests {
+
+			testsTargetFile := strings.Replace(targetFile, ".go", "_test.go", 1)
+			if testsTargetFile == targetFile {
+				return fmt.Errorf("unable to determine test file name for %q", targetFile)
+			}
+
+			err = OutputGoSrcTemplate(s, data, testsTargetFile, `
+package {{.PackageName}}
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocaveman/caveman/webutil"
+	"github.com/gocaveman/tmeta/tmetautil"
+	"github.com/stretchr/testify/assert"
+{{- if .StoreImport}}
+
+	"{{.StoreImport}}"
+{{- end}}
+)
+
+// test{{.ModelName}}PageStore is an in-memory {{.ModelName}}PageStore
+type test{{.ModelName}}PageStore map[string]{{.ModelTypeName}}
+
+func (s test{{.ModelName}}PageStore) Fetch{{.ModelName}}(ctx context.Context, o {{if .GenericMode}}interface{}{{else}}*{{.ModelTypeName}}{{end}}, id string, related ...string) error {
+	v, ok := s[id]
+	if !ok {
+		return webutil.ErrNotFound
+	}
+{{- if .GenericMode}}
+	*o.(*{{.ModelTypeName}}) = v
+{{- else}}
+	*o = v
+{{- end}}
+	return nil
+}
+
+func (s test{{.ModelName}}PageStore) Search{{.ModelName}}Count(ctx context.Context, criteria tmetautil.Criteria, orderBy tmetautil.OrderByList, maxRows int64) (int64, error) {
+	return int64(len(s)), nil
+}
+
+func (s test{{.ModelName}}PageStore) Search{{.ModelName}}(ctx context.Context, criteria tmetautil.Criteria, orderBy tmetautil.OrderByList, limit, offset int64, related ...string) ([]{{.ModelTypeName}}, error) {
+	ret := make([]{{.ModelTypeName}}, 0, len(s))
+	for _, v := range s {
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func Test{{.ModelName}}Pages(t *testing.T) {
+
+	assert := assert.New(t)
+
+	h := &{{.ModelName}}PageRouter{
+		Controller: &{{.ModelName}}PageController{
+			Store: test{{.ModelName}}PageStore{
+				"id1": {{.ModelTypeName}}{ {{.ModelName}}ID: "id1"},
+			},
+			SortFields: []string{"{{.PKDBName}}"},
+		},
+	}
+	assert.NoError(h.AfterWire())
+
+	serve := func(path string) (*httptest.ResponseRecorder, *http.Request) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", path, nil)
+		_, r = h.ServeHTTPChain(w, r)
+		return w, r
+	}
+
+	w, r := serve("{{.PagePrefix}}/id1")
+	assert.Equal(200, w.Code)
+	assert.Equal("{{.PagePrefix}}/detail", r.URL.Path)
+	if assert.NotNil(r.Context().Value({{.ModelName}}PageKey)) {
+		assert.Equal("id1", r.Context().Value({{.ModelName}}PageKey).(*{{.ModelTypeName}}).{{.ModelName}}ID)
+	}
+
+	w, r = serve("{{.PagePrefix}}/id2")
+	assert.Equal(404, w.Code)
+	assert.Nil(r.Context().Value({{.ModelName}}PageKey))
+
+	w, r = serve("{{.PagePrefix}}/new")
+	assert.Equal(200, w.Code)
+	assert.Equal("{{.PagePrefix}}/detail", r.URL.Path)
+	assert.Nil(r.Context().Value({{.ModelName}}PageKey))
+
+	w, r = serve("{{.PagePrefix}}?limit=10")
+	assert.Equal(200, w.Code)
+	assert.Len(r.Context().Value({{.ModelName}}ListPageKey), 1)
+	assert.Equal(int64(1), r.Context().Value({{.ModelName}}ListCountPageKey))
+	params := r.Context().Value({{.ModelName}}ListParamsPageKey).(list{{.ModelName}}PageParams)
+	assert.False(params.HasPrev())
+	assert.False(params.HasNext())
+	assert.Equal(int64(1), params.PageCount())
+
+	w, _ = serve("{{.PagePrefix}}?limit=100000")
+	assert.Equal(400, w.Code)
+
+	w, _ = serve("{{.PagePrefix}}?sort=-{{.PKDBName}}")
+	assert.Equal(200, w.Code)
+	w, _ = serve("{{.PagePrefix}}?sort=password")
+	assert.Equal(400, w.Code)
+
+	w, r = serve("/some-other-page")
+	assert.Equal(200, w.Code)
+	assert.Nil(r.Context().Value({{.ModelName}}ListPageKey))
+
+}
+
+`, false)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+
+	})
+}
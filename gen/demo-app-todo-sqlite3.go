@@ -35,11 +35,15 @@ func init() {
 		if err != nil {
 			return err
 		}
-		err = globalMapGenerator.Generate(s, "store-crud", filepath.Join(targetDir, "store-todo-item.go"))
+		err = globalMapGenerator.Generate(s, "store-crud", "--relation", "belongs_to:TodoList:TodoList", filepath.Join(targetDir, "store-todo-item.go"))
 		if err != nil {
 			return err
 		}
-		err = globalMapGenerator.Generate(s, "ctrl-api-crud", filepath.Join(targetDir, "ctrl-todo-item.go"))
+		err = globalMapGenerator.Generate(s, "ctrl-api-crud", "--relation", "belongs_to:TodoList:TodoList", filepath.Join(targetDir, "ctrl-todo-item.go"))
+		if err != nil {
+			return err
+		}
+		err = globalMapGenerator.Generate(s, "ctrl-pages", filepath.Join(targetDir, "ctrl-todo-item-pages.go"))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,173 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// UnifiedDiff returns a unified diff (as from "diff -u") between a and b, with the
+// file names given in the header.  An empty string is returned if a and b are the same.
+func UnifiedDiff(aName, bName string, a, b []byte) string {
+
+	if bytes.Equal(a, b) {
+		return ""
+	}
+
+	al, bl := splitLines(string(a)), splitLines(string(b))
+	edits := diffLines(al, bl)
+
+	const context = 3
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", aName, bName)
+
+	for i := 0; i < len(edits); {
+
+		// find the next change
+		for i < len(edits) && edits[i].op == ' ' {
+			i++
+		}
+		if i >= len(edits) {
+			break
+		}
+
+		// the hunk runs until there are more than 2*context unchanged lines
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+			n := 0
+			for end+n < len(edits) && edits[end+n].op == ' ' {
+				n++
+			}
+			if end+n >= len(edits) || n > 2*context {
+				if n > context {
+					n = context
+				}
+				end += n
+				break
+			}
+			end += n
+		}
+
+		aStart, bStart := edits[start].a, edits[start].b
+		aCount, bCount := 0, 0
+		for _, e := range edits[start:end] {
+			if e.op != '+' {
+				aCount++
+			}
+			if e.op != '-' {
+				bCount++
+			}
+		}
+		// by convention an empty range starts at the line before
+		if aCount > 0 {
+			aStart++
+		}
+		if bCount > 0 {
+			bStart++
+		}
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+		for _, e := range edits[start:end] {
+			buf.WriteByte(e.op)
+			buf.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		i = end
+	}
+
+	return buf.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// splitLines splits s into lines, each keeping its "\n".
+func splitLines(s string) []string {
+	var ret []string
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\n')
+		if i < 0 {
+			ret = append(ret, s)
+			break
+		}
+		ret = append(ret, s[:i+1])
+		s = s[i+1:]
+	}
+	return ret
+}
+
+type diffEdit struct {
+	op   byte // ' ', '-' or '+'
+	line string
+	a, b int // index of the line in a and b (or where it would be)
+}
+
+// diffLines returns the edits which turn a into b, from the longest common subsequence.
+func diffLines(a, b []string) []diffEdit {
+
+	// the common prefix and suffix are easy, and usually most of it
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	am, bm := a[pre:len(a)-suf], b[pre:len(b)-suf]
+
+	// lcs[i][j] is the length of the LCS of am[i:] and bm[j:]
+	w := len(bm) + 1
+	lcs := make([]int32, (len(am)+1)*w)
+	for i := len(am) - 1; i >= 0; i-- {
+		for j := len(bm) - 1; j >= 0; j-- {
+			if am[i] == bm[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else if lcs[(i+1)*w+j] >= lcs[i*w+j+1] {
+				lcs[i*w+j] = lcs[(i+1)*w+j]
+			} else {
+				lcs[i*w+j] = lcs[i*w+j+1]
+			}
+		}
+	}
+
+	ret := make([]diffEdit, 0, len(a)+len(b)-len(am)-len(bm))
+	for i := 0; i < pre; i++ {
+		ret = append(ret, diffEdit{op: ' ', line: a[i], a: i, b: i})
+	}
+	i, j := 0, 0
+	for i < len(am) || j < len(bm) {
+		switch {
+		case i < len(am) && j < len(bm) && am[i] == bm[j]:
+			ret = append(ret, diffEdit{op: ' ', line: am[i], a: pre + i, b: pre + j})
+			i++
+			j++
+		case j >= len(bm) || (i < len(am) && lcs[(i+1)*w+j] >= lcs[i*w+j+1]):
+			ret = append(ret, diffEdit{op: '-', line: am[i], a: pre + i, b: pre + j})
+			i++
+		default:
+			ret = append(ret, diffEdit{op: '+', line: bm[j], a: pre + i, b: pre + j})
+			j++
+		}
+	}
+	for k := 0; k < suf; k++ {
+		ret = append(ret, diffEdit{op: ' ', line: a[len(a)-suf+k], a: len(a) - suf + k, b: len(b) - suf + k})
+	}
+
+	return ret
+}
@@ -2,6 +2,7 @@ package gen
 
 import (
 	"fmt"
+	"io/fs"
 	"path"
 	"path/filepath"
 	"strings"
@@ -9,10 +10,15 @@ import (
 	"github.com/spf13/pflag"
 )
 
+// embed-tmpl packages the views and includes directories next to the target file into
+// the binary with go:embed and registers them in tmplregistry, so changes to a view
+// need only a rebuild.
+
 func init() {
 	globalMapGenerator["embed-tmpl"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
 
 		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		dirs := fset.StringSlice("dirs", nil, "The directories to embed, next to the target file (defaults to those of 'views' and 'includes' which exist, or both).  Those other than views and includes are only available from EmbeddedAssets.")
 		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
 		if err != nil {
 			return err
@@ -22,30 +28,51 @@ func init() {
 		if path.Ext(targetFileName) != ".go" {
 			return fmt.Errorf("target file name (%q) must end with .go", targetFileName)
 		}
-		_ = targetDir
-
-		targetGogenFileName := strings.Replace(targetFileName, ".go", "-gogen.go", 1)
-		data["TargetGogenFileName"] = targetGogenFileName
-		targetGogenFile := filepath.Join(targetDir, targetGogenFileName)
 
-		targetDataFileName := strings.Replace(targetFileName, ".go", "-data.go", 1)
-		data["TargetDataFileName"] = targetDataFileName
-		targetDataDevFileName := strings.Replace(targetFileName, ".go", "-data-dev.go", 1)
-		data["TargetDataDevFileName"] = targetDataDevFileName
+		if len(*dirs) == 0 {
+			for _, d := range []string{"views", "includes"} {
+				if s.dirExists(filepath.Join(targetDir, d)) {
+					*dirs = append(*dirs, d)
+				}
+			}
+			if len(*dirs) == 0 {
+				// go:embed needs them to exist, but this is what they should be called
+				*dirs = []string{"views", "includes"}
+				fmt.Fprintf(s.stdout(), "NOTE: create the views and includes directories in %s (go:embed fails if they are missing) or use --dirs\n", filepath.Join(s.GOPATH, targetDir))
+			}
+		}
+		for _, d := range *dirs {
+			if !fs.ValidPath(d) || d == "." {
+				return fmt.Errorf("invalid directory %q, must be a path below the target file's directory", d)
+			}
+		}
+		data["Dirs"] = *dirs
+
+		// the vfsgen files from earlier versions define EmbeddedAssets too
+		for _, suffix := range []string{"-gogen.go", "-data.go", "-data-dev.go"} {
+			old := filepath.Join(targetDir, strings.Replace(targetFileName, ".go", suffix, 1))
+			if _, err := s.readFile(old); err == nil {
+				fmt.Fprintf(s.stdout(), "NOTE: %s was used by vfsgen and is no longer needed, please remove it\n", filepath.Join(s.GOPATH, old))
+			}
+		}
 
-		err = OutputGoSrcTemplate(s, data, targetFile, `
+		return OutputGoSrcTemplate(s, data, targetFile, `
 package {{.PackageName}}
 
 import (
+	"embed"
 	"net/http"
-	"path"
 
 	"github.com/gocaveman/caveman/filesystem/fsutil"
 	"github.com/gocaveman/caveman/tmpl"
 	"github.com/gocaveman/caveman/tmpl/tmplregistry"
 )
 
-//go:generate go run {{.TargetGogenFileName}}
+// EmbeddedAssets has the files of this package's{{range .Dirs}} {{.}}{{end}} director{{if gt (len .Dirs) 1}}ies{{else}}y{{end}},
+// built in with go:embed (the "all:" includes files starting with "_" or ".").
+//
+//go:embed{{range .Dirs}} all:{{.}}{{end}}
+var EmbeddedAssets embed.FS
 
 func init() {
 
@@ -56,97 +83,20 @@ func init() {
 }
 
 func NewViewsFS() http.FileSystem {
-	return fsutil.NewHTTPFuncFS(func(name string) (http.File, error) {
-		return EmbeddedAssets.Open("/views" + path.Clean("/"+name))
-	})
+	return fsutil.MustHTTPSubFS(EmbeddedAssets, "views")
 }
 
 func NewIncludesFS() http.FileSystem {
-	return fsutil.NewHTTPFuncFS(func(name string) (http.File, error) {
-		return EmbeddedAssets.Open("/includes" + path.Clean("/"+name))
-	})
+	return fsutil.MustHTTPSubFS(EmbeddedAssets, "includes")
 }
 
 func NewTmplStore() tmpl.Store {
-	return &tmpl.HFSStore{
-		FileSystems: map[string]http.FileSystem{
-			tmpl.ViewsCategory:    NewViewsFS(),
-			tmpl.IncludesCategory: NewIncludesFS(),
-		},
-	}
-}
-`, false)
-		if err != nil {
-			return err
-		}
-
-		err = OutputGoSrcTemplate(s, data, targetGogenFile, `// +build ignore
-
-package main
-
-import (
-	"io/ioutil"
-	"log"
-	"os"
-	"regexp"
-	"strings"
-
-	"github.com/shurcooL/vfsgen"
-	"github.com/spf13/afero"
-)
-
-func main() {
-
-	packageName := "{{.PackageName}}"
-
-	wd, err := os.Getwd()
-	if err != nil {
-		log.Fatal(err)
-	}
-	base := afero.NewBasePathFs(afero.NewOsFs(), wd)
-	filteredAFS := afero.NewRegexpFs(base, regexp.MustCompile("\\.(gohtml|html|css|js|jpg|png|gif|svg)$"))
-	filteredHFS := afero.NewHttpFs(filteredAFS)
-	err = vfsgen.Generate(filteredHFS, vfsgen.Options{
-		PackageName:  packageName,
-		BuildTags:    "!dev",
-		VariableName: "EmbeddedAssets",
-		Filename:     "{{.TargetDataFileName}}",
+	return tmpl.NewFSStore(EmbeddedAssets, map[string]string{
+		tmpl.ViewsCategory:    "views",
+		tmpl.IncludesCategory: "includes",
 	})
-	if err != nil {
-		log.Fatalln(err)
-	}
-
-	devContents := `+"`"+`// +build dev
-
-package __PACKAGE__
-
-// CODE GENERATED FILE, DO NOT EDIT!
-
-import (
-	"net/http"
-)
-
-// EmbeddedAssets dev version loads from local filesystem.
-var EmbeddedAssets = func() http.FileSystem {
-	return http.Dir("__LOCALPATH__")
-}()
-`+"`"+`
-
-	devStr := strings.Replace(string(devContents), "__PACKAGE__", packageName, -1)
-	devStr = strings.Replace(devStr, "__LOCALPATH__", wd, -1)
-
-	err = ioutil.WriteFile("{{.TargetDataDevFileName}}", []byte(devStr), 0644)
-	if err != nil {
-		log.Fatal(err)
-	}
-
 }
-
 `, false)
-		if err != nil {
-			return err
-		}
 
-		return nil
 	})
 }
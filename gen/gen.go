@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/build"
 	"go/parser"
 	"go/token"
-	"io/ioutil"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -23,6 +25,8 @@ import (
 
 // ideas of what we want to accomplish:
 
+// cavegen new-app myapp -module example.com/myapp - a new project with its own go.mod: main, config, store, API, views and tests
+
 // cavegen ctrl-rest-crud src/mypjt/ctrl/ctrl-customer.go - probably need a -store CustomerStore option
 
 // cavegen store-struct src/mypjt/store/store.go - the Store struct itself and setup
@@ -33,15 +37,27 @@ import (
 
 // cavegen model-sample-customer src/mypjt/store/model-customer.go - an example of a customer
 
-// cavegen ctrl-sample-pages src/mypjt/ctrl/ctrl-customer-pages.go - sample of page data controller
+// cavegen ctrl-pages src/mypjt/ctrl/ctrl-customer-pages.go - listing and detail page data controller
 
 // cavegen asset-package src/mypjt/views/assets.go - make a dir that, with go:generate, is packaged into a fs avail at runtime
 
-// cavegen embed-tmpl src/mypjt/somefiles/embed.go - use vfsgen to package a directory up into http.FileSystem and tmpl.Store and register it
+// cavegen embed-tmpl src/mypjt/somefiles/embed.go - use go:embed to package the views and includes up into http.FileSystem and tmpl.Store and register it
 
 type Settings struct {
-	WorkDir string // directory that all of the paths are relative to
-	GOPATH  string // GOPATH as extracted from env
+	WorkDir string    // directory that all of the paths are relative to
+	GOPATH  string    // GOPATH as extracted from env
+	DryRun  bool      // don't write any files, print a diff of the changes instead
+	Force   bool      // overwrite files and sections which were modified by hand (see output.go)
+	Stdout  io.Writer // where dry run diffs are printed, os.Stdout if nil
+
+	dryRunFiles map[string][]byte // files "written" during a dry run, so later steps see them
+}
+
+func (s *Settings) stdout() io.Writer {
+	if s.Stdout == nil {
+		return os.Stdout
+	}
+	return s.Stdout
 }
 
 // RelativeToGOPATH returns "src/whatever" from "./whatever" given
@@ -110,9 +126,14 @@ func (s *Settings) FormatGoCode(filename string, src []byte) ([]byte, error) {
 
 	// FIXME: ideally we would be using -srcdir when we use goimports
 	cmd := exec.Command(cmdPath)
-	cmd.Dir = s.WorkDir
-	// copy os environment but use our own GOPATH
+	if fi, err := os.Stat(s.WorkDir); err == nil && fi.IsDir() { // not yet created in a dry run
+		cmd.Dir = s.WorkDir
+	}
+	// copy os environment but use our own GOPATH, keeping the module cache where it was
 	env := os.Environ()
+	if os.Getenv("GOMODCACHE") == "" {
+		env = append(env, "GOMODCACHE="+filepath.Join(filepath.SplitList(build.Default.GOPATH)[0], "pkg", "mod"))
+	}
 	didGoPath := false
 	for i := range env {
 		if strings.HasPrefix(env[i], "GOPATH=") {
@@ -183,10 +204,15 @@ func andOneFile(s *Settings, targetFileIn string) (targetFile string, retdata ma
 	// detect existing package name, if any
 	targetDir, _ := path.Split(targetFile)
 	packageName, err := DetectDirPackage(filepath.Join(s.GOPATH, targetDir))
-	if err == ErrNoPackageNameFound {
+	if err == ErrNoPackageNameFound || os.IsNotExist(err) {
 		targetFileSlash := filepath.ToSlash(targetFile)
 		targetFileSlashParts := strings.Split(targetFileSlash, "/")
-		packageName = targetFileSlashParts[len(targetFileSlashParts)-2]
+		if len(targetFileSlashParts) < 2 {
+			// a file at the top, e.g. of a module created by new-app
+			packageName = "main"
+		} else {
+			packageName = targetFileSlashParts[len(targetFileSlashParts)-2]
+		}
 	} else if err != nil {
 		return "", nil, err
 	}
@@ -200,8 +226,9 @@ func andOneFile(s *Settings, targetFileIn string) (targetFile string, retdata ma
 }
 
 // ParsePFlagsAndOneFile will parse arguments against a pflag set of flags.
+// Long flags can be given with one dash ("-table x") as well as two.
 func ParsePFlagsAndOneFile(s *Settings, fset *pflag.FlagSet, args []string) (targetFile string, retdata map[string]interface{}, reterr error) {
-	err := fset.Parse(args)
+	err := fset.Parse(longFlagArgs(fset, args))
 	if err != nil {
 		return "", nil, err
 	}
@@ -218,6 +245,25 @@ func ParsePFlagsAndOneFile(s *Settings, fset *pflag.FlagSet, args []string) (tar
 	return andOneFile(s, targetFile)
 }
 
+// longFlagArgs returns args with the single dash forms of the long flags in fset
+// ("-table x") changed to two dashes, as pflag would otherwise take them as shorthands.
+// Arguments after "--" are left as is.
+func longFlagArgs(fset *pflag.FlagSet, args []string) []string {
+	ret := make([]string, 0, len(args))
+	for i, arg := range args {
+		if arg == "--" {
+			return append(ret, args[i:]...)
+		}
+		if strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") {
+			if fset.Lookup(strings.SplitN(arg[1:], "=", 2)[0]) != nil {
+				arg = "-" + arg
+			}
+		}
+		ret = append(ret, arg)
+	}
+	return ret
+}
+
 // ParseFlagsAndOneFile is deprecated, ParsePFlagsAndOneFile is recommended instead.
 func ParseFlagsAndOneFile(s *Settings, fset *flag.FlagSet, args []string) (targetFile string, retdata map[string]interface{}, reterr error) {
 
@@ -262,23 +308,42 @@ func ParseFlagsAndOneFile(s *Settings, fset *flag.FlagSet, args []string) (targe
 
 // GoSrcReplace performs a regexp replace on a file inline.
 // Useful for adding things to an existing file.  The filePath
-// is relative to and joined with s.GOPATH.
+// is relative to and joined with s.GOPATH.  If the file has generated
+// sections which were modified by hand it is an error unless s.Force is set,
+// and the sums of the sections are updated to include the replacement.
 func GoSrcReplace(s *Settings, filePath string, pattern *regexp.Regexp, repl func(string) string) error {
 
-	fullPath := filepath.Join(s.GOPATH, filePath)
-
-	b, err := ioutil.ReadFile(fullPath)
+	b, err := s.readFile(filePath)
 	if err != nil {
 		return err
 	}
 
+	segs, err := parseSections(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", filePath, err)
+	}
+	if !s.Force {
+		err = checkSections(filePath, segs)
+		if err != nil {
+			return err
+		}
+	}
+
 	// FIXME: this should somehow error if nothing was replaced...
 	outs := pattern.ReplaceAllStringFunc(string(b), repl)
 
 	// gofmt/goimports before writing back
 	outBFmt, err := s.FormatGoCode(filePath, []byte(outs))
+	if err != nil {
+		return err
+	}
+
+	segs, err = parseSections(outBFmt)
+	if err != nil {
+		return fmt.Errorf("%s: %v", filePath, err)
+	}
 
-	return ioutil.WriteFile(fullPath, outBFmt, 0644)
+	return s.writeFile(filePath, b, joinSections(segs))
 }
 
 func OutputGoSrcTemplate(s *Settings, data map[string]interface{}, targetFile string, tmplSrc string, debug bool) error {
@@ -315,12 +380,59 @@ func OutputGoSrcTemplate(s *Settings, data map[string]interface{}, targetFile st
 		return err
 	}
 
-	err = ioutil.WriteFile(filepath.Join(s.GOPATH, targetFile), b, 0644)
+	b, err = wrapGoSection(b)
+	if err != nil {
+		return fmt.Errorf("%s: %v", targetFile, err)
+	}
+
+	return s.outputFile(targetFile, b, true)
+}
+
+// OutputTemplate runs a template and writes the output as-is, creating the directory if needed.
+// It is for files which are not Go source, in particular templates like .gohtml files,
+// so the delimiters are "[[" and "]]" instead of "{{" and "}}".  The same functions
+// as OutputGoSrcTemplate are available.  The output is not put in a generated section,
+// so an existing file is only replaced if s.Force is set, unless the template has its own
+// section markers.
+func OutputTemplate(s *Settings, data map[string]interface{}, targetFile string, tmplSrc string, debug bool) error {
+
+	b, err := ExecuteTemplate(data, tmplSrc)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if debug {
+		log.Printf("Generated output:\n%s", b)
+	}
+
+	return s.outputFile(targetFile, b, false)
+}
+
+// ExecuteTemplate runs a template the same as OutputTemplate does and returns the output.
+func ExecuteTemplate(data map[string]interface{}, tmplSrc string) ([]byte, error) {
+
+	t := template.New("_out_").Delims("[[", "]]")
+	t = t.Funcs(template.FuncMap(map[string]interface{}{
+		"bq": func(s string) string {
+			return "`" + s + "`"
+		},
+		"plural": func(s string) string {
+			return inflection.Plural(s)
+		},
+	}))
+
+	t, err := t.Parse(tmplSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
 }
 
 // NameSnakeToCamel converts "some-name" to SomeName.
@@ -353,18 +465,51 @@ func NameSnakeToCamel(s string, trimPrefixes []string, trimSuffixes []string) st
 	return strings.Join(parts, "")
 }
 
+// NameLowerFirst converts "SomeName" to "someName", for variables named after a type.
+// FIXME: this breaks on JSONThing -> jSONThing
+func NameLowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
 var ErrNoPackageNameFound = fmt.Errorf("no package name found")
 
 // DetectDirPackage will look at a Go directory and return the package name for existing files in it.
+// Files excluded with "+build ignore" (such as the "-gogen.go" programs) and test files are skipped.
 func DetectDirPackage(dirPath string) (string, error) {
 
 	fileSet := token.NewFileSet()
-	pmap, err := parser.ParseDir(fileSet, dirPath, nil, parser.PackageClauseOnly)
+	pmap, err := parser.ParseDir(fileSet, dirPath, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.PackageClauseOnly|parser.ParseComments)
 	if err != nil {
 		return "", err
 	}
-	for k := range pmap {
-		return k, nil
+	for k, pkg := range pmap {
+		for _, f := range pkg.Files {
+			if !buildIgnored(f) {
+				return k, nil
+			}
+		}
 	}
 	return "", ErrNoPackageNameFound
 }
+
+var buildIgnoreRE = regexp.MustCompile(`^//\s*(\+build|go:build)\s+ignore\s*$`)
+
+// buildIgnored returns true if the file has an "ignore" build constraint.
+func buildIgnored(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if buildIgnoreRE.MatchString(c.Text) {
+				return true
+			}
+		}
+	}
+	return false
+}
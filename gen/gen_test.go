@@ -1,11 +1,20 @@
 package gen
 
 import (
+	"bytes"
+	"context"
+	"database/sql"
+	htmltemplate "html/template"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migratedbr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +58,825 @@ func TestGen(t *testing.T) {
 	assert.Equal("demoproj", packageName)
 
 }
+
+func TestMigrationDiff(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestMigrationDiff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/model.go"), []byte(`package demoproj
+
+import "time"
+
+type Timestamps struct {
+	CreateTime time.Time `+"`db:\"create_time\"`"+`
+}
+
+type Widget struct {
+	WidgetID int64   `+"`db:\"widget_id\" tmeta:\"pk\"`"+`
+	Name     string  `+"`db:\"name\" ddl:\"index\"`"+`
+	Weight   float64 `+"`db:\"weight\" ddl:\"default=0\"`"+`
+	Timestamps
+}
+
+type Part struct {
+	PartID   string `+"`db:\"part_id\" tmeta:\"pk\"`"+`
+	WidgetID int64  `+"`db:\"widget_id\" ddl:\"fk=widget.widget_id,ondelete=cascade\"`"+`
+}
+`), 0644))
+
+	// the database has an older version of the table
+	dsn := filepath.Join(tmpDir, "test.db")
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	up, _, err := ddl.New().
+		CreateTable("widget").
+		Column("widget_id", ddl.BigIntAutoPK).PrimaryKey().
+		Column("name", ddl.VarChar).
+		Column("color", ddl.VarChar).Null().
+		Column("create_time", ddl.DateTime).
+		CreateTable("migration_state").
+		Column("category", ddl.VarCharPK).PrimaryKey().
+		MakeSQL(ddl.NewSQLite3Formatter(false))
+	assert.NoError(err)
+	for _, s := range up {
+		_, err := db.Exec(s)
+		assert.NoError(err)
+	}
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "migration-diff",
+		"--dsn", dsn, "--model", "src/demoproj/model.go:Widget", "--model", "src/demoproj/model.go:Part", "--version", "0002_widget",
+		"src/demoproj/migrations-widget.go"))
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/migrations-widget.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	t.Logf("Generated:\n%s", src)
+	assert.Contains(src, `func MigrationsWidgetMigrations() (ml migrate.MigrationList)`)
+	assert.Contains(src, `b.SetCategory("0100_demoproj")`)
+	assert.Contains(src, `b.SetVersion("0002_widget")`)
+	assert.Contains(src, `b.DefineTable("widget")`)
+	assert.Contains(src, `b.AlterTableDropColumn("widget", "color")`)
+	assert.Contains(src, `b.AlterTableAdd("widget").Column("weight", ddl.Double).Default(0)`)
+	assert.Contains(src, `b.CreateIndex("widget_name", "widget").Columns("name")`)
+	assert.Contains(src, `ForeignKey("part_widget_id_fk", "widget_id", "widget", "widget_id").OnDelete(ddl.Cascade)`)
+	assert.NotContains(src, `migration_state`)
+
+	assert.Error(globalMapGenerator.Generate(s, "migration-diff",
+		"--dsn", dsn, "--model", "src/demoproj/model.go:Gadget", "src/demoproj/migrations-gadget.go"))
+	assert.Error(globalMapGenerator.Generate(s, "migration-diff",
+		"--model", "src/demoproj/model.go:Widget", "src/demoproj/migrations-gadget.go"))
+
+}
+
+func TestCtrlAPICrudOpenAPI(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestCtrlAPICrudOpenAPI")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "ctrl-api-crud", "src/demoproj/ctrl-todo-item.go"))
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	assert.Contains(src, `func (h *TodoItemAPIRouter) DescribeOpenAPI(doc *openapi.Document) error {`)
+	assert.Contains(src, `openapiregistry.MustRegister("TodoItemAPIRouter", h)`)
+	assert.Contains(src, `doc.AddSchema("TodoItem", store.TodoItem{})`)
+	assert.Contains(src, `OperationID: "searchTodoItemsJSON"`)
+
+}
+
+func TestCtrlAPICrudPatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestCtrlAPICrudPatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "ctrl-api-crud", "src/demoproj/ctrl-todo-item.go"))
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	assert.Contains(src, `case ar.ParseRESTObj("PATCH", &bulkData, h.APIPrefix+h.ModelPrefix):`)
+	assert.Contains(src, `err = h.Controller.Patch(w, r, ar, todoItemID, patchData)`)
+	assert.Contains(src, `RequestBody: openapi.PatchRequestBody(patch),`)
+	assert.Contains(src, `OperationID: "bulkUpdateTodoItems",`)
+	assert.Contains(src, `id, _ := item["todo_item_id"].(string)`)
+	assert.Contains(src, `i, err := h.Store.UpdateTodoItemList(r.Context(), list)`)
+	assert.Contains(src, `mapData, err := httpapi.PatchObj(todoItem, contentType, patch)`)
+
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "src/demoproj/store-todo-item.go"))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store-todo-item.go"))
+	assert.NoError(err)
+	assert.Contains(string(bdata), `func (s *Store) UpdateTodoItemList(ctx context.Context, list []*TodoItem) (int, error) {`)
+
+}
+
+func TestRelations(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestRelations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	relArgs := []string{
+		"--relation", "has_many:TodoItemList:TodoItem:ondelete=cascade",
+		"--relation", "belongs_to_many:TagList:Tag",
+		"--relation", "has_one:Note:TodoNote:ondelete=restrict",
+	}
+
+	// the join table migration needs to know where the tables are created
+	err = globalMapGenerator.Generate(s, "store-crud", append(relArgs, "src/demoproj/store-todo-list.go")...)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), `no migration creating table "todo_list" found`)
+	}
+	assert.NoError(globalMapGenerator.Generate(s, "model-todo-list", "--no-types", "src/demoproj/model-todo-list.go"))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/model-tag.go"), []byte(`package demoproj
+
+func TagMigrations() (ml migrate.MigrationList) {
+	b := ddl.New()
+	b.SetCategory("0050_tags")
+	b.SetVersion("20180301120000_create_tag")
+	b.Up().CreateTable("tag").Column("tag_id", ddl.VarCharPK).PrimaryKey()
+	b.MustMigrations(fl...).AppendTo(&ml)
+	return
+}
+`), 0644))
+
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", append(relArgs, "src/demoproj/store-todo-list.go")...))
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store-todo-list.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	assert.Contains(src, `related, err = s.loadTodoListRelated(tx, ptrs, related)`)
+	assert.Contains(src, `Where(dbr.Eq("todo_list_id", ids)).Load(&rl)`)
+	assert.Contains(src, `err = s.saveTodoListRelated(tx, o)`)
+	assert.Contains(src, `cond = dbr.And(cond, dbr.Neq("todo_item_id", keep))`)
+	assert.Contains(src, `tx.InsertInto(joinTable).Columns("todo_list_id", "tag_id")`)
+	assert.Contains(src, `err = s.deleteTodoListRelated(tx, o)`)
+	assert.Contains(src, `has %d related TodoNote record(s) (note), cannot delete`)
+	assert.Contains(src, `CreateTable("todo_list_tag")`)
+	assert.Contains(src, `ForeignKey("todo_list_tag_tag_id_fk", "tag_id", "tag", "tag_id").OnDelete(ddl.Cascade)`)
+	assert.Contains(src, "\tb.SetVersion(\"0100_Acreate_todo_item_create_todo_list_tag\")\n\tb.DependsOn(\"0050_tags\", \"20180301120000_create_tag\")\n")
+	// dropped children are only removed as the ondelete option says
+	assert.Contains(src, `_, err := tx.DeleteFrom(ti.SQLName()).Where(cond).Exec()`)
+
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "--join-version", "0200_tags", "--relation", "has_many:TodoItemList:TodoItem", "--relation", "belongs_to_many:TagList:Tag", "src/demoproj/store-todo-list.go"))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store-todo-list.go"))
+	assert.NoError(err)
+	src = string(bdata)
+	assert.Contains(src, `b.SetVersion("0200_tags")`)
+	assert.NotContains(src, `b.DependsOn(`)
+	assert.NotContains(src, `keep`)
+
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "--relation", "has_many:TodoItemList:TodoItem:ondelete=restrict", "src/demoproj/store-todo-list.go"))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store-todo-list.go"))
+	assert.NoError(err)
+	src = string(bdata)
+	assert.Contains(src, `has %d related TodoItem record(s) (todo_item_list) not in the list, cannot remove them`)
+	assert.NotContains(src, `tx.DeleteFrom(ti.SQLName())`)
+
+	assert.NoError(globalMapGenerator.Generate(s, "ctrl-api-crud", append(relArgs, "src/demoproj/ctrl-todo-list.go")...))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-list.go"))
+	assert.NoError(err)
+	src = string(bdata)
+	assert.Contains(src, `CheckRelationNames(undeclaredTodoListRelations(params.Related)...)`)
+	assert.Contains(src, `{"todo_item_list", &todoList.TodoItemList},`)
+
+	// no relations, no relation code
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "src/demoproj/store-tag.go"))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store-tag.go"))
+	assert.NoError(err)
+	assert.NotContains(string(bdata), `Related(tx`)
+
+	assert.Error(globalMapGenerator.Generate(s, "store-crud", "--relation", "has_lots:ItemList:Item", "src/demoproj/store-x.go"))
+	assert.Error(globalMapGenerator.Generate(s, "store-crud", "--relation", "belongs_to:List:TodoList:ondelete=cascade", "src/demoproj/store-x.go"))
+	assert.Error(globalMapGenerator.Generate(s, "store-crud", "--generic", "--relation", "belongs_to:List:TodoList", "src/demoproj/store-x.go"))
+
+}
+
+func TestCtrlPages(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestCtrlPages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "ctrl-pages", "--prefix", "/todos", "src/demoproj/ctrl-todo-item-pages.go"))
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item-pages.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	assert.Contains(src, `type TodoItemPageRouter struct`)
+	assert.Contains(src, `autowire.Populate(h)`)
+	assert.Contains(src, `handlerregistry.MustRegister(handlerregistry.SeqCtrl, "TodoItemPageRouter", h)`)
+	assert.Contains(src, `h.PagePrefix = "/todos"`)
+	assert.Regexp(`Store\s+\*store.Store`, src)
+	assert.Contains(src, `var todoItem store.TodoItem`)
+	assert.Contains(src, `weberrors.New(err, 404, "not found", nil, nil)`)
+
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item-pages_test.go"))
+	assert.NoError(err)
+	assert.Contains(string(bdata), `func TestTodoItemPages(t *testing.T)`)
+
+	// the page store interface matches a store generated with --generic
+	assert.Contains(src, `FetchTodoItem(ctx context.Context, o *store.TodoItem, id string, related ...string) error`)
+	assert.NoError(globalMapGenerator.Generate(s, "ctrl-pages", "--generic", "--prefix", "/todos", "src/demoproj/ctrl-todo-item-pages.go"))
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item-pages.go"))
+	assert.NoError(err)
+	assert.Contains(string(bdata), `FetchTodoItem(ctx context.Context, o interface{}, id string, related ...string) error`)
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ctrl-todo-item-pages_test.go"))
+	assert.NoError(err)
+	assert.Contains(string(bdata), "\t*o.(*store.TodoItem) = v\n")
+
+}
+
+type viewTestGadget struct {
+	GadgetID string    `db:"gadget_id" json:"gadget_id" tmeta:"pk"`
+	Name     string    `db:"name" json:"name" valid:"minlen=1,maxlen=100"`
+	Email    string    `db:"email" json:"email" valid:"email"`
+	Notes    string    `db:"notes" json:"notes" ddl:"type=text"`
+	Weight   float64   `db:"weight" json:"weight" valid:"minval=0"`
+	Active   bool      `db:"active" json:"active"`
+	Shipped  time.Time `db:"shipped" json:"shipped"`
+	Version  int64     `db:"version" json:"version" tmeta:"version"`
+	PartList []string  `db:"-" json:"part_list"`
+}
+
+func TestViewModel(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestViewModel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/model.go"), []byte(`package demoproj
+
+import "time"
+
+type Gadget struct {
+	GadgetID string    `+"`db:\"gadget_id\" json:\"gadget_id\" tmeta:\"pk\"`"+`
+	Name     string    `+"`db:\"name\" json:\"name\" valid:\"minlen=1,maxlen=100\"`"+`
+	Email    string    `+"`db:\"email\" json:\"email\" valid:\"email\"`"+`
+	Notes    string    `+"`db:\"notes\" json:\"notes\" ddl:\"type=text\"`"+`
+	Weight   float64   `+"`db:\"weight\" json:\"weight\" valid:\"minval=0\"`"+`
+	Active   bool      `+"`db:\"active\" json:\"active\"`"+`
+	Shipped  time.Time `+"`db:\"shipped\" json:\"shipped\"`"+`
+	Version  int64     `+"`db:\"version\" json:\"version\" tmeta:\"version\"`"+`
+	PartList []string  `+"`db:\"-\" json:\"part_list\"`"+`
+}
+`), 0644))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	fields, err := GoStructModelFields(filepath.Join(tmpDir, "src/demoproj/model.go"), "Gadget")
+	assert.NoError(err)
+	assert.Len(fields, 8)
+	assert.Equal("gadget_id", fields.PKField().JSONName)
+	assert.True(fields.ByName("version").ReadOnly)
+	assert.Equal("textarea", fields.ByName("Notes").InputType)
+	assert.Equal("email", fields.ByName("email").InputType)
+	assert.Equal(100, fields.ByName("name").MaxLen)
+	assert.True(fields.ByName("name").Required)
+	assert.Equal("Gadget ID", fields.PKField().Label)
+
+	// parse the output the way the renderer does and run the body
+	render := func(fname string, values map[interface{}]interface{}) string {
+		b, err := ioutil.ReadFile(filepath.Join(tmpDir, fname))
+		if !assert.NoError(err) {
+			return ""
+		}
+		src := string(b)
+		assert.True(strings.HasPrefix(src, "---\n"))
+		src = src[strings.Index(src[4:], "---\n")+8:]
+		tmpl, err := htmltemplate.New(fname).Parse(src)
+		if !assert.NoError(err) {
+			return ""
+		}
+		var buf bytes.Buffer
+		ctx := context.Background()
+		for k, v := range values {
+			ctx = context.WithValue(ctx, k, v)
+		}
+		assert.NoError(tmpl.ExecuteTemplate(&buf, "body", ctx))
+		return buf.String()
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "view-model-listing",
+		"--model", "src/demoproj/model.go:Gadget", "--search", "email,name", "src/demoproj/views/gadget.gohtml"))
+	out := render("src/demoproj/views/gadget.gohtml", map[interface{}]interface{}{
+		"gadgetList": []viewTestGadget{{GadgetID: "g1", Name: "Sprocket", Weight: 1.5}},
+	})
+	assert.Contains(out, `<a href="/gadget/g1">Sprocket</a>`)
+	assert.Contains(out, `var searchFields = ["email", "name"];`)
+	assert.Contains(out, `var apiPath = "/api/gadget";`)
+	assert.NotContains(out, `Notes`)
+
+	assert.NoError(globalMapGenerator.Generate(s, "view-model-detail",
+		"--model", "src/demoproj/model.go:Gadget", "src/demoproj/views/gadget/detail.gohtml"))
+	out = render("src/demoproj/views/gadget/detail.gohtml", map[interface{}]interface{}{
+		"gadget": &viewTestGadget{GadgetID: "g1", Name: "Sprocket", Active: true, Shipped: time.Date(2018, 1, 2, 15, 4, 0, 0, time.UTC)},
+	})
+	t.Logf("detail:\n%s", out)
+	assert.Contains(out, `data-id="g1"`)
+	assert.Contains(out, `name="name" required minlength="1" maxlength="100" value="Sprocket"`)
+	assert.Contains(out, `type="email"`)
+	assert.Contains(out, `name="weight" data-type="number" step="any" min="0"`)
+	assert.Contains(out, `name="active" data-type="checkbox" checked`)
+	assert.Contains(out, `value="2018-01-02T15:04"`)
+	assert.Contains(out, `<textarea id="gadget-notes" name="notes">`)
+	assert.NotContains(out, `name="version"`)
+	out = render("src/demoproj/views/gadget/detail.gohtml", nil)
+	assert.Contains(out, `New Gadget`)
+	assert.Contains(out, `data-id=""`)
+
+	assert.Error(globalMapGenerator.Generate(s, "view-model-detail", "src/demoproj/views/gadget/detail.gohtml"))
+
+}
+
+func TestAPIClientTS(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestAPIClientTS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/model.go"), []byte(`package demoproj
+
+import "time"
+
+type Gadget struct {
+	GadgetID string     `+"`db:\"gadget_id\" json:\"gadget_id\" tmeta:\"pk\"`"+`
+	Name     string     `+"`db:\"name\" json:\"name\"`"+`
+	Weight   float64    `+"`db:\"weight\" json:\"weight\"`"+`
+	Active   bool       `+"`db:\"active\" json:\"active\"`"+`
+	Shipped  *time.Time `+"`db:\"shipped\" json:\"shipped\"`"+`
+}
+`), 0644))
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "api-client-ts", "--model", "src/demoproj/model.go:Gadget", "src/demoproj/ui-gadget-api.go"))
+
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ui-gadget-api.ts"))
+	assert.NoError(err)
+	ts := string(bdata)
+	assert.Contains(ts, `var apiPath = "/api/gadget";`)
+	assert.Contains(ts, `export interface Gadget {`)
+	assert.Contains(ts, "\tgadget_id: string; // read-only\n")
+	assert.Contains(ts, "\tweight: number;\n")
+	assert.Contains(ts, "\tactive: boolean;\n")
+	assert.Contains(ts, "\tshipped: string | null;\n")
+	assert.Contains(ts, `export interface SearchGadgetParams {`)
+	assert.Contains(ts, `export function searchGadgets(params: SearchGadgetParams): Promise<SearchGadgetResult> {`)
+	assert.Contains(ts, `export function updateGadget(id: string, o: Partial<Gadget>): Promise<Gadget> {`)
+
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/ui-gadget-api.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	assert.Contains(src, `uiregistry.MustRegister("js:gadget-api", nil,`)
+	assert.Contains(src, `window.GadgetAPI = {`)
+	assert.Contains(src, `function fetchGadget(id, related) {`)
+	assert.NotContains(src, `export `)
+	assert.NotContains(src, `: string`)
+
+	// an absolute --ts-file is taken as is, as long as it is under GOPATH
+	assert.NoError(globalMapGenerator.Generate(s, "api-client-ts", "--model", "src/demoproj/model.go:Gadget",
+		"--ts-file", filepath.Join(tmpDir, "src/demoproj/ts/gadget.ts"), "src/demoproj/ui-gadget-api.go"))
+	_, err = os.Stat(filepath.Join(tmpDir, "src/demoproj/ts/gadget.ts"))
+	assert.NoError(err)
+	assert.Error(globalMapGenerator.Generate(s, "api-client-ts", "--model", "src/demoproj/model.go:Gadget",
+		"--ts-file", filepath.Join(filepath.Dir(tmpDir), "gadget.ts"), "src/demoproj/ui-gadget-api.go"))
+
+}
+
+func TestModelFromDB(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestModelFromDB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/store.go"), []byte(`package demoproj
+
+type Store struct{}
+
+func (s *Store) init() error {
+	// end meta type init
+	return nil
+}
+`), 0644))
+
+	// a table made outside of caveman
+	dsn := filepath.Join(tmpDir, "legacy.db")
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, s := range []string{
+		`CREATE TABLE customers (
+			customer_id INTEGER PRIMARY KEY AUTOINCREMENT,
+			full_name VARCHAR(100) NOT NULL,
+			home_url TEXT,
+			balance REAL NOT NULL DEFAULT 0,
+			signup_time DATETIME,
+			active BOOLEAN NOT NULL
+		)`,
+		`CREATE INDEX customers_full_name ON customers (full_name)`,
+	} {
+		_, err := db.Exec(s)
+		assert.NoError(err)
+	}
+
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+	}
+
+	assert.NoError(globalMapGenerator.Generate(s, "model-from-db",
+		"--dsn", dsn, "--table", "customers", "--version", "0001_customers",
+		"src/demoproj/model-customers.go"))
+
+	bdata, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/model-customers.go"))
+	assert.NoError(err)
+	src := string(bdata)
+	t.Logf("Generated:\n%s", src)
+	assert.Contains(src, "type Customers struct")
+	assert.Regexp(`CustomerID\s+int64\s+`+"`"+`tmeta:"pk" db:"customer_id" json:"customer_id"`+"`", src)
+	assert.Regexp(`FullName\s+string\s+`, src)
+	assert.Regexp(`HomeURL\s+\*string\s+`, src)
+	assert.Regexp(`Balance\s+float64\s+`, src)
+	assert.Regexp(`SignupTime\s+\*tmetautil.DBTime\s+`, src)
+	assert.Regexp(`Active\s+bool\s+`, src)
+	assert.Contains(src, `CreateTable("customers").IfNotExists()`)
+	assert.Contains(src, `CreateIndex("customers_full_name", "customers").IfNotExists()`)
+	assert.Contains(src, `migrateregistry.MustRegisterList(CustomersMigrations())`)
+	assert.NotContains(src, "Down()")
+
+	// the same migration run up and then down against the legacy database leaves the table
+	_, err = db.Exec(`INSERT INTO customers (full_name, active) VALUES ('Joe', 1)`)
+	assert.NoError(err)
+	tables, err := ddl.Introspect(db, "sqlite3", "")
+	assert.NoError(err)
+	b := ddl.New().SetCategory("0100_demoproj").SetVersion("0001_customers")
+	ct := b.Up().CreateTable("customers").IfNotExists()
+	ct.Columns, ct.PrimaryKeys = tables["customers"].Table.Columns, tables["customers"].Table.PrimaryKeys
+	b.Up().CreateIndex("customers_full_name", "customers").IfNotExists().Columns("full_name")
+	var ml migrate.MigrationList
+	b.MustMigrations(ddl.NewSQLite3Formatter(true)).AppendTo(&ml)
+	versioner, err := migratedbr.New("sqlite3", dsn)
+	assert.NoError(err)
+	defer versioner.Close()
+	runner := migrate.NewRunner("sqlite3", dsn, versioner, ml)
+	assert.NoError(runner.RunAllUpToLatest())
+	assert.NoError(runner.RunDownTo("0100_demoproj", ""))
+	var n int
+	assert.NoError(db.QueryRow(`SELECT COUNT(*) FROM customers`).Scan(&n))
+	assert.Equal(1, n)
+
+	bdata, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/store.go"))
+	assert.NoError(err)
+	assert.Contains(string(bdata), "s.Meta.Parse(Customers{})")
+
+	_, err = os.Stat(filepath.Join(tmpDir, "src/demoproj/store-customers.go"))
+	assert.NoError(err)
+
+	// single dash long flags work too
+	err = globalMapGenerator.Generate(s, "model-from-db",
+		"-driver", "sqlite3", "-dsn", dsn, "-table=orders", "src/demoproj/model-orders.go")
+	if assert.Error(err) {
+		assert.Equal(`table "orders" not found`, err.Error())
+	}
+
+}
+
+func TestUnifiedDiff(t *testing.T) {
+
+	assert := assert.New(t)
+
+	assert.Equal("", UnifiedDiff("a", "b", []byte("x\n"), []byte("x\n")))
+
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	b := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n"
+	assert.Equal(`--- a/f
++++ b/f
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++three
+ 4
+ 5
+ 6
+@@ -10,3 +10,4 @@
+ 10
+ 11
+ 12
++13
+`, UnifiedDiff("a/f", "b/f", []byte(a), []byte(b)))
+
+	assert.Equal(`--- /dev/null
++++ b/f
+@@ -0,0 +1,2 @@
++x
++y
+`, UnifiedDiff("/dev/null", "b/f", nil, []byte("x\ny\n")))
+
+}
+
+func TestSections(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestSections")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj"), 0755))
+
+	var out bytes.Buffer
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+		Stdout:  &out,
+	}
+
+	fname := "src/demoproj/store-todo-item.go"
+	fpath := filepath.Join(tmpDir, fname)
+	read := func() string {
+		b, err := ioutil.ReadFile(fpath)
+		assert.NoError(err)
+		return string(b)
+	}
+
+	// dry run writes nothing and prints the diff
+	s.DryRun = true
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", fname))
+	_, err = os.Stat(fpath)
+	assert.True(os.IsNotExist(err))
+	assert.Contains(out.String(), "+++ b/src/demoproj/store-todo-item.go\n")
+	assert.Contains(out.String(), "+// cavegen:begin generated sum=")
+	s.DryRun = false
+
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", fname))
+	src := read()
+	assert.Contains(src, "\n// cavegen:begin generated sum=")
+	assert.Contains(src, "\n// cavegen:end generated\n")
+
+	// hand-written code outside of the section is kept, imports the section needs are added
+	assert.NoError(ioutil.WriteFile(fpath, []byte(src+"\n// Extra is mine.\nfunc (s *Store) Extra() {}\n"), 0644))
+	out.Reset()
+	s.DryRun = true
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "--join-version", "0200_tags", "--relation", "belongs_to_many:TagList:Tag", fname))
+	assert.Contains(out.String(), "+\terr = s.saveTodoItemRelated(tx, o)\n")
+	assert.NotContains(out.String(), "-func (s *Store) Extra() {}")
+	assert.Equal(src+"\n// Extra is mine.\nfunc (s *Store) Extra() {}\n", read())
+	s.DryRun = false
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "--join-version", "0200_tags", "--relation", "belongs_to_many:TagList:Tag", fname))
+	src = read()
+	assert.Contains(src, "err = s.saveTodoItemRelated(tx, o)")
+	assert.Contains(src, "\t\"strings\"\n")
+	assert.True(strings.HasSuffix(src, "// cavegen:end generated\n\n// Extra is mine.\nfunc (s *Store) Extra() {}\n"), src)
+
+	// regenerating with the same output changes nothing
+	out.Reset()
+	s.DryRun = true
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", "--join-version", "0200_tags", "--relation", "belongs_to_many:TagList:Tag", fname))
+	assert.Equal("", out.String())
+	s.DryRun = false
+
+	// a section modified by hand is not overwritten unless forced
+	assert.NoError(ioutil.WriteFile(fpath, []byte(strings.Replace(src, "inserts the record", "inserts a record", 1)), 0644))
+	err = globalMapGenerator.Generate(s, "store-crud", fname)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), `section "generated" was modified by hand`)
+	}
+	assert.Contains(read(), "inserts a record")
+	s.Force = true
+	assert.NoError(globalMapGenerator.Generate(s, "store-crud", fname))
+	assert.Contains(read(), "inserts the record")
+	assert.Contains(read(), "func (s *Store) Extra() {}")
+	s.Force = false
+
+	// as is a file which wasn't generated
+	other := filepath.Join(tmpDir, "src/demoproj/store-tag.go")
+	assert.NoError(ioutil.WriteFile(other, []byte("package demoproj\n\n// mine\n"), 0644))
+	assert.Error(globalMapGenerator.Generate(s, "store-crud", "src/demoproj/store-tag.go"))
+	b, err := ioutil.ReadFile(other)
+	assert.NoError(err)
+	assert.Equal("package demoproj\n\n// mine\n", string(b))
+
+	// sections in other places and orders
+	segs, err := parseSections([]byte("head\n# cavegen:begin a\nA\n# cavegen:end a\nmine\n# cavegen:begin c\nC\n# cavegen:end c\n"))
+	assert.NoError(err)
+	newSegs, err := parseSections([]byte("# cavegen:begin b\nB\n# cavegen:end b\n# cavegen:begin c\nC2\n# cavegen:end c\n"))
+	assert.NoError(err)
+	assert.Equal("head\nmine\n# cavegen:begin b sum="+sectionSum("B\n")+"\nB\n# cavegen:end b\n# cavegen:begin c sum="+sectionSum("C2\n")+"\nC2\n# cavegen:end c\n",
+		string(joinSections(mergeSections(segs, newSegs))))
+	_, err = parseSections([]byte("# cavegen:begin a\nA\n"))
+	assert.Error(err)
+	_, err = parseSections([]byte("# cavegen:end a\n"))
+	assert.Error(err)
+
+}
+
+func TestNewApp(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestNewApp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var out bytes.Buffer
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+		Stdout:  &out,
+	}
+
+	assert.Error(globalMapGenerator.Generate(s, "new-app", "myapp"))
+	assert.Error(globalMapGenerator.Generate(s, "new-app", "myapp", "-module", "example.com/myapp", "-db", "oracle"))
+	assert.Error(globalMapGenerator.Generate(s, "new-app", "myapp", "-module", "example.com/myapp", "-theme", "jurassic"))
+
+	appDir := filepath.Join(tmpDir, "myapp")
+	read := func(name string) string {
+		b, err := ioutil.ReadFile(filepath.Join(appDir, name))
+		assert.NoError(err)
+		return string(b)
+	}
+
+	// a dry run writes nothing, the views are still generated from the model "written" before them
+	s.DryRun = true
+	assert.NoError(globalMapGenerator.Generate(s, "new-app", "myapp", "-module", "example.com/myapp", "-db", "mysql"))
+	_, err = os.Stat(appDir)
+	assert.True(os.IsNotExist(err))
+	assert.Contains(out.String(), "+++ b/main.go\n")
+	assert.Contains(out.String(), "+++ b/ui/views/todo-list/detail.gohtml\n")
+	assert.Contains(out.String(), "Would create myapp")
+	s.DryRun = false
+
+	out.Reset()
+	assert.NoError(globalMapGenerator.Generate(s, "new-app", "myapp", "-module", "example.com/myapp", "-db", "mysql", "-theme", "paleolithic"))
+	assert.Contains(out.String(), "Created myapp")
+
+	for _, name := range []string{
+		"go.mod", "main.go", "config.go", "config.yaml", "app_test.go",
+		"store/store.go", "store/model-todo-list.go", "store/store-todo-list.go", "store/migrations.go",
+		"ctrl/ctrl-todo-list.go", "ctrl/ctrl-todo-list-pages.go", "ctrl/ctrl-todo-list-pages_test.go",
+		"ui/embed.go", "ui/includes/app-page.gohtml", "ui/views/index.gohtml",
+		"ui/views/todo-list.gohtml", "ui/views/todo-list/detail.gohtml",
+	} {
+		_, err := os.Stat(filepath.Join(appDir, name))
+		assert.NoError(err, name)
+	}
+
+	assert.Equal("module example.com/myapp\n\ngo 1.18\n", read("go.mod"))
+	main := read("main.go")
+	assert.Contains(main, "package main\n")
+	assert.Contains(main, `const APP_NAME = "myapp"`)
+	assert.Contains(main, "\t\"example.com/myapp/store\"\n")
+	assert.Contains(main, "\t_ \"github.com/gocaveman/caveman/themes/paleolithic\"\n")
+	assert.Contains(main, "\t_ \"github.com/go-sql-driver/mysql\"\n")
+	assert.Contains(main, "\tdefer versioner.Close()\n")
+	assert.Contains(main, "versioner.ClearStaleLocks(false)")
+	assert.Contains(read("config.go"), `v.SetEnvPrefix("MYAPP")`)
+	assert.Contains(read("config.yaml"), "db-driver: mysql\n")
+	assert.Contains(read("store/store.go"), "s.Meta.Parse(TodoList{})")
+	assert.Contains(read("ctrl/ctrl-todo-list.go"), "package ctrl\n")
+	assert.Contains(read("ctrl/ctrl-todo-list.go"), "\t\"example.com/myapp/store\"\n")
+	assert.Contains(read("ctrl/ctrl-todo-list-pages_test.go"), "\t\"example.com/myapp/store\"\n")
+	assert.Contains(read("ui/views/todo-list.gohtml"), `{{template "/app-page.gohtml" .}}`)
+	assert.Contains(read("ui/includes/app-page.gohtml"), `{{block "body" .}}{{end}}`)
+	assert.Contains(read("ui/embed.go"), "//go:embed all:views all:includes\n")
+
+	// running it again ends up with the same files
+	before := make(map[string]string)
+	filepath.Walk(appDir, func(p string, fi os.FileInfo, err error) error {
+		if err == nil && !fi.IsDir() {
+			before[p] = read(strings.TrimPrefix(p, appDir))
+		}
+		return err
+	})
+	assert.NoError(globalMapGenerator.Generate(s, "new-app", "myapp", "-module", "example.com/myapp", "-db", "mysql", "-theme", "paleolithic"))
+	for p, src := range before {
+		assert.Equal(src, read(strings.TrimPrefix(p, appDir)), p)
+	}
+
+}
+
+func TestEmbedTmpl(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestEmbedTmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var out bytes.Buffer
+	s := &Settings{
+		WorkDir: tmpDir,
+		GOPATH:  tmpDir,
+		Stdout:  &out,
+	}
+
+	assert.NoError(os.MkdirAll(filepath.Join(tmpDir, "src/demoproj/views"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/views/_404.gohtml"), []byte("not found\n"), 0644))
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "src/demoproj/embed-data.go"), []byte("package demoproj\n"), 0644))
+
+	assert.NoError(globalMapGenerator.Generate(s, "embed-tmpl", "src/demoproj/embed.go"))
+	b, err := ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/embed.go"))
+	assert.NoError(err)
+	src := string(b)
+	assert.Contains(src, "package demoproj\n")
+	// only the directories which exist, go:embed fails otherwise
+	assert.Contains(src, "//go:embed all:views\n")
+	assert.Contains(src, "var EmbeddedAssets embed.FS\n")
+	assert.Contains(src, `tmplregistry.MustRegister(tmplregistry.SeqTheme, "demoproj", NewTmplStore())`)
+	assert.Contains(out.String(), "embed-data.go was used by vfsgen and is no longer needed")
+
+	assert.NoError(globalMapGenerator.Generate(s, "embed-tmpl", "--dirs", "views,static", "src/demoproj/embed.go"))
+	b, err = ioutil.ReadFile(filepath.Join(tmpDir, "src/demoproj/embed.go"))
+	assert.NoError(err)
+	assert.Contains(string(b), "//go:embed all:views all:static\n")
+
+	assert.Error(globalMapGenerator.Generate(s, "embed-tmpl", "--dirs", "../views", "src/demoproj/embed.go"))
+
+}
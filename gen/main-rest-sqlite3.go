@@ -49,7 +49,8 @@ func main() {
 	// TODO: on the migrations we probably also want a way to separately check, and then update
 	// without running the app - so you can build the new version with the schema changes, check it
 	// apply it, and then deploy.
-	pflag.StringP("db-migrate", "", "auto", "Database migration behavior ('auto' to update, 'check' to report out of date, or 'none' to ignore migrations)")
+	pflag.StringP("db-migrate", "", "auto", "Database migration behavior ('auto' to update, 'check' to report out of date, 'none' to ignore migrations, or 'unlock' to clear stale migration locks left by a process which died and exit)")
+	pflag.BoolP("db-migrate-strict", "", false, "Refuse to start if applied migrations were since modified, are missing or were skipped")
 	pflag.BoolP("debug", "g", false, "Enable debug output (intended for development only)")
 	pflag.Parse()
 	viper.BindPFlags(pflag.CommandLine)
@@ -66,6 +67,8 @@ func main() {
 	for _, item := range handlerregistry.Contents() {
 		hl = append(hl, item.Value)
 	}
+	// the OpenAPI document for the APIs in openapiregistry
+	hl = append(hl, openapiregistry.NewHandler("/api/openapi.json", "/api/openapi.yaml", APP_NAME, "0.0.1"))
 	hl = append(hl, http.NotFoundHandler())
 
 	dbDriver := viper.GetString("db-driver")
@@ -86,6 +89,11 @@ func main() {
 		log.Fatalf("autowire error: %v", err)
 	}
 
+	// for "cavegen migrate --bin"
+	migrateregistry.DumpIfRequested()
+	// CAVEMAN_OPENAPI_DUMP=yaml writes the OpenAPI document to stdout, for use offline
+	openapiregistry.DumpIfRequested(APP_NAME, "0.0.1")
+
 	dbMigrateMode := viper.GetString("db-migrate")
 	ml := migrateregistry.Contents().WithDriverName(dbDriver).Sorted()
 	// EDITME: you can filter migrations here if needed
@@ -94,12 +102,27 @@ func main() {
 		log.Fatal(err)
 	}
 	runner := migrate.NewRunner(dbDriver, dbDsn, versioner, ml)
-	if dbMigrateMode == "check" {
+	runner.Strict = viper.GetBool("db-migrate-strict")
+	if dbMigrateMode == "unlock" {
+		cats, err := versioner.ClearStaleLocks(false)
+		if err != nil {
+			log.Fatalf("Migration unlock error: %v", err)
+		}
+		log.Printf("Migration locks cleared for categories: %v", cats)
+		return
+	} else if dbMigrateMode == "check" {
 		result, err := runner.CheckAll(true)
 		if err != nil {
 			log.Fatalf("Migration check error: %v", err)
 		}
 		log.Printf("Migration check result: %+v", result) // TODO: better output
+		if runner.Strict {
+			for _, item := range result {
+				if err := item.DriftError(); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
 	} else if dbMigrateMode == "auto" {
 		err := runner.RunAllUpToLatest()
 		if err != nil {
@@ -0,0 +1,466 @@
+package gen
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/spf13/pflag"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	globalMapGenerator["migration-diff"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		driver := fset.String("driver", "sqlite3", "The database driver, sqlite3 or mysql.")
+		dsn := fset.String("dsn", "", "The data source name of the database to compare against (required).")
+		prefix := fset.String("prefix", "", "The table prefix used in the database.")
+		models := fset.StringArray("model", nil, "A model struct giving a table of the desired schema, in the form file.go:TypeName or file.go:TypeName:table_name (repeat for each table).")
+		category := fset.String("category", "", "The migration category, default is 0100_ and the package name.")
+		version := fset.String("version", "", "The migration version, default is based on the current time.")
+		funcName := fset.String("func", "", "The name of the function returning the migrations, default is deduced from the file name.")
+		dropTables := fset.Bool("drop-tables", false, "Drop tables which are in the database but not in the models.")
+		ignore := fset.StringSlice("ignore", []string{"migration_state"}, "Tables in the database to leave alone (without the prefix).")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		if *dsn == "" {
+			return fmt.Errorf("-dsn is required")
+		}
+		if len(*models) == 0 {
+			return fmt.Errorf("at least one -model is required")
+		}
+
+		var f ddl.Formatter
+		switch *driver {
+		case "sqlite3":
+			f = ddl.NewSQLite3Formatter(false)
+		case "mysql":
+			f = ddl.NewMySQLFormatter(false)
+		default:
+			return fmt.Errorf("unsupported driver %q", *driver)
+		}
+
+		desired := make(ddl.TableDefMap)
+		for _, m := range *models {
+			parts := strings.Split(m, ":")
+			if len(parts) < 2 || len(parts) > 3 {
+				return fmt.Errorf("invalid -model %q, must be file.go:TypeName or file.go:TypeName:table_name", m)
+			}
+			fileName := parts[0]
+			if !filepath.IsAbs(fileName) {
+				fileName = filepath.Join(s.WorkDir, fileName)
+			}
+			tableName := ddl.SnakeCase(parts[1])
+			if len(parts) == 3 {
+				tableName = parts[2]
+			}
+			d, err := GoStructTableDef(fileName, parts[1], tableName)
+			if err != nil {
+				return err
+			}
+			desired[tableName] = d
+		}
+
+		db, err := sql.Open(*driver, *dsn)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		current, err := ddl.Introspect(db, *driver, *prefix)
+		if err != nil {
+			return err
+		}
+		for _, t := range *ignore {
+			delete(current, t)
+		}
+
+		b := ddl.New()
+		err = b.Diff(current, desired, f, *dropTables)
+		if err != nil {
+			return err
+		}
+		if len(b.UpStmtList) == 0 {
+			return fmt.Errorf("no differences found between the database and the models")
+		}
+
+		_, fname := path.Split(targetFile)
+		if *funcName == "" {
+			*funcName = NameSnakeToCamel(fname, nil, nil)
+			if !strings.HasSuffix(*funcName, "Migrations") {
+				*funcName += "Migrations"
+			}
+		}
+		if *category == "" {
+			*category = "0100_" + data["PackageName"].(string)
+		}
+		if *version == "" {
+			*version = time.Now().UTC().Format("20060102150405") + "_" + strings.Replace(strings.TrimSuffix(fname, ".go"), "-", "_", -1)
+		}
+
+		src, err := DDLBuilderGoSrc(b)
+		if err != nil {
+			return err
+		}
+
+		data["FuncName"] = *funcName
+		data["Category"] = *category
+		data["Version"] = *version
+		data["BuilderSrc"] = src
+
+		return OutputGoSrcTemplate(s, data, targetFile, `
+package {{.PackageName}}
+
+import (
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/ddl"
+)
+
+// {{.FuncName}} was generated by comparing the database schema with the models.
+func {{.FuncName}}() (ml migrate.MigrationList) {
+
+	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true), ddl.NewPostgresFormatter(true)}
+
+	b := ddl.New()
+	b.SetCategory({{printf "%q" .Category}})
+
+	b.SetVersion({{printf "%q" .Version}})
+{{.BuilderSrc}}
+	b.MustMigrations(fl...).AppendTo(&ml)
+
+	return
+}
+`, false)
+
+	})
+}
+
+// GoStructTableDef parses a Go source file and returns the table definition for the named
+// struct type, using ddl.TableDef.AddField for each field.  Embedded structs declared in
+// the same file have their fields included, other embedded types are skipped.
+func GoStructTableDef(fileName, typeName, tableName string) (*ddl.TableDef, error) {
+
+	d := ddl.NewTableDef(tableName)
+	err := walkGoStructFields(fileName, nil, typeName, func(fieldName, goType string, tag reflect.StructTag) error {
+		return d.AddField(fieldName, goType, tag)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// walkGoStructFields parses a Go source file and calls fn for each exported field of the
+// named struct type, in order.  Embedded structs declared in the same file have their fields
+// walked, other embedded types are skipped unless they have a db tag.  If src is not nil
+// it is parsed instead of reading the file (see parser.ParseFile).
+func walkGoStructFields(fileName string, src interface{}, typeName string, fn func(fieldName, goType string, tag reflect.StructTag) error) error {
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, fileName, src, 0)
+	if err != nil {
+		return err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok {
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				structs[ts.Name.Name] = st
+			}
+		}
+		return true
+	})
+
+	var walk func(typeName string) error
+	walk = func(typeName string) error {
+		st := structs[typeName]
+		if st == nil {
+			return fmt.Errorf("struct %q not found in %q", typeName, fileName)
+		}
+		for _, field := range st.Fields.List {
+			var tag reflect.StructTag
+			if field.Tag != nil {
+				tagStr, err := strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					return err
+				}
+				tag = reflect.StructTag(tagStr)
+			}
+			goType := types.ExprString(field.Type)
+			names := field.Names
+			if len(names) == 0 { // embedded
+				if tag.Get("db") == "" {
+					if structs[goType] != nil {
+						if err := walk(goType); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+				names = []*ast.Ident{ast.NewIdent(goType)}
+			}
+			for _, fieldName := range names {
+				if !fieldName.IsExported() {
+					continue
+				}
+				if err := fn(fieldName.Name, goType, tag); err != nil {
+					return fmt.Errorf("%s.%s: %v", typeName, fieldName.Name, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	return walk(typeName)
+}
+
+// DDLBuilderGoSrc returns Go source which recreates the statements in b as calls on a
+// *ddl.Builder named "b".  Tables and indexes from PriorStmtList are only included if they
+// are altered, since that is when they are needed (to rebuild tables in SQLite3).
+func DDLBuilderGoSrc(b *ddl.Builder) (string, error) {
+
+	var buf bytes.Buffer
+
+	// existing tables which are altered
+	altered := make(map[string]bool)
+	for _, list := range []ddl.StmtList{b.UpStmtList, b.DownStmtList} {
+		for _, st := range list {
+			if tn := alteredTableName(st); tn != "" {
+				altered[tn] = true
+			}
+		}
+	}
+	for _, st := range b.PriorStmtList {
+		switch st := st.(type) {
+		case *ddl.CreateTableStmt:
+			if !altered[st.NameValue] {
+				continue
+			}
+			fmt.Fprintf(&buf, "\tb.DefineTable(%q)%s\n", st.NameValue, tableGoSrc(st))
+		case *ddl.CreateIndexStmt:
+			if !altered[st.TableNameValue] {
+				continue
+			}
+			fmt.Fprintf(&buf, "\tb.DefineIndex(%q, %q)%s\n", st.NameValue, st.TableNameValue, indexColumnsGoSrc(st))
+		}
+	}
+
+	for i, list := range []ddl.StmtList{b.UpStmtList, b.DownStmtList} {
+		if i == 0 {
+			buf.WriteString("\tb.Up()\n")
+		} else {
+			buf.WriteString("\tb.Down()\n")
+		}
+		for _, st := range list {
+			s, err := stmtGoSrc(st)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&buf, "\tb.%s\n", s)
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// alteredTableName returns the table an ALTER TABLE statement changes, or empty.
+func alteredTableName(st ddl.Stmt) string {
+	switch st := st.(type) {
+	case *ddl.AlterTableDropColumnStmt:
+		return st.NameValue
+	case *ddl.AlterTableModifyStmt:
+		return st.NameValue
+	case *ddl.AlterTableAddForeignKeyStmt:
+		return st.NameValue
+	case *ddl.AlterTableDropForeignKeyStmt:
+		return st.NameValue
+	}
+	return ""
+}
+
+func stmtGoSrc(st ddl.Stmt) (string, error) {
+	switch st := st.(type) {
+	case *ddl.CreateTableStmt:
+		return fmt.Sprintf("CreateTable(%q)%s", st.NameValue, tableGoSrc(st)), nil
+	case *ddl.DropTableStmt:
+		return fmt.Sprintf("DropTable(%q)", st.NameValue), nil
+	case *ddl.AlterTableRenameStmt:
+		return fmt.Sprintf("AlterTableRename(%q, %q)", st.OldNameValue, st.NewNameValue), nil
+	case *ddl.AlterTableAddStmt:
+		return fmt.Sprintf("AlterTableAdd(%q).%s", st.NameValue, columnGoSrc(&st.DataTypeDef)), nil
+	case *ddl.AlterTableDropColumnStmt:
+		return fmt.Sprintf("AlterTableDropColumn(%q, %q)", st.NameValue, st.ColumnValue), nil
+	case *ddl.AlterTableRenameColumnStmt:
+		return fmt.Sprintf("AlterTableRenameColumn(%q, %q, %q)", st.NameValue, st.OldColumnValue, st.NewColumnValue), nil
+	case *ddl.AlterTableModifyStmt:
+		return fmt.Sprintf("AlterTableModify(%q).%s", st.NameValue, columnGoSrc(&st.DataTypeDef)), nil
+	case *ddl.AlterTableAddForeignKeyStmt:
+		return fmt.Sprintf("AlterTableAddForeignKey(%q, %q).Column(%q).References(%q, %q)%s",
+			st.NameValue, st.ForeignKey.NameValue, st.ForeignKey.ColumnValue, st.ForeignKey.OtherTableValue, st.ForeignKey.OtherColumnValue,
+			fkActionsGoSrc(&st.ForeignKey)), nil
+	case *ddl.AlterTableDropForeignKeyStmt:
+		return fmt.Sprintf("AlterTableDropForeignKey(%q, %q)", st.NameValue, st.ForeignKeyNameValue), nil
+	case *ddl.AlterTableAddUniqueStmt:
+		return fmt.Sprintf("AlterTableAddUnique(%q, %q).Columns(%s)", st.NameValue, st.Unique.NameValue, quotedList(st.Unique.ColumnNames)), nil
+	case *ddl.AlterTableDropUniqueStmt:
+		return fmt.Sprintf("AlterTableDropUnique(%q, %q)", st.NameValue, st.UniqueNameValue), nil
+	case *ddl.CreateIndexStmt:
+		s := fmt.Sprintf("CreateIndex(%q, %q)", st.NameValue, st.TableNameValue)
+		if st.UniqueValue {
+			s += ".Unique()"
+		}
+		return s + indexColumnsGoSrc(st), nil
+	case *ddl.DropIndexStmt:
+		return fmt.Sprintf("DropIndex(%q, %q)", st.NameValue, st.TableNameValue), nil
+	}
+	return "", fmt.Errorf("unknown statement type %T", st)
+}
+
+// tableGoSrc returns the method calls which define a table: its columns, primary key,
+// constraints and options.
+func tableGoSrc(st *ddl.CreateTableStmt) string {
+	var buf bytes.Buffer
+
+	// PrimaryKey() on each column works unless the key is in a different order
+	pkInline := true
+	i := 0
+	for _, col := range st.Columns {
+		if i < len(st.PrimaryKeys) && st.PrimaryKeys[i] == col.NameValue {
+			i++
+		}
+	}
+	if i < len(st.PrimaryKeys) {
+		pkInline = false
+	}
+
+	for _, col := range st.Columns {
+		fmt.Fprintf(&buf, ".\n\t\t%s", columnGoSrc(col))
+		if pkInline {
+			for _, pk := range st.PrimaryKeys {
+				if pk == col.NameValue {
+					buf.WriteString(".PrimaryKey()")
+				}
+			}
+		}
+		for _, fk := range st.ForeignKeys {
+			if fk.NameValue == "" && fk.ColumnValue == col.NameValue {
+				fmt.Fprintf(&buf, ".ForiegnKey(%q, %q)%s", fk.OtherTableValue, fk.OtherColumnValue, fkActionsGoSrc(fk))
+			}
+		}
+	}
+	if !pkInline {
+		fmt.Fprintf(&buf, ".\n\t\tPrimaryKeyColumns(%s)", quotedList(st.PrimaryKeys))
+	}
+	for _, u := range st.Uniques {
+		fmt.Fprintf(&buf, ".\n\t\tUnique(%q, %s)", u.NameValue, quotedList(u.ColumnNames))
+	}
+	for _, fk := range st.ForeignKeys {
+		if fk.NameValue != "" {
+			fmt.Fprintf(&buf, ".\n\t\tForeignKey(%q, %q, %q, %q)%s",
+				fk.NameValue, fk.ColumnValue, fk.OtherTableValue, fk.OtherColumnValue, fkActionsGoSrc(fk))
+		}
+	}
+	for _, c := range st.Checks {
+		fmt.Fprintf(&buf, ".\n\t\tCheck(%q, %q)", c.NameValue, c.ExprValue)
+	}
+	if st.MySQLEngineValue != "" {
+		fmt.Fprintf(&buf, ".\n\t\tMySQLEngine(%q)", st.MySQLEngineValue)
+	}
+	if st.MySQLCharsetValue != "" {
+		fmt.Fprintf(&buf, ".\n\t\tMySQLCharset(%q)", st.MySQLCharsetValue)
+	}
+	if st.MySQLCollationValue != "" {
+		fmt.Fprintf(&buf, ".\n\t\tMySQLCollation(%q)", st.MySQLCollationValue)
+	}
+	if st.SQLite3WithoutRowidValue {
+		buf.WriteString(".\n\t\tSQLite3WithoutRowid()")
+	}
+	if st.SQLite3StrictValue {
+		buf.WriteString(".\n\t\tSQLite3Strict()")
+	}
+	return buf.String()
+}
+
+// fkActionsGoSrc returns the OnDelete() and OnUpdate() calls for a foreign key.
+func fkActionsGoSrc(fk *ddl.CreateTableFKDef) string {
+	s := ""
+	if fk.OnDeleteValue != "" {
+		s += fmt.Sprintf(".OnDelete(%s)", fkActionGoSrc(fk.OnDeleteValue))
+	}
+	if fk.OnUpdateValue != "" {
+		s += fmt.Sprintf(".OnUpdate(%s)", fkActionGoSrc(fk.OnUpdateValue))
+	}
+	return s
+}
+
+func fkActionGoSrc(a ddl.FKAction) string {
+	switch a {
+	case ddl.Cascade:
+		return "ddl.Cascade"
+	case ddl.SetNull:
+		return "ddl.SetNull"
+	case ddl.Restrict:
+		return "ddl.Restrict"
+	case ddl.NoAction:
+		return "ddl.NoAction"
+	}
+	return fmt.Sprintf("ddl.FKAction(%q)", string(a))
+}
+
+func columnGoSrc(col *ddl.DataTypeDef) string {
+	var buf bytes.Buffer
+	if col.DataTypeValue == ddl.Custom {
+		fmt.Fprintf(&buf, "ColumnCustom(%q, %q)", col.NameValue, col.CustomSQLValue)
+	} else {
+		fmt.Fprintf(&buf, "Column(%q, ddl.%s)", col.NameValue, col.DataTypeValue)
+	}
+	if col.NullValue {
+		buf.WriteString(".Null()")
+	}
+	if col.DefaultValue != nil {
+		fmt.Fprintf(&buf, ".Default(%#v)", col.DefaultValue)
+	}
+	if col.LengthValue > 0 {
+		fmt.Fprintf(&buf, ".Length(%d)", col.LengthValue)
+	}
+	if col.CaseSensitiveValue {
+		buf.WriteString(".CaseSensitive()")
+	}
+	if col.PrecisionValue > 0 {
+		fmt.Fprintf(&buf, ".Precision(%d)", col.PrecisionValue)
+	}
+	if col.ScaleValue > 0 || col.ScaleSet {
+		fmt.Fprintf(&buf, ".Scale(%d)", col.ScaleValue)
+	}
+	return buf.String()
+}
+
+func indexColumnsGoSrc(st *ddl.CreateIndexStmt) string {
+	return fmt.Sprintf(".Columns(%s)", quotedList(st.ColumnNames))
+}
+
+func quotedList(l []string) string {
+	q := make([]string, len(l))
+	for i, s := range l {
+		q[i] = strconv.Quote(s)
+	}
+	return strings.Join(q, ", ")
+}
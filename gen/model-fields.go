@@ -0,0 +1,184 @@
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/gocaveman/caveman/valid"
+)
+
+// ModelField describes a field of a model struct, as needed to generate views for it.
+type ModelField struct {
+	Name     string       // Go field name, e.g. "TodoItemID"
+	GoType   string       // Go type as written in the source, e.g. "string", "tmetautil.DBTime"
+	DBName   string       // from the db tag, e.g. "todo_item_id"
+	JSONName string       // from the json tag, or DBName if none
+	Label    string       // human readable, e.g. "Todo Item ID"
+	DataType ddl.DataType // the column type the ddl package would use
+
+	PK       bool // primary key, from `tmeta:"pk"`
+	ReadOnly bool // primary key, version and create/update time are not edited in forms
+
+	Required  bool   // from notnil or minlen
+	MinLen    int    // from minlen
+	MaxLen    int    // from maxlen
+	Pattern   string // from regexp
+	Email     bool   // from email
+	MinVal    string // from minval
+	MaxVal    string // from maxval
+	InputType string // the HTML input type to use, or "textarea" or "checkbox"
+}
+
+// ModelFieldList is a list of fields in struct order.
+type ModelFieldList []ModelField
+
+// ByName returns the field with the Go name, DB name or JSON name given, or nil.
+func (l ModelFieldList) ByName(name string) *ModelField {
+	for i := range l {
+		f := &l[i]
+		if f.Name == name || f.DBName == name || f.JSONName == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// PKField returns the first primary key field, or nil.
+func (l ModelFieldList) PKField() *ModelField {
+	for i := range l {
+		if l[i].PK {
+			return &l[i]
+		}
+	}
+	return nil
+}
+
+// Select returns the fields named in names (Go, DB or JSON names), in that order.
+// An error is returned if one is not found.
+func (l ModelFieldList) Select(names []string) (ModelFieldList, error) {
+	ret := make(ModelFieldList, 0, len(names))
+	for _, n := range names {
+		f := l.ByName(strings.TrimSpace(n))
+		if f == nil {
+			return nil, fmt.Errorf("no field %q", n)
+		}
+		ret = append(ret, *f)
+	}
+	return ret, nil
+}
+
+// GoStructModelFields parses a Go source file and returns the fields of the named struct type
+// which are stored in the database (fields with `db:"-"`, such as relations, are skipped).
+// The db, json, tmeta, ddl and valid struct tags are used.
+func GoStructModelFields(fileName, typeName string) (ModelFieldList, error) {
+	return goStructModelFields(fileName, nil, typeName)
+}
+
+func goStructModelFields(fileName string, src interface{}, typeName string) (ModelFieldList, error) {
+
+	var ret ModelFieldList
+	err := walkGoStructFields(fileName, src, typeName, func(fieldName, goType string, tag reflect.StructTag) error {
+
+		// let ddl work out the column name and type, the same as for migrations
+		d := ddl.NewTableDef(ddl.SnakeCase(typeName))
+		err := d.AddField(fieldName, goType, tag)
+		if err != nil {
+			return err
+		}
+		if len(d.Table.Columns) == 0 { // db:"-"
+			return nil
+		}
+		col := d.Table.Columns[0]
+
+		f := ModelField{
+			Name:     fieldName,
+			GoType:   goType,
+			DBName:   col.NameValue,
+			JSONName: strings.Split(tag.Get("json"), ",")[0],
+			Label:    camelToWords(fieldName),
+			DataType: col.DataTypeValue,
+			PK:       len(d.Table.PrimaryKeys) > 0,
+		}
+		if f.JSONName == "" || f.JSONName == "-" {
+			f.JSONName = f.DBName
+		}
+		for _, v := range strings.Split(tag.Get("tmeta"), ",") {
+			switch strings.TrimSpace(v) {
+			case "pk", "version":
+				f.ReadOnly = true
+			}
+		}
+		if f.PK || f.DBName == "create_time" || f.DBName == "update_time" {
+			f.ReadOnly = true
+		}
+
+		if vtag, ok := tag.Lookup("valid"); ok {
+			vals := valid.StructTagToValues(vtag)
+			_, f.Required = vals["notnil"]
+			fmt.Sscanf(vals.Get("minlen"), "%d", &f.MinLen)
+			fmt.Sscanf(vals.Get("maxlen"), "%d", &f.MaxLen)
+			if f.MinLen > 0 {
+				f.Required = true
+			}
+			f.Pattern = vals.Get("regexp")
+			_, f.Email = vals["email"]
+			f.MinVal = vals.Get("minval")
+			f.MaxVal = vals.Get("maxval")
+		}
+
+		switch {
+		case f.Email:
+			f.InputType = "email"
+		case f.DataType == ddl.Bool:
+			f.InputType = "checkbox"
+		case f.DataType == ddl.DateTime:
+			f.InputType = "datetime-local"
+		case f.DataType == ddl.Text:
+			f.InputType = "textarea"
+		case f.DataType == ddl.Int || f.DataType == ddl.IntU || f.DataType == ddl.BigInt || f.DataType == ddl.BigIntU ||
+			f.DataType == ddl.Double || f.DataType == ddl.Decimal:
+			f.InputType = "number"
+		default:
+			f.InputType = "text"
+		}
+
+		ret = append(ret, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// parseModelFlag parses a "file.go:TypeName" model argument, relative to s.WorkDir.
+func parseModelFlag(s *Settings, model string) (fileName, typeName string, err error) {
+	parts := strings.Split(model, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid model %q, must be file.go:TypeName", model)
+	}
+	fileName = parts[0]
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(s.WorkDir, fileName)
+	}
+	return fileName, parts[1], nil
+}
+
+// camelToWords converts "TodoItemID" to "Todo Item ID".
+func camelToWords(s string) string {
+	rs := []rune(s)
+	var buf strings.Builder
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+			buf.WriteRune(' ')
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
@@ -0,0 +1,428 @@
+package gen
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/spf13/pflag"
+)
+
+// For legacy databases: the table is introspected and the model is written so that its
+// tags describe the same table (so migration-diff works from there on), along with a
+// migration that creates the table if it does not exist - the migration is a no-op on
+// the database the model was generated from, and creates the table on new ones.  It has
+// no down statements, dropping the table there would lose the legacy data.
+
+// TODO: relations could be deduced from the foreign keys (belongs_to on this side,
+// has_many on the other)
+
+func init() {
+	globalMapGenerator["model-from-db"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		driver := fset.String("driver", "sqlite3", "The database driver, sqlite3 or mysql.")
+		dsn := fset.String("dsn", "", "The data source name of the database (required).")
+		prefix := fset.String("prefix", "", "The table prefix used in the database.")
+		table := fset.String("table", "", "The table to generate the model from, without the prefix (required).")
+		modelName := fset.String("model", "", "The model struct name (defaults to the table name in camel case, which tmeta maps back to the table name; if you change it make sure the table name still matches).")
+		category := fset.String("category", "", "The migration category, default is 0100_ and the package name.")
+		version := fset.String("version", "", "The migration version, default is based on the current time.")
+		storeName := fset.String("store", "Store", "The name of the store struct.")
+		storeCrud := fset.Bool("store-crud", true, "Also generate the store-crud methods, in store-<model>.go.")
+		noTypes := fset.Bool("no-types", false, "Do not attempt to add the type to store.go")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		if *dsn == "" {
+			return fmt.Errorf("-dsn is required")
+		}
+		if *table == "" {
+			return fmt.Errorf("-table is required")
+		}
+
+		db, err := sql.Open(*driver, *dsn)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		tables, err := ddl.Introspect(db, *driver, *prefix)
+		if err != nil {
+			return err
+		}
+		d := tables[*table]
+		if d == nil {
+			return fmt.Errorf("table %q not found", *prefix+*table)
+		}
+
+		if *modelName == "" {
+			*modelName = columnFieldName(*table)
+		}
+		fields, err := modelFieldsFromTable(d)
+		if err != nil {
+			return err
+		}
+
+		data["ModelName"] = *modelName
+		data["TableName"] = *table
+		data["Fields"] = fields
+		data["Imports"] = modelFieldImports(fields)
+
+		var pkField *modelFromDBField
+		for i := range fields {
+			f := &fields[i]
+			if f.PK {
+				if pkField != nil { // composite key, leave assigning IDs to the caller
+					pkField = nil
+					break
+				}
+				pkField = f
+			}
+		}
+		if pkField != nil && pkField.GoType == "string" {
+			data["IDField"] = pkField.Name
+			data["Imports"] = append(data["Imports"].([]string), "github.com/bradleypeabody/gouuidv6")
+		}
+		for _, f := range fields {
+			if f.GoType != "tmetautil.DBTime" {
+				continue
+			}
+			switch f.Column {
+			case "create_time":
+				data["CreateTimeField"] = f.Name
+			case "update_time":
+				data["UpdateTimeField"] = f.Name
+			}
+		}
+
+		if *category == "" {
+			*category = "0100_" + data["PackageName"].(string)
+		}
+		if *version == "" {
+			*version = time.Now().UTC().Format("20060102150405") + "_create_" + *table
+		}
+		data["Category"] = *category
+		data["Version"] = *version
+		data["BuilderSrc"] = createTableGoSrc(d)
+
+		if !*noTypes {
+			storeFile := filepath.Join(filepath.Dir(targetFile), "store.go")
+			storeMetaStr := "\n" + `if s.Meta.For(` + *modelName + `{}) == nil { if err := s.Meta.Parse(` + *modelName + `{}); err != nil { return err } }` + "\n"
+			err = GoSrcReplace(s, storeFile, regexp.MustCompile(`// end meta type init`), func(s string) string {
+				return storeMetaStr + "\n\n" + s
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = OutputGoSrcTemplate(s, data, targetFile, `
+package {{.PackageName}}
+
+import (
+{{range .Imports}}	"{{.}}"
+{{end}}
+)
+
+// {{.ModelName}} is a record in the {{.TableName}} table.
+// Generated from the database schema by "cavegen model-from-db".
+type {{.ModelName}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}} {{bq .Tag}}
+{{- end}}
+}
+
+{{if .IDField}}func (o *{{.ModelName}}) IDAssign() { if o.{{.IDField}} == "" { o.{{.IDField}} = gouuidv6.NewB64().String() } }
+{{end}}
+{{- if .CreateTimeField}}func (o *{{.ModelName}}) CreateTimeTouch() { o.{{.CreateTimeField}} = tmetautil.NewDBTime() }
+{{end}}
+{{- if .UpdateTimeField}}func (o *{{.ModelName}}) UpdateTimeTouch() { o.{{.UpdateTimeField}} = tmetautil.NewDBTime() }
+{{end}}
+
+func init() {
+	migrateregistry.MustRegisterList({{.ModelName}}Migrations())
+}
+
+// {{.ModelName}}Migrations creates the {{.TableName}} table as it was when the model was
+// generated.  The table and indexes are only created if they don't exist, so running
+// this against the original database does not change anything, and the first migration
+// has no down statements so running it down does not drop the original table.
+func {{.ModelName}}Migrations() (ml migrate.MigrationList) {
+
+	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true), ddl.NewPostgresFormatter(true)}
+
+	b := ddl.New()
+	b.SetCategory({{printf "%q" .Category}})
+
+	b.SetVersion({{printf "%q" .Version}})
+{{.BuilderSrc}}
+	b.MustMigrations(fl...).AppendTo(&ml)
+
+	// more migrations can go here, using the pattern of SetVersion(), statement(s), MustMigrations()...AppendTo()
+
+	return
+}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		if *storeCrud {
+			storeFile := filepath.Join(filepath.Dir(targetFile), "store-"+strings.Replace(ddl.SnakeCase(*modelName), "_", "-", -1)+".go")
+			err = globalMapGenerator.Generate(s, "store-crud", "--store", *storeName, "--model", *modelName, storeFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// modelFromDBField is a field of a model generated from a table column.
+type modelFromDBField struct {
+	Name   string
+	GoType string
+	Tag    string
+	Column string
+	PK     bool
+}
+
+// modelFieldsFromTable returns the fields for each column of a table.  The type and
+// ddl tag options are chosen so that ddl.TableDef.AddField makes the same column, as
+// far as possible (Custom columns are given the closest Go type).
+func modelFieldsFromTable(d *ddl.TableDef) ([]modelFromDBField, error) {
+
+	pks := make(map[string]bool, len(d.Table.PrimaryKeys))
+	for _, pk := range d.Table.PrimaryKeys {
+		pks[pk] = true
+	}
+
+	var ret []modelFromDBField
+	for _, col := range d.Table.Columns {
+
+		f := modelFromDBField{
+			Name:   columnFieldName(col.NameValue),
+			GoType: columnGoType(col),
+			Column: col.NameValue,
+			PK:     pks[col.NameValue],
+		}
+
+		var tmetaOpts []string
+		if f.PK {
+			tmetaOpts = append(tmetaOpts, "pk")
+		}
+		if col.NameValue == "version" && (f.GoType == "int64" || f.GoType == "int") {
+			tmetaOpts = append(tmetaOpts, "version")
+		}
+
+		// keys, the version and timestamps are not set from API input (see httpapi.Fill)
+		fillable := !f.PK && len(tmetaOpts) == 0 && col.NameValue != "create_time" && col.NameValue != "update_time"
+
+		var ddlOpts []string
+		for _, fk := range d.Table.ForeignKeys {
+			if fk.ColumnValue != col.NameValue {
+				continue
+			}
+			ddlOpts = append(ddlOpts, "fk="+fk.OtherTableValue+"."+fk.OtherColumnValue)
+			if fk.OnDeleteValue != "" {
+				ddlOpts = append(ddlOpts, "ondelete="+fkActionTagValue(fk.OnDeleteValue))
+			}
+			if fk.OnUpdateValue != "" {
+				ddlOpts = append(ddlOpts, "onupdate="+fkActionTagValue(fk.OnUpdateValue))
+			}
+			break
+		}
+
+		// see what AddField would make of it, and add options for the differences
+		tag := func() reflect.StructTag {
+			var parts []string
+			if len(tmetaOpts) > 0 {
+				parts = append(parts, `tmeta:"`+strings.Join(tmetaOpts, ",")+`"`)
+			}
+			parts = append(parts, `db:"`+col.NameValue+`"`, `json:"`+col.NameValue+`"`)
+			if len(ddlOpts) > 0 {
+				parts = append(parts, `ddl:"`+strings.Join(ddlOpts, ",")+`"`)
+			}
+			if fillable {
+				parts = append(parts, `httpapi:"`+col.NameValue+`,fillable"`)
+			}
+			return reflect.StructTag(strings.Join(parts, " "))
+		}
+		td := ddl.NewTableDef(d.Table.NameValue)
+		err := td.AddField(f.Name, f.GoType, tag())
+		if err != nil {
+			return nil, err
+		}
+		got := td.Table.Columns[0]
+		if col.DataTypeValue != ddl.Custom && got.DataTypeValue != col.DataTypeValue {
+			ddlOpts = append(ddlOpts, "type="+col.DataTypeValue.String())
+		}
+		if got.NullValue != col.NullValue {
+			if col.NullValue {
+				ddlOpts = append(ddlOpts, "null")
+			} else {
+				ddlOpts = append(ddlOpts, "notnull")
+			}
+		}
+		if col.LengthValue > 0 {
+			ddlOpts = append(ddlOpts, fmt.Sprintf("length=%d", col.LengthValue))
+		}
+		if col.PrecisionValue > 0 {
+			ddlOpts = append(ddlOpts, fmt.Sprintf("precision=%d", col.PrecisionValue))
+		}
+		if col.ScaleValue > 0 || col.ScaleSet {
+			ddlOpts = append(ddlOpts, fmt.Sprintf("scale=%d", col.ScaleValue))
+		}
+		if col.CaseSensitiveValue {
+			ddlOpts = append(ddlOpts, "casesensitive")
+		}
+		if col.DefaultValue != nil {
+			if v := fmt.Sprint(col.DefaultValue); !strings.ContainsAny(v, `,"`+"`") {
+				ddlOpts = append(ddlOpts, "default="+v)
+			}
+		}
+		f.Tag = string(tag())
+
+		ret = append(ret, f)
+	}
+
+	return ret, nil
+}
+
+// columnGoType returns the Go type for a column, a pointer if it is nullable.
+func columnGoType(col *ddl.DataTypeDef) string {
+
+	var t string
+	switch col.DataTypeValue {
+	case ddl.VarCharPK, ddl.VarCharFK, ddl.VarChar, ddl.Text:
+		t = "string"
+	case ddl.BigIntAutoPK, ddl.BigIntFK, ddl.BigInt:
+		t = "int64"
+	case ddl.Int:
+		t = "int"
+	case ddl.IntU:
+		t = "uint"
+	case ddl.BigIntU:
+		t = "uint64"
+	case ddl.Double:
+		t = "float64"
+	case ddl.Bool:
+		t = "bool"
+	case ddl.DateTime:
+		t = "tmetautil.DBTime"
+	case ddl.Blob:
+		return "[]byte" // nil for NULL
+	case ddl.Decimal:
+		t = "dbutil.Decimal"
+	default:
+		t = customGoType(col.CustomSQLValue)
+		if t == "[]byte" {
+			return t
+		}
+	}
+
+	if col.NullValue {
+		return "*" + t
+	}
+	return t
+}
+
+// customGoType returns the Go type for a column type not known to ddl, by the same rules
+// SQLite uses to determine the type affinity of a column from its declared type.
+func customGoType(sqlType string) string {
+	st := strings.ToUpper(sqlType)
+	switch {
+	case strings.HasPrefix(st, "TINYINT(1)"), strings.Contains(st, "BOOL"):
+		return "bool"
+	case strings.Contains(st, "BIGINT"):
+		return "int64"
+	case strings.Contains(st, "INT"):
+		return "int"
+	case strings.Contains(st, "CHAR"), strings.Contains(st, "CLOB"), strings.Contains(st, "TEXT"):
+		return "string"
+	case strings.Contains(st, "BLOB"), strings.Contains(st, "BINARY"):
+		return "[]byte"
+	case strings.Contains(st, "REAL"), strings.Contains(st, "FLOA"), strings.Contains(st, "DOUB"):
+		return "float64"
+	case strings.Contains(st, "DATE"), strings.Contains(st, "TIME"):
+		return "tmetautil.DBTime"
+	case strings.Contains(st, "DEC"), strings.Contains(st, "NUMERIC"):
+		return "dbutil.Decimal"
+	}
+	return "string"
+}
+
+// modelFieldImports returns the packages the model file needs for the fields given,
+// along with those always used.
+func modelFieldImports(fields []modelFromDBField) []string {
+	ret := []string{
+		"github.com/gocaveman/caveman/ddl",
+		"github.com/gocaveman/caveman/migrate",
+		"github.com/gocaveman/caveman/migrate/migrateregistry",
+	}
+	var dbutil, tmetautil bool
+	for _, f := range fields {
+		dbutil = dbutil || strings.Contains(f.GoType, "dbutil.")
+		tmetautil = tmetautil || strings.Contains(f.GoType, "tmetautil.")
+	}
+	if dbutil {
+		ret = append(ret, "github.com/gocaveman/caveman/dbutil")
+	}
+	if tmetautil {
+		ret = append(ret, "github.com/gocaveman/tmeta/tmetautil")
+	}
+	return ret
+}
+
+func fkActionTagValue(a ddl.FKAction) string {
+	return strings.ToLower(strings.Replace(string(a), " ", "", -1))
+}
+
+// commonInitialisms are the parts of column names which are all caps in Go names.
+var commonInitialisms = map[string]bool{
+	"api": true, "css": true, "dns": true, "html": true, "http": true, "https": true,
+	"id": true, "ip": true, "json": true, "sql": true, "ssh": true, "tcp": true,
+	"uid": true, "uri": true, "url": true, "utf8": true, "uuid": true, "xml": true,
+}
+
+// columnFieldName converts a column or table name like "customer_id" to a Go name like "CustomerID".
+func columnFieldName(s string) string {
+	var buf bytes.Buffer
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		if commonInitialisms[strings.ToLower(part)] {
+			buf.WriteString(strings.ToUpper(part))
+			continue
+		}
+		buf.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	ret := buf.String()
+	if ret == "" || (ret[0] >= '0' && ret[0] <= '9') {
+		ret = "F" + ret
+	}
+	return ret
+}
+
+// createTableGoSrc returns Go source for a migration creating the table and its indexes
+// (if they do not exist) as calls on a *ddl.Builder named "b", with no down statements.
+func createTableGoSrc(d *ddl.TableDef) string {
+	var buf bytes.Buffer
+	buf.WriteString("\t// no down statements, the table was here before this migration and running it down leaves it alone\n")
+	fmt.Fprintf(&buf, "\tb.Up().\n\t\tCreateTable(%q).IfNotExists()%s\n", d.Table.NameValue, tableGoSrc(d.Table))
+	for _, idx := range d.Indexes {
+		s := fmt.Sprintf("CreateIndex(%q, %q).IfNotExists()", idx.NameValue, idx.TableNameValue)
+		if idx.UniqueValue {
+			s += ".Unique()"
+		}
+		fmt.Fprintf(&buf, "\tb.Up().\n\t\t%s%s\n", s, indexColumnsGoSrc(idx))
+	}
+	return buf.String()
+}
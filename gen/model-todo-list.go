@@ -101,7 +101,7 @@ import (
 
 func TodoListMigrations() (ml migrate.MigrationList) {
 
-	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true)}
+	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true), ddl.NewPostgresFormatter(true)}
 
 	b := ddl.New()
 	b.SetCategory("0100_{{.PackageName}}")
@@ -165,9 +165,9 @@ func init() {
 */}}
 
 type TodoList struct {
-	TodoListID string {{bq "tmeta:\"pk\" db:\"todo_item_id\" json:\"todo_item_id\""}}
-	Name string {{bq "db:\"category\" json:\"category\" valid:\"minlen=1\""}} 
-	Description string {{bq "db:\"description\" json:\"description\""}}
+	TodoListID string {{bq "tmeta:\"pk\" db:\"todo_list_id\" json:\"todo_list_id\""}}
+	Name string {{bq "db:\"name\" json:\"name\" valid:\"minlen=1\" httpapi:\"name,fillable\""}}
+	Description string {{bq "db:\"description\" json:\"description\" httpapi:\"description,fillable\""}}
 	Version int64 {{bq "tmeta:\"version\" db:\"version\" json:\"version\""}}
 	CreateTime tmetautil.DBTime {{bq "db:\"create_time\" json:\"create_time\""}}
 	UpdateTime tmetautil.DBTime {{bq "db:\"update_time\" json:\"update_time\""}}
@@ -209,7 +209,7 @@ import (
 
 func TodoItemMigrations() (ml migrate.MigrationList) {
 
-	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true)}
+	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true), ddl.NewPostgresFormatter(true)}
 
 	b := ddl.New()
 	b.SetCategory("0100_{{.PackageName}}")
@@ -271,9 +271,9 @@ func init() {
 
 type TodoItem struct {
 	TodoItemID string {{bq "tmeta:\"pk\" db:\"todo_item_id\" json:\"todo_item_id\""}}
-	TodoListID string {{bq "db:\"todo_list_id\" json:\"todo_list_id\""}}
-	Line string {{bq "db:\"line\" json:\"line\" valid:\"minlen=1\""}} 
-	Sequence float64 {{bq "db:\"sequence\" json:\"sequence\""}}
+	TodoListID string {{bq "db:\"todo_list_id\" json:\"todo_list_id\" httpapi:\"todo_list_id,fillable\""}}
+	Line string {{bq "db:\"line\" json:\"line\" valid:\"minlen=1\" httpapi:\"line,fillable\""}}
+	Sequence float64 {{bq "db:\"sequence\" json:\"sequence\" httpapi:\"sequence,fillable\""}}
 
 	Version int64 {{bq "tmeta:\"version\" db:\"version\" json:\"version\""}}
 	CreateTime tmetautil.DBTime {{bq "db:\"create_time\" json:\"create_time\""}}
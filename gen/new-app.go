@@ -0,0 +1,555 @@
+package gen
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// new-app creates a complete project to start from, with its own go.mod, by running
+// the other generators in a new directory and adding what ties them together: main.go
+// and config.go for the executable, a package for the app's own views and a test suite
+// which starts the whole app against an in-memory SQLite database.  The layout is:
+//
+//	go.mod, main.go, config.go, config.yaml, app_test.go
+//	store/  - the Store, the sample TodoList model and its store methods and migrations
+//	ctrl/   - the TodoList API (ctrl-api-crud) and page data (ctrl-pages)
+//	ui/     - views and includes, built in with embed-tmpl (go:embed)
+//
+// As with the other generators nothing is written in a dry run and files which already
+// exist are only updated in their generated sections, so it can be run again on an app
+// to pick up changes to the templates.
+
+var newAppThemes = []string{"pleistocene", "paleolithic"}
+
+var newAppDrivers = map[string]struct{ Import, DSN string }{
+	"sqlite3": {"github.com/mattn/go-sqlite3", "file:%s.db?cache=shared"},
+	"mysql":   {"github.com/go-sql-driver/mysql", "root:@tcp(localhost:3306)/%s?charset=utf8mb4,utf8"},
+}
+
+func init() {
+	globalMapGenerator["new-app"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		module := fset.String("module", "", "The module path of the new app, e.g. example.com/app (required).")
+		db := fset.String("db", "sqlite3", "The database the app uses by default, 'sqlite3' or 'mysql'.")
+		theme := fset.String("theme", newAppThemes[0], "The theme package to use, one of: "+strings.Join(newAppThemes, ", ")+".")
+		err := fset.Parse(longFlagArgs(fset, args))
+		if err != nil {
+			return err
+		}
+
+		if fset.NArg() != 1 {
+			return fmt.Errorf("exactly one directory must be specified")
+		}
+		if *module == "" {
+			return fmt.Errorf("-module is required")
+		}
+		driver, ok := newAppDrivers[*db]
+		if !ok {
+			return fmt.Errorf("unknown -db %q, must be 'sqlite3' or 'mysql'", *db)
+		}
+		themeOK := false
+		for _, t := range newAppThemes {
+			themeOK = themeOK || t == *theme
+		}
+		if !themeOK {
+			return fmt.Errorf("unknown -theme %q, must be one of: %s", *theme, strings.Join(newAppThemes, ", "))
+		}
+
+		appDir := fset.Arg(0)
+		if !filepath.IsAbs(appDir) {
+			appDir = filepath.Join(s.WorkDir, appDir)
+		}
+		appName := filepath.Base(appDir)
+
+		// everything below is relative to the app directory, which need not be in GOPATH
+		as := &Settings{
+			WorkDir: appDir,
+			GOPATH:  appDir,
+			DryRun:  s.DryRun,
+			Force:   s.Force,
+			Stdout:  s.Stdout,
+		}
+
+		data := map[string]interface{}{
+			"PackageName":  "main",
+			"AppName":      appName,
+			"EnvPrefix":    strings.Trim(regexp.MustCompile(`[^A-Z0-9]+`).ReplaceAllString(strings.ToUpper(appName), "_"), "_"),
+			"Module":       *module,
+			"DriverName":   *db,
+			"DriverImport": driver.Import,
+			"DefaultDSN":   fmt.Sprintf(driver.DSN, appName),
+			"Theme":        *theme,
+			"Include":      "/app-page.gohtml",
+		}
+
+		err = OutputTemplate(as, data, "go.mod", `module [[.Module]]
+
+go 1.18
+`, false)
+		if err != nil {
+			return err
+		}
+
+		steps := [][]string{
+			{"store", "store/store.go"},
+			{"model-todo-list", "store/model-todo-list.go"},
+			{"store-crud", "store/store-todo-list.go"},
+			{"ctrl-api-crud", "--store-import", *module + "/store", "ctrl/ctrl-todo-list.go"},
+			{"ctrl-pages", "--store-import", *module + "/store", "ctrl/ctrl-todo-list-pages.go"},
+			{"view-model-listing", "--model", "store/model-todo-list.go:TodoList", "--include", data["Include"].(string), "ui/views/todo-list.gohtml"},
+			{"view-model-detail", "--model", "store/model-todo-list.go:TodoList", "--include", data["Include"].(string), "ui/views/todo-list/detail.gohtml"},
+			{"embed-tmpl", "--dirs", "views,includes", "ui/embed.go"},
+		}
+		for _, step := range steps {
+			err = globalMapGenerator.Generate(as, step[0], step[1:]...)
+			if err != nil {
+				return fmt.Errorf("%s: %v", step[0], err)
+			}
+		}
+
+		// model-todo-list leaves registering the migrations to us
+		err = OutputGoSrcTemplate(as, map[string]interface{}{"PackageName": "store"}, "store/migrations.go", `
+package {{.PackageName}}
+
+import (
+	"github.com/gocaveman/caveman/migrate/migrateregistry"
+)
+
+func init() {
+	migrateregistry.MustRegisterList(TodoListMigrations())
+}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputTemplate(as, data, "ui/includes/app-page.gohtml", `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>{{with .Value "tmpl.Meta"}}{{.title}} - {{end}}[[.AppName]]</title>
+</head>
+<body>
+
+<nav>
+	<a href="/">[[.AppName]]</a>
+	<a href="/todo-list">Todo Lists</a>
+</nav>
+
+<main>
+{{block "body" .}}{{end}}
+</main>
+
+</body>
+</html>
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputTemplate(as, data, "ui/views/index.gohtml", `---
+title: Home
+---
+{{template "[[.Include]]" .}}
+
+{{define "body"}}
+<h1>[[.AppName]]</h1>
+
+<p>Start with the <a href="/todo-list">todo lists</a>, the API is described at <a href="/api/openapi.json">/api/openapi.json</a>.</p>
+{{end}}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputTemplate(as, data, "config.yaml", `# Settings for [[.AppName]], overridden by [[.EnvPrefix]]_* environment variables
+# (e.g. [[.EnvPrefix]]_HTTP_LISTEN) and command line flags.
+http-listen: ":8080"
+db-driver: [[.DriverName]]
+db-dsn: "[[.DefaultDSN]]"
+db-migrate: auto
+db-migrate-strict: false
+debug: false
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputGoSrcTemplate(as, data, "config.go", `
+package {{.PackageName}}
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Config has the settings of the app.
+type Config struct {
+	HTTPListen      string // IP:Port to listen on for HTTP
+	DBDriver        string // database driver name
+	DBDSN           string // database connection string
+	DBMigrate       string // 'auto', 'check', 'none' or 'unlock', see the db-migrate flag
+	DBMigrateStrict bool   // refuse to start if applied migrations were modified, are missing or were skipped
+	Debug           bool   // debug output, for development only
+}
+
+// LoadConfig reads the settings from the command line arguments (without the program
+// name), {{.EnvPrefix}}_* environment variables and the config file, in that order of
+// precedence.  The config file is config.yaml in the current directory if there is one,
+// or as given by --config.
+func LoadConfig(args []string) (*Config, error) {
+
+	fset := pflag.NewFlagSet(APP_NAME, pflag.ContinueOnError)
+	fset.StringP("config", "c", "", "Config file to read (default config.yaml, if it exists)")
+	fset.StringP("http-listen", "l", ":8080", "IP:Port to listen on for HTTP")
+	fset.String("db-driver", "{{.DriverName}}", "Database driver name")
+	fset.String("db-dsn", "{{.DefaultDSN}}", "Database connection string")
+	fset.String("db-migrate", "auto", "Database migration behavior ('auto' to update, 'check' to report out of date, 'none' to ignore migrations, or 'unlock' to clear stale migration locks left by a process which died and exit)")
+	fset.Bool("db-migrate-strict", false, "Refuse to start if applied migrations were since modified, are missing or were skipped")
+	fset.BoolP("debug", "g", false, "Enable debug output (intended for development only)")
+	err := fset.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetEnvPrefix("{{.EnvPrefix}}")
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+	err = v.BindPFlags(fset)
+	if err != nil {
+		return nil, err
+	}
+
+	if configFile := v.GetString("config"); configFile != "" {
+		v.SetConfigFile(configFile)
+	} else {
+		v.SetConfigName("config")
+		v.AddConfigPath(".")
+	}
+	err = v.ReadInConfig()
+	if _, ok := err.(viper.ConfigFileNotFoundError); err != nil && !ok {
+		return nil, err
+	}
+
+	return &Config{
+		HTTPListen:      v.GetString("http-listen"),
+		DBDriver:        v.GetString("db-driver"),
+		DBDSN:           v.GetString("db-dsn"),
+		DBMigrate:       v.GetString("db-migrate"),
+		DBMigrateStrict: v.GetBool("db-migrate-strict"),
+		Debug:           v.GetBool("debug"),
+	}, nil
+}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputGoSrcTemplate(as, data, "main.go", `
+package {{.PackageName}}
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/gocaveman/caveman/autowire"
+	"github.com/gocaveman/caveman/httpapi/openapi/openapiregistry"
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migratedbr"
+	"github.com/gocaveman/caveman/migrate/migrateregistry"
+	"github.com/gocaveman/caveman/renderer"
+	"github.com/gocaveman/caveman/tmpl/tmplregistry"
+	"github.com/gocaveman/caveman/webutil"
+	"github.com/gocaveman/caveman/webutil/handlerregistry"
+	"github.com/gocaveman/tmeta"
+	"github.com/gocraft/dbr"
+	"github.com/spf13/pflag"
+
+	_ "{{.Module}}/ctrl"
+	"{{.Module}}/store"
+	_ "{{.Module}}/ui"
+
+	_ "github.com/gocaveman/caveman/themes/{{.Theme}}"
+	_ "{{.DriverImport}}"
+)
+
+const APP_NAME = "{{.AppName}}"
+
+func main() {
+
+	cfg, err := LoadConfig(os.Args[1:])
+	if err == pflag.ErrHelp {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// for "cavegen migrate --bin"
+	migrateregistry.DumpIfRequested()
+	// CAVEMAN_OPENAPI_DUMP=yaml writes the OpenAPI document to stdout, for use offline
+	openapiregistry.DumpIfRequested(APP_NAME, "0.0.1")
+
+	app, err := NewApp(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if app == nil { // nothing to serve, e.g. --db-migrate=unlock
+		return
+	}
+	defer app.DB.Close()
+
+	var wg sync.WaitGroup
+	webutil.StartHTTPServer(&http.Server{
+		Addr:    cfg.HTTPListen,
+		Handler: app.Handler,
+	}, &wg)
+	log.Printf("%s listening at %q", APP_NAME, cfg.HTTPListen)
+	wg.Wait()
+}
+
+// App is the wired up application.
+type App struct {
+	Config  *Config
+	DB      *sql.DB
+	Store   *store.Store
+	Handler http.Handler // everything, in the order it handles requests
+}
+
+// NewApp connects to the database, migrates it according to cfg.DBMigrate, wires up
+// the components registered with autowire and returns the app ready to serve.  It can
+// only be called once, as it provides to the global autowire instance.  A nil App is
+// returned if the migration mode means it should not be run ('unlock').
+func NewApp(cfg *Config) (*App, error) {
+
+	err := migrateDB(cfg)
+	if err != nil || cfg.DBMigrate == "unlock" {
+		return nil, err
+	}
+
+	db, err := sql.Open(cfg.DBDriver, cfg.DBDSN)
+	if err != nil {
+		return nil, err
+	}
+	app := &App{Config: cfg, DB: db}
+
+	// EDITME: provide anything else your components need here
+	autowire.Provide("driver_name", cfg.DBDriver)
+	autowire.Provide("", db)
+	autowire.Provide("", tmeta.NewMeta())
+	var eventReceiver dbr.EventReceiver = &dbr.NullEventReceiver{}
+	autowire.Provide("", eventReceiver)
+	app.Store = &store.Store{}
+	autowire.ProvideAndPopulate("", app.Store)
+
+	// the app's own views (ui) come before the theme's
+	rend := renderer.NewFromTemplateReader(tmplregistry.ContentsStore())
+	autowire.Provide("", rend)
+
+	err = autowire.Contents().Run()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("autowire error: %v", err)
+	}
+
+	hl := webutil.NewDefaultHandlerList()
+	for _, item := range handlerregistry.Contents() {
+		hl = append(hl, item.Value)
+	}
+	// the OpenAPI document for the APIs in openapiregistry
+	hl = append(hl, openapiregistry.NewHandler("/api/openapi.json", "/api/openapi.yaml", APP_NAME, "0.0.1"))
+	hl = append(hl, renderer.NewHandler(rend))
+	hl = append(hl, renderer.NotFoundHandler(rend, "/_404.gohtml"))
+	app.Handler = hl
+
+	return app, nil
+}
+
+// migrateDB applies, checks or unlocks the migrations registered in migrateregistry.
+func migrateDB(cfg *Config) error {
+
+	if cfg.DBMigrate == "none" {
+		return nil
+	}
+
+	ml := migrateregistry.Contents().WithDriverName(cfg.DBDriver).Sorted()
+	versioner, err := migratedbr.New(cfg.DBDriver, cfg.DBDSN)
+	if err != nil {
+		return err
+	}
+	defer versioner.Close()
+	runner := migrate.NewRunner(cfg.DBDriver, cfg.DBDSN, versioner, ml)
+	runner.Strict = cfg.DBMigrateStrict
+
+	switch cfg.DBMigrate {
+	case "auto":
+		err = runner.RunAllUpToLatest()
+		if err != nil {
+			return fmt.Errorf("migration auto-update error: %v", err)
+		}
+	case "check":
+		result, err := runner.CheckAll(true)
+		if err != nil {
+			return fmt.Errorf("migration check error: %v", err)
+		}
+		log.Printf("Migration check result: %+v", result)
+		if runner.Strict {
+			for _, item := range result {
+				if err := item.DriftError(); err != nil {
+					return err
+				}
+			}
+		}
+	case "unlock":
+		cats, err := versioner.ClearStaleLocks(false)
+		if err != nil {
+			return fmt.Errorf("migration unlock error: %v", err)
+		}
+		log.Printf("Migration locks cleared for categories: %v", cats)
+	default:
+		return fmt.Errorf("unknown db-migrate mode %q", cfg.DBMigrate)
+	}
+
+	return nil
+}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		err = OutputGoSrcTemplate(as, data, "app_test.go", `
+package {{.PackageName}}
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gocaveman/caveman/tmpl/tmplregistry"
+	"github.com/gocaveman/caveman/webutil/handlerregistry"
+	"github.com/stretchr/testify/assert"
+
+	"{{.Module}}/store"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// testApp is the whole app, with an in-memory SQLite database.
+var testApp *App
+
+func TestMain(m *testing.M) {
+
+	// the registries may only be read from package main, which a test binary is not
+	handlerregistry.OnlyReadableFromMain = false
+	tmplregistry.OnlyReadableFromMain = false
+
+	var err error
+	testApp, err = NewApp(&Config{
+		DBDriver:  "sqlite3",
+		DBDSN:     "file:" + APP_NAME + "_test?mode=memory&cache=shared",
+		DBMigrate: "auto",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func testGet(t *testing.T, path string) (int, string) {
+	w := httptest.NewRecorder()
+	testApp.Handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+	b, err := ioutil.ReadAll(w.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, string(b)
+}
+
+func TestTodoListStore(t *testing.T) {
+
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	o := &store.TodoList{Name: "Chores", Description: "Around the house"}
+	assert.NoError(testApp.Store.CreateTodoList(ctx, o))
+	assert.NotEmpty(o.TodoListID)
+
+	o.Name = "House Chores"
+	assert.NoError(testApp.Store.UpdateTodoList(ctx, o))
+
+	o2 := &store.TodoList{}
+	assert.NoError(testApp.Store.FetchTodoList(ctx, o2, o.TodoListID))
+	assert.Equal("House Chores", o2.Name)
+
+	assert.NoError(testApp.Store.DeleteTodoList(ctx, o2))
+	assert.Error(testApp.Store.FetchTodoList(ctx, o2, o.TodoListID))
+}
+
+func TestPages(t *testing.T) {
+
+	assert := assert.New(t)
+
+	code, body := testGet(t, "/")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, "<h1>"+APP_NAME+"</h1>")
+
+	code, _ = testGet(t, "/todo-list")
+	assert.Equal(http.StatusOK, code)
+
+	code, _ = testGet(t, "/todo-list/new")
+	assert.Equal(http.StatusOK, code)
+
+	code, _ = testGet(t, "/no-such-page")
+	assert.Equal(http.StatusNotFound, code)
+}
+
+func TestOpenAPI(t *testing.T) {
+
+	assert := assert.New(t)
+
+	code, body := testGet(t, "/api/openapi.json")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, "/api/todo-list")
+}
+`, false)
+		if err != nil {
+			return err
+		}
+
+		out := as.stdout()
+		if _, err := exec.LookPath("goimports"); err != nil {
+			fmt.Fprintf(out, "WARNING: goimports was not found, some generated files may be missing imports (go install golang.org/x/tools/cmd/goimports@latest and run this again)\n")
+		}
+		verb := "Created"
+		if s.DryRun {
+			verb = "Would create"
+		}
+		fmt.Fprintf(out, `%s %s, next steps:
+
+	cd %s
+	go mod tidy
+	go test ./...
+	go run .
+
+`, verb, appName, fset.Arg(0))
+
+		return nil
+	})
+}
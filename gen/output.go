@@ -0,0 +1,419 @@
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Generated files are divided into sections with marker comments, so they can be
+// regenerated without losing code written by hand:
+//
+//	// cavegen:begin generated sum=0123456789abcdef
+//	... generated code ...
+//	// cavegen:end generated
+//
+// When a file is regenerated only the sections are replaced, anything outside of them
+// is kept as is (for Go files the imports the new sections need are added).  The sum is
+// of the section's content as generated; if it doesn't match, the section was edited
+// by hand and the file is not touched unless Settings.Force is set.  The same goes for
+// an existing file with no sections at all.  OutputGoSrcTemplate puts everything after
+// the imports in one section named "generated" unless the template has its own markers
+// (any comment syntax will do, the markers are found by line).
+
+var (
+	sectionBeginRE = regexp.MustCompile(`^(.*?)cavegen:begin\s+([\w.-]+)(?:\s+sum=([0-9a-f]+))?(.*)$`)
+	sectionEndRE   = regexp.MustCompile(`^(.*?)cavegen:end\s+([\w.-]+)(.*)$`)
+)
+
+// fileSegment is a section of a file, or the text between sections (name is empty).
+type fileSegment struct {
+	name  string
+	sum   string // sum from the begin marker
+	begin string // begin marker line
+	body  string
+	end   string // end marker line
+}
+
+func (seg fileSegment) String() string {
+	return seg.begin + seg.body + seg.end
+}
+
+// hasSections returns true if there are any sections.
+func hasSections(segs []fileSegment) bool {
+	for _, seg := range segs {
+		if seg.name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionSum(body string) string {
+	h := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(h[:8])
+}
+
+// parseSections splits a file into sections and the text between them.
+func parseSections(b []byte) ([]fileSegment, error) {
+
+	var ret []fileSegment
+	var cur *fileSegment
+	names := make(map[string]bool)
+
+	text := fileSegment{}
+	for n, line := range splitLines(string(b)) {
+		l := strings.TrimRight(line, "\r\n")
+
+		if m := sectionBeginRE.FindStringSubmatch(l); m != nil {
+			if cur != nil {
+				return nil, fmt.Errorf("line %d: section %q begins inside section %q", n+1, m[2], cur.name)
+			}
+			if names[m[2]] {
+				return nil, fmt.Errorf("line %d: section %q appears more than once", n+1, m[2])
+			}
+			names[m[2]] = true
+			if text.body != "" {
+				ret = append(ret, text)
+				text = fileSegment{}
+			}
+			cur = &fileSegment{name: m[2], sum: m[3], begin: line}
+			continue
+		}
+
+		if m := sectionEndRE.FindStringSubmatch(l); m != nil {
+			if cur == nil || cur.name != m[2] {
+				return nil, fmt.Errorf("line %d: unexpected end of section %q", n+1, m[2])
+			}
+			cur.end = line
+			ret = append(ret, *cur)
+			cur = nil
+			continue
+		}
+
+		if cur != nil {
+			cur.body += line
+		} else {
+			text.body += line
+		}
+	}
+
+	if cur != nil {
+		return nil, fmt.Errorf("section %q has no end", cur.name)
+	}
+	if text.body != "" {
+		ret = append(ret, text)
+	}
+
+	return ret, nil
+}
+
+// checkSections returns an error if any section was modified since it was generated.
+func checkSections(fileName string, segs []fileSegment) error {
+	for _, seg := range segs {
+		if seg.name != "" && seg.sum != "" && seg.sum != sectionSum(seg.body) {
+			return fmt.Errorf("%s: section %q was modified by hand, use -force to overwrite it", fileName, seg.name)
+		}
+	}
+	return nil
+}
+
+// joinSections puts the file back together, with the sums in the begin markers updated.
+func joinSections(segs []fileSegment) []byte {
+	var buf bytes.Buffer
+	for _, seg := range segs {
+		if seg.name != "" {
+			m := sectionBeginRE.FindStringSubmatch(strings.TrimRight(seg.begin, "\r\n"))
+			nl := seg.begin[len(strings.TrimRight(seg.begin, "\r\n")):]
+			seg.begin = m[1] + "cavegen:begin " + seg.name + " sum=" + sectionSum(seg.body) + m[4] + nl
+		}
+		buf.WriteString(seg.String())
+	}
+	return buf.Bytes()
+}
+
+// mergeSections replaces the sections of old with those of new, keeping the text
+// between them from old.  Sections which are no longer generated are removed and new
+// ones are put after the section which comes before them in new (or before the first
+// section if none does).
+func mergeSections(old, new []fileSegment) []fileSegment {
+
+	newByName := make(map[string]fileSegment, len(new))
+	for _, seg := range new {
+		if seg.name != "" {
+			newByName[seg.name] = seg
+		}
+	}
+
+	var ret []fileSegment
+	done := make(map[string]bool, len(new))
+	for _, seg := range old {
+		if seg.name == "" {
+			ret = append(ret, seg)
+			continue
+		}
+		nseg, ok := newByName[seg.name]
+		if !ok {
+			continue
+		}
+		ret = append(ret, nseg)
+		done[seg.name] = true
+	}
+
+	prev := ""
+	for _, seg := range new {
+		if seg.name == "" {
+			continue
+		}
+		if !done[seg.name] {
+			at := len(ret)
+			for i := range ret {
+				if ret[i].name == prev {
+					at = i + 1
+					break
+				}
+			}
+			if prev == "" {
+				// before the first existing section
+				for i := range ret {
+					if ret[i].name != "" {
+						at = i
+						break
+					}
+				}
+			}
+			ret = append(ret[:at], append([]fileSegment{seg}, ret[at:]...)...)
+			done[seg.name] = true
+		}
+		prev = seg.name
+	}
+
+	return ret
+}
+
+// wrapGoSection puts the Go source after the package clause and imports in a section
+// named "generated", unless it already has sections.
+func wrapGoSection(src []byte) ([]byte, error) {
+
+	segs, err := parseSections(src)
+	if err != nil {
+		return nil, err
+	}
+	if hasSections(segs) {
+		return src, nil
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	end := fset.Position(f.Name.End()).Offset
+	for _, d := range f.Decls {
+		if off := fset.Position(d.End()).Offset; off > end {
+			end = off
+		}
+	}
+	if i := bytes.IndexByte(src[end:], '\n'); i >= 0 {
+		end += i + 1
+	} else {
+		end = len(src)
+	}
+
+	body := bytes.Trim(src[end:], "\n")
+	if len(body) == 0 {
+		return src, nil
+	}
+
+	var buf bytes.Buffer
+	buf.Write(src[:end])
+	buf.WriteString("\n// cavegen:begin generated\n\n")
+	buf.Write(body)
+	buf.WriteString("\n\n// cavegen:end generated\n")
+	return buf.Bytes(), nil
+}
+
+// addGoImports adds the imports of from which are missing in src.
+func addGoImports(src, from []byte) ([]byte, error) {
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	ffrom, err := parser.ParseFile(token.NewFileSet(), "", from, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	have := make(map[string]bool, len(f.Imports))
+	for _, imp := range f.Imports {
+		have[imp.Path.Value] = true
+	}
+	var add []string
+	for _, imp := range ffrom.Imports {
+		if have[imp.Path.Value] {
+			continue
+		}
+		line := "\t" + imp.Path.Value + "\n"
+		if imp.Name != nil {
+			line = "\t" + imp.Name.Name + " " + imp.Path.Value + "\n"
+		}
+		add = append(add, line)
+	}
+	if len(add) == 0 {
+		return src, nil
+	}
+
+	// into the last import block with parens, or a new one after the package clause
+	at, ins := fset.Position(f.Name.End()).Offset, "\n\nimport (\n"+strings.Join(add, "")+")"
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Lparen.IsValid() {
+			at, ins = fset.Position(gd.Rparen).Offset, strings.Join(add, "")
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.Write(src[:at])
+	buf.WriteString(ins)
+	buf.Write(src[at:])
+	return buf.Bytes(), nil
+}
+
+// readFile reads a file relative to GOPATH, including those "written" in a dry run.
+func (s *Settings) readFile(targetFile string) ([]byte, error) {
+	if b, ok := s.dryRunFiles[targetFile]; ok && s.DryRun {
+		return b, nil
+	}
+	return ioutil.ReadFile(filepath.Join(s.GOPATH, targetFile))
+}
+
+// dirExists returns true if a directory relative to GOPATH exists, or has files "written"
+// to it in a dry run.
+func (s *Settings) dirExists(dir string) bool {
+	if fi, err := os.Stat(filepath.Join(s.GOPATH, dir)); err == nil && fi.IsDir() {
+		return true
+	}
+	if s.DryRun {
+		prefix := filepath.Clean(dir) + string(filepath.Separator)
+		for name := range s.dryRunFiles {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dryRunSource returns the content of a file (an absolute path) "written" earlier in a dry
+// run, as the src argument for go/parser, or nil to read it from disk.
+func (s *Settings) dryRunSource(fileName string) interface{} {
+	if !s.DryRun {
+		return nil
+	}
+	rel, err := filepath.Rel(s.GOPATH, fileName)
+	if err != nil {
+		return nil
+	}
+	if b, ok := s.dryRunFiles[rel]; ok {
+		return b
+	}
+	return nil
+}
+
+// writeFile writes a file relative to GOPATH, creating the directory if needed, or
+// in a dry run prints the diff from old.
+func (s *Settings) writeFile(targetFile string, old, b []byte) error {
+
+	if old != nil && bytes.Equal(old, b) {
+		return nil
+	}
+
+	if s.DryRun {
+		aName := "a/" + filepath.ToSlash(targetFile)
+		if old == nil {
+			aName = "/dev/null"
+		}
+		_, err := fmt.Fprint(s.stdout(), UnifiedDiff(aName, "b/"+filepath.ToSlash(targetFile), old, b))
+		if err != nil {
+			return err
+		}
+		if s.dryRunFiles == nil {
+			s.dryRunFiles = make(map[string][]byte)
+		}
+		s.dryRunFiles[targetFile] = b
+		return nil
+	}
+
+	outPath := filepath.Join(s.GOPATH, targetFile)
+	err := os.MkdirAll(filepath.Dir(outPath), 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outPath, b, 0644)
+}
+
+// outputFile writes generated content to a file, merging it with the sections of the
+// existing file if there is one (see above).  For Go source the result is formatted.
+func (s *Settings) outputFile(targetFile string, b []byte, goSrc bool) error {
+
+	newSegs, err := parseSections(b)
+	if err != nil {
+		return fmt.Errorf("%s: generated output: %v", targetFile, err)
+	}
+
+	old, err := s.readFile(targetFile)
+	if os.IsNotExist(err) {
+		return s.writeFile(targetFile, nil, joinSections(newSegs))
+	}
+	if err != nil {
+		return err
+	}
+
+	oldSegs, err := parseSections(old)
+	if err != nil && !s.Force {
+		return fmt.Errorf("%s: %v, use -force to overwrite it", targetFile, err)
+	}
+
+	out := joinSections(newSegs)
+
+	if err == nil && hasSections(oldSegs) && hasSections(newSegs) {
+
+		err = checkSections(targetFile, oldSegs)
+		if err != nil && !s.Force {
+			return err
+		}
+
+		merged := joinSections(mergeSections(oldSegs, newSegs))
+		if goSrc {
+			merged, err = addGoImports(merged, b)
+			if err != nil {
+				return fmt.Errorf("%s: %v", targetFile, err)
+			}
+			merged, err = s.FormatGoCode(targetFile, merged)
+			if err != nil {
+				return err
+			}
+			segs, err := parseSections(merged)
+			if err != nil {
+				return fmt.Errorf("%s: %v", targetFile, err)
+			}
+			merged = joinSections(segs)
+		}
+		out = merged
+
+	} else if !bytes.Equal(old, out) && !s.Force {
+		return fmt.Errorf("%s already exists and does not have generated sections, use -force to overwrite it", targetFile)
+	}
+
+	return s.writeFile(targetFile, old, out)
+}
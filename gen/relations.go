@@ -0,0 +1,536 @@
+package gen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gocaveman/caveman/ddl"
+)
+
+// Relations are declared to store-crud and ctrl-api-crud with a --relation flag for each,
+// in the form kind:Field:Model[:option=value,...], e.g.:
+//
+//	--relation has_many:TodoItemList:TodoItem:ondelete=cascade
+//	--relation belongs_to:TodoList:TodoList
+//	--relation belongs_to_many:TagList:Tag
+//
+// The field must exist on the model with `db:"-"` and the relation name as the json name
+// (a slice of Model for has_many and belongs_to_many, a pointer to Model for has_one and
+// belongs_to), e.g. TodoItemList []TodoItem `db:"-" json:"todo_item_list"`.  Models are assumed
+// to follow the conventions of the other generators: the table is the snake case of the
+// model name and the primary key is a string field named ModelID (column model_id).
+//
+// Options:
+//
+//	column=   the foreign key column; default is this model's key column (has_many,
+//	          has_one, in the other table) or the other model's key column (belongs_to)
+//	join=     the join table for belongs_to_many, default this_table_other_table
+//	ondelete= what deleting a record does to the related records of has_many and has_one:
+//	          cascade (delete them), setnull (clear the foreign key) or restrict (fail
+//	          if there are any); this should match the ddl foreign key of the column, the
+//	          store does it explicitly so the behavior is the same on databases where
+//	          foreign keys are not enforced (SQLite by default).  The same goes for has_many
+//	          records left out of the list when saving; without the option they are left as is
+//
+// The join table of belongs_to_many is created by a migration in the category given with
+// --category, versioned to run after the migrations which create the two tables.  These are
+// looked for in the package of the store (i.e. the migrations of the model generators), and
+// if they are in another category the join table migration depends on it (see ddl DependsOn).
+// If they are not found, use --join-version to give the version.
+
+// relationFlagUsage is the help for the --relation flag.
+const relationFlagUsage = "A relation, in the form kind:Field:Model[:option=value,...], kind being has_many, has_one, belongs_to or belongs_to_many (repeat for each relation, see gen/relations.go)."
+
+// ModelRelation is a relation from one model to another, as declared with --relation.
+type ModelRelation struct {
+	Kind  string // has_many, has_one, belongs_to or belongs_to_many
+	Field string // field on the model, e.g. "TodoItemList"
+	Name  string // the name used with "related", the snake case of Field, e.g. "todo_item_list"
+	Model string // the related model, e.g. "TodoItem"
+
+	IDField     string // the key field of the model the relation is on, e.g. "TodoListID"
+	Column      string // the foreign key column, see the column option
+	ColumnField string // the field for Column (on the related model for has_many and has_one)
+
+	OtherTable    string // the table of the related model, e.g. "todo_item"
+	OtherIDField  string // the key field of the related model, e.g. "TodoItemID"
+	OtherIDColumn string // the key column of the related model, e.g. "todo_item_id"
+
+	JoinTable       string // belongs_to_many: the join table
+	JoinColumn      string // belongs_to_many: the join table column referring to this model
+	JoinOtherColumn string // belongs_to_many: the join table column referring to the related model
+
+	OnDelete ddl.FKAction // has_many and has_one: what deleting does to related records
+
+	JoinVersion   string            // belongs_to_many: the version of the join table migration
+	JoinDependsOn map[string]string // belongs_to_many: category -> version the migration depends on
+}
+
+// parseModelRelations parses the --relation declarations for the model given.
+func parseModelRelations(modelName string, decls []string) ([]ModelRelation, error) {
+
+	var ret []ModelRelation
+	for _, decl := range decls {
+
+		parts := strings.SplitN(decl, ":", 4)
+		if len(parts) < 3 || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid relation %q, must be kind:Field:Model[:option=value,...]", decl)
+		}
+
+		r := ModelRelation{
+			Kind:  parts[0],
+			Field: parts[1],
+			Name:  ddl.SnakeCase(parts[1]),
+			Model: parts[2],
+		}
+		thisTable, otherTable := ddl.SnakeCase(modelName), ddl.SnakeCase(r.Model)
+		r.IDField = modelName + "ID"
+		r.OtherTable = otherTable
+		r.OtherIDField = r.Model + "ID"
+		r.OtherIDColumn = otherTable + "_id"
+
+		switch r.Kind {
+		case "has_many", "has_one":
+			r.Column = thisTable + "_id"
+		case "belongs_to":
+			r.Column = otherTable + "_id"
+		case "belongs_to_many":
+			r.JoinTable = thisTable + "_" + otherTable
+			r.JoinColumn = thisTable + "_id"
+			r.JoinOtherColumn = otherTable + "_id"
+			if r.JoinColumn == r.JoinOtherColumn { // relation to the same model
+				r.JoinOtherColumn = "other_" + r.JoinOtherColumn
+			}
+		default:
+			return nil, fmt.Errorf("invalid relation %q, unknown kind %q", decl, r.Kind)
+		}
+
+		if len(parts) > 3 {
+			for _, opt := range strings.Split(parts[3], ",") {
+				k, v := opt, ""
+				if i := strings.Index(opt, "="); i >= 0 {
+					k, v = opt[:i], opt[i+1:]
+				}
+				switch {
+				case k == "column" && r.Kind != "belongs_to_many":
+					r.Column = v
+				case k == "join" && r.Kind == "belongs_to_many":
+					r.JoinTable = v
+				case k == "ondelete" && (r.Kind == "has_many" || r.Kind == "has_one"):
+					a, err := ddl.ParseFKAction(v)
+					if err != nil {
+						return nil, fmt.Errorf("invalid relation %q: %v", decl, err)
+					}
+					if a == ddl.NoAction {
+						a = ""
+					}
+					r.OnDelete = a
+				default:
+					return nil, fmt.Errorf("invalid relation %q, unknown option %q for %s", decl, k, r.Kind)
+				}
+			}
+		}
+		if r.Column != "" {
+			r.ColumnField = columnFieldName(r.Column)
+		}
+
+		for _, r2 := range ret {
+			if r2.Field == r.Field {
+				return nil, fmt.Errorf("relation field %q declared more than once", r.Field)
+			}
+		}
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
+
+// setJoinVersions sets the version and dependencies of the join table migrations of the
+// belongs_to_many relations in rl, which go in category.  If version is not empty it is used
+// as is, otherwise the migrations which create the tables are looked for in dir.
+func setJoinVersions(rl []ModelRelation, dir, category, thisTable, version string) error {
+	for i := range rl {
+		r := &rl[i]
+		if r.Kind != "belongs_to_many" {
+			continue
+		}
+		if version != "" {
+			r.JoinVersion = version
+			continue
+		}
+		latest := ""
+		for _, table := range []string{thisTable, r.OtherTable} {
+			cat, ver, err := findCreateMigration(dir, table)
+			if err != nil {
+				return err
+			}
+			if ver == "" {
+				return fmt.Errorf("relation %q: no migration creating table %q found in %s, use --join-version to set the version of the join table migration", r.Field, table, dir)
+			}
+			if cat != category {
+				if r.JoinDependsOn == nil {
+					r.JoinDependsOn = make(map[string]string)
+				}
+				r.JoinDependsOn[cat] = ver
+				continue
+			}
+			if ver > latest {
+				latest = ver
+			}
+		}
+		if latest == "" {
+			latest = "0001"
+		}
+		r.JoinVersion = latest + "_create_" + r.JoinTable
+	}
+	return nil
+}
+
+var migrationCallRE = regexp.MustCompile(`\b(SetCategory|SetVersion|CreateTable)\("([^"]*)"\)`)
+
+// findCreateMigration looks through the Go files in dir (not tests) for a ddl Builder which
+// creates table, and returns the category and version it was set to at that point.  The
+// version is empty if there is none.
+func findCreateMigration(dir, table string) (category, version string, err error) {
+	fil, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", "", err
+	}
+	for _, fi := range fil {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ".go") || strings.HasSuffix(fi.Name(), "_test.go") {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return "", "", err
+		}
+		cat, ver := "", ""
+		for _, m := range migrationCallRE.FindAllStringSubmatch(string(b), -1) {
+			switch m[1] {
+			case "SetCategory":
+				cat = m[2]
+			case "SetVersion":
+				ver = m[2]
+			case "CreateTable":
+				if m[2] == table && cat != "" && ver != "" {
+					return cat, ver, nil
+				}
+			}
+		}
+	}
+	return "", "", nil
+}
+
+// relationsHaveKind returns true if any of the relations is of the kind given.
+func relationsHaveKind(rl []ModelRelation, kind string) bool {
+	for _, r := range rl {
+		if r.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// OnDeleteCascade returns true if deleting a record deletes the related records.
+func (r ModelRelation) OnDeleteCascade() bool { return r.OnDelete == ddl.Cascade }
+
+// OnDeleteSetNull returns true if deleting a record clears the foreign key of the related records.
+func (r ModelRelation) OnDeleteSetNull() bool { return r.OnDelete == ddl.SetNull }
+
+// OnDeleteRestrict returns true if a record cannot be deleted while it has related records.
+func (r ModelRelation) OnDeleteRestrict() bool { return r.OnDelete == ddl.Restrict }
+
+// storeCrudRelationsTmpl is the part of the store-crud template for relations, the methods
+// which load, save and delete related records and the join table migrations.
+const storeCrudRelationsTmpl = `
+{{define "relations"}}
+// load{{.ModelName}}Related loads the relations named for all of the records given, with one
+// query per relation (the join table is an extra query for belongs_to_many).  Only the
+// relations declared to the generator are loaded, the names of any others are returned.
+func (s *{{.StoreName}}) load{{.ModelName}}Related(tx *dbr.Tx, list []*{{.ModelName}}, related []string) ([]string, error) {
+
+	var rest []string
+	for _, name := range related {
+		switch name {
+{{range .Relations}}
+		case "{{.Name}}":
+{{- if eq .Kind "belongs_to"}}
+			ids := make([]string, 0, len(list))
+			for _, o := range list {
+				o.{{.Field}} = nil
+				if o.{{.ColumnField}} != "" {
+					ids = append(ids, o.{{.ColumnField}})
+				}
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			ti := s.Meta.For({{.Model}}{})
+			var rl []{{.Model}}
+			_, err := tx.Select(ti.SQLFields(true)...).From(ti.SQLName()).
+				Where(dbr.Eq("{{.OtherIDColumn}}", ids)).Load(&rl)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*{{.Model}}, len(rl))
+			for i := range rl {
+				byID[rl[i].{{.OtherIDField}}] = &rl[i]
+			}
+			for _, o := range list {
+				o.{{.Field}} = byID[o.{{.ColumnField}}]
+			}
+{{- else}}
+			ids := make([]string, 0, len(list))
+			byID := make(map[string]*{{$.ModelName}}, len(list))
+			for _, o := range list {
+				{{if eq .Kind "has_one"}}o.{{.Field}} = nil{{else}}o.{{.Field}} = make([]{{.Model}}, 0){{end}}
+				ids = append(ids, o.{{.IDField}})
+				byID[o.{{.IDField}}] = o
+			}
+			if len(ids) == 0 {
+				continue
+			}
+{{- if eq .Kind "belongs_to_many"}}
+			var jl []struct {
+				ID      string {{bq (printf "db:%q" .JoinColumn)}}
+				OtherID string {{bq (printf "db:%q" .JoinOtherColumn)}}
+			}
+			_, err := tx.Select("{{.JoinColumn}}", "{{.JoinOtherColumn}}").From(s.{{$.ModelNameL}}JoinTable("{{.JoinTable}}")).
+				Where(dbr.Eq("{{.JoinColumn}}", ids)).Load(&jl)
+			if err != nil {
+				return nil, err
+			}
+			if len(jl) == 0 {
+				continue
+			}
+			otherIDs := make([]string, 0, len(jl))
+			for _, j := range jl {
+				otherIDs = append(otherIDs, j.OtherID)
+			}
+			ti := s.Meta.For({{.Model}}{})
+			var rl []{{.Model}}
+			_, err = tx.Select(ti.SQLFields(true)...).From(ti.SQLName()).
+				Where(dbr.Eq("{{.OtherIDColumn}}", otherIDs)).Load(&rl)
+			if err != nil {
+				return nil, err
+			}
+			otherByID := make(map[string]*{{.Model}}, len(rl))
+			for i := range rl {
+				otherByID[rl[i].{{.OtherIDField}}] = &rl[i]
+			}
+			for _, j := range jl {
+				if o, r := byID[j.ID], otherByID[j.OtherID]; o != nil && r != nil {
+					o.{{.Field}} = append(o.{{.Field}}, *r)
+				}
+			}
+{{- else}}
+			ti := s.Meta.For({{.Model}}{})
+			var rl []{{.Model}}
+			_, err := tx.Select(ti.SQLFields(true)...).From(ti.SQLName()).
+				Where(dbr.Eq("{{.Column}}", ids)).Load(&rl)
+			if err != nil {
+				return nil, err
+			}
+			for i := range rl {
+				o := byID[rl[i].{{.ColumnField}}]
+				if o == nil {
+					continue
+				}
+				{{if eq .Kind "has_one"}}o.{{.Field}} = &rl[i]{{else}}o.{{.Field}} = append(o.{{.Field}}, rl[i]){{end}}
+			}
+{{- end}}
+{{- end}}
+{{end}}
+		default:
+			rest = append(rest, name)
+		}
+	}
+
+	return rest, nil
+}
+
+// save{{.ModelName}}Related saves the related records set on o, for the relations declared
+// to the generator which are not nil (nil means leave them as they are).  has_many and has_one
+// records get the foreign key set and are inserted if they have no ID, otherwise updated;
+// has_many records no longer in the list are deleted, get the foreign key cleared or make
+// the save fail according to the ondelete option, and are left as they are without it.
+// belongs_to_many is set to exactly the records in the list, which must already exist.
+// belongs_to is not saved, set the foreign key field instead.
+func (s *{{.StoreName}}) save{{.ModelName}}Related(tx *dbr.Tx, o *{{.ModelName}}) error {
+
+{{range .Relations}}
+{{- if eq .Kind "has_many"}}
+	if o.{{.Field}} != nil {
+		b := tmetadbr.New(tx, s.Meta)
+{{- if .OnDelete}}
+		keep := make([]string, 0, len(o.{{.Field}}))
+{{- end}}
+		for i := range o.{{.Field}} {
+			r := &o.{{.Field}}[i]
+			r.{{.ColumnField}} = o.{{.IDField}}
+			err := valid.Obj(r, nil)
+			if err != nil {
+				return err
+			}
+			if r.{{.OtherIDField}} == "" {
+				_, err = b.MustInsert(r).Exec()
+			} else {
+				err = b.ResultWithOneUpdate(b.MustUpdateByID(r).Exec())
+			}
+			if err != nil {
+				return err
+			}
+{{- if .OnDelete}}
+			keep = append(keep, r.{{.OtherIDField}})
+{{- end}}
+		}
+{{- if .OnDelete}}
+		ti := s.Meta.For({{.Model}}{})
+		cond := dbr.Eq("{{.Column}}", o.{{.IDField}})
+		if len(keep) > 0 {
+			cond = dbr.And(cond, dbr.Neq("{{.OtherIDColumn}}", keep))
+		}
+{{- if .OnDeleteCascade}}
+		_, err := tx.DeleteFrom(ti.SQLName()).Where(cond).Exec()
+{{- else if .OnDeleteSetNull}}
+		_, err := tx.Update(ti.SQLName()).Set("{{.Column}}", nil).Where(cond).Exec()
+{{- else}}
+		var n int64
+		err := tx.Select("count(1)").From(ti.SQLName()).Where(cond).LoadOne(&n)
+		if err == nil && n > 0 {
+			err = fmt.Errorf("{{$.ModelName}} %q has %d related {{.Model}} record(s) ({{.Name}}) not in the list, cannot remove them", o.{{.IDField}}, n)
+		}
+{{- end}}
+		if err != nil {
+			return err
+		}
+{{- end}}
+	}
+{{else if eq .Kind "has_one"}}
+	if r := o.{{.Field}}; r != nil {
+		b := tmetadbr.New(tx, s.Meta)
+		r.{{.ColumnField}} = o.{{.IDField}}
+		err := valid.Obj(r, nil)
+		if err != nil {
+			return err
+		}
+		if r.{{.OtherIDField}} == "" {
+			_, err = b.MustInsert(r).Exec()
+		} else {
+			err = b.ResultWithOneUpdate(b.MustUpdateByID(r).Exec())
+		}
+		if err != nil {
+			return err
+		}
+	}
+{{else if eq .Kind "belongs_to_many"}}
+	if o.{{.Field}} != nil {
+		joinTable := s.{{$.ModelNameL}}JoinTable("{{.JoinTable}}")
+		_, err := tx.DeleteFrom(joinTable).Where(dbr.Eq("{{.JoinColumn}}", o.{{.IDField}})).Exec()
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(o.{{.Field}}))
+		for _, r := range o.{{.Field}} {
+			if r.{{.OtherIDField}} == "" {
+				return valid.Messages{valid.Message{FieldName: "{{.Name}}", Code: "notnil", Message: "related {{.Model}} has no ID"}}
+			}
+			if seen[r.{{.OtherIDField}}] {
+				continue
+			}
+			seen[r.{{.OtherIDField}}] = true
+			_, err = tx.InsertInto(joinTable).Columns("{{.JoinColumn}}", "{{.JoinOtherColumn}}").
+				Values(o.{{.IDField}}, r.{{.OtherIDField}}).Exec()
+			if err != nil {
+				return err
+			}
+		}
+	}
+{{end}}
+{{- end}}
+	return nil
+}
+
+// delete{{.ModelName}}Related is called before o is deleted, and does what that means for
+// the related records: join table rows of belongs_to_many are removed, and has_many and
+// has_one follow the ondelete option (consistent with the foreign key in the database).
+func (s *{{.StoreName}}) delete{{.ModelName}}Related(tx *dbr.Tx, o *{{.ModelName}}) error {
+{{range .Relations}}
+{{- if eq .Kind "belongs_to_many"}}
+	{
+		_, err := tx.DeleteFrom(s.{{$.ModelNameL}}JoinTable("{{.JoinTable}}")).Where(dbr.Eq("{{.JoinColumn}}", o.{{.IDField}})).Exec()
+		if err != nil {
+			return err
+		}
+	}
+{{else if .OnDelete}}
+	{
+		ti := s.Meta.For({{.Model}}{})
+		cond := dbr.Eq("{{.Column}}", o.{{.IDField}})
+{{- if .OnDeleteCascade}}
+		_, err := tx.DeleteFrom(ti.SQLName()).Where(cond).Exec()
+{{- else if .OnDeleteSetNull}}
+		_, err := tx.Update(ti.SQLName()).Set("{{.Column}}", nil).Where(cond).Exec()
+{{- else}}
+		var n int64
+		err := tx.Select("count(1)").From(ti.SQLName()).Where(cond).LoadOne(&n)
+		if err == nil && n > 0 {
+			err = fmt.Errorf("{{$.ModelName}} %q has %d related {{.Model}} record(s) ({{.Name}}), cannot delete", o.{{.IDField}}, n)
+		}
+{{- end}}
+		if err != nil {
+			return err
+		}
+	}
+{{end}}
+{{- end}}
+	return nil
+}
+{{if .JoinTables}}
+// {{.ModelNameL}}JoinTable returns the name of a join table of {{.ModelName}}, with the same
+// table prefix as {{.ModelName}}.
+func (s *{{.StoreName}}) {{.ModelNameL}}JoinTable(name string) string {
+	return strings.TrimSuffix(s.Meta.For({{.ModelName}}{}).SQLName(), "{{.TableName}}") + name
+}
+
+func init() {
+	migrateregistry.MustRegisterList({{.ModelName}}RelationMigrations())
+}
+
+// {{.ModelName}}RelationMigrations creates the join tables of {{.ModelName}}.  Rows are
+// deleted along with either record they refer to.
+func {{.ModelName}}RelationMigrations() (ml migrate.MigrationList) {
+
+	fl := ddl.FormatterList{ddl.NewSQLite3Formatter(true), ddl.NewMySQLFormatter(true), ddl.NewPostgresFormatter(true)}
+
+	b := ddl.New()
+	b.SetCategory({{printf "%q" .Category}})
+{{range .Relations}}{{if eq .Kind "belongs_to_many"}}
+	b.SetVersion({{printf "%q" .JoinVersion}})
+{{- range $cat, $ver := .JoinDependsOn}}
+	b.DependsOn({{printf "%q" $cat}}, {{printf "%q" $ver}})
+{{- end}}
+	b.Up().
+		CreateTable("{{.JoinTable}}").
+		Column("{{.JoinColumn}}", ddl.VarCharFK).
+		Column("{{.JoinOtherColumn}}", ddl.VarCharFK).
+		PrimaryKeyColumns("{{.JoinColumn}}", "{{.JoinOtherColumn}}").
+		ForeignKey("{{.JoinTable}}_{{.JoinColumn}}_fk", "{{.JoinColumn}}", "{{$.TableName}}", "{{$.TableName}}_id").OnDelete(ddl.Cascade).
+		ForeignKey("{{.JoinTable}}_{{.JoinOtherColumn}}_fk", "{{.JoinOtherColumn}}", "{{.OtherTable}}", "{{.OtherIDColumn}}").OnDelete(ddl.Cascade).
+		Down().
+		DropTable("{{.JoinTable}}")
+	b.Up().
+		CreateIndex("{{.JoinTable}}_{{.JoinOtherColumn}}", "{{.JoinTable}}").Columns("{{.JoinOtherColumn}}").
+		Down().
+		DropIndex("{{.JoinTable}}_{{.JoinOtherColumn}}", "{{.JoinTable}}")
+	b.MustMigrations(fl...).AppendTo(&ml)
+{{end}}{{end}}
+	return
+}
+{{end}}
+{{end}}
+`
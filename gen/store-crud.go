@@ -3,8 +3,10 @@ package gen
 import (
 	"fmt"
 	"path"
+	"path/filepath"
 	"strings"
 
+	"github.com/gocaveman/caveman/ddl"
 	"github.com/spf13/pflag"
 )
 
@@ -16,6 +18,9 @@ func init() {
 		modelName := fset.String("model", "", "The model object name, if not specified default will be deduced from file name.")
 		genericMode := fset.Bool("generic", false, "Generic mode outputs methods that use interface{} instead of the specific type and can have the underlying model object swapped out.")
 		tests := fset.Bool("tests", true, "Create test file with test(s) for these store methods.")
+		relations := fset.StringArray("relation", nil, relationFlagUsage)
+		category := fset.String("category", "", "The migration category for join tables of belongs_to_many relations, default is 0100_ and the package name.")
+		joinVersion := fset.String("join-version", "", "The migration version for join tables of belongs_to_many relations, default is after the migrations creating the tables (see gen/relations.go).")
 		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
 		if err != nil {
 			return err
@@ -30,14 +35,53 @@ func init() {
 			modelNameFixed = NameSnakeToCamel(fname, []string{"store-"}, nil)
 		}
 		data["ModelName"] = modelNameFixed
+		data["ModelNameL"] = NameLowerFirst(modelNameFixed)
+		data["TableName"] = ddl.SnakeCase(modelNameFixed)
+
+		rl, err := parseModelRelations(modelNameFixed, *relations)
+		if err != nil {
+			return err
+		}
+		if len(rl) > 0 && *genericMode {
+			return fmt.Errorf("relations are not supported in generic mode")
+		}
+		data["JoinTables"] = relationsHaveKind(rl, "belongs_to_many")
+		data["Restrict"] = false
+		for _, r := range rl {
+			if r.OnDelete == ddl.Restrict {
+				data["Restrict"] = true
+			}
+		}
+		if *category == "" {
+			*category = "0100_" + data["PackageName"].(string)
+		}
+		data["Category"] = *category
+
+		targetDir, _ := path.Split(targetFile)
+		err = setJoinVersions(rl, filepath.Join(s.GOPATH, targetDir), *category, data["TableName"].(string), *joinVersion)
+		if err != nil {
+			return err
+		}
+		data["Relations"] = rl
 
 		err = OutputGoSrcTemplate(s, data, targetFile, `
 package {{.PackageName}}
 
 import (
  	"context"
+{{- if .Restrict}}
+ 	"fmt"
+{{- end}}
+{{- if .JoinTables}}
+ 	"strings"
+{{- end}}
 
  	"github.com/bradleypeabody/gouuidv6"
+{{- if .JoinTables}}
+ 	"github.com/gocaveman/caveman/ddl"
+ 	"github.com/gocaveman/caveman/migrate"
+ 	"github.com/gocaveman/caveman/migrate/migrateregistry"
+{{- end}}
  	"github.com/gocaveman/caveman/valid"
  	"github.com/gocaveman/tmeta/tmetadbr"
  	"github.com/gocaveman/tmeta/tmetautil"
@@ -63,7 +107,12 @@ func (s *{{.StoreName}}) Create{{.ModelName}}(ctx context.Context, o {{if .Gener
 	if err != nil {
 		return err
 	}
-
+{{if .Relations}}
+	err = s.save{{.ModelName}}Related(tx, o)
+	if err != nil {
+		return err
+	}
+{{end}}
 	return tx.Commit()
 }
 
@@ -86,10 +135,49 @@ func (s *{{.StoreName}}) Update{{.ModelName}}(ctx context.Context, o {{if .Gener
 	if err != nil {
 		return err
 	}
-
+{{if .Relations}}
+	err = s.save{{.ModelName}}Related(tx, o)
+	if err != nil {
+		return err
+	}
+{{end}}
 	return tx.Commit()
 }
 
+// Update{{.ModelName}}List updates several records in one transaction, either all of
+// them are saved or none are.  On error the index of the record which failed is
+// returned along with it (-1 if it was not any particular record).
+func (s *{{.StoreName}}) Update{{.ModelName}}List(ctx context.Context, list []{{if .GenericMode}}interface{}{{else}}*{{.ModelName}}{{end}}) (int, error) {
+
+	tx, err := s.dbrc.NewSession(s.EventReceiver).BeginTx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	b := tmetadbr.New(tx, s.Meta)
+	for i, o := range list {
+
+		err = valid.Obj(o, nil)
+		if err != nil {
+			return i, err
+		}
+
+		err = b.ResultWithOneUpdate(b.MustUpdateByID(o).Exec())
+		if err != nil {
+			return i, err
+		}
+{{if .Relations}}
+		err = s.save{{.ModelName}}Related(tx, o)
+		if err != nil {
+			return i, err
+		}
+{{end}}
+	}
+
+	return -1, tx.Commit()
+}
+
 // Delete{{.ModelName}} deletes this record in the database.
 func (s *{{.StoreName}}) Delete{{.ModelName}}(ctx context.Context, o {{if .GenericMode}}interface{}{{else}}*{{.ModelName}}{{end}}) error {
 
@@ -98,7 +186,12 @@ func (s *{{.StoreName}}) Delete{{.ModelName}}(ctx context.Context, o {{if .Gener
 		return err
 	}
 	defer tx.RollbackUnlessCommitted()
-
+{{if .Relations}}
+	err = s.delete{{.ModelName}}Related(tx, o)
+	if err != nil {
+		return err
+	}
+{{end}}
 	b := tmetadbr.New(tx, s.Meta)
 	err = b.ResultWithOneUpdate(b.MustDeleteByID(o).Exec())
 	if err != nil {
@@ -122,7 +215,12 @@ func (s *{{.StoreName}}) Fetch{{.ModelName}}(ctx context.Context, o {{if .Generi
 	if err != nil {
 		return err
 	}
-
+{{if .Relations}}
+	related, err = s.load{{.ModelName}}Related(tx, []*{{.ModelName}}{o}, related)
+	if err != nil {
+		return err
+	}
+{{end}}
 	ti := s.Meta.For(o)
 	for _, r := range related {
 		rstmt, err := b.SelectRelation(o, r)
@@ -228,7 +326,16 @@ func (s *{{.StoreName}}) Search{{.ModelName}}(ctx context.Context, criteria tmet
 	if err != nil {
 		return nil, err
 	}
-
+{{if .Relations}}
+	ptrs := make([]*{{.ModelName}}, len(ret))
+	for i := range ret {
+		ptrs[i] = &ret[i]
+	}
+	related, err = s.load{{.ModelName}}Related(tx, ptrs, related)
+	if err != nil {
+		return nil, err
+	}
+{{end}}
 	if len(related) > 0 {
 		for i := range ret {
 			for _, r := range related {
@@ -247,6 +354,7 @@ func (s *{{.StoreName}}) Search{{.ModelName}}(ctx context.Context, criteria tmet
 
 	return ret, tx.Commit()
 }
+{{if .Relations}}{{template "relations" .}}{{end}}
 
 
 {{/* NOTE: the paging/limits here are all based on the idea of not overloading the database server and putting sensible limits on how
@@ -263,11 +371,7 @@ func (s *{{.StoreName}}) Search{{.ModelName}}(ctx context.Context, criteria tmet
 
 // TODO: upsert? - maybe it's an option to add an example if desired.
 
-// TODO: related/joins; also specifically look at having methods that say "set the
-// list of this type of join to exactly X set as one call in one transaction", rather
-// than having to delete and re-write.
-
-`, false)
+`+storeCrudRelationsTmpl, false)
 		if err != nil {
 			return err
 		}
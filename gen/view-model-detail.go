@@ -1,8 +1,15 @@
 package gen
 
-// generates form from model
+import (
+	"github.com/spf13/pflag"
+)
 
-// mobile-first
+// The detail page is a form for the model loaded by ctrl-pages (or an empty one, to
+// create a record), saved via the API generated by ctrl-api-crud.  Inputs are made
+// from the struct tags: the type from the column type, required/minlength/maxlength/
+// pattern/min/max from the valid rules, so the browser checks the same things the
+// server does.  Errors from saving are shown next to the field (validation messages
+// have the Go field name) or at the top of the form.
 
 // embedability - think about what happens if we want to move this to an include file
 //  and call it from JS in a modal or something, what can we do to make that scenario painless
@@ -14,11 +21,188 @@ package gen
 //  name, we can even do {{if .Once}} ... {{end}} - which would be rad, although maybe
 //  {{if .Once "/template-name.gohtml"}} ... {{end}} is more practical.
 
-// can customize fields
+// need to be able to pass in something that allows us to look up relations - definitely needs think-through
 
-// loads page data from controller
+func init() {
+	globalMapGenerator["view-model-detail"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
 
-// need to be able to pass in something that allows us to look up relations - definitely needs think-through
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		model := fset.String("model", "", "The model struct, in the form file.go:TypeName (required).")
+		pagePrefix := fset.String("page-prefix", "", "The path of the listing page, as used by ctrl-pages (defaults to '/' plus the model name, e.g. '/todo-item').")
+		apiPrefix := fset.String("api-prefix", "/api", "The prefix of the API generated by ctrl-api-crud.")
+		include := fset.String("include", "/main-page.gohtml", "The page template from the theme which defines the layout.")
+		loginPath := fset.String("login-path", "", "If set, a 401 response from saving redirects here, with return_to set to the current page.")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		_, err = viewModelData(s, data, *model, *pagePrefix, *apiPrefix, *include)
+		if err != nil {
+			return err
+		}
+		data["LoginPath"] = *loginPath
+
+		return OutputTemplate(s, data, targetFile, `---
+title: [[.ModelLabel]]
+---
+{{template "[[.Include]]" .}}
+
+{{define "body"}}
+{{$o := .Value "[[.ModelNameL]]"}}
+<div class="detail" id="[[.ElementID]]-detail">
+
+	<p><a class="detail-back" href="[[.PagePrefix]]">[[plural .ModelLabel]]</a></p>
+
+	<h1>{{if $o}}[[.ModelLabel]]{{else}}New [[.ModelLabel]]{{end}}</h1>
+
+	<form class="detail-form" method="POST" data-id="{{if $o}}{{$o.[[.PK.Name]]}}{{end}}">
+
+		<p class="detail-error detail-form-error"></p>
+
+		[[range .Fields]][[if .ReadOnly]]
+		{{if $o}}
+		<div class="detail-field">
+			<label>[[.Label]]</label>
+			<span class="detail-value">{{$o.[[.Name]]}}</span>
+		</div>
+		{{end}}
+		[[else]]
+		<div class="detail-field" data-field="[[.Name]]">
+			[[- if eq .InputType "checkbox"]]
+			<label><input type="checkbox" name="[[.JSONName]]" data-type="checkbox"{{if $o}}{{if $o.[[.Name]]}} checked{{end}}{{end}}> [[.Label]]</label>
+			[[- else]]
+			<label for="[[$.ElementID]]-[[.JSONName]]">[[.Label]]</label>
+			[[- if eq .InputType "textarea"]]
+			<textarea id="[[$.ElementID]]-[[.JSONName]]" name="[[.JSONName]]"[[template "attrs" .]]>{{if $o}}{{$o.[[.Name]]}}{{end}}</textarea>
+			[[- else if eq .InputType "datetime-local"]]
+			<input type="datetime-local" id="[[$.ElementID]]-[[.JSONName]]" name="[[.JSONName]]" data-type="datetime"[[template "attrs" .]] value="{{if $o}}[[if eq .GoType "time.Time"]]{{$o.[[.Name]].Format "2006-01-02T15:04"}}[[else]]{{$o.[[.Name]]}}[[end]]{{end}}">
+			[[- else]]
+			<input type="[[.InputType]]" id="[[$.ElementID]]-[[.JSONName]]" name="[[.JSONName]]"[[if eq .InputType "number"]] data-type="number"[[if or (eq .GoType "float64") (eq .GoType "float32")]] step="any"[[end]][[end]][[template "attrs" .]] value="{{if $o}}{{$o.[[.Name]]}}{{end}}">
+			[[- end]]
+			[[- end]]
+			<span class="detail-error"></span>
+		</div>
+		[[end]][[end]]
+
+		<p class="detail-buttons">
+			<button type="submit">Save</button>
+			{{if $o}}<button type="button" class="detail-delete">Delete</button>{{end}}
+		</p>
+
+	</form>
+
+</div>
+
+<script>
+(function() {
+
+	var apiPath = "[[.APIPath]]";
+	var pagePrefix = "[[.PagePrefix]]";
+	var pkName = "[[.PK.JSONName]]";
+	var loginPath = "[[.LoginPath]]";
+
+	var form = document.querySelector("#[[.ElementID]]-detail .detail-form");
+	var id = form.getAttribute("data-id");
+
+	function clearErrors() {
+		Array.prototype.forEach.call(form.querySelectorAll(".detail-error"), function(el) {
+			el.textContent = "";
+		});
+	}
+
+	// showError puts validation messages next to their field, anything else at the top
+	function showError(res, data) {
+		if (res.status == 401 && loginPath) {
+			location.href = loginPath + "?return_to=" + encodeURIComponent(location.pathname + location.search);
+			return;
+		}
+		var shown = false;
+		if (Array.isArray(data.data)) {
+			data.data.forEach(function(m) {
+				var el = m.field_name && form.querySelector('[data-field="' + m.field_name + '"] .detail-error');
+				if (el) {
+					el.textContent = m.message || m.messsage || "invalid";
+					shown = true;
+				}
+			});
+		}
+		if (!shown || data.message) {
+			form.querySelector(".detail-form-error").textContent = data.message || res.statusText;
+		}
+	}
+
+	function call(method, url, obj) {
+		clearErrors();
+		return fetch(url, {
+			method: method,
+			credentials: "same-origin",
+			headers: {"Content-Type": "application/json"},
+			body: obj ? JSON.stringify(obj) : undefined
+		}).then(function(res) {
+			return res.json().then(function(data) {
+				if (!res.ok) {
+					showError(res, data);
+					return null;
+				}
+				return data;
+			}, function() {
+				showError(res, {});
+				return null;
+			});
+		});
+	}
+
+	form.addEventListener("submit", function(e) {
+		e.preventDefault();
+		var obj = {};
+		Array.prototype.forEach.call(form.elements, function(el) {
+			if (!el.name) {
+				return;
+			}
+			switch (el.getAttribute("data-type")) {
+			case "checkbox":
+				obj[el.name] = el.checked;
+				break;
+			case "number":
+				obj[el.name] = el.value === "" ? 0 : Number(el.value);
+				break;
+			case "datetime":
+				obj[el.name] = el.value === "" ? null : new Date(el.value).toISOString();
+				break;
+			default:
+				obj[el.name] = el.value;
+			}
+		});
+		var req = id ?
+			call("PATCH", apiPath + "/" + encodeURIComponent(id), obj) :
+			call("POST", apiPath, obj);
+		req.then(function(data) {
+			if (data) {
+				location.href = pagePrefix + "/" + encodeURIComponent(data[pkName] || id);
+			}
+		});
+	});
+
+	var del = form.querySelector(".detail-delete");
+	if (del) {
+		del.addEventListener("click", function() {
+			if (!confirm("Delete this [[.ModelLabel]]?")) {
+				return;
+			}
+			call("DELETE", apiPath + "/" + encodeURIComponent(id)).then(function(data) {
+				if (data) {
+					location.href = pagePrefix;
+				}
+			});
+		});
+	}
+
+})();
+</script>
+
+{{end}}
+[[define "attrs"]][[if .Required]] required[[end]][[if .MinLen]] minlength="[[.MinLen]]"[[end]][[if .MaxLen]] maxlength="[[.MaxLen]]"[[end]][[if .Pattern]] pattern="[[html .Pattern]]"[[end]][[if .MinVal]] min="[[.MinVal]]"[[end]][[if .MaxVal]] max="[[.MaxVal]]"[[end]][[end]]`, false)
 
-// error message handling on saving
-// including login redirection for when login times out
+	})
+}
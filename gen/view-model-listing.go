@@ -1,57 +1,302 @@
 package gen
 
-// listing - probably loads ajax (rather than page data), because
-// search will need that anyway
+import (
+	"fmt"
+	"strings"
 
-// mobile-first
+	"github.com/gocaveman/caveman/ddl"
+	"github.com/spf13/pflag"
+)
 
-// embedability - think about what happens if we want to move this to an include file
-//  and call it from JS in a modal or something, what can we do to make that scenario painless
+// The listing page is rendered with the page of records loaded by ctrl-pages, with
+// column headings that sort and paging links, all of which are plain links so any
+// page of the listing has a permalink.
+//
+// Fancy search: The case of a single 'word' typed in to the search results in a
+// query against the Search API for each of the search fields, in sequence, with
+// the word as a prefix (LIKE 'word%').  It stops when it gets the max number of
+// results (50 by default).  Example - we search "email", "phone", "company_name",
+// "first_name", "last_name" - in that sequence.  The guy types in a phone number,
+// great, one match.  Same with an email.  But if he types in "joe" it will end up
+// searching for "joe%" in those fields and probably return some things for the
+// company, first and last name.  If you want fast and simple single-field searches,
+// make the field list just one field and it has the right behavior.  The query is
+// put in the URL (?q=...) so the search is also a permalink.
 
-// sort by columns
+// Case-sensitivity: the ddl package uses case-insensitive collations for VarChar and
+// Text columns (and case sensitive for keys), so like 'joe%' does the right thing.
 
-// max record limit
-
-// ability to add the idea that you can drag and drop a sequnece for things - not core functionalityu
+// TODO: ability to drag and drop a sequence for things - not core functionality
 // but needs to be addable if needed; possibly a separate generator
 
-// paging - think about how it integrates with search, and how permalinks work
-
-// fancy search: Here’s the idea I’m leaning toward:
-// In our listing pages, we basically have a pattern where we
-// have a set of which fields are searched.  The case of a single
-// ‘word’ typed in (i.e. no space) to the search, would result in
-// an AJAX query for each of the fields, in sequence, with a max
-// limit on each one, for example 50.  It also stops when it gets
-// 50 results.  Example - we search “email”, “phone”, “company_name”,
-// “first_name”, “last_name” - in that sequence.  And just fill up to
-// 50 records.  So the guy types in a phone number, great, one match.
-// Same with and email.  But if he types in “joe” it will end up
-// searching for “joe%” in those fields and probably return some
-// things for the company, first and last. It would be a bit slower,
-// but it would be really cool and useful.  And once the pattern is there,
-// pretty easy to do. (And if you want fast and simple single-field
-// searches, you just make the field list just one field and it has
-// the right behavior.)
-
-// think through how case-sensitivity works
-// current idea: both mysql and sqlite3 provide case-insensitive collations, it
-// makes good sense to just use the right collation for fields in the migrations
-// we provide and then like 'joe%' will do the right thing.  (need to make sure that
-// string keys (base64 uuids) are case sensitive but pretty much everything else is
-// case insensitive); for postgress we need to use ILIKE, maybe it can be a hack
-// in the store layer to structure the query in that way (or look at adding it to
-// dbrobj to help abstract it away) - either way, get the database to do it for us -
-// it's somewhat obscure but is actually supported if you know what you're doing;
-// user can also just change the collation for a field if case-insensitivity is wrong,
-// but for common cases it will be correct.
-
-// toggle which fields it searches?
-
-// option to edit records inline! (to add them too? delete? - what about field validation...
-// this starts to get really compilcated, see if it's worth it, or if there is some
-// simplification than can be done to limit the complexity on how this is implemented -
-// it would very very cool though...)  See if there is a way to combine logic with the
-// detail page, otherwise it may start to be duplicative... such as...:
-// error message handling on saving
-// including login redirection for when login times out
+// TODO: option to edit records inline - see if there is a way to combine logic
+// with the detail page, otherwise it may start to be duplicative
+
+func init() {
+	globalMapGenerator["view-model-listing"] = GeneratorFunc(func(s *Settings, name string, args ...string) error {
+
+		fset := pflag.NewFlagSet("gen", pflag.ContinueOnError)
+		model := fset.String("model", "", "The model struct, in the form file.go:TypeName (required).")
+		columns := fset.StringSlice("columns", nil, "The fields shown as columns, in order (defaults to the editable fields other than long text).")
+		search := fset.StringSlice("search", nil, "The fields searched, in sequence (defaults to the editable text fields).")
+		maxResults := fset.Int("max-results", 50, "The number of search results to fill up to.")
+		pagePrefix := fset.String("page-prefix", "", "The path of the listing page, as used by ctrl-pages (defaults to '/' plus the model name, e.g. '/todo-item').")
+		apiPrefix := fset.String("api-prefix", "/api", "The prefix of the API generated by ctrl-api-crud.")
+		include := fset.String("include", "/main-page.gohtml", "The page template from the theme which defines the layout.")
+		targetFile, data, err := ParsePFlagsAndOneFile(s, fset, args)
+		if err != nil {
+			return err
+		}
+
+		fields, err := viewModelData(s, data, *model, *pagePrefix, *apiPrefix, *include)
+		if err != nil {
+			return err
+		}
+
+		var cols ModelFieldList
+		if len(*columns) > 0 {
+			cols, err = fields.Select(*columns)
+			if err != nil {
+				return err
+			}
+		} else {
+			for _, f := range fields {
+				if !f.ReadOnly && f.InputType != "textarea" {
+					cols = append(cols, f)
+				}
+			}
+			if len(cols) == 0 {
+				cols = append(cols, *fields.PKField())
+			}
+		}
+		data["Columns"] = cols
+
+		var searchFields ModelFieldList
+		if len(*search) > 0 {
+			searchFields, err = fields.Select(*search)
+			if err != nil {
+				return err
+			}
+		} else {
+			for _, f := range fields {
+				if !f.ReadOnly && (f.DataType == ddl.VarChar || f.DataType == ddl.Text) {
+					searchFields = append(searchFields, f)
+				}
+			}
+		}
+		var labels []string
+		for _, f := range searchFields {
+			labels = append(labels, f.Label)
+		}
+		data["SearchFields"] = searchFields
+		data["SearchLabels"] = strings.Join(labels, ", ")
+		data["MaxResults"] = *maxResults
+
+		return OutputTemplate(s, data, targetFile, `---
+title: [[plural .ModelLabel]]
+---
+{{template "[[.Include]]" .}}
+
+{{define "body"}}
+{{$list := .Value "[[.ModelNameL]]List"}}
+{{$params := .Value "[[.ModelNameL]]ListParams"}}
+<div class="listing" id="[[.ElementID]]-listing">
+
+	<h1>[[plural .ModelLabel]]</h1>
+
+	<p><a class="listing-new" href="[[.PagePrefix]]/new">New [[.ModelLabel]]</a></p>
+
+	[[if .SearchFields]]
+	<form class="listing-search" method="GET" action="[[.PagePrefix]]">
+		<input type="search" name="q" placeholder="Search [[html .SearchLabels]]" autocomplete="off">
+		<button type="submit">Search</button>
+	</form>
+	<p class="listing-search-status"></p>
+	[[end]]
+
+	<table class="listing-table">
+		<thead>
+			<tr>
+				[[range .Columns]]
+				<th>{{if $params}}<a href="?sort={{$params.SortBy "[[.DBName]]"}}&amp;limit={{$params.Limit}}">[[.Label]]</a>{{else}}[[.Label]]{{end}}</th>
+				[[end]]
+			</tr>
+		</thead>
+		<tbody class="listing-results">
+			{{range $list}}
+			<tr>
+				[[range $i, $c := .Columns]]
+				<td>[[if eq $i 0]]<a href="[[$.PagePrefix]]/{{.[[$.PK.Name]]}}">{{.[[$c.Name]]}}</a>[[else]]{{.[[$c.Name]]}}[[end]]</td>
+				[[end]]
+			</tr>
+			{{else}}
+			<tr><td colspan="[[len .Columns]]">No [[plural .ModelLabel]] found.</td></tr>
+			{{end}}
+		</tbody>
+	</table>
+
+	{{if $params}}{{if gt $params.PageCount 1}}
+	<nav class="listing-pages">
+		{{if $params.HasPrev}}<a href="?sort={{$params.Sort}}&amp;limit={{$params.Limit}}&amp;offset={{$params.PrevOffset}}" rel="prev">Previous</a>{{end}}
+		<span>Page {{$params.PageNum}} of {{$params.PageCount}}</span>
+		{{if $params.HasNext}}<a href="?sort={{$params.Sort}}&amp;limit={{$params.Limit}}&amp;offset={{$params.NextOffset}}" rel="next">Next</a>{{end}}
+	</nav>
+	{{end}}{{end}}
+
+</div>
+
+[[if .SearchFields]]
+<script>
+(function() {
+
+	var apiPath = "[[.APIPath]]";
+	var pagePrefix = "[[.PagePrefix]]";
+	var pkName = "[[.PK.JSONName]]";
+	var maxResults = [[.MaxResults]];
+
+	// searched in this sequence until maxResults are found
+	var searchFields = [ [[- range $i, $f := .SearchFields]][[if $i]], [[end]]"[[$f.DBName]]"[[end -]] ];
+
+	// the columns of the table, the first links to the detail page
+	var columns = [ [[- range $i, $c := .Columns]][[if $i]], [[end]]"[[$c.JSONName]]"[[end -]] ];
+
+	var listing = document.getElementById("[[.ElementID]]-listing");
+	var form = listing.querySelector(".listing-search");
+	var status = listing.querySelector(".listing-search-status");
+	var tbody = listing.querySelector(".listing-results");
+	var pages = listing.querySelector(".listing-pages");
+
+	// searchSeq queries each of the search fields in turn for values starting with q,
+	// resolving to the combined (de-duplicated) results
+	function searchSeq(q) {
+		var results = [], seen = {}, i = 0;
+		function next() {
+			if (i >= searchFields.length || results.length >= maxResults) {
+				return Promise.resolve(results);
+			}
+			var field = searchFields[i++];
+			return fetch(apiPath + "/search", {
+				method: "POST",
+				credentials: "same-origin",
+				headers: {"Content-Type": "application/json"},
+				body: JSON.stringify({
+					criteria: [{field: field, op: "like", value: q.replace(/[%_]/g, "") + "%"}],
+					order_by: [{field: field}],
+					limit: maxResults - results.length
+				})
+			}).then(function(res) {
+				return res.json().then(function(data) {
+					if (!res.ok) {
+						throw new Error(data.message || res.statusText);
+					}
+					(data.result_list || []).forEach(function(o) {
+						if (!seen[o[pkName]]) {
+							seen[o[pkName]] = true;
+							results.push(o);
+						}
+					});
+					return next();
+				});
+			});
+		}
+		return next();
+	}
+
+	function showResults(results) {
+		tbody.innerHTML = "";
+		results.forEach(function(o) {
+			var tr = document.createElement("tr");
+			columns.forEach(function(c, i) {
+				var td = document.createElement("td");
+				var v = o[c] == null ? "" : String(o[c]);
+				if (i == 0) {
+					var a = document.createElement("a");
+					a.href = pagePrefix + "/" + encodeURIComponent(o[pkName]);
+					a.textContent = v;
+					td.appendChild(a);
+				} else {
+					td.textContent = v;
+				}
+				tr.appendChild(td);
+			});
+			tbody.appendChild(tr);
+		});
+	}
+
+	function doSearch(q) {
+		form.q.value = q;
+		status.textContent = "Searching...";
+		if (pages) {
+			pages.style.display = "none";
+		}
+		searchSeq(q).then(function(results) {
+			status.textContent = results.length + (results.length >= maxResults ? "+" : "") + " found";
+			showResults(results);
+		}, function(err) {
+			status.textContent = "Search failed: " + err.message;
+		});
+	}
+
+	form.addEventListener("submit", function(e) {
+		var q = form.q.value.trim();
+		if (q == "") {
+			return; // regular submit, back to the listing
+		}
+		e.preventDefault();
+		// permalink
+		history.replaceState(null, "", pagePrefix + "?q=" + encodeURIComponent(q));
+		doSearch(q);
+	});
+
+	var m = /[?&]q=([^&]*)/.exec(location.search);
+	if (m && m[1]) {
+		doSearch(decodeURIComponent(m[1].replace(/\+/g, " ")));
+	}
+
+})();
+</script>
+[[end]]
+
+{{end}}
+`, false)
+
+	})
+}
+
+// viewModelData parses the model and sets the data used by the listing and detail templates (and api-client-ts).
+func viewModelData(s *Settings, data map[string]interface{}, model, pagePrefix, apiPrefix, include string) (ModelFieldList, error) {
+
+	if model == "" {
+		return nil, fmt.Errorf("-model is required")
+	}
+	fileName, typeName, err := parseModelFlag(s, model)
+	if err != nil {
+		return nil, err
+	}
+	// the model may have only been "written" so far, in a dry run
+	fields, err := goStructModelFields(fileName, s.dryRunSource(fileName), typeName)
+	if err != nil {
+		return nil, err
+	}
+	pk := fields.PKField()
+	if pk == nil {
+		return nil, fmt.Errorf("model %q has no primary key field (use `tmeta:\"pk\"`)", typeName)
+	}
+
+	pathPart := strings.Replace(ddl.SnakeCase(typeName), "_", "-", -1)
+	if pagePrefix == "" {
+		pagePrefix = "/" + pathPart
+	}
+
+	data["ModelName"] = typeName
+	data["ModelNameL"] = NameLowerFirst(typeName)
+	data["ModelLabel"] = camelToWords(typeName)
+	data["ElementID"] = pathPart
+	data["PagePrefix"] = "/" + strings.Trim(pagePrefix, "/")
+	data["APIPath"] = strings.TrimRight(apiPrefix, "/") + "/" + pathPart
+	data["Include"] = include
+	data["Fields"] = fields
+	data["PK"] = pk
+
+	return fields, nil
+}
@@ -1,6 +1,7 @@
 package httpapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -8,15 +9,42 @@ import (
 
 var errNotFillable = fmt.Errorf("field not fillable")
 
-func Fill(dst, src interface{}) (reterr error) {
+// FilledFields is the names of the fields set by FillTracked, in the order they were set.
+type FilledFields []string
+
+// Has returns true if the field name given was filled.
+func (f FilledFields) Has(name string) bool {
+	for _, n := range f {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Fill copies the fields of src to dst, each of which can be a map or a struct.  For a
+// struct dst only fields marked "fillable" in the httpapi tag are set (e.g. `httpapi:",fillable"`,
+// the name being the snake case of the field name unless given in the tag), others are skipped.
+// Values are converted to the type of the field where needed, so e.g. a map decoded from
+// JSON can be used as the source.
+func Fill(dst, src interface{}) error {
+	_, err := FillTracked(dst, src)
+	return err
+}
+
+// FillTracked is like Fill but also returns the names of the fields which were present in
+// src and set in dst, so callers can tell a field given as empty or null from one not given
+// (e.g. to only update what a PATCH request included).  Map keys are filled in sorted order.
+func FillTracked(dst, src interface{}) (filled FilledFields, reterr error) {
 
 	// catch any of the reflect panic stuff and return it as an error
 	defer func() {
 		if r := recover(); r != nil {
 			if rerr, ok := r.(error); ok {
 				reterr = rerr
+			} else {
+				reterr = fmt.Errorf("caught panic: %v", r)
 			}
-			reterr = fmt.Errorf("caught panic: %v", r)
 		}
 	}()
 
@@ -28,8 +56,7 @@ func Fill(dst, src interface{}) (reterr error) {
 
 	if srcType.Kind() == reflect.Map {
 
-		keyVals := srcObj.MapKeys()
-		for _, kv := range keyVals {
+		for _, kv := range sortedKeys(srcObj) {
 			kstr := fmt.Sprintf("%v", kv.Interface())
 
 			vv := srcObj.MapIndex(kv).Interface()
@@ -40,17 +67,21 @@ func Fill(dst, src interface{}) (reterr error) {
 				continue
 			}
 			if err != nil {
-				return err
+				return filled, err
 			}
+			filled = append(filled, kstr)
 
 		}
 
-		return nil
+		return filled, nil
 
 	} else if srcType.Kind() == reflect.Struct {
 
 		for i := 0; i < srcType.NumField(); i++ {
 			f := srcType.Field(i)
+			if f.PkgPath != "" { // unexported
+				continue
+			}
 
 			// use snake version of Go field name or httpapi tag if present
 			fieldName := toSnake(f.Name)
@@ -66,18 +97,21 @@ func Fill(dst, src interface{}) (reterr error) {
 				continue
 			}
 			if err != nil {
-				return err
+				return filled, err
 			}
+			filled = append(filled, fieldName)
 
 		}
 
-		return nil
+		return filled, nil
 
 	}
 
-	return fmt.Errorf("cannot fill from type %T", src)
+	return nil, fmt.Errorf("cannot fill from type %T", src)
 }
 
+// fillField sets a field of dst, returning errNotFillable if there is no such field or it
+// is not fillable.
 func fillField(dst interface{}, fieldName string, val interface{}) error {
 
 	dstObj := reflect.ValueOf(dst)
@@ -122,11 +156,11 @@ func fillField(dst interface{}, fieldName string, val interface{}) error {
 			}
 
 			// do the actual assignment
-			dstObj.Field(i).Set(reflect.ValueOf(val))
+			return setFieldValue(dstObj.Field(i), fieldName, val)
 
 		}
 
-		return nil
+		return errNotFillable
 
 	}
 
@@ -134,6 +168,54 @@ func fillField(dst interface{}, fieldName string, val interface{}) error {
 
 }
 
+// setFieldValue assigns val to a field, converting it if it is not of the field's type:
+// nil is the zero value, numbers are converted to other number types (if they fit), and
+// otherwise val is converted through JSON (e.g. a map to a struct, or a string to a time).
+func setFieldValue(fv reflect.Value, fieldName string, val interface{}) error {
+
+	if val == nil {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	v := reflect.ValueOf(val)
+	if v.Type().AssignableTo(fv.Type()) {
+		fv.Set(v)
+		return nil
+	}
+
+	if isNumberKind(v.Kind()) && isNumberKind(fv.Kind()) {
+		cv := v.Convert(fv.Type())
+		if cv.Convert(v.Type()).Interface() != v.Interface() {
+			return fmt.Errorf("field %s: value %v does not fit in %s", fieldName, val, fv.Type())
+		}
+		fv.Set(cv)
+		return nil
+	}
+
+	b, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("field %s: %v", fieldName, err)
+	}
+	nv := reflect.New(fv.Type())
+	err = json.Unmarshal(b, nv.Interface())
+	if err != nil {
+		return fmt.Errorf("field %s: cannot convert %T to %s", fieldName, val, fv.Type())
+	}
+	fv.Set(nv.Elem())
+	return nil
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
 // // NOTE: need to document that fieldName will be the JSON name - NOT the Go name, i.e. "customer_id" not "CustomerID"
 
 // type readFielder interface {
@@ -73,3 +73,48 @@ func TestFill(t *testing.T) {
 	}
 
 }
+
+type FillS2 struct {
+	Name     string   `httpapi:"name,fillable"`
+	Count    int64    `httpapi:"count,fillable"`
+	Price    *float64 `httpapi:"price,fillable"`
+	Tags     []string `httpapi:"tags,fillable"`
+	Internal string   `httpapi:"internal"`
+}
+
+func TestFillTracked(t *testing.T) {
+
+	assert := assert.New(t)
+
+	s2 := FillS2{Name: "old", Count: 1, Tags: []string{"a"}}
+	filled, err := FillTracked(&s2, map[string]interface{}{
+		"name":     "",
+		"count":    float64(3), // as decoded from JSON
+		"price":    1.5,
+		"tags":     []interface{}{"x", "y"},
+		"internal": "nope",
+		"unknown":  true,
+	})
+	assert.NoError(err)
+	assert.Equal(FilledFields{"count", "name", "price", "tags"}, filled)
+	assert.True(filled.Has("name"))
+	assert.False(filled.Has("internal"))
+	assert.Equal("", s2.Name)
+	assert.Equal(int64(3), s2.Count)
+	assert.Equal(1.5, *s2.Price)
+	assert.Equal([]string{"x", "y"}, s2.Tags)
+	assert.Equal("", s2.Internal)
+
+	// null clears
+	filled, err = FillTracked(&s2, map[string]interface{}{"price": nil})
+	assert.NoError(err)
+	assert.Equal(FilledFields{"price"}, filled)
+	assert.Nil(s2.Price)
+
+	// numbers must fit
+	_, err = FillTracked(&s2, map[string]interface{}{"count": 2.5})
+	assert.Error(err)
+	_, err = FillTracked(&s2, map[string]interface{}{"count": "three"})
+	assert.Error(err)
+
+}
@@ -9,7 +9,7 @@
 // And REST is mostly path matching on the path you provide to each call.
 //
 // Advanced things to review: Documentation generation based on comments (separate package but should
-// play well with this one).  Code-generating clients? (Difficult but not necessarily impossible.)
+// play well with this one).  OpenAPI 3 documents can be made with the openapi subpackage.  Code-generating clients? (Difficult but not necessarily impossible.)
 //
 package httpapi
 
@@ -189,7 +189,7 @@ func (apir *APIRequest) ParseRESTObj(httpMethod string, obj interface{}, path st
 	}
 
 	ct, _, _ := mime.ParseMediaType(r.Header.Get("content-type"))
-	if ct == "application/json" {
+	if isJSONContentType(ct) {
 
 		apir.Type = TYPE_REST
 		apir.Input = obj
@@ -235,7 +235,7 @@ func (apir *APIRequest) ParseRESTObjPath(httpMethod string, obj interface{}, pat
 	}
 
 	ct, _, _ := mime.ParseMediaType(r.Header.Get("content-type"))
-	if ct == "application/json" {
+	if isJSONContentType(ct) {
 
 		apir.Type = TYPE_REST
 		apir.Input = obj
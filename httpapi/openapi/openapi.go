@@ -0,0 +1,389 @@
+// Builds OpenAPI 3 documents (https://swagger.io/specification/) describing the APIs made with httpapi.
+//
+// Each API adds its operations to a Document, usually by implementing Describer and registering
+// with openapiregistry.  Schemas for request and response bodies are reflected from Go types,
+// using the json tags for the property names and the valid tags for the constraints (required,
+// minLength, maxLength, pattern, format email, minimum and maximum), so the document agrees with
+// what the server actually accepts.  Errors are described with the shape written by
+// httpapi.APIRequest.WriteErr, i.e. {"code":..., "message":..., "data":...} as per weberrors.
+//
+// The document can be served with Handler (as JSON and YAML) and written out for use
+// offline with JSON() and YAML().
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Version is the OpenAPI version of documents made by NewDocument.
+const Version = "3.0.2"
+
+const (
+	ErrorSchemaName             = "Error"             // component schema for error responses
+	ValidationMessageSchemaName = "ValidationMessage" // component schema for the items of Error.data on validation errors
+	ErrorResponseName           = "Error"             // component response used as the default response
+)
+
+// Describer is implemented by things which can add their operations to a Document.
+type Describer interface {
+	DescribeOpenAPI(doc *Document) error
+}
+
+// DescriberFunc adapts a function to a Describer.
+type DescriberFunc func(doc *Document) error
+
+func (f DescriberFunc) DescribeOpenAPI(doc *Document) error {
+	return f(doc)
+}
+
+// Document is the root object of an OpenAPI 3 document.
+type Document struct {
+	OpenAPI    string      `json:"openapi"`
+	Info       Info        `json:"info"`
+	Servers    []Server    `json:"servers,omitempty"`
+	Paths      Paths       `json:"paths"`
+	Components *Components `json:"components,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Paths maps an OpenAPI path (e.g. "/api/todo-item/{id}") to its operations.
+type Paths map[string]*PathItem
+
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	OperationID string               `json:"operationId,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // "path", "query", "header" or "cookie"
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Headers     map[string]*Header   `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Schema is the subset of the OpenAPI schema object that can be reflected from Go types.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+}
+
+type Components struct {
+	Schemas   map[string]*Schema   `json:"schemas,omitempty"`
+	Responses map[string]*Response `json:"responses,omitempty"`
+}
+
+// NewDocument returns an empty document with the Error schema and response components.
+func NewDocument(title, version string) *Document {
+
+	d := &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   make(Paths),
+		Components: &Components{
+			Schemas:   make(map[string]*Schema),
+			Responses: make(map[string]*Response),
+		},
+	}
+
+	d.Components.Schemas[ValidationMessageSchemaName] = &Schema{
+		Type:        "object",
+		Description: "Describes a field which failed validation, see the valid package.",
+		Properties: map[string]*Schema{
+			"object":     {Type: "string"},
+			"index":      {Description: "For multiple objects, the index of the one which failed, starting with zero."},
+			"field_name": {Type: "string", Description: "The Go name of the field."},
+			"code":       {Description: "Indicates which validation rule failed."},
+			"messsage":   {Type: "string", Description: "The English language message describing the failure."},
+			"data":       {Description: "Additional data about the failure, e.g. for translation."},
+		},
+	}
+	d.Components.Schemas[ErrorSchemaName] = &Schema{
+		Type:        "object",
+		Description: "An error, see the weberrors package.",
+		Properties: map[string]*Schema{
+			"code":    {Type: "integer", Description: "The error code, usually the same as the HTTP status."},
+			"message": {Type: "string", Description: "A message which can be shown to the user."},
+			"data":    {Description: "Additional information about the error, for validation errors an array of " + ValidationMessageSchemaName + "."},
+		},
+	}
+	d.Components.Responses[ErrorResponseName] = &Response{
+		Description: "Error",
+		Content:     JSONContent(RefSchema(ErrorSchemaName)),
+	}
+
+	return d
+}
+
+// AddOperation adds an operation for the HTTP method and path given.  Path parameters
+// can be given as httpapi path formats ("%s") or OpenAPI style ("{id}"); each "%s" is
+// replaced by the name of the next path parameter in op.Parameters.  It is an error
+// to add the same method and path twice.
+func (d *Document) AddOperation(method, path string, op *Operation) error {
+
+	for _, p := range op.Parameters {
+		if p.In != "path" || !strings.Contains(path, "%s") {
+			continue
+		}
+		path = strings.Replace(path, "%s", "{"+p.Name+"}", 1)
+	}
+	if strings.Contains(path, "%s") {
+		return fmt.Errorf("openapi: path %q has more parameters than the operation", path)
+	}
+
+	item := d.Paths[path]
+	if item == nil {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+
+	var dst **Operation
+	switch strings.ToUpper(method) {
+	case "GET":
+		dst = &item.Get
+	case "PUT":
+		dst = &item.Put
+	case "POST":
+		dst = &item.Post
+	case "DELETE":
+		dst = &item.Delete
+	case "PATCH":
+		dst = &item.Patch
+	default:
+		return fmt.Errorf("openapi: unsupported method %q", method)
+	}
+	if *dst != nil {
+		return fmt.Errorf("openapi: duplicate operation %s %s", method, path)
+	}
+	*dst = op
+
+	return nil
+}
+
+// AddSchema reflects the type of v and adds it to the component schemas with the name
+// given, returning a reference to it.  Struct types used by v's fields are added as well,
+// named after their Go type.
+func (d *Document) AddSchema(name string, v interface{}) *Schema {
+	d.Components.Schemas[name] = d.schemaForType(reflect.TypeOf(v), true)
+	return RefSchema(name)
+}
+
+// AddPatchSchema adds a copy of the component schema ref refers to, with no required
+// properties and name given, for partial updates.
+func (d *Document) AddPatchSchema(name string, ref *Schema) *Schema {
+	s := *d.Components.Schemas[strings.TrimPrefix(ref.Ref, refPrefix)]
+	s.Required = nil
+	d.Components.Schemas[name] = &s
+	return RefSchema(name)
+}
+
+// JSON returns the document as indented JSON.
+func (d *Document) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// YAML returns the document as YAML.
+func (d *Document) YAML() ([]byte, error) {
+	// go via JSON so the json tags are used
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	var v yaml.MapSlice
+	err = yaml.Unmarshal(b, &v)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(v)
+}
+
+const refPrefix = "#/components/schemas/"
+
+// RefSchema returns a reference to the component schema with the name given.
+func RefSchema(name string) *Schema {
+	return &Schema{Ref: refPrefix + name}
+}
+
+// ArraySchema returns an array schema with the items given.
+func ArraySchema(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+// JSONContent returns content for a JSON request or response with the schema given.
+func JSONContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": MediaType{Schema: schema}}
+}
+
+// JSONRequestBody returns a required request body with the JSON schema given.
+func JSONRequestBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: JSONContent(schema)}
+}
+
+// PatchRequestBody returns a required request body for a PATCH, either a merge patch
+// (RFC 7396) with the schema given or a JSON Patch (RFC 6902).
+func PatchRequestBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]MediaType{
+		"application/json":             MediaType{Schema: schema},
+		"application/merge-patch+json": MediaType{Schema: schema},
+		"application/json-patch+json":  MediaType{Schema: JSONPatchSchema()},
+	}}
+}
+
+// JSONPatchSchema returns the schema of a JSON Patch document, a list of operations.
+func JSONPatchSchema() *Schema {
+	str := &Schema{Type: "string"}
+	return ArraySchema(&Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"op":    &Schema{Type: "string", Description: "add, remove, replace, move, copy or test"},
+			"path":  str,
+			"from":  str,
+			"value": &Schema{},
+		},
+		Required: []string{"op", "path"},
+	})
+}
+
+// Responses returns the responses for an operation with a JSON result: the schema given
+// for the HTTP status code and the Error response for anything else.
+func Responses(code int, description string, schema *Schema) map[string]*Response {
+	return map[string]*Response{
+		strconv.Itoa(code): &Response{Description: description, Content: JSONContent(schema)},
+		"default":          &Response{Ref: "#/components/responses/" + ErrorResponseName},
+	}
+}
+
+// PathParam returns a required string path parameter.
+func PathParam(name, description string) *Parameter {
+	return &Parameter{Name: name, In: "path", Description: description, Required: true, Schema: &Schema{Type: "string"}}
+}
+
+// QueryParams reflects the fields of the struct v which httpapi.FormUnmarshal can
+// set (scalars and []string) as query parameters.
+func (d *Document) QueryParams(v interface{}) []*Parameter {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	var ret []*Parameter
+	s := d.schemaForType(t, true)
+	for _, name := range sortedKeys(s.Properties) {
+		ps := s.Properties[name]
+		switch ps.Type {
+		case "string", "integer", "number", "boolean":
+		case "array":
+			if ps.Items == nil || ps.Items.Type != "string" {
+				continue
+			}
+		default:
+			continue
+		}
+		ret = append(ret, &Parameter{Name: name, In: "query", Required: false, Schema: ps})
+	}
+	return ret
+}
+
+// Handler serves the Document as JSON at JSONPath and YAML at YAMLPath.
+// The document is made for each request, so it reflects the current
+// configuration of the describers (e.g. prefixes set during autowiring).
+type Handler struct {
+	JSONPath string                    // e.g. "/api/openapi.json", empty to not serve JSON
+	YAMLPath string                    // e.g. "/api/openapi.yaml", empty to not serve YAML
+	Document func() (*Document, error) // makes the document
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != "GET" && r.Method != "HEAD" {
+		return
+	}
+
+	var contentType string
+	var marshal func(*Document) ([]byte, error)
+	switch {
+	case h.JSONPath != "" && r.URL.Path == h.JSONPath:
+		contentType, marshal = "application/json", (*Document).JSON
+	case h.YAMLPath != "" && r.URL.Path == h.YAMLPath:
+		contentType, marshal = "application/x-yaml", (*Document).YAML
+	default:
+		return
+	}
+
+	doc, err := h.Document()
+	var b []byte
+	if err == nil {
+		b, err = marshal(doc)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	w.Header().Set("content-type", contentType)
+	w.Write(b)
+}
@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testWidget struct {
+	WidgetID   string         `json:"widget_id"`
+	Name       string         `json:"name" valid:"minlen=1,maxlen=64"`
+	Email      string         `json:"email" valid:"email"`
+	Code       string         `json:"code" valid:"regexp=^[A-Z]+$"`
+	Count      int64          `json:"count" valid:"minval=0,maxval=10"`
+	Price      float64        `json:"price,string"`
+	Notes      *string        `json:"notes"`
+	CreateTime time.Time      `json:"create_time"`
+	Parts      []testPart     `json:"parts"`
+	Parent     *testWidget    `json:"parent,omitempty"`
+	Secret     string         `json:"-"`
+	Attrs      map[string]int `json:"attrs"`
+	unexported string
+}
+
+type testPart struct {
+	PartID string `json:"part_id" valid:"notnil"`
+}
+
+type testSearchParams struct {
+	Limit   int64        `json:"limit"`
+	Related []string     `json:"related"`
+	Widgets []testWidget `json:"widgets"`
+	Deep    testPart     `json:"deep"`
+}
+
+func TestSchema(t *testing.T) {
+
+	assert := assert.New(t)
+
+	doc := NewDocument("Test", "1.0")
+	ref := doc.AddSchema("Widget", testWidget{})
+	assert.Equal("#/components/schemas/Widget", ref.Ref)
+
+	s := doc.Components.Schemas["Widget"]
+	assert.Equal("object", s.Type)
+	assert.Equal([]string{"name"}, s.Required)
+
+	assert.Equal("string", s.Properties["widget_id"].Type)
+	assert.Equal(1, *s.Properties["name"].MinLength)
+	assert.Equal(64, *s.Properties["name"].MaxLength)
+	assert.Equal("email", s.Properties["email"].Format)
+	assert.Equal("^[A-Z]+$", s.Properties["code"].Pattern)
+	assert.Equal("int64", s.Properties["count"].Format)
+	assert.Equal(0.0, *s.Properties["count"].Minimum)
+	assert.Equal(10.0, *s.Properties["count"].Maximum)
+	assert.Equal("string", s.Properties["price"].Type)
+	assert.True(s.Properties["notes"].Nullable)
+	assert.Equal("date-time", s.Properties["create_time"].Format)
+	assert.Equal("array", s.Properties["parts"].Type)
+	assert.Equal("#/components/schemas/testPart", s.Properties["parts"].Items.Ref)
+	assert.Equal("#/components/schemas/testWidget", s.Properties["parent"].Ref)
+	assert.Equal("integer", s.Properties["attrs"].AdditionalProperties.Type)
+	assert.Nil(s.Properties["Secret"])
+	assert.Nil(s.Properties["unexported"])
+	assert.Len(s.Properties, 11)
+
+	assert.Equal([]string{"part_id"}, doc.Components.Schemas["testPart"].Required)
+
+	patch := doc.AddPatchSchema("WidgetPatch", ref)
+	assert.Nil(doc.Components.Schemas[strings.TrimPrefix(patch.Ref, refPrefix)].Required)
+	assert.NotNil(doc.Components.Schemas["Widget"].Required)
+
+	params := doc.QueryParams(testSearchParams{})
+	if assert.Len(params, 2) {
+		assert.Equal("limit", params[0].Name)
+		assert.Equal("query", params[0].In)
+		assert.Equal("related", params[1].Name)
+	}
+}
+
+func TestDocument(t *testing.T) {
+
+	assert := assert.New(t)
+
+	doc := NewDocument("Test", "1.0")
+	ref := doc.AddSchema("Widget", testWidget{})
+
+	assert.NoError(doc.AddOperation("GET", "/api/widget/%s", &Operation{
+		OperationID: "fetchWidget",
+		Parameters:  []*Parameter{PathParam("id", "ID of the widget")},
+		Responses:   Responses(200, "The widget", ref),
+	}))
+	assert.NoError(doc.AddOperation("post", "/api/widget", &Operation{
+		OperationID: "createWidget",
+		RequestBody: JSONRequestBody(ref),
+		Responses:   Responses(201, "The created widget", ref),
+	}))
+	assert.NoError(doc.AddOperation("PATCH", "/api/widget/%s", &Operation{
+		OperationID: "updateWidget",
+		Parameters:  []*Parameter{PathParam("id", "ID of the widget")},
+		RequestBody: PatchRequestBody(doc.AddPatchSchema("WidgetPatch", ref)),
+		Responses:   Responses(200, "The updated widget", ref),
+	}))
+	assert.Error(doc.AddOperation("GET", "/api/widget/{id}", &Operation{}))
+	assert.Error(doc.AddOperation("GET", "/api/widget/%s", &Operation{}))
+	assert.Error(doc.AddOperation("TRACE", "/api/widget", &Operation{}))
+
+	if assert.NotNil(doc.Paths["/api/widget/{id}"]) {
+		assert.Equal("fetchWidget", doc.Paths["/api/widget/{id}"].Get.OperationID)
+	}
+	assert.Equal("createWidget", doc.Paths["/api/widget"].Post.OperationID)
+	content := doc.Paths["/api/widget/{id}"].Patch.RequestBody.Content
+	assert.Equal(RefSchema("WidgetPatch"), content["application/merge-patch+json"].Schema)
+	assert.Equal("array", content["application/json-patch+json"].Schema.Type)
+	assert.Nil(doc.Components.Schemas["WidgetPatch"].Required)
+
+	b, err := doc.JSON()
+	assert.NoError(err)
+	var m map[string]interface{}
+	assert.NoError(json.Unmarshal(b, &m))
+	assert.Equal("3.0.2", m["openapi"])
+	assert.Contains(string(b), `"$ref": "#/components/responses/Error"`)
+	assert.Contains(string(b), `"messsage"`)
+
+	b, err = doc.YAML()
+	assert.NoError(err)
+	assert.True(strings.HasPrefix(string(b), "openapi: 3.0.2\n"), string(b))
+	assert.Contains(string(b), "operationId: fetchWidget")
+
+	h := &Handler{
+		JSONPath: "/api/openapi.json",
+		YAMLPath: "/api/openapi.yaml",
+		Document: func() (*Document, error) { return doc, nil },
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/openapi.json", nil))
+	assert.Equal(200, w.Code)
+	assert.Equal("application/json", w.Header().Get("content-type"))
+	assert.Contains(w.Body.String(), `"fetchWidget"`)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/openapi.yaml", nil))
+	assert.Contains(w.Body.String(), "operationId: createWidget")
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/api/other", nil))
+	assert.Equal(0, w.Body.Len())
+}
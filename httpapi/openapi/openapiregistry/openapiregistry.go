@@ -0,0 +1,83 @@
+// Registry for APIs to describe themselves in the application's OpenAPI document.
+package openapiregistry
+
+import (
+	"log"
+	"os"
+	"sort"
+
+	"github.com/gocaveman/caveman/httpapi/openapi"
+	"github.com/gocaveman/caveman/webutil"
+)
+
+var reg webutil.NamedSequence
+
+// MustRegister adds a Describer to the registry.  The name should be unique and is used to
+// order the describers (so the document comes out the same every time).
+func MustRegister(name string, d openapi.Describer) openapi.Describer {
+	reg = append(reg, webutil.NamedSequenceItem{Name: name, Value: d})
+	return d
+}
+
+// Contents returns the current contents of the registry as a NamedSequence, sorted by name.
+func Contents() webutil.NamedSequence {
+	ret := reg.Copy()
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
+
+// Document makes a new document with the title and version given and calls each
+// registered Describer on it.
+func Document(title, version string) (*openapi.Document, error) {
+	doc := openapi.NewDocument(title, version)
+	for _, item := range Contents() {
+		err := item.Value.(openapi.Describer).DescribeOpenAPI(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// NewHandler returns a handler serving the document made by Document at jsonPath
+// (e.g. "/api/openapi.json") and yamlPath (e.g. "/api/openapi.yaml").
+func NewHandler(jsonPath, yamlPath, title, version string) *openapi.Handler {
+	return &openapi.Handler{
+		JSONPath: jsonPath,
+		YAMLPath: yamlPath,
+		Document: func() (*openapi.Document, error) {
+			return Document(title, version)
+		},
+	}
+}
+
+// DumpEnv is the environment variable which makes DumpIfRequested write the document,
+// set it to "json" or "yaml".
+const DumpEnv = "CAVEMAN_OPENAPI_DUMP"
+
+// DumpIfRequested writes the document to stdout and exits, if the DumpEnv environment
+// variable is set.  Call this in main() after autowiring (so APIs have their prefixes),
+// and e.g. `CAVEMAN_OPENAPI_DUMP=yaml ./yourapp > openapi.yaml` gives you the document
+// for use offline, without starting the server.
+func DumpIfRequested(title, version string) {
+	format := os.Getenv(DumpEnv)
+	if format == "" {
+		return
+	}
+	doc, err := Document(title, version)
+	var b []byte
+	if err == nil {
+		if format == "yaml" {
+			b, err = doc.YAML()
+		} else {
+			b, err = doc.JSON()
+		}
+	}
+	if err == nil {
+		_, err = os.Stdout.Write(b)
+	}
+	if err != nil {
+		log.Fatalf("openapiregistry: dump error: %v", err)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,205 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocaveman/caveman/valid"
+)
+
+var (
+	timeType           = reflect.TypeOf(time.Time{})
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+	byteSliceType      = reflect.TypeOf([]byte(nil))
+	jsonRawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// schemaForType returns the schema for a Go type, as encoding/json would marshal it.
+// Named struct types are added to the component schemas by their Go name and a
+// reference returned, unless inline is true (only applies to t itself).
+func (d *Document) schemaForType(t reflect.Type, inline bool) *Schema {
+
+	if t == nil || t == emptyInterfaceType || t == jsonRawMessageType {
+		return &Schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		s := d.schemaForType(t.Elem(), inline)
+		if s.Ref != "" {
+			// nullable can't be set next to a $ref (OpenAPI 3.0)
+			return s
+		}
+		s.Nullable = true
+		return s
+	}
+
+	switch {
+	case t == timeType || (t.Kind() == reflect.Struct && t.ConvertibleTo(timeType)):
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		// marshals itself, could be anything; types which embed time.Time (e.g. for
+		// database scanning) will usually be a date-time
+		if t.Kind() == reflect.Struct {
+			if f, ok := t.FieldByName("Time"); ok && f.Anonymous && f.Type == timeType {
+				return &Schema{Type: "string", Format: "date-time"}
+			}
+		}
+		return &Schema{}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	case t == byteSliceType:
+		return &Schema{Type: "string", Format: "byte"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return ArraySchema(d.schemaForType(t.Elem(), false))
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaForType(t.Elem(), false)}
+	case reflect.Struct:
+		if inline || t.Name() == "" {
+			return d.structSchema(t)
+		}
+		name := t.Name()
+		if _, ok := d.Components.Schemas[name]; !ok {
+			d.Components.Schemas[name] = &Schema{} // placeholder, in case the type refers to itself
+			d.Components.Schemas[name] = d.structSchema(t)
+		}
+		return RefSchema(name)
+	}
+
+	return &Schema{}
+}
+
+// structSchema returns an object schema with a property for each field encoding/json
+// would marshal, with the constraints from the valid tag.
+func (d *Document) structSchema(t reflect.Type) *Schema {
+
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.Tag.Get("json") == "-" {
+			continue
+		}
+		jsonName, opts := parseJSONTag(f.Tag.Get("json"))
+
+		if f.Anonymous && jsonName == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				// fields of embedded structs are promoted
+				es := d.structSchema(ft)
+				for k, v := range es.Properties {
+					if _, ok := s.Properties[k]; !ok {
+						s.Properties[k] = v
+					}
+				}
+				s.Required = append(s.Required, es.Required...)
+				continue
+			}
+		}
+
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+
+		fs := d.schemaForType(f.Type, false)
+		if strings.Contains(","+opts+",", ",string,") {
+			switch fs.Type {
+			case "integer", "number", "boolean":
+				fs = &Schema{Type: "string"}
+			}
+		}
+
+		if vtag, ok := f.Tag.Lookup("valid"); ok && fs.Ref == "" {
+			vals := valid.StructTagToValues(vtag)
+			if _, ok := vals["notnil"]; ok {
+				s.Required = append(s.Required, jsonName)
+			}
+			if n, err := strconv.Atoi(vals.Get("minlen")); err == nil {
+				fs.MinLength = &n
+				if n > 0 {
+					s.Required = append(s.Required, jsonName)
+				}
+			}
+			if n, err := strconv.Atoi(vals.Get("maxlen")); err == nil {
+				fs.MaxLength = &n
+			}
+			if p := vals.Get("regexp"); p != "" {
+				fs.Pattern = p
+			}
+			if _, ok := vals["email"]; ok {
+				fs.Format = "email"
+			}
+			if n, err := strconv.ParseFloat(vals.Get("minval"), 64); err == nil {
+				fs.Minimum = &n
+			}
+			if n, err := strconv.ParseFloat(vals.Get("maxval"), 64); err == nil {
+				fs.Maximum = &n
+			}
+		}
+
+		s.Properties[jsonName] = fs
+	}
+
+	s.Required = dedupStrings(s.Required)
+
+	return s
+}
+
+func parseJSONTag(tag string) (name, opts string) {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+	return tag, ""
+}
+
+func dedupStrings(in []string) []string {
+	var ret []string
+	seen := make(map[string]bool, len(in))
+	for _, s := range in {
+		if !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
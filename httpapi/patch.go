@@ -0,0 +1,401 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gocaveman/caveman/weberrors"
+)
+
+// Content types of patch documents, for PATCH requests.  A plain "application/json"
+// body is treated as a merge patch.
+const (
+	MergePatchContentType = "application/merge-patch+json" // RFC 7396
+	JSONPatchContentType  = "application/json-patch+json"  // RFC 6902
+)
+
+// MergePatch applies an RFC 7396 merge patch to a JSON value (as decoded into an
+// interface{}) and returns the result.  Members of the patch which are null are removed,
+// objects are merged recursively and anything else replaces the target.  The target
+// is modified in place where possible.
+func MergePatch(target, patch interface{}) interface{} {
+
+	pm, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	tm, ok := target.(map[string]interface{})
+	if !ok {
+		tm = make(map[string]interface{}, len(pm))
+	}
+	for k, v := range pm {
+		if v == nil {
+			delete(tm, k)
+			continue
+		}
+		tm[k] = MergePatch(tm[k], v)
+	}
+
+	return tm
+}
+
+// JSONPatchOp is one operation of an RFC 6902 JSON Patch.
+type JSONPatchOp struct {
+	Op    string      `json:"op"`             // add, remove, replace, move, copy or test
+	Path  string      `json:"path"`           // JSON Pointer (RFC 6901) to the target location
+	From  string      `json:"from,omitempty"` // JSON Pointer to the source, for move and copy
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JSONPatch is an RFC 6902 JSON Patch document.
+type JSONPatch []JSONPatchOp
+
+// Apply applies the operations in order to a JSON value (as decoded into an interface{})
+// and returns the result.  If an operation fails (including a test which does not match)
+// an error is returned and the patch should be considered not applied, although the
+// value passed in may have been partly modified.
+func (p JSONPatch) Apply(doc interface{}) (interface{}, error) {
+
+	var err error
+	for i, op := range p {
+		doc, err = op.apply(doc)
+		if err != nil {
+			return nil, weberrors.New(err, 422, fmt.Sprintf("patch operation %d (%s %s): %v", i, op.Op, op.Path, err), nil, nil)
+		}
+	}
+
+	return doc, nil
+}
+
+func (op JSONPatchOp) apply(doc interface{}) (interface{}, error) {
+
+	switch op.Op {
+	case "add":
+		return jsonPointerSet(doc, op.Path, op.Value, true)
+	case "remove":
+		doc, _, err := jsonPointerRemove(doc, op.Path)
+		return doc, err
+	case "replace":
+		if _, err := jsonPointerGet(doc, op.Path); err != nil {
+			return nil, err
+		}
+		return jsonPointerSet(doc, op.Path, op.Value, false)
+	case "move":
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("cannot move a value into one of its children")
+		}
+		doc, v, err := jsonPointerRemove(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPointerSet(doc, op.Path, v, true)
+	case "copy":
+		v, err := jsonPointerGet(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPointerSet(doc, op.Path, jsonDeepCopy(v), true)
+	case "test":
+		v, err := jsonPointerGet(doc, op.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonEqual(v, op.Value) {
+			return nil, fmt.Errorf("test failed")
+		}
+		return doc, nil
+	}
+
+	return nil, fmt.Errorf("unknown op %q", op.Op)
+}
+
+// parseJSONPointer splits an RFC 6901 JSON Pointer into its unescaped reference tokens.
+func parseJSONPointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("invalid JSON pointer %q", ptr)
+	}
+	parts := strings.Split(ptr[1:], "/")
+	for i := range parts {
+		parts[i] = strings.Replace(strings.Replace(parts[i], "~1", "/", -1), "~0", "~", -1)
+	}
+	return parts, nil
+}
+
+// arrayIndex returns the index for a reference token into an array of length n, "-"
+// meaning the end of the array (for add).
+func arrayIndex(tok string, n int, forAdd bool) (int, error) {
+	if tok == "-" && forAdd {
+		return n, nil
+	}
+	i, err := strconv.Atoi(tok)
+	if err != nil || i < 0 || (tok != "0" && tok[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	max := n - 1
+	if forAdd {
+		max = n
+	}
+	if i > max {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+func jsonPointerGet(doc interface{}, ptr string) (interface{}, error) {
+	toks, err := parseJSONPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	for _, tok := range toks {
+		switch d := doc.(type) {
+		case map[string]interface{}:
+			v, ok := d[tok]
+			if !ok {
+				return nil, fmt.Errorf("path %q not found", ptr)
+			}
+			doc = v
+		case []interface{}:
+			i, err := arrayIndex(tok, len(d), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = d[i]
+		default:
+			return nil, fmt.Errorf("path %q not found", ptr)
+		}
+	}
+	return doc, nil
+}
+
+// jsonPointerSet sets the value at ptr and returns the new document.  With insert
+// values are inserted into arrays (add), otherwise they replace the existing element.
+func jsonPointerSet(doc interface{}, ptr string, v interface{}, insert bool) (interface{}, error) {
+
+	toks, err := parseJSONPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return v, nil
+	}
+
+	parentPtr := ptr[:strings.LastIndex(ptr, "/")]
+	parent, err := jsonPointerGet(doc, parentPtr)
+	if err != nil {
+		return nil, err
+	}
+	last := toks[len(toks)-1]
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[last] = v
+		return doc, nil
+	case []interface{}:
+		i, err := arrayIndex(last, len(p), insert)
+		if err != nil {
+			return nil, err
+		}
+		if insert {
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+		}
+		p[i] = v
+		// the slice may have been reallocated, so put it back
+		return jsonPointerSet(doc, parentPtr, p, false)
+	}
+
+	return nil, fmt.Errorf("path %q not found", ptr)
+}
+
+// jsonPointerRemove removes the value at ptr and returns the new document and the value removed.
+func jsonPointerRemove(doc interface{}, ptr string) (interface{}, interface{}, error) {
+
+	toks, err := parseJSONPointer(ptr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(toks) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	parentPtr := ptr[:strings.LastIndex(ptr, "/")]
+	parent, err := jsonPointerGet(doc, parentPtr)
+	if err != nil {
+		return nil, nil, err
+	}
+	last := toks[len(toks)-1]
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		v, ok := p[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("path %q not found", ptr)
+		}
+		delete(p, last)
+		return doc, v, nil
+	case []interface{}:
+		i, err := arrayIndex(last, len(p), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		v := p[i]
+		p = append(p[:i:i], p[i+1:]...)
+		doc, err = jsonPointerSet(doc, parentPtr, p, false)
+		return doc, v, err
+	}
+
+	return nil, nil, fmt.Errorf("path %q not found", ptr)
+}
+
+func jsonDeepCopy(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			ret[k] = jsonDeepCopy(e)
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, len(vv))
+		for i, e := range vv {
+			ret[i] = jsonDeepCopy(e)
+		}
+		return ret
+	}
+	return v
+}
+
+// jsonEqual compares two JSON values, numbers by value regardless of Go type.
+func jsonEqual(a, b interface{}) bool {
+	ab, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	var av, bv interface{}
+	if json.Unmarshal(ab, &av) != nil || json.Unmarshal(bb, &bv) != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// PatchObj applies a patch to an object, as it would be encoded as JSON.  The content type
+// is that of the request: JSONPatchContentType means patch is a JSON Patch (a JSONPatch or
+// the same decoded into an interface{}), otherwise it is a merge patch (a map).  The return
+// value has the top level fields of the patched object which the patch changed or touched,
+// with their new values (nil if removed), ready to give to Fill.  The object itself is not
+// modified.
+func PatchObj(obj interface{}, contentType string, patch interface{}) (map[string]interface{}, error) {
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	err = json.Unmarshal(b, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ct, _, _ := mime.ParseMediaType(contentType)
+
+	touched := make(map[string]bool)
+
+	if ct == JSONPatchContentType {
+
+		jp, ok := patch.(JSONPatch)
+		if !ok {
+			b, err := json.Marshal(patch)
+			if err != nil {
+				return nil, err
+			}
+			err = json.Unmarshal(b, &jp)
+			if err != nil {
+				return nil, weberrors.New(err, 400, "invalid JSON Patch document", nil, nil)
+			}
+		}
+
+		for _, op := range jp {
+			for _, ptr := range []string{op.Path, op.From} {
+				toks, _ := parseJSONPointer(ptr)
+				if len(toks) > 0 && (ptr == op.Path || op.Op == "move") {
+					touched[toks[0]] = true
+				}
+			}
+		}
+
+		doc, err = jp.Apply(doc)
+		if err != nil {
+			return nil, err
+		}
+
+	} else {
+
+		pm, ok := patch.(map[string]interface{})
+		if !ok {
+			return nil, weberrors.New(fmt.Errorf("merge patch is %T, not an object", patch), 400, "merge patch must be a JSON object", nil, nil)
+		}
+		for k := range pm {
+			touched[k] = true
+		}
+		doc = MergePatch(doc, pm)
+
+	}
+
+	dm, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, weberrors.New(fmt.Errorf("patched document is %T, not an object", doc), 422, "patch result must be a JSON object", nil, nil)
+	}
+
+	ret := make(map[string]interface{}, len(touched))
+	for k := range touched {
+		ret[k] = dm[k]
+	}
+	return ret, nil
+}
+
+// BulkItemResult is the outcome of one item of a bulk request, the result if it
+// succeeded or otherwise the error (with the same code, message and data as an error
+// response).
+type BulkItemResult struct {
+	Index  int         `json:"index"`
+	ID     string      `json:"id,omitempty"`
+	Result interface{} `json:"result,omitempty"`
+	Error  error       `json:"error,omitempty"`
+}
+
+// NewBulkItemError returns a BulkItemResult for an item which failed.
+func NewBulkItemError(index int, id string, err error) BulkItemResult {
+	return BulkItemResult{
+		Index: index,
+		ID:    id,
+		Error: weberrors.New(err, weberrors.ErrorCode(err), weberrors.ErrorMessage(err), weberrors.ErrorData(err), nil),
+	}
+}
+
+// sortedKeys returns the keys of a map with string keys, sorted.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// isJSONContentType returns true for "application/json" and the structured syntax
+// "+json" types, such as the patch content types above.
+func isJSONContentType(ct string) bool {
+	return ct == "application/json" || (strings.HasPrefix(ct, "application/") && strings.HasSuffix(ct, "+json"))
+}
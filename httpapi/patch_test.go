@@ -0,0 +1,126 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func jsonValue(t *testing.T, s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestMergePatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	// examples from RFC 7396 appendix A
+	for _, c := range []struct{ target, patch, result string }{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	} {
+		assert.Equal(jsonValue(t, c.result), MergePatch(jsonValue(t, c.target), jsonValue(t, c.patch)), c.patch)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	// mostly examples from RFC 6902 appendix A
+	for _, c := range []struct{ doc, patch, result string }{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{`{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`, `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{`{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{`{"baz":"qux","foo":["a",2,"c"]}`, `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`, `{"baz":"qux","foo":["a",2,"c"]}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"foo":"bar","child":{"grandchild":{}}}`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+		{`{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10}]`, `{"/":9,"~1":10}`},
+		{`{"foo":{"a":1}}`, `[{"op":"copy","from":"/foo","path":"/bar"},{"op":"replace","path":"/bar/a","value":2}]`, `{"foo":{"a":1},"bar":{"a":2}}`},
+	} {
+		var p JSONPatch
+		assert.NoError(json.Unmarshal([]byte(c.patch), &p))
+		res, err := p.Apply(jsonValue(t, c.doc))
+		if assert.NoError(err, c.patch) {
+			assert.Equal(jsonValue(t, c.result), res, c.patch)
+		}
+	}
+
+	for _, c := range []struct{ doc, patch string }{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`},
+		{`{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/2","value":"x"}]`},
+		{`{"foo":["bar"]}`, `[{"op":"replace","path":"/foo/01","value":"x"}]`},
+		{`{"foo":"bar"}`, `[{"op":"replace","path":"/nope","value":"x"}]`},
+		{`{"foo":"bar"}`, `[{"op":"remove","path":"/nope"}]`},
+		{`{"foo":{"a":1}}`, `[{"op":"move","from":"/foo","path":"/foo/b"}]`},
+		{`{"foo":"bar"}`, `[{"op":"frob","path":"/foo"}]`},
+	} {
+		var p JSONPatch
+		assert.NoError(json.Unmarshal([]byte(c.patch), &p))
+		_, err := p.Apply(jsonValue(t, c.doc))
+		assert.Error(err, c.patch)
+	}
+}
+
+type patchWidget struct {
+	WidgetID string   `json:"widget_id"`
+	Name     string   `json:"name" httpapi:"name,fillable"`
+	Tags     []string `json:"tags" httpapi:"tags,fillable"`
+	Note     *string  `json:"note" httpapi:"note,fillable"`
+}
+
+func TestPatchObj(t *testing.T) {
+
+	assert := assert.New(t)
+
+	note := "hi"
+	w := patchWidget{WidgetID: "w1", Name: "Widget", Tags: []string{"a", "b"}, Note: &note}
+
+	m, err := PatchObj(w, "application/merge-patch+json", jsonValue(t, `{"name":"Gadget","note":null}`))
+	assert.NoError(err)
+	assert.Equal(map[string]interface{}{"name": "Gadget", "note": nil}, m)
+	assert.Equal("Widget", w.Name) // not modified
+
+	filled, err := FillTracked(&w, m)
+	assert.NoError(err)
+	assert.Equal(FilledFields{"name", "note"}, filled)
+	assert.Equal("Gadget", w.Name)
+	assert.Nil(w.Note)
+
+	m, err = PatchObj(w, JSONPatchContentType, jsonValue(t, `[{"op":"test","path":"/name","value":"Gadget"},{"op":"add","path":"/tags/0","value":"z"}]`))
+	assert.NoError(err)
+	assert.Equal(map[string]interface{}{"name": "Gadget", "tags": []interface{}{"z", "a", "b"}}, m)
+
+	_, err = PatchObj(w, JSONPatchContentType, jsonValue(t, `[{"op":"test","path":"/name","value":"Widget"}]`))
+	assert.Error(err)
+	_, err = PatchObj(w, JSONPatchContentType, jsonValue(t, `{"name":"x"}`))
+	assert.Error(err)
+	_, err = PatchObj(w, "application/json", jsonValue(t, `["x"]`))
+	assert.Error(err)
+	_, err = PatchObj(w, JSONPatchContentType, jsonValue(t, `[{"op":"replace","path":"","value":[]}]`))
+	assert.Error(err)
+}
@@ -4,15 +4,19 @@ package migrate
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"path"
+	"regexp"
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 )
 
 // OpenFunc is the function to use to "open" connections.  It defaults to
@@ -42,6 +46,68 @@ type Versioner interface {
 	EndVersionChange(category, newVersionName string) error
 }
 
+// TxVersioner is implemented by Versioners which can record the end of a version change as
+// part of a transaction, so a migration and its version update are committed together.
+type TxVersioner interface {
+	Versioner
+	EndVersionChangeTx(tx *sql.Tx, category, newVersionName string) error
+}
+
+// Locker is implemented by Versioners which can lock a category so only one process migrates
+// it at a time, e.g. when several servers in a cluster start at once.  Implementations should
+// expire locks held by processes which have died.
+type Locker interface {
+	// TryLock attempts to lock a category, returning false if another process holds the lock.
+	TryLock(category string) (bool, error)
+	// Unlock releases a lock obtained with TryLock.
+	Unlock(category string) error
+}
+
+// DefaultLockWait is used when Runner.LockWait is zero.
+var DefaultLockWait = 5 * time.Minute
+
+// LockPollInterval is how often the Runner retries a lock held by another process.
+var LockPollInterval = time.Second
+
+// ChecksumVersioner is implemented by Versioners which record each migration applied along
+// with the checksum of its up statements (see Checksum), which allows CheckAll to report drift.
+// The tx argument is the transaction the migration is run in, or nil if it is not run in one.
+type ChecksumVersioner interface {
+	Versioner
+	// AppliedChecksums returns version -> checksum for the migrations applied in a category.
+	AppliedChecksums(category string) (map[string]string, error)
+	// RecordApplied records a migration as applied.
+	RecordApplied(tx *sql.Tx, category, version, checksum string) error
+	// RecordUnapplied removes the record of a migration, after its down steps are run.
+	RecordUnapplied(tx *sql.Tx, category, version string) error
+}
+
+// Checksum returns a SHA-256 checksum (hex encoded) of the up statements of a migration,
+// or an empty string if it does not implement StmtMigration.  Whitespace around each
+// statement is ignored.
+func Checksum(m Migration) (string, error) {
+	sm, ok := m.(StmtMigration)
+	if !ok {
+		return "", nil
+	}
+	stmts, err := sm.UpStmts()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	for _, s := range stmts {
+		fmt.Fprintf(h, "%s\n;\n", strings.TrimSpace(s))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// TxDriverNames are the drivers which support transactional DDL, i.e. CREATE TABLE, etc. can
+// be rolled back.  MySQL is not here because DDL statements implicitly commit.
+var TxDriverNames = map[string]bool{
+	"sqlite3":  true,
+	"postgres": true,
+}
+
 // NewRunner creates a Runner.
 func NewRunner(driverName, dsn string, versioner Versioner, migrations MigrationList) *Runner {
 	return &Runner{
@@ -58,6 +124,62 @@ type Runner struct {
 	DSN        string
 	Versioner
 	Migrations MigrationList
+
+	// DisableTx turns off running migrations in a transaction.  Normally each StmtMigration
+	// and its version update are run in one transaction if the driver is in TxDriverNames
+	// and the Versioner implements TxVersioner, so a failure does not leave it half applied.
+	DisableTx bool
+
+	// DryRun, if not nil, makes the Runner write the SQL it would execute here instead of
+	// running it.  The versions recorded are not changed.
+	DryRun io.Writer
+
+	// Strict makes RunUpTo and RunDownTo refuse to run if CheckAll would report drift for
+	// the category, i.e. applied migrations which were since modified, are missing or which
+	// were skipped.  Needs a ChecksumVersioner.
+	Strict bool
+
+	// LockWait is how long to wait for another process which is migrating the same category,
+	// if the Versioner is a Locker.  DefaultLockWait is used if zero.
+	LockWait time.Duration
+
+	dryRunVersions map[string]string // category -> version as if the dry run had happened
+}
+
+// lock waits for the lock on a category, if the Versioner supports it, and returns the
+// func to release it.
+func (r *Runner) lock(category string) (unlock func(), err error) {
+
+	l, ok := r.Versioner.(Locker)
+	if !ok || r.DryRun != nil {
+		return func() {}, nil
+	}
+
+	wait := r.LockWait
+	if wait <= 0 {
+		wait = DefaultLockWait
+	}
+	deadline := time.Now().Add(wait)
+
+	for {
+		ok, err := l.TryLock(category)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %v waiting for migration lock on category %q", wait, category)
+		}
+		time.Sleep(LockPollInterval)
+	}
+
+	return func() {
+		if err := l.Unlock(category); err != nil {
+			log.Printf("Unlock(%q) returned error: %v", category, err)
+		}
+	}, nil
 }
 
 // FIXME: Runner should also be able to tell us if there are outstanding migrations
@@ -68,13 +190,29 @@ type Runner struct {
 // CheckResult is a list of CheckResultItem
 type CheckResult []CheckResultItem
 
+// HasDrift returns true if any item has drift.
+func (res CheckResult) HasDrift() bool {
+	for _, item := range res {
+		if item.HasDrift() {
+			return true
+		}
+	}
+	return false
+}
+
 // CheckResultItem gives the current and latest versions for a specific driver/dsn/category.
+// If the Versioner is a ChecksumVersioner it also reports drift, i.e. differences between the
+// migrations which were applied and the migrations we have now.
 type CheckResultItem struct {
 	DriverName     string
 	DSN            string
 	Category       string
 	CurrentVersion string
 	LatestVersion  string
+
+	Modified   []string // versions applied whose up statements have changed since
+	Missing    []string // versions applied which are not in the migration list
+	OutOfOrder []string // versions not applied but before the current version
 }
 
 // IsCurrent returns true if latest version is current version.
@@ -82,9 +220,32 @@ func (i CheckResultItem) IsCurrent() bool {
 	return i.CurrentVersion == i.LatestVersion
 }
 
+// HasDrift returns true if there are modified, missing or out of order migrations.
+func (i CheckResultItem) HasDrift() bool {
+	return len(i.Modified) > 0 || len(i.Missing) > 0 || len(i.OutOfOrder) > 0
+}
+
+// DriftError returns an error describing the drift, or nil if there is none.
+func (i CheckResultItem) DriftError() error {
+	if !i.HasDrift() {
+		return nil
+	}
+	var msgs []string
+	if len(i.Modified) > 0 {
+		msgs = append(msgs, fmt.Sprintf("modified since applied: %s", strings.Join(i.Modified, ", ")))
+	}
+	if len(i.Missing) > 0 {
+		msgs = append(msgs, fmt.Sprintf("applied but missing: %s", strings.Join(i.Missing, ", ")))
+	}
+	if len(i.OutOfOrder) > 0 {
+		msgs = append(msgs, fmt.Sprintf("not applied but before current version: %s", strings.Join(i.OutOfOrder, ", ")))
+	}
+	return fmt.Errorf("migration drift in category %q: %s", i.Category, strings.Join(msgs, "; "))
+}
+
 // CheckAll checks all categories and compares the current version to the latest version
 // and returns the results.  If you pass true then all results will be returned, otherwise
-// only results where the version is not current will be returned.
+// only results where the version is not current or there is drift will be returned.
 func (r *Runner) CheckAll(returnAll bool) (CheckResult, error) {
 
 	var res CheckResult
@@ -93,22 +254,11 @@ func (r *Runner) CheckAll(returnAll bool) (CheckResult, error) {
 
 	cats := ms.Categories()
 	for _, cat := range cats {
-		msc := ms.WithCategory(cat).Sorted()
-		latestVersion := ""
-		if len(msc) > 0 {
-			latestVersion = msc[len(msc)-1].Version()
-		}
-		currentVersion, err := r.Versioner.Version(cat)
+		item, err := r.check(cat)
 		if err != nil {
 			return nil, err
 		}
-		res = append(res, CheckResultItem{
-			DriverName:     r.DriverName,
-			DSN:            r.DSN,
-			Category:       cat,
-			CurrentVersion: currentVersion,
-			LatestVersion:  latestVersion,
-		})
+		res = append(res, item)
 	}
 
 	if returnAll {
@@ -117,7 +267,7 @@ func (r *Runner) CheckAll(returnAll bool) (CheckResult, error) {
 
 	var res2 CheckResult
 	for _, item := range res {
-		if !item.IsCurrent() {
+		if !item.IsCurrent() || item.HasDrift() {
 			res2 = append(res2, item)
 		}
 	}
@@ -126,15 +276,206 @@ func (r *Runner) CheckAll(returnAll bool) (CheckResult, error) {
 
 }
 
+// check returns the CheckResultItem for one category.
+func (r *Runner) check(category string) (CheckResultItem, error) {
+
+	msc := r.Migrations.WithDriverName(r.DriverName).WithCategory(category).Sorted()
+	latestVersion := ""
+	if len(msc) > 0 {
+		latestVersion = msc[len(msc)-1].Version()
+	}
+	currentVersion, err := r.Versioner.Version(category)
+	if err != nil {
+		return CheckResultItem{}, err
+	}
+	item := CheckResultItem{
+		DriverName:     r.DriverName,
+		DSN:            r.DSN,
+		Category:       category,
+		CurrentVersion: currentVersion,
+		LatestVersion:  latestVersion,
+	}
+
+	cv, ok := r.Versioner.(ChecksumVersioner)
+	if !ok {
+		return item, nil
+	}
+	applied, err := cv.AppliedChecksums(category)
+	if err != nil {
+		return item, err
+	}
+	if len(applied) == 0 {
+		return item, nil
+	}
+
+	appliedVers := make([]string, 0, len(applied))
+	for ver := range applied {
+		appliedVers = append(appliedVers, ver)
+	}
+	sort.Strings(appliedVers)
+
+	for _, ver := range appliedVers {
+		idx := msc.versionIndex(ver)
+		if idx < 0 {
+			item.Missing = append(item.Missing, ver)
+			continue
+		}
+		sum, err := Checksum(msc[idx])
+		if err != nil {
+			return item, err
+		}
+		if sum != "" && applied[ver] != "" && sum != applied[ver] {
+			item.Modified = append(item.Modified, ver)
+		}
+	}
+
+	// migrations applied before checksums were recorded have no record, so
+	// only look for gaps after the first one recorded
+	for _, m := range msc {
+		ver := m.Version()
+		if ver <= appliedVers[0] || ver > currentVersion {
+			continue
+		}
+		if _, ok := applied[ver]; !ok {
+			item.OutOfOrder = append(item.OutOfOrder, ver)
+		}
+	}
+
+	return item, nil
+}
+
+// checkStrict returns the drift error for a category if Strict is set.
+func (r *Runner) checkStrict(category string) error {
+	if !r.Strict {
+		return nil
+	}
+	item, err := r.check(category)
+	if err != nil {
+		return err
+	}
+	return item.DriftError()
+}
+
 // RunAllUpToLatest runs all migrations for all categories up to the latest version.
+// Migrations are run in an order which satisfies their dependencies on other categories
+// (see DependentMigration), an error is returned if the dependencies have a cycle.
+// If Strict is set every category is checked for drift first, including those with
+// nothing to run.
 func (r *Runner) RunAllUpToLatest() error {
-	cats := r.Migrations.Categories()
-	for _, cat := range cats {
-		err := r.RunUpToLatest(cat)
+
+	ml, err := r.Migrations.WithDriverName(r.DriverName).DependencyOrder()
+	if err != nil {
+		return err
+	}
+
+	if r.Strict {
+		res, err := r.CheckAll(true)
+		if err != nil {
+			return err
+		}
+		for _, item := range res {
+			if err := item.DriftError(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, m := range ml {
+		curVer, err := r.version(m.Category())
+		if err != nil {
+			return err
+		}
+		if curVer != "" && m.Version() <= curVer {
+			continue // already applied
+		}
+		err = r.RunUpTo(m.Category(), m.Version())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// version returns the current version of a category, during a dry run this includes the
+// changes which would have been made.
+func (r *Runner) version(category string) (string, error) {
+	if r.DryRun != nil {
+		if v, ok := r.dryRunVersions[category]; ok {
+			return v, nil
+		}
+	}
+	return r.Versioner.Version(category)
+}
+
+// checkDeps returns an error if the categories a migration depends on are not at the
+// minimum versions.
+func (r *Runner) checkDeps(m Migration) error {
+	dm, ok := m.(DependentMigration)
+	if !ok {
+		return nil
+	}
+	for _, cat := range sortedKeys(dm.DependsOn()) {
+		if cat == m.Category() {
+			continue
+		}
+		minVer := dm.DependsOn()[cat]
+		curVer, err := r.version(cat)
+		if err != nil {
+			return err
+		}
+		if curVer == "" || curVer < minVer {
+			return fmt.Errorf("migration %q %q depends on category %q version %q or later (current version %q), run it first",
+				m.Category(), m.Version(), cat, minVer, curVer)
+		}
+	}
+	return nil
+}
+
+// downDependents runs down migrations of other categories which depend on versions of
+// category later than targetVersion, so it can be run down to targetVersion.
+func (r *Runner) downDependents(category, targetVersion string) error {
+
+	ml := r.Migrations.WithDriverName(r.DriverName)
+
+	for _, cat := range ml.Categories() {
+		if cat == category {
+			continue
+		}
+		curVer, err := r.version(cat)
 		if err != nil {
 			return err
 		}
+		if curVer == "" {
+			continue
+		}
+
+		// the earliest applied migration which depends on what is being removed
+		mc := ml.WithCategory(cat).Sorted()
+		for i, m := range mc {
+			if m.Version() > curVer {
+				break
+			}
+			dm, ok := m.(DependentMigration)
+			if !ok {
+				continue
+			}
+			minVer, ok := dm.DependsOn()[category]
+			if !ok || (targetVersion != "" && minVer <= targetVersion) {
+				continue
+			}
+			prevVer := ""
+			if i > 0 {
+				prevVer = mc[i-1].Version()
+			}
+			err := r.RunDownTo(cat, prevVer)
+			if err != nil {
+				return err
+			}
+			break
+		}
 	}
+
 	return nil
 }
 
@@ -160,7 +501,7 @@ func (r *Runner) RunTo(category, targetVersion string) error {
 	}
 
 	mc := r.Migrations.WithDriverName(r.DriverName).WithCategory(category).Sorted()
-	curVer, err := r.Versioner.Version(category)
+	curVer, err := r.version(category)
 	if err != nil {
 		return err
 	}
@@ -203,10 +544,6 @@ func (r *Runner) RunTo(category, targetVersion string) error {
 // this version is lower than the current one.
 func (r *Runner) RunUpTo(category, targetVersion string) error {
 
-	curVer, err := r.Versioner.Version(category)
-	if err != nil {
-		return err
-	}
 	ml := r.Migrations.WithDriverName(r.DriverName).WithCategory(category).Sorted()
 	if len(ml) == 0 {
 		return nil
@@ -216,6 +553,28 @@ func (r *Runner) RunUpTo(category, targetVersion string) error {
 		return fmt.Errorf("version %q not found", targetVersion)
 	}
 
+	unlock, err := r.lock(category)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := r.checkStrict(category); err != nil {
+		return err
+	}
+
+	// read after locking, another process may have just migrated
+	curVer, err := r.version(category)
+	if err != nil {
+		return err
+	}
+	if curVer == targetVersion {
+		return nil
+	}
+	if curVer != "" && ml.versionIndex(curVer) > ml.versionIndex(targetVersion) {
+		return fmt.Errorf("current version %q is higher than %q, cannot run up", curVer, targetVersion)
+	}
+
 	active := curVer == "" // start active if empty current version
 	for _, m := range ml {
 
@@ -228,24 +587,12 @@ func (r *Runner) RunUpTo(category, targetVersion string) error {
 			continue
 		}
 
-		err := r.Versioner.StartVersionChange(category, curVer)
-		if err != nil {
+		if err := r.checkDeps(m); err != nil {
 			return err
 		}
 
-		err = m.ExecUp(r.DSN)
-		if err != nil {
-			// try to revert the version
-			err2 := r.Versioner.EndVersionChange(category, curVer)
-			if err2 != nil { // just log the error in this case, so the orignal error is preserved
-				log.Printf("EndVersionChange returned error: %v", err2)
-			}
-			return fmt.Errorf("ExecUp(%q) error: %v", r.DSN, err)
-		}
-
-		// Update version to the migration we just ran.
-		// NOTE: This will leave things in an inconsistent state if it errors but nothing we can do...
-		err = r.Versioner.EndVersionChange(category, m.Version())
+		// update version to the migration we just ran
+		err := r.runMigration(m, true, category, curVer, m.Version())
 		if err != nil {
 			return err
 		}
@@ -262,16 +609,13 @@ func (r *Runner) RunUpTo(category, targetVersion string) error {
 
 }
 
-// RunUpTo runs migrations down to a specific version. Will only run down, will error if
-// this version is higher than the current one.
+// RunDownTo runs migrations down to a specific version. Will only run down, will error if
+// this version is higher than the current one.  Migrations in other categories which depend
+// on the migrations being run down are run down first.
 func (r *Runner) RunDownTo(category, targetVersion string) error {
 
 	// log.Printf("RunDownTo %q %q", category, targetVersion)
 
-	curVer, err := r.Versioner.Version(category)
-	if err != nil {
-		return err
-	}
 	ml := r.Migrations.WithDriverName(r.DriverName).WithCategory(category).Sorted()
 	sort.Sort(sort.Reverse(ml))
 	if len(ml) == 0 {
@@ -282,6 +626,26 @@ func (r *Runner) RunDownTo(category, targetVersion string) error {
 		return fmt.Errorf("version %q not found", targetVersion)
 	}
 
+	err := r.downDependents(category, targetVersion)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := r.lock(category)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := r.checkStrict(category); err != nil {
+		return err
+	}
+
+	curVer, err := r.version(category)
+	if err != nil {
+		return err
+	}
+
 	active := false
 	for mlidx, m := range ml {
 
@@ -299,40 +663,289 @@ func (r *Runner) RunDownTo(category, targetVersion string) error {
 			continue
 		}
 
-		err := r.Versioner.StartVersionChange(category, curVer)
-		if err != nil {
-			return err
-		}
-
-		err = m.ExecDown(r.DSN)
-		if err != nil {
-			// try to revert the version
-			err2 := r.Versioner.EndVersionChange(category, curVer)
-			if err2 != nil { // just log the error in this case, so the orignal error is preserved
-				log.Printf("EndVersionChange returned error: %v", err2)
-			}
-			return fmt.Errorf("ExecDown(%q) error: %v", r.DSN, err)
-		}
-
 		// Update version to the NEXT migration in the sequence or empty string if at the end
 		nextLowerVersion := ""
 		if mlidx+1 < len(ml) {
 			nextLowerVersion = ml[mlidx+1].Version()
 		}
 
-		// NOTE: This will leave things in an inconsistent state if it errors but nothing we can do...
-		err = r.Versioner.EndVersionChange(category, nextLowerVersion)
+		err := r.runMigration(m, false, category, curVer, nextLowerVersion)
 		if err != nil {
 			return err
 		}
 
-		curVer = m.Version()
+		curVer = nextLowerVersion
 
 	}
 
 	return nil
 }
 
+// runMigration runs the up or down steps of a migration and changes the version of the
+// category from curVer to newVer.
+func (r *Runner) runMigration(m Migration, up bool, category, curVer, newVer string) error {
+
+	if r.DryRun != nil {
+		if r.dryRunVersions == nil {
+			r.dryRunVersions = make(map[string]string)
+		}
+		r.dryRunVersions[category] = newVer
+		return r.printMigration(m, up, category, newVer)
+	}
+
+	execName := "ExecUp"
+	if !up {
+		execName = "ExecDown"
+	}
+
+	err := r.Versioner.StartVersionChange(category, curVer)
+	if err != nil {
+		return err
+	}
+
+	sm, isStmt := m.(StmtMigration)
+	tv, isTxVer := r.Versioner.(TxVersioner)
+	if isStmt && isTxVer && !r.DisableTx && TxDriverNames[r.DriverName] {
+		err = r.execTx(sm, up, tv, category, newVer)
+	} else {
+		if up {
+			err = m.ExecUp(r.DSN)
+		} else {
+			err = m.ExecDown(r.DSN)
+		}
+		if err == nil {
+			// NOTE: This will leave things in an inconsistent state if it errors but nothing we can do...
+			err = r.Versioner.EndVersionChange(category, newVer)
+			if err != nil {
+				return err
+			}
+			return r.recordApplied(nil, m, up)
+		}
+	}
+	if err != nil {
+		// try to revert the version
+		err2 := r.Versioner.EndVersionChange(category, curVer)
+		if err2 != nil { // just log the error in this case, so the orignal error is preserved
+			log.Printf("EndVersionChange returned error: %v", err2)
+		}
+		return fmt.Errorf("%s(%q) error: %v", execName, r.DSN, err)
+	}
+
+	return nil
+}
+
+// execTx runs the statements of a migration and the version update in one transaction.
+func (r *Runner) execTx(m StmtMigration, up bool, tv TxVersioner, category, newVer string) error {
+
+	stmts, err := migrationStmts(m, up)
+	if err != nil {
+		return err
+	}
+
+	db, err := OpenFunc(r.DriverName, r.DSN)
+	if err != nil {
+		return err
+	}
+	defer CloseFunc(db)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for n, s := range stmts {
+		_, err := tx.Exec(s)
+		if err != nil {
+			return fmt.Errorf("migration (driverName=%q, category=%q, version=%q, stmtidx=%d) Exec failed with error: %v\nSQL Statement:\n%s",
+				m.DriverName(), m.Category(), m.Version(), n, err, s)
+		}
+	}
+
+	err = tv.EndVersionChangeTx(tx, category, newVer)
+	if err != nil {
+		return err
+	}
+
+	err = r.recordApplied(tx, m, up)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// recordApplied records a migration as applied or not, if the Versioner is a ChecksumVersioner.
+func (r *Runner) recordApplied(tx *sql.Tx, m Migration, up bool) error {
+	cv, ok := r.Versioner.(ChecksumVersioner)
+	if !ok {
+		return nil
+	}
+	if !up {
+		return cv.RecordUnapplied(tx, m.Category(), m.Version())
+	}
+	sum, err := Checksum(m)
+	if err != nil {
+		return err
+	}
+	return cv.RecordApplied(tx, m.Category(), m.Version(), sum)
+}
+
+// printMigration writes the SQL for a migration to DryRun.
+func (r *Runner) printMigration(m Migration, up bool, category, newVer string) error {
+
+	dir := "up"
+	if !up {
+		dir = "down"
+	}
+	fmt.Fprintf(r.DryRun, "-- %s %s (%s), version becomes %q\n", category, m.Version(), dir, newVer)
+
+	sm, ok := m.(StmtMigration)
+	if !ok {
+		_, err := fmt.Fprintf(r.DryRun, "-- %T does not provide its SQL, it cannot be shown\n\n", m)
+		return err
+	}
+
+	stmts, err := migrationStmts(sm, up)
+	if err != nil {
+		return err
+	}
+	for _, s := range stmts {
+		s = strings.TrimSpace(s)
+		if !strings.HasSuffix(s, ";") {
+			s += ";"
+		}
+		fmt.Fprintf(r.DryRun, "%s\n", s)
+	}
+	_, err = fmt.Fprintln(r.DryRun)
+	return err
+}
+
+func migrationStmts(m StmtMigration, up bool) ([]string, error) {
+	if up {
+		return m.UpStmts()
+	}
+	return m.DownStmts()
+}
+
+// StmtMigration is implemented by migrations which consist of SQL statements.  It allows the
+// Runner to execute them itself, in a transaction, and to show them for a dry run.
+// UpStmts and DownStmts return the statements ready to execute, e.g. with templates rendered.
+type StmtMigration interface {
+	Migration
+	UpStmts() ([]string, error)
+	DownStmts() ([]string, error)
+}
+
+// DependentMigration is implemented by migrations which need other categories to have
+// reached a minimum version before they can run, e.g. because they add a foreign key to a
+// table created by another package.  DependsOn returns category -> minimum version.
+type DependentMigration interface {
+	Migration
+	DependsOn() map[string]string
+}
+
+// DependencyOrder returns the migrations sorted so each comes after the migrations before it in
+// its category and after the migrations it depends on (see DependentMigration), otherwise
+// in category and version order.  The list should be for one driver.  A dependency is on the
+// first migration of the category at or after the version given.  An error is returned
+// if there is no such migration or if there is a cycle.
+func (ml MigrationList) DependencyOrder() (MigrationList, error) {
+
+	sorted := ml.Sorted()
+
+	type node struct {
+		m     Migration
+		after []int // indexes of the nodes which must come first
+	}
+	nodes := make([]node, len(sorted))
+	for i, m := range sorted {
+		nodes[i].m = m
+		if i > 0 && sorted[i-1].Category() == m.Category() {
+			nodes[i].after = append(nodes[i].after, i-1)
+		}
+	}
+	for i, m := range sorted {
+		dm, ok := m.(DependentMigration)
+		if !ok {
+			continue
+		}
+		for _, cat := range sortedKeys(dm.DependsOn()) {
+			if cat == m.Category() {
+				continue
+			}
+			// the version is a minimum, so it comes after the first migration which reaches it
+			ver := dm.DependsOn()[cat]
+			j := -1
+			for k, m2 := range sorted {
+				if m2.Category() == cat && m2.Version() >= ver {
+					j = k
+					break
+				}
+			}
+			if j < 0 {
+				return nil, fmt.Errorf("migration %q %q depends on category %q version %q or later which was not found", m.Category(), m.Version(), cat, ver)
+			}
+			nodes[i].after = append(nodes[i].after, j)
+		}
+	}
+
+	// depth first, visiting in sorted order so the result is stable
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, len(nodes))
+	var path []int
+	ret := make(MigrationList, 0, len(nodes))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case done:
+			return nil
+		case visiting:
+			// report the cycle from where it starts on the path
+			var names []string
+			for k := len(path) - 1; k >= 0; k-- {
+				names = append([]string{fmt.Sprintf("%s %s", nodes[path[k]].m.Category(), nodes[path[k]].m.Version())}, names...)
+				if path[k] == i {
+					break
+				}
+			}
+			names = append(names, fmt.Sprintf("%s %s", nodes[i].m.Category(), nodes[i].m.Version()))
+			return fmt.Errorf("migration dependency cycle: %s", strings.Join(names, " -> "))
+		}
+		state[i] = visiting
+		path = append(path, i)
+		for _, j := range nodes[i].after {
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = done
+		ret = append(ret, nodes[i].m)
+		return nil
+	}
+	for i := range nodes {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 // Migration represents a driver name, category and version and functionality to perform an
 // "up" and "down" to and from this version.  See SQLMigration and FuncsMigration for implementations.
 type Migration interface {
@@ -381,6 +994,16 @@ func (ml MigrationList) HasVersion(ver string) bool {
 	return false
 }
 
+// versionIndex returns the index of the first migration with a version, or -1.
+func (ml MigrationList) versionIndex(ver string) int {
+	for i, m := range ml {
+		if m.Version() == ver {
+			return i
+		}
+	}
+	return -1
+}
+
 // Categories returns a unique list of categories from these Migrations.
 func (ml MigrationList) Categories() []string {
 	var ret []string
@@ -546,6 +1169,7 @@ func LoadSQLMigrationsHFS(hfs http.FileSystem, dir string) (MigrationList, error
 		for {
 			line, err := r.ReadBytes('\n')
 			if err == io.EOF {
+				thisStmt.Write(line) // last line without a newline
 				break
 			}
 			if err != nil {
@@ -553,10 +1177,17 @@ func LoadSQLMigrationsHFS(hfs http.FileSystem, dir string) (MigrationList, error
 			}
 			thisStmt.Write(line)
 
+			if m := dependsOnRE.FindSubmatch(line); m != nil && parts[3] == "up" {
+				if sqlMigration.DependsOnValue == nil {
+					sqlMigration.DependsOnValue = make(map[string]string)
+				}
+				sqlMigration.DependsOnValue[string(m[1])] = string(m[2])
+			}
+
 			// check for end of statement
 			if bytes.HasSuffix(bytes.TrimSpace(line), []byte(";")) {
 				thisStmtStr := thisStmt.String()
-				if len(strings.TrimSpace(thisStmtStr)) > 0 {
+				if hasSQL(thisStmtStr) {
 					(*stmts) = append((*stmts), thisStmtStr)
 				}
 				thisStmt.Truncate(0)
@@ -565,7 +1196,7 @@ func LoadSQLMigrationsHFS(hfs http.FileSystem, dir string) (MigrationList, error
 		}
 
 		thisStmtStr := thisStmt.String()
-		if len(strings.TrimSpace(thisStmtStr)) > 0 {
+		if hasSQL(thisStmtStr) {
 			(*stmts) = append((*stmts), thisStmtStr)
 		}
 
@@ -580,6 +1211,19 @@ func LoadSQLMigrationsHFS(hfs http.FileSystem, dir string) (MigrationList, error
 	return ret, nil
 }
 
+var dependsOnRE = regexp.MustCompile(`^\s*--\s*depends-on:\s*(\S+)\s+(\S+)\s*$`)
+
+// hasSQL returns true if s has something other than whitespace and "--" comment lines.
+func hasSQL(s string) bool {
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
+
 // LoadSQLMigrations loads migrations from the specified directory.  File names are
 // expected to be in exactly four parts each separated with a dash and have a .sql extension:
 // `driver-category-version-up.sql` is the format for up migrations, the corresponding down
@@ -591,7 +1235,10 @@ func LoadSQLMigrationsHFS(hfs http.FileSystem, dir string) (MigrationList, error
 //
 // Both the up and down files must be present for a migration or an error will be returned.
 // Files are plain text with SQL in them.  Each line that ends with a semicolon (ignoring whitespace after)
-// will be treated as a separate SQL statement.
+// will be treated as a separate SQL statement.  The up file can declare dependencies on other
+// categories (see DependentMigration) with comment lines like:
+//
+// -- depends-on: users 2017120301_create
 func LoadSQLMigrations(dir string) (MigrationList, error) {
 	return LoadSQLMigrationsHFS(http.Dir(dir), "/")
 }
@@ -604,6 +1251,7 @@ type SQLMigration struct {
 	VersionValue    string
 	UpSQL           []string
 	DownSQL         []string
+	DependsOnValue  map[string]string // category -> minimum version, see DependentMigration
 }
 
 // NewWithDriverName as a convenience returns a copy with DriverNameValue set to the specified value.
@@ -613,9 +1261,10 @@ func (m *SQLMigration) NewWithDriverName(driverName string) *SQLMigration {
 	return &ret
 }
 
-func (m *SQLMigration) DriverName() string { return m.DriverNameValue }
-func (m *SQLMigration) Category() string   { return m.CategoryValue }
-func (m *SQLMigration) Version() string    { return m.VersionValue }
+func (m *SQLMigration) DriverName() string           { return m.DriverNameValue }
+func (m *SQLMigration) Category() string             { return m.CategoryValue }
+func (m *SQLMigration) Version() string              { return m.VersionValue }
+func (m *SQLMigration) DependsOn() map[string]string { return m.DependsOnValue }
 
 func (m *SQLMigration) exec(dsn string, stmts []string) error {
 
@@ -644,6 +1293,12 @@ func (m *SQLMigration) ExecDown(dsn string) error {
 	return m.exec(dsn, m.DownSQL)
 }
 
+// UpStmts returns UpSQL.
+func (m *SQLMigration) UpStmts() ([]string, error) { return m.UpSQL, nil }
+
+// DownStmts returns DownSQL.
+func (m *SQLMigration) DownStmts() ([]string, error) { return m.DownSQL, nil }
+
 // SQLTmplMigration implements Migration with a simple slice of strings which are
 // interpreted as Go templates with SQL as the up and down migration steps.
 // This allows you to customize the SQL with things like table prefixes.
@@ -655,6 +1310,7 @@ type SQLTmplMigration struct {
 	VersionValue    string
 	UpSQL           []string
 	DownSQL         []string
+	DependsOnValue  map[string]string // category -> minimum version, see DependentMigration
 
 	// a common reason to use SQLTmplMigration is be able to configure the table prefix
 	TablePrefix string `autowire:"db.TablePrefix,optional"`
@@ -669,40 +1325,55 @@ func (m *SQLTmplMigration) NewWithDriverName(driverName string) *SQLTmplMigratio
 	return &ret
 }
 
-func (m *SQLTmplMigration) DriverName() string { return m.DriverNameValue }
-func (m *SQLTmplMigration) Category() string   { return m.CategoryValue }
-func (m *SQLTmplMigration) Version() string    { return m.VersionValue }
-
-func (m *SQLTmplMigration) tmplExec(dsn string, stmts []string) error {
+func (m *SQLTmplMigration) DriverName() string           { return m.DriverNameValue }
+func (m *SQLTmplMigration) Category() string             { return m.CategoryValue }
+func (m *SQLTmplMigration) Version() string              { return m.VersionValue }
+func (m *SQLTmplMigration) DependsOn() map[string]string { return m.DependsOnValue }
 
-	db, err := OpenFunc(m.DriverNameValue, dsn)
-	if err != nil {
-		return err
-	}
-	defer CloseFunc(db)
+// render executes the statement templates.
+func (m *SQLTmplMigration) render(stmts []string) ([]string, error) {
 
+	ret := make([]string, 0, len(stmts))
 	for n, s := range stmts {
 
 		t := template.New("sql")
 		t, err := t.Parse(s)
 		if err != nil {
-			return fmt.Errorf("SQLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template parse on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
+			return nil, fmt.Errorf("SQLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template parse failed with error: %v\nSQL Statement:\n%s",
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, err, s)
 		}
 
 		var buf bytes.Buffer
 		err = t.Execute(&buf, m)
 		if err != nil {
-			return fmt.Errorf("SQLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template execute on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
+			return nil, fmt.Errorf("SQLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) template execute failed with error: %v\nSQL Statement:\n%s",
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, err, s)
 		}
 
-		newS := buf.String()
+		ret = append(ret, buf.String())
+	}
+
+	return ret, nil
+}
+
+func (m *SQLTmplMigration) tmplExec(dsn string, stmts []string) error {
+
+	stmts, err := m.render(stmts)
+	if err != nil {
+		return err
+	}
+
+	db, err := OpenFunc(m.DriverNameValue, dsn)
+	if err != nil {
+		return err
+	}
+	defer CloseFunc(db)
 
-		_, err = db.Exec(newS)
+	for n, s := range stmts {
+		_, err = db.Exec(s)
 		if err != nil {
 			return fmt.Errorf("SQLTmplMigration (driverName=%q, category=%q, version=%q, stmtidx=%d) Exec on dsn=%q failed with error: %v\nSQL Statement:\n%s",
-				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, newS)
+				m.DriverNameValue, m.CategoryValue, m.VersionValue, n, dsn, err, s)
 		}
 	}
 
@@ -717,6 +1388,12 @@ func (m *SQLTmplMigration) ExecDown(dsn string) error {
 	return m.tmplExec(dsn, m.DownSQL)
 }
 
+// UpStmts returns UpSQL with the templates executed.
+func (m *SQLTmplMigration) UpStmts() ([]string, error) { return m.render(m.UpSQL) }
+
+// DownStmts returns DownSQL with the templates executed.
+func (m *SQLTmplMigration) DownStmts() ([]string, error) { return m.render(m.DownSQL) }
+
 // NewFuncsMigration makes and returns a new FuncsMigration pointer with the data you provide.
 func NewFuncsMigration(driverName, category, version string, upFunc, downFunc MigrationFunc) *FuncsMigration {
 	return &FuncsMigration{
@@ -737,11 +1414,13 @@ type FuncsMigration struct {
 	VersionValue    string
 	UpFunc          MigrationFunc
 	DownFunc        MigrationFunc
+	DependsOnValue  map[string]string // category -> minimum version, see DependentMigration
 }
 
-func (m *FuncsMigration) DriverName() string { return m.DriverNameValue }
-func (m *FuncsMigration) Category() string   { return m.CategoryValue }
-func (m *FuncsMigration) Version() string    { return m.VersionValue }
+func (m *FuncsMigration) DriverName() string           { return m.DriverNameValue }
+func (m *FuncsMigration) Category() string             { return m.CategoryValue }
+func (m *FuncsMigration) Version() string              { return m.VersionValue }
+func (m *FuncsMigration) DependsOn() map[string]string { return m.DependsOnValue }
 func (m *FuncsMigration) ExecUp(dsn string) error {
 	return m.UpFunc(m.DriverNameValue, dsn)
 }
@@ -1,7 +1,10 @@
 package migrate
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gocaveman/caveman/migrate/migratedbr"
 	"github.com/gocraft/dbr"
@@ -211,3 +214,360 @@ func TestSQLTmplMigration(t *testing.T) {
 	_, err = sess.InsertInto("prefix_test1").Columns("id", "name").Values("k1", "Key 1").Exec()
 	assert.NoError(err)
 }
+
+func TestRunnerTx(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestRunnerTx?mode=memory&cache=shared`
+	driverName := "sqlite3"
+
+	ml := MigrationList{
+		&SQLMigration{
+			DriverNameValue: driverName,
+			CategoryValue:   "example1",
+			VersionValue:    "0001",
+			UpSQL:           []string{`CREATE TABLE test1(id TEXT, PRIMARY KEY(id))`},
+			DownSQL:         []string{`DROP TABLE test1`},
+		},
+		&SQLMigration{
+			DriverNameValue: driverName,
+			CategoryValue:   "example1",
+			VersionValue:    "0002",
+			UpSQL:           []string{`CREATE TABLE test2(id TEXT, PRIMARY KEY(id))`, `THIS IS NOT SQL`},
+			DownSQL:         []string{`DROP TABLE test2`},
+		},
+	}
+
+	versioner, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runner := NewRunner(driverName, dsn, versioner, ml)
+
+	err = runner.RunAllUpToLatest()
+	assert.Error(err)
+
+	// the first migration is applied, the second is rolled back entirely
+	v, err := versioner.Version("example1")
+	assert.NoError(err)
+	assert.Equal("0001", v)
+
+	conn, err := dbr.Open(driverName, dsn, nil)
+	assert.NoError(err)
+	sess := conn.NewSession(nil)
+	_, err = sess.InsertInto("test1").Columns("id").Values("k1").Exec()
+	assert.NoError(err)
+	_, err = sess.InsertInto("test2").Columns("id").Values("k2").Exec()
+	assert.Error(err)
+
+	// fixed migration can run
+	ml[1].(*SQLMigration).UpSQL = ml[1].(*SQLMigration).UpSQL[:1]
+	assert.NoError(runner.RunAllUpToLatest())
+	_, err = sess.InsertInto("test2").Columns("id").Values("k2").Exec()
+	assert.NoError(err)
+
+}
+
+func TestRunnerDryRun(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestRunnerDryRun?mode=memory&cache=shared`
+	driverName := "sqlite3"
+
+	ml := MigrationList{
+		&SQLTmplMigration{
+			DriverNameValue: driverName,
+			CategoryValue:   "example1",
+			VersionValue:    "0001",
+			TablePrefix:     "prefix_",
+			UpSQL:           []string{`CREATE TABLE {{.TablePrefix}}test1(id TEXT, PRIMARY KEY(id))`},
+			DownSQL:         []string{`DROP TABLE {{.TablePrefix}}test1`},
+		},
+		NewFuncsMigration(driverName, "example1", "0002", func(driverName, dsn string) error {
+			t.Errorf("FuncsMigration should not be run for a dry run")
+			return nil
+		}, nil),
+	}
+
+	versioner, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	runner := NewRunner(driverName, dsn, versioner, ml)
+	runner.DryRun = &buf
+
+	assert.NoError(runner.RunAllUpToLatest())
+	t.Logf("Dry run:\n%s", buf.String())
+	assert.Contains(buf.String(), `-- example1 0001 (up), version becomes "0001"`)
+	assert.Contains(buf.String(), "CREATE TABLE prefix_test1(id TEXT, PRIMARY KEY(id));\n")
+	assert.Contains(buf.String(), `-- *migrate.FuncsMigration does not provide its SQL`)
+
+	// nothing was changed
+	v, err := versioner.Version("example1")
+	assert.NoError(err)
+	assert.Equal("", v)
+	conn, err := dbr.Open(driverName, dsn, nil)
+	assert.NoError(err)
+	_, err = conn.NewSession(nil).InsertInto("prefix_test1").Columns("id").Values("k1").Exec()
+	assert.Error(err)
+
+}
+
+func TestRunnerLockWait(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestRunnerLockWait?mode=memory&cache=shared`
+	driverName := "sqlite3"
+
+	ml := MigrationList{
+		&SQLMigration{
+			DriverNameValue: driverName,
+			CategoryValue:   "example1",
+			VersionValue:    "0001",
+			UpSQL:           []string{`CREATE TABLE test1(id TEXT, PRIMARY KEY(id))`},
+			DownSQL:         []string{`DROP TABLE test1`},
+		},
+	}
+
+	defer func(d time.Duration) { LockPollInterval = d }(LockPollInterval)
+	LockPollInterval = 10 * time.Millisecond
+
+	versioner, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+
+	// another node holds the lock
+	ok, err := other.TryLock("example1")
+	assert.NoError(err)
+	assert.True(ok)
+
+	runner := NewRunner(driverName, dsn, versioner, ml)
+	runner.LockWait = 50 * time.Millisecond
+	assert.Error(runner.RunAllUpToLatest())
+
+	// ...and releases it while we wait
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		other.Unlock("example1")
+	}()
+	runner.LockWait = 5 * time.Second
+	assert.NoError(runner.RunAllUpToLatest())
+
+	v, err := versioner.Version("example1")
+	assert.NoError(err)
+	assert.Equal("0001", v)
+
+	// already current, nothing to run
+	assert.NoError(runner.RunUpTo("example1", "0001"))
+
+}
+
+func TestRunnerDrift(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestRunnerDrift?mode=memory&cache=shared`
+	driverName := "sqlite3"
+
+	mig := func(ver, table string) *SQLMigration {
+		return &SQLMigration{
+			DriverNameValue: driverName,
+			CategoryValue:   "example1",
+			VersionValue:    ver,
+			UpSQL:           []string{`CREATE TABLE ` + table + `(id TEXT, PRIMARY KEY(id))`},
+			DownSQL:         []string{`DROP TABLE ` + table},
+		}
+	}
+	ml := MigrationList{mig("0001", "test1"), mig("0002", "test2"), mig("0003", "test3")}
+
+	versioner, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runner := NewRunner(driverName, dsn, versioner, ml)
+	runner.Strict = true
+	assert.NoError(runner.RunAllUpToLatest())
+
+	res, err := runner.CheckAll(false)
+	assert.NoError(err)
+	assert.Len(res, 0)
+
+	sums, err := versioner.AppliedChecksums("example1")
+	assert.NoError(err)
+	assert.Len(sums, 3)
+	sum, err := Checksum(ml[0])
+	assert.NoError(err)
+	assert.Equal(sum, sums["0001"])
+
+	// whitespace does not matter
+	ml[0].(*SQLMigration).UpSQL[0] = "\n  " + ml[0].(*SQLMigration).UpSQL[0] + "\n"
+	// but changes do
+	ml[1].(*SQLMigration).UpSQL[0] = `CREATE TABLE test2(id TEXT, name TEXT, PRIMARY KEY(id))`
+	runner.Migrations = MigrationList{
+		mig("0000", "test0"), // before the first recorded, not reported
+		ml[0], ml[1],
+		mig("0002a", "test2a"), // out of order
+		mig("0004", "test4"),
+		// 0003 missing
+	}
+
+	res, err = runner.CheckAll(false)
+	assert.NoError(err)
+	if assert.Len(res, 1) {
+		assert.True(res.HasDrift())
+		assert.Equal([]string{"0002"}, res[0].Modified)
+		assert.Equal([]string{"0003"}, res[0].Missing)
+		assert.Equal([]string{"0002a"}, res[0].OutOfOrder)
+		assert.Contains(res[0].DriftError().Error(), `modified since applied: 0002`)
+	}
+
+	// strict mode refuses to run
+	assert.Error(runner.RunAllUpToLatest())
+
+	// also when everything is applied and there is nothing to run
+	runner.Migrations = MigrationList{ml[0], ml[1], mig("0003", "test3")}
+	err = runner.RunAllUpToLatest()
+	if assert.Error(err) {
+		assert.Contains(err.Error(), `modified since applied: 0002`)
+	}
+	runner.Migrations = MigrationList{ml[0], ml[1]}
+	err = runner.RunAllUpToLatest()
+	if assert.Error(err) {
+		assert.Contains(err.Error(), `applied but missing: 0003`)
+	}
+
+	// down removes the record
+	runner.Strict = false
+	runner.Migrations = ml
+	assert.NoError(runner.RunTo("example1", "0002"))
+	sums, err = versioner.AppliedChecksums("example1")
+	assert.NoError(err)
+	assert.Len(sums, 2)
+	assert.NotContains(sums, "0003")
+
+}
+
+func TestDependencyOrder(t *testing.T) {
+
+	assert := assert.New(t)
+
+	mig := func(cat, ver string, deps ...string) *SQLMigration {
+		m := &SQLMigration{DriverNameValue: "sqlite3", CategoryValue: cat, VersionValue: ver}
+		for i := 0; i+1 < len(deps); i += 2 {
+			if m.DependsOnValue == nil {
+				m.DependsOnValue = make(map[string]string)
+			}
+			m.DependsOnValue[deps[i]] = deps[i+1]
+		}
+		return m
+	}
+	names := func(ml MigrationList) (ret []string) {
+		for _, m := range ml {
+			ret = append(ret, m.Category()+" "+m.Version())
+		}
+		return
+	}
+
+	ml := MigrationList{
+		mig("todo", "0001"),
+		mig("todo", "0002", "users", "0002", "lists", "0001"),
+		mig("users", "0001"),
+		mig("users", "0002", "todo", "0001"),
+		mig("lists", "0001"),
+	}
+	ordered, err := ml.DependencyOrder()
+	assert.NoError(err)
+	assert.Equal([]string{"lists 0001", "todo 0001", "users 0001", "users 0002", "todo 0002"}, names(ordered))
+
+	// the version is a minimum, it does not need a migration of its own
+	ml[1].(*SQLMigration).DependsOnValue = map[string]string{"users": "0001a", "lists": "0000_start"}
+	ordered, err = ml.DependencyOrder()
+	assert.NoError(err)
+	assert.Equal([]string{"lists 0001", "todo 0001", "users 0001", "users 0002", "todo 0002"}, names(ordered))
+
+	// cycle
+	ml[0].(*SQLMigration).DependsOnValue = map[string]string{"users": "0002"}
+	_, err = ml.DependencyOrder()
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "todo 0001 -> users 0002 -> todo 0001")
+	}
+
+	// not found
+	ml[0].(*SQLMigration).DependsOnValue = map[string]string{"users": "0003"}
+	_, err = ml.DependencyOrder()
+	assert.Error(err)
+
+}
+
+func TestRunnerDependencies(t *testing.T) {
+
+	assert := assert.New(t)
+
+	fs := afero.NewMemMapFs()
+	fs.Mkdir("/data", 0755)
+	writeFile(fs, "/data/sqlite3-todo-0001_lists-up.sql", []byte("CREATE TABLE todo_list(id TEXT, PRIMARY KEY(id));\n"))
+	writeFile(fs, "/data/sqlite3-todo-0001_lists-down.sql", []byte("DROP TABLE todo_list;\n"))
+	writeFile(fs, "/data/sqlite3-todo-0002_items-up.sql", []byte(`-- depends-on: users 0001_users
+CREATE TABLE todo_item(id TEXT, user_id TEXT, PRIMARY KEY(id));
+INSERT INTO users(id) VALUES('system');
+INSERT INTO todo_item(id, user_id) VALUES('welcome', 'system');`))
+	writeFile(fs, "/data/sqlite3-todo-0002_items-down.sql", []byte("DELETE FROM users WHERE id = 'system';\nDROP TABLE todo_item;\n"))
+	writeFile(fs, "/data/sqlite3-users-0001_users-up.sql", []byte("CREATE TABLE users(id TEXT, PRIMARY KEY(id));\n"))
+	writeFile(fs, "/data/sqlite3-users-0001_users-down.sql", []byte("DROP TABLE users;\n"))
+
+	ml, err := LoadSQLMigrationsHFS(afero.NewHttpFs(fs), "/data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(map[string]string{"users": "0001_users"}, ml.WithCategory("todo")[1].(DependentMigration).DependsOn())
+	assert.Len(ml.WithCategory("todo")[1].(*SQLMigration).UpSQL, 3)
+
+	dsn := `file:TestRunnerDependencies?mode=memory&cache=shared`
+	driverName := "sqlite3"
+
+	versioner, err := migratedbr.New(driverName, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := NewRunner(driverName, dsn, versioner, ml)
+
+	// a dry run shows the order
+	var buf bytes.Buffer
+	runner.DryRun = &buf
+	assert.NoError(runner.RunAllUpToLatest())
+	runner.DryRun = nil
+	out := buf.String()
+	assert.True(strings.Index(out, "CREATE TABLE users") < strings.Index(out, "CREATE TABLE todo_item"), out)
+	assert.Equal(1, strings.Count(out, "CREATE TABLE todo_list"))
+
+	// running the category alone fails, the dependency is not there
+	assert.Error(runner.RunUpToLatest("todo"))
+
+	assert.NoError(runner.RunAllUpToLatest())
+	v, err := versioner.Version("todo")
+	assert.NoError(err)
+	assert.Equal("0002_items", v)
+
+	// taking users down takes todo down first
+	assert.NoError(runner.RunDownTo("users", ""))
+	v, err = versioner.Version("todo")
+	assert.NoError(err)
+	assert.Equal("0001_lists", v)
+	v, err = versioner.Version("users")
+	assert.NoError(err)
+	assert.Equal("", v)
+
+}
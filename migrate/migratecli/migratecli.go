@@ -0,0 +1,304 @@
+// Command line operation of migrations, used by "cavegen migrate".
+package migratecli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migratedbr"
+	"github.com/gocaveman/caveman/migrate/migrateregistry"
+	"github.com/spf13/pflag"
+)
+
+const usage = `Usage: migrate [flags] command [args...]
+
+  Commands:
+    status                    show the current and latest version of each category
+    up [category]             run migrations up to the latest, for all categories or one
+    down [category]           run the last migration of a category down
+    to [category] version     run up or down to a version
+    new category name         create empty up and down SQL files in --dir
+    unlock                    clear stale migration locks (see --force)
+
+  The category can be omitted for down and to if there is only one which fits.
+
+  Flags:
+`
+
+// Run executes a migrate command, args are the command line after "migrate".
+// Output is written to out.
+func Run(args []string, out io.Writer) error {
+
+	fset := pflag.NewFlagSet("migrate", pflag.ContinueOnError)
+	fset.SetOutput(out)
+	driverName := fset.String("driver", "sqlite3", "Database driver name")
+	dsn := fset.String("dsn", "", "Database connection string")
+	dir := fset.String("dir", "migrations", "Directory of SQL migration files")
+	bin := fset.String("bin", "", "Go program to load migrations from, it must call migrateregistry.DumpIfRequested()")
+	table := fset.String("table", "migration_state", "Table the versions are stored in")
+	dryRun := fset.Bool("dry-run", false, "Print the SQL instead of running it")
+	strict := fset.Bool("strict", false, "Refuse to run if applied migrations were modified, are missing or were skipped")
+	force := fset.Bool("force", false, "With unlock, clear all locks, not just stale ones")
+	lockWait := fset.Duration("lock-wait", migrate.DefaultLockWait, "How long to wait for another process migrating the same category")
+	fset.Usage = func() {
+		fmt.Fprint(out, usage)
+		fset.PrintDefaults()
+	}
+
+	err := fset.Parse(args)
+	if err != nil {
+		return err
+	}
+	args = fset.Args()
+	if len(args) == 0 {
+		fset.Usage()
+		return fmt.Errorf("no command given")
+	}
+	cmd, args := args[0], args[1:]
+
+	if cmd == "new" {
+		if len(args) != 2 {
+			return fmt.Errorf("usage: new category name")
+		}
+		upFile, downFile, err := NewSQLFiles(*dir, *driverName, args[0], args[1], time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Created %s\nCreated %s\n", upFile, downFile)
+		return nil
+	}
+
+	if *dsn == "" {
+		return fmt.Errorf("--dsn is required")
+	}
+
+	var ml migrate.MigrationList
+	if _, err := os.Stat(*dir); err == nil {
+		ml, err = migrate.LoadSQLMigrations(*dir)
+		if err != nil {
+			return err
+		}
+	} else if *bin == "" {
+		return fmt.Errorf("migration directory %q not found and no --bin given", *dir)
+	}
+	if *bin != "" {
+		binML, err := LoadBinMigrations(*bin)
+		if err != nil {
+			return err
+		}
+		ml = append(ml, binML...)
+	}
+	ml = ml.WithDriverName(*driverName).Sorted()
+
+	versioner, err := migratedbr.NewTable(*driverName, *dsn, *table)
+	if err != nil {
+		return err
+	}
+	defer versioner.Close()
+
+	runner := migrate.NewRunner(*driverName, *dsn, versioner, ml)
+	runner.Strict = *strict
+	runner.LockWait = *lockWait
+	if *dryRun {
+		runner.DryRun = out
+	}
+
+	switch cmd {
+
+	case "status":
+		res, err := runner.CheckAll(true)
+		if err != nil {
+			return err
+		}
+		return WriteCheckResult(out, res)
+
+	case "up":
+		if len(args) > 1 {
+			return fmt.Errorf("usage: up [category]")
+		}
+		if len(args) == 1 {
+			return runner.RunUpToLatest(args[0])
+		}
+		return runner.RunAllUpToLatest()
+
+	case "down":
+		if len(args) > 1 {
+			return fmt.Errorf("usage: down [category]")
+		}
+		cat, err := findCategory(ml, args, "")
+		if err != nil {
+			return err
+		}
+		curVer, err := versioner.Version(cat)
+		if err != nil {
+			return err
+		}
+		if curVer == "" {
+			return fmt.Errorf("category %q has no migrations applied", cat)
+		}
+		vers := ml.WithCategory(cat).Versions()
+		prevVer := ""
+		for i, v := range vers {
+			if v == curVer && i > 0 {
+				prevVer = vers[i-1]
+			}
+		}
+		return runner.RunDownTo(cat, prevVer)
+
+	case "to":
+		if len(args) < 1 || len(args) > 2 {
+			return fmt.Errorf("usage: to [category] version")
+		}
+		ver := args[len(args)-1]
+		cat, err := findCategory(ml, args[:len(args)-1], ver)
+		if err != nil {
+			return err
+		}
+		return runner.RunTo(cat, ver)
+
+	case "unlock":
+		cats, err := versioner.ClearStaleLocks(*force)
+		if err != nil {
+			return err
+		}
+		if len(cats) == 0 {
+			fmt.Fprintf(out, "No locks cleared\n")
+		} else {
+			fmt.Fprintf(out, "Locks cleared: %s\n", strings.Join(cats, ", "))
+		}
+		return nil
+
+	}
+
+	fset.Usage()
+	return fmt.Errorf("unknown command %q", cmd)
+}
+
+// findCategory returns the category given in args, or if none the only category
+// (which has version ver, if not empty).
+func findCategory(ml migrate.MigrationList, args []string, ver string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	var cats []string
+	for _, cat := range ml.Categories() {
+		if ver == "" || ml.WithCategory(cat).HasVersion(ver) {
+			cats = append(cats, cat)
+		}
+	}
+	if len(cats) != 1 {
+		return "", fmt.Errorf("please specify the category, one of: %s", strings.Join(cats, ", "))
+	}
+	return cats[0], nil
+}
+
+// WriteCheckResult writes a CheckResult as a table, followed by any drift.
+func WriteCheckResult(w io.Writer, res migrate.CheckResult) error {
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "CATEGORY\tCURRENT\tLATEST\tSTATUS\n")
+	for _, item := range res {
+		status := "current"
+		if !item.IsCurrent() {
+			status = "pending"
+		}
+		if item.HasDrift() {
+			status += ", drift"
+		}
+		cur := item.CurrentVersion
+		if cur == "" {
+			cur = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", item.Category, cur, item.LatestVersion, status)
+	}
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range res {
+		if err := item.DriftError(); err != nil {
+			fmt.Fprintf(w, "%v\n", err)
+		}
+	}
+
+	return nil
+}
+
+var newNameRE = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// NewSQLFiles creates empty up and down SQL files for a new migration in dir, named so
+// migrate.LoadSQLMigrations will load them, e.g. "sqlite3-users-20180102150405_add_email-up.sql".
+// Spaces and dashes in the name are replaced with underscores.
+func NewSQLFiles(dir, driverName, category, name string, t time.Time) (upFile, downFile string, err error) {
+
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+	for _, s := range []string{driverName, category, name} {
+		if !newNameRE.MatchString(s) {
+			return "", "", fmt.Errorf("invalid name %q, only letters, numbers and underscores are allowed", s)
+		}
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", "", err
+	}
+
+	version := t.Format("20060102150405") + "_" + name
+	prefix := filepath.Join(dir, driverName+"-"+category+"-"+version)
+	upFile, downFile = prefix+"-up.sql", prefix+"-down.sql"
+	for _, f := range []string{upFile, downFile} {
+		if _, err := os.Stat(f); err == nil {
+			return "", "", fmt.Errorf("file %q already exists", f)
+		}
+	}
+
+	err = ioutil.WriteFile(upFile, []byte(fmt.Sprintf("-- %s %s: statements to apply the change, each ending with a semicolon\n", category, version)), 0644)
+	if err != nil {
+		return "", "", err
+	}
+	err = ioutil.WriteFile(downFile, []byte(fmt.Sprintf("-- %s %s: statements to undo the change, each ending with a semicolon\n", category, version)), 0644)
+	if err != nil {
+		return "", "", err
+	}
+
+	return upFile, downFile, nil
+}
+
+// LoadBinMigrations runs a Go program which calls migrateregistry.DumpIfRequested() and
+// returns the migrations it outputs.
+func LoadBinMigrations(binPath string) (migrate.MigrationList, error) {
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(binPath)
+	cmd.Env = append(os.Environ(), migrateregistry.DumpEnv+"=1")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("running %q: %v\n%s", binPath, err, stderr.String())
+	}
+
+	var sml []*migrate.SQLMigration
+	err = json.Unmarshal(stdout.Bytes(), &sml)
+	if err != nil {
+		return nil, fmt.Errorf("reading migrations from %q (does it call migrateregistry.DumpIfRequested()?): %v", binPath, err)
+	}
+
+	ml := make(migrate.MigrationList, 0, len(sml))
+	for _, m := range sml {
+		ml = append(ml, m)
+	}
+	return ml, nil
+}
@@ -0,0 +1,95 @@
+package migratecli
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRun(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestMigrateCLI")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	dir := filepath.Join(tmpDir, "migrations")
+	dsn := filepath.Join(tmpDir, "test.db")
+
+	var out bytes.Buffer
+	run := func(args ...string) error {
+		out.Reset()
+		return Run(append([]string{"--dir", dir, "--dsn", dsn}, args...), &out)
+	}
+
+	// scaffold two migrations
+	up1, down1, err := NewSQLFiles(dir, "sqlite3", "users", "create users", time.Date(2018, 1, 2, 15, 4, 5, 0, time.UTC))
+	assert.NoError(err)
+	assert.Equal(filepath.Join(dir, "sqlite3-users-20180102150405_create_users-up.sql"), up1)
+	assert.Equal(filepath.Join(dir, "sqlite3-users-20180102150405_create_users-down.sql"), down1)
+	_, _, err = NewSQLFiles(dir, "sqlite3", "users", "create-users", time.Date(2018, 1, 2, 15, 4, 5, 0, time.UTC))
+	assert.Error(err, "already exists")
+	_, _, err = NewSQLFiles(dir, "sqlite3", "my-users", "x", time.Now())
+	assert.Error(err, "dash in category")
+
+	assert.NoError(run("new", "users", "add_email"))
+	assert.Contains(out.String(), "-users-")
+	files, err := filepath.Glob(filepath.Join(dir, "*_add_email-*.sql"))
+	assert.NoError(err)
+	if !assert.Len(files, 2) {
+		t.FailNow()
+	}
+
+	appendFile := func(fname, s string) {
+		f, err := os.OpenFile(fname, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		f.WriteString(s)
+	}
+	appendFile(up1, "CREATE TABLE users(id TEXT, PRIMARY KEY(id));\n")
+	appendFile(down1, "DROP TABLE users;\n")
+	appendFile(files[1], "ALTER TABLE users ADD COLUMN email TEXT;\n") // sorts before -up
+	// the down file has only a comment, which is fine
+
+	assert.NoError(run("status"))
+	t.Logf("status:\n%s", out.String())
+	assert.Regexp(`users\s+-\s+\S+_add_email\s+pending`, out.String())
+
+	assert.NoError(run("--dry-run", "up"))
+	assert.Contains(out.String(), "CREATE TABLE users")
+	assert.NoError(run("status"))
+	assert.Contains(out.String(), "pending")
+
+	assert.NoError(run("up"))
+	assert.NoError(run("status"))
+	assert.Regexp(`users\s+(\S+)_add_email\s+\S+_add_email\s+current`, out.String())
+
+	assert.NoError(run("down"))
+	assert.NoError(run("status"))
+	assert.Regexp(`users\s+20180102150405_create_users\s+\S+\s+pending`, out.String())
+
+	assert.NoError(run("to", "20180102150405_create_users")) // already there
+	assert.Error(run("to", "nonexistent_version"))
+	assert.NoError(run("down", "users"))
+	assert.NoError(run("status"))
+	assert.Regexp(`users\s+-\s+`, out.String())
+
+	assert.NoError(run("unlock"))
+	assert.Contains(out.String(), "No locks cleared")
+
+	assert.Error(run("explode"))
+	assert.Error(Run([]string{"--dir", dir, "status"}, &out), "no dsn")
+
+}
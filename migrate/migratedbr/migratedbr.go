@@ -1,11 +1,21 @@
 package migratedbr
 
 import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/gocraft/dbr"
 )
 
+// DefaultLockTimeout is the LockTimeout for a new DbrVersioner.
+var DefaultLockTimeout = 2 * time.Minute
+
 func New(driverName, dsn string) (*DbrVersioner, error) {
 	return NewTable(driverName, dsn, "migration_state")
 }
@@ -32,15 +42,65 @@ CREATE TABLE IF NOT EXISTS ` + tableName + ` (
 		return nil, err
 	}
 
+	// locks are in their own table so existing state tables need no change,
+	// times are unix milliseconds
+	_, err = conn.DB.Exec(`
+CREATE TABLE IF NOT EXISTS ` + tableName + `_lock (
+	category varchar(128),
+	owner varchar(255),
+	locked_at bigint,
+	heartbeat_at bigint,
+	PRIMARY KEY (category)
+)
+`)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = conn.DB.Exec(`
+CREATE TABLE IF NOT EXISTS ` + tableName + `_applied (
+	category varchar(128),
+	version varchar(255),
+	checksum varchar(64),
+	applied_at bigint,
+	PRIMARY KEY (category, version)
+)
+`)
+	if err != nil {
+		return nil, err
+	}
+
 	return &DbrVersioner{
-		Connection: conn,
-		TableName:  tableName,
+		Connection:  conn,
+		TableName:   tableName,
+		OwnerID:     newOwnerID(),
+		LockTimeout: DefaultLockTimeout,
 	}, nil
 }
 
+// newOwnerID returns an ID for this process, the host name and pid plus some randomness.
+func newOwnerID() string {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	rand.Read(b)
+	return fmt.Sprintf("%s:%d:%s", host, os.Getpid(), hex.EncodeToString(b))
+}
+
+// DbrVersioner implements migrate.Versioner, migrate.TxVersioner, migrate.Locker and
+// migrate.ChecksumVersioner using tables in the database.
 type DbrVersioner struct {
 	Connection *dbr.Connection
-	TableName  string
+	TableName  string // locks are kept in TableName+"_lock", applied migrations in TableName+"_applied"
+
+	// OwnerID identifies this process in locks, it defaults to host name, pid and a random part.
+	OwnerID string
+	// LockTimeout is how long since its last heartbeat before a lock is considered stale and
+	// can be taken over, e.g. because the process holding it died.  Heartbeats are sent every
+	// LockTimeout/4 while a lock is held.
+	LockTimeout time.Duration
+
+	mu         sync.Mutex
+	heartbeats map[string]chan struct{} // category -> close to stop heartbeat
 }
 
 func (v *DbrVersioner) Close() error {
@@ -166,11 +226,98 @@ func (v *DbrVersioner) EndVersionChange(category, newVersionName string) error {
 
 	sess := v.Connection.NewSession(nil)
 
-	res, err := sess.Update(v.TableName).
+	return v.endVersionChange(sess.Update(v.TableName), category, newVersionName)
+}
+
+// EndVersionChangeTx is like EndVersionChange but the update is done as part of a transaction,
+// so it is only recorded if the migration run in the same transaction is committed.
+func (v *DbrVersioner) EndVersionChangeTx(tx *sql.Tx, category, newVersionName string) error {
+
+	return v.endVersionChange(v.runner(tx).Update(v.TableName), category, newVersionName)
+}
+
+// AppliedChecksums returns version -> checksum for each migration recorded as applied in a category.
+func (v *DbrVersioner) AppliedChecksums(category string) (map[string]string, error) {
+
+	sess := v.Connection.NewSession(nil)
+	recs := []struct {
+		Version  string `db:"version"`
+		Checksum string `db:"checksum"`
+	}{}
+	_, err := sess.Select("version", "checksum").From(v.appliedTableName()).Where("category = ?", category).Load(&recs)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]string, len(recs))
+	for _, rec := range recs {
+		ret[rec.Version] = rec.Checksum
+	}
+	return ret, nil
+}
+
+// RecordApplied records a migration as applied with the checksum of its up statements.
+// If tx is not nil the record is written as part of it.
+func (v *DbrVersioner) RecordApplied(tx *sql.Tx, category, version, checksum string) error {
+
+	runner := v.runner(tx)
+
+	_, err := runner.DeleteFrom(v.appliedTableName()).
+		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("version", version))).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = runner.InsertInto(v.appliedTableName()).
+		Columns("category", "version", "checksum", "applied_at").
+		Values(category, version, checksum, unixMillis()).
+		Exec()
+	return err
+}
+
+// RecordUnapplied removes the record of a migration being applied.
+// If tx is not nil this is done as part of it.
+func (v *DbrVersioner) RecordUnapplied(tx *sql.Tx, category, version string) error {
+	_, err := v.runner(tx).DeleteFrom(v.appliedTableName()).
+		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("version", version))).
+		Exec()
+	return err
+}
+
+// dbrRunner is the methods of dbr.Session and dbr.Tx used with runner.
+type dbrRunner interface {
+	Update(table string) *dbr.UpdateStmt
+	InsertInto(table string) *dbr.InsertStmt
+	DeleteFrom(table string) *dbr.DeleteStmt
+}
+
+// runner returns a dbr.Tx wrapping tx, or a new session if tx is nil.
+func (v *DbrVersioner) runner(tx *sql.Tx) dbrRunner {
+	if tx == nil {
+		return v.Connection.NewSession(nil)
+	}
+	return &dbr.Tx{
+		EventReceiver: &dbr.NullEventReceiver{},
+		Dialect:       v.Connection.Dialect,
+		Tx:            tx,
+	}
+}
+
+func (v *DbrVersioner) appliedTableName() string {
+	return v.TableName + "_applied"
+}
+
+func (v *DbrVersioner) endVersionChange(stmt *dbr.UpdateStmt, category, newVersionName string) error {
+
+	res, err := stmt.
 		Set("version", newVersionName).
 		Set("status", "none").
 		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("status", "inprogress"))).
 		Exec()
+	if err != nil {
+		return err
+	}
 
 	// res, err := tx.Exec(`UPDATE `+v.TableName+` SET version = ?, status = ? WHERE category = ? AND status = ?`, newVersionName, "none", category, "inprogress")
 	// if err != nil {
@@ -193,3 +340,203 @@ func (v *DbrVersioner) EndVersionChange(category, newVersionName string) error {
 
 	return nil
 }
+
+func (v *DbrVersioner) lockTableName() string {
+	return v.TableName + "_lock"
+}
+
+func unixMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func (v *DbrVersioner) lockTimeout() time.Duration {
+	if v.LockTimeout <= 0 {
+		return DefaultLockTimeout
+	}
+	return v.LockTimeout
+}
+
+// TryLock attempts to lock a category for this OwnerID and returns false if it is held by
+// another owner and is not stale.  While held a heartbeat is sent in the background, until
+// Unlock is called.  If a version change was left in progress (by a process which died)
+// it is cleared, the version remains the last one completed.
+func (v *DbrVersioner) TryLock(category string) (bool, error) {
+
+	sess := v.Connection.NewSession(nil)
+
+	// make sure there is a row to lock
+	n, err := sess.Select("count(*)").From(v.lockTableName()).Where("category = ?", category).ReturnInt64()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		_, err := sess.InsertInto(v.lockTableName()).Columns("category", "owner", "locked_at", "heartbeat_at").
+			Values(category, "", 0, 0).Exec()
+		if err != nil { // may have been inserted by someone else in the meantime, which is fine
+			n, err2 := sess.Select("count(*)").From(v.lockTableName()).Where("category = ?", category).ReturnInt64()
+			if err2 != nil || n == 0 {
+				return false, err
+			}
+		}
+	}
+
+	now := unixMillis()
+	_, err = sess.Update(v.lockTableName()).
+		Set("owner", v.OwnerID).
+		Set("locked_at", now).
+		Set("heartbeat_at", now).
+		Where(dbr.And(
+			dbr.Eq("category", category),
+			dbr.Or(
+				dbr.Eq("owner", ""),
+				dbr.Eq("owner", v.OwnerID),
+				dbr.Lt("heartbeat_at", now-int64(v.lockTimeout()/time.Millisecond)),
+			),
+		)).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	// check instead of using rows affected, which MySQL reports as 0 if nothing changed
+	owner := ""
+	err = sess.Select("owner").From(v.lockTableName()).Where("category = ?", category).LoadOne(&owner)
+	if err != nil {
+		return false, err
+	}
+	if owner != v.OwnerID {
+		return false, nil
+	}
+
+	// with the lock held nobody else can be changing the version
+	res, err := sess.Update(v.TableName).
+		Set("status", "none").
+		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("status", "inprogress"))).
+		Exec()
+	if err != nil {
+		return true, err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("migratedbr: category %q had a version change in progress from a previous lock holder, it may be partially applied", category)
+	}
+
+	v.startHeartbeat(category)
+
+	return true, nil
+}
+
+func (v *DbrVersioner) startHeartbeat(category string) {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.heartbeats == nil {
+		v.heartbeats = make(map[string]chan struct{})
+	}
+	if v.heartbeats[category] != nil {
+		return
+	}
+	stop := make(chan struct{})
+	v.heartbeats[category] = stop
+
+	interval := v.lockTimeout() / 4
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-t.C:
+				if err := v.Heartbeat(category); err != nil {
+					log.Printf("migratedbr: heartbeat for category %q: %v", category, err)
+				}
+			}
+		}
+	}()
+}
+
+func (v *DbrVersioner) stopHeartbeat(category string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if stop := v.heartbeats[category]; stop != nil {
+		close(stop)
+		delete(v.heartbeats, category)
+	}
+}
+
+// Heartbeat updates the time on a lock held by this OwnerID, so it does not become stale.
+// TryLock does this automatically.  An error is returned if the lock is not held.
+func (v *DbrVersioner) Heartbeat(category string) error {
+
+	sess := v.Connection.NewSession(nil)
+
+	_, err := sess.Update(v.lockTableName()).
+		Set("heartbeat_at", unixMillis()).
+		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("owner", v.OwnerID))).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	owner := ""
+	err = sess.Select("owner").From(v.lockTableName()).Where("category = ?", category).LoadOne(&owner)
+	if err != nil {
+		return err
+	}
+	if owner != v.OwnerID {
+		return fmt.Errorf("lock is held by %q", owner)
+	}
+
+	return nil
+}
+
+// Unlock releases a lock taken with TryLock.  It does nothing if the lock is not held by this OwnerID.
+func (v *DbrVersioner) Unlock(category string) error {
+
+	v.stopHeartbeat(category)
+
+	sess := v.Connection.NewSession(nil)
+	_, err := sess.Update(v.lockTableName()).
+		Set("owner", "").
+		Where(dbr.And(dbr.Eq("category", category), dbr.Eq("owner", v.OwnerID))).
+		Exec()
+
+	return err
+}
+
+// ClearStaleLocks removes locks which have not had a heartbeat within LockTimeout, or all locks if
+// force is true, and clears any version change left in progress for those categories.  This is
+// for recovering by hand, e.g. from a command line option, after a process died while migrating.
+// Only use force if you are sure no other process is migrating.  Returns the categories cleared.
+func (v *DbrVersioner) ClearStaleLocks(force bool) ([]string, error) {
+
+	sess := v.Connection.NewSession(nil)
+
+	cond := dbr.Neq("owner", "")
+	if !force {
+		cond = dbr.And(cond, dbr.Lt("heartbeat_at", unixMillis()-int64(v.lockTimeout()/time.Millisecond)))
+	}
+
+	var cats []string
+	_, err := sess.Select("category").From(v.lockTableName()).Where(cond).Load(&cats)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cat := range cats {
+		_, err := sess.Update(v.lockTableName()).Set("owner", "").Where(dbr.And(dbr.Eq("category", cat), cond)).Exec()
+		if err != nil {
+			return nil, err
+		}
+		_, err = sess.Update(v.TableName).
+			Set("status", "none").
+			Where(dbr.And(dbr.Eq("category", cat), dbr.Eq("status", "inprogress"))).
+			Exec()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cats, nil
+}
@@ -3,6 +3,7 @@ package migratedbr
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -52,3 +53,70 @@ func TestDbrVersioner(t *testing.T) {
 	assert.Equal([]string{"cat1"}, cats)
 
 }
+
+func TestDbrVersionerLock(t *testing.T) {
+
+	assert := assert.New(t)
+
+	dsn := `file:TestDbrVersionerLock?mode=memory&cache=shared`
+
+	v1, err := New("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v1.Close()
+	v2, err := New("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v2.Close()
+	assert.NotEqual(v1.OwnerID, v2.OwnerID)
+
+	ok, err := v1.TryLock("cat1")
+	assert.NoError(err)
+	assert.True(ok)
+	ok, err = v1.TryLock("cat1") // already ours
+	assert.NoError(err)
+	assert.True(ok)
+	ok, err = v2.TryLock("cat1")
+	assert.NoError(err)
+	assert.False(ok)
+	assert.Error(v2.Heartbeat("cat1"))
+	assert.NoError(v1.Heartbeat("cat1"))
+
+	assert.NoError(v1.Unlock("cat1"))
+	ok, err = v2.TryLock("cat1")
+	assert.NoError(err)
+	assert.True(ok)
+
+	// v2 dies in the middle of a version change
+	assert.NoError(v2.StartVersionChange("cat1", ""))
+	v2.stopHeartbeat("cat1")
+
+	// not stale yet
+	ok, err = v1.TryLock("cat1")
+	assert.NoError(err)
+	assert.False(ok)
+	cats, err := v1.ClearStaleLocks(false)
+	assert.NoError(err)
+	assert.Empty(cats)
+
+	// stale lock is taken over and the change in progress is cleared
+	v1.LockTimeout = 50 * time.Millisecond
+	time.Sleep(100 * time.Millisecond)
+	ok, err = v1.TryLock("cat1")
+	assert.NoError(err)
+	assert.True(ok)
+	assert.NoError(v1.StartVersionChange("cat1", ""))
+	assert.NoError(v1.EndVersionChange("cat1", "0001"))
+
+	// forcibly cleared
+	cats, err = v2.ClearStaleLocks(true)
+	assert.NoError(err)
+	assert.Equal([]string{"cat1"}, cats)
+	ok, err = v2.TryLock("cat1")
+	assert.NoError(err)
+	assert.True(ok)
+	assert.NoError(v2.Unlock("cat1"))
+
+}
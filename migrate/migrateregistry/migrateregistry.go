@@ -1,6 +1,13 @@
 package migrateregistry
 
-import "github.com/gocaveman/caveman/migrate"
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gocaveman/caveman/migrate"
+)
 
 var global migrate.MigrationList
 
@@ -17,3 +24,56 @@ func MustRegisterList(ml migrate.MigrationList) migrate.MigrationList {
 func Contents() migrate.MigrationList {
 	return global.Sorted()
 }
+
+// DumpEnv is the environment variable which makes DumpIfRequested dump the migrations.
+const DumpEnv = "CAVEMAN_MIGRATIONS_DUMP"
+
+// DumpIfRequested writes the registered migrations to stdout as JSON and exits, if the
+// DumpEnv environment variable is set.  Call this in main() after autowiring (so template
+// migrations have their table prefix, etc.) to allow "cavegen migrate --bin=yourapp" to
+// operate on the migrations compiled into your application.
+func DumpIfRequested() {
+	if os.Getenv(DumpEnv) == "" {
+		return
+	}
+	ml, err := Dump(Contents())
+	if err == nil {
+		err = json.NewEncoder(os.Stdout).Encode(ml)
+	}
+	if err != nil {
+		log.Fatalf("migrateregistry: dump error: %v", err)
+	}
+	os.Exit(0)
+}
+
+// Dump converts migrations to SQLMigrations with the SQL that would be executed.
+// Migrations which are not a migrate.StmtMigration cannot be converted and are an error.
+func Dump(ml migrate.MigrationList) ([]*migrate.SQLMigration, error) {
+	ret := make([]*migrate.SQLMigration, 0, len(ml))
+	for _, m := range ml {
+		sm, ok := m.(migrate.StmtMigration)
+		if !ok {
+			return nil, fmt.Errorf("migration %s/%s/%s (%T) does not provide its SQL", m.DriverName(), m.Category(), m.Version(), m)
+		}
+		up, err := sm.UpStmts()
+		if err != nil {
+			return nil, err
+		}
+		down, err := sm.DownStmts()
+		if err != nil {
+			return nil, err
+		}
+		sqlm := &migrate.SQLMigration{
+			DriverNameValue: m.DriverName(),
+			CategoryValue:   m.Category(),
+			VersionValue:    m.Version(),
+			UpSQL:           up,
+			DownSQL:         down,
+		}
+		if dm, ok := m.(migrate.DependentMigration); ok {
+			sqlm.DependsOnValue = dm.DependsOn()
+		}
+		ret = append(ret, sqlm)
+	}
+	return ret, nil
+}
@@ -1,12 +1,168 @@
 package pageinfo
 
+import (
+	"path"
+	"strings"
+
+	"github.com/gocaveman/caveman/tmpl"
+)
+
 // TemplateStore is a subset of tmpl.Store of the things we need.
 type TemplateStore interface {
 	ReadTemplate(category, fileName string) (body []byte, mimeType string, meta map[string]interface{}, err error)
 	FindByPrefix(category, fileNamePrefix string, limit int) ([]string, error)
 }
 
+// TemplateQuerier is implemented by template stores which can query by meta (see tmpl.QueryableStore).
+type TemplateQuerier interface {
+	Query(q tmpl.Query) ([]string, error)
+}
+
+// type check
+var _ QueryableStore = &PageInfoFromTmplStore{}
+
 // PageInfoFromTmplStore adapts a tmpl.Store to a pageinfo.Store with certain rules applied.
+// The rules follow renderer.DefaultFileNamer: templates in Category with one of the
+// Extensions are pages at their file name without the extension, "index" files are
+// the page for their directory (with a trailing slash), and templates with any path
+// component starting with "_" are not pages.  If TemplateStore implements
+// TemplateQuerier it is used by QueryPages.
 type PageInfoFromTmplStore struct {
 	TemplateStore TemplateStore
+	Category      string   // defaults to "views"
+	Extensions    []string // defaults to ".gohtml", ".html", ".md"
+}
+
+func (s *PageInfoFromTmplStore) category() string {
+	if s.Category == "" {
+		return tmpl.ViewsCategory
+	}
+	return s.Category
+}
+
+func (s *PageInfoFromTmplStore) extensions() []string {
+	if len(s.Extensions) == 0 {
+		return []string{".gohtml", ".html", ".md"}
+	}
+	return s.Extensions
+}
+
+// PathFor returns the page path for a template file name, or "" if it is not a page.
+func (s *PageInfoFromTmplStore) PathFor(fileName string) string {
+	for _, part := range strings.Split(fileName, "/") {
+		if strings.HasPrefix(part, "_") {
+			return ""
+		}
+	}
+	ext := path.Ext(fileName)
+	for _, e := range s.extensions() {
+		if ext != e {
+			continue
+		}
+		p := strings.TrimSuffix(fileName, ext)
+		if path.Base(p) == "index" {
+			return strings.TrimSuffix(p, "index")
+		}
+		return p
+	}
+	return ""
+}
+
+// fileNamesFor returns the possible template file names for a page path.
+func (s *PageInfoFromTmplStore) fileNamesFor(p string) []string {
+	for _, part := range strings.Split(p, "/") {
+		if strings.HasPrefix(part, "_") {
+			return nil
+		}
+	}
+	base := p
+	if strings.HasSuffix(p, "/") {
+		base = p + "index"
+	} else if path.Ext(p) != "" || path.Base(p) == "index" {
+		return nil
+	}
+	var ret []string
+	for _, e := range s.extensions() {
+		ret = append(ret, base+e)
+	}
+	return ret
+}
+
+func (s *PageInfoFromTmplStore) ReadPageInfo(p string) (tmplFileName string, meta map[string]interface{}, err error) {
+	for _, fn := range s.fileNamesFor(p) {
+		_, _, meta, err := s.TemplateStore.ReadTemplate(s.category(), fn)
+		if err == tmpl.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return fn, meta, nil
+	}
+	return "", nil, ErrNotFound
+}
+
+// dirPrefix returns the file name prefix to search for paths beginning with pathPrefix.
+func dirPrefix(pathPrefix string) string {
+	if strings.HasSuffix(pathPrefix, "/") {
+		return pathPrefix
+	}
+	dir := path.Dir("/" + pathPrefix)
+	if dir == "/" {
+		return dir
+	}
+	return dir + "/"
+}
+
+func (s *PageInfoFromTmplStore) FindByPath(pathPrefix string, limit int) ([]string, error) {
+	fileNames, err := s.TemplateStore.FindByPrefix(s.category(), dirPrefix(pathPrefix), -1)
+	if err != nil {
+		return nil, err
+	}
+	return s.pathsFor(fileNames, pathPrefix, 0, limit), nil
+}
+
+// pathsFor maps file names to paths, removing duplicates and the ones not under
+// pathPrefix, and applies offset and limit.
+func (s *PageInfoFromTmplStore) pathsFor(fileNames []string, pathPrefix string, offset, limit int) []string {
+	ret := make([]string, 0, len(fileNames))
+	seen := make(map[string]bool, len(fileNames))
+	for _, fn := range fileNames {
+		p := s.PathFor(fn)
+		if p == "" || seen[p] || !strings.HasPrefix(p, pathPrefix) {
+			continue
+		}
+		seen[p] = true
+		if offset > 0 {
+			offset--
+			continue
+		}
+		ret = append(ret, p)
+		if limit > 0 && len(ret) >= limit {
+			break
+		}
+	}
+	return ret
+}
+
+// QueryPages implements QueryableStore.  If TemplateStore is a TemplateQuerier
+// the conditions and sorting are done by it.
+func (s *PageInfoFromTmplStore) QueryPages(q Query) ([]string, error) {
+
+	tq, ok := s.TemplateStore.(TemplateQuerier)
+	if !ok {
+		return MemQueryPages(s, q)
+	}
+
+	// limit and offset are done here, since some templates may not be pages
+	fileNames, err := tq.Query(tmpl.Query{
+		Category:       s.category(),
+		FileNamePrefix: dirPrefix(q.PathPrefix),
+		Conds:          q.Conds,
+		Sort:           q.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.pathsFor(fileNames, q.PathPrefix, q.Offset, q.Limit), nil
 }
@@ -0,0 +1,175 @@
+package pageinfo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gocaveman/caveman/tmpl"
+)
+
+// Query describes a search of pages by meta.  Conditions and sorting follow
+// the same rules as tmpl.Query.
+type Query struct {
+	PathPrefix string           // same as for FindByPath
+	Conds      []tmpl.Cond      // all must match
+	Sort       []tmpl.SortField // sequence of keys to sort by, then by path
+	Limit      int              // <= 0 means no limit
+	Offset     int              // number of results to skip
+}
+
+// IsValid returns an error if the Query is not usable.
+func (q Query) IsValid() error {
+	for _, c := range q.Conds {
+		if err := c.IsValid(); err != nil {
+			return err
+		}
+	}
+	for _, sf := range q.Sort {
+		if sf.Key == "" {
+			return fmt.Errorf("query sort has no key")
+		}
+	}
+	return nil
+}
+
+// QueryableStore is implemented by Stores which can efficiently search pages by meta.
+type QueryableStore interface {
+	Store
+	QueryPages(q Query) ([]string, error)
+}
+
+// QueryPages runs a Query against a Store.  If it implements QueryableStore
+// that is used, otherwise it falls back to MemQueryPages.
+func QueryPages(s Store, q Query) ([]string, error) {
+	if qs, ok := s.(QueryableStore); ok {
+		return qs.QueryPages(q)
+	}
+	return MemQueryPages(s, q)
+}
+
+// MemQueryPages runs a Query by reading each page under the prefix and filtering in memory.
+func MemQueryPages(s Store, q Query) ([]string, error) {
+
+	if err := q.IsValid(); err != nil {
+		return nil, err
+	}
+
+	paths, err := s.FindByPath(q.PathPrefix, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]tmpl.MetaItem, 0, len(paths))
+	for _, p := range paths {
+		_, meta, err := s.ReadPageInfo(p)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, tmpl.MetaItem{Name: p, Meta: meta})
+	}
+
+	items = tmpl.FilterMetaItems(items, q.Conds, q.Sort, q.Offset, q.Limit)
+
+	ret := make([]string, 0, len(items))
+	for _, item := range items {
+		ret = append(ret, item.Name)
+	}
+	return ret, nil
+}
+
+// PageInfoQuerier is the context key for the Querier provided by QueryHandler.
+const PageInfoQuerier = "pageinfo.Querier"
+
+// QueryHandler is a handler which makes a Querier for Store available in the
+// request context with the key "pageinfo.Querier", so templates can render
+// dynamic listings of pages.
+type QueryHandler struct {
+	Store Store `autowire:"pageinfo.Store"`
+}
+
+func (h *QueryHandler) ServeHTTPChain(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, *http.Request) {
+	ctx := r.Context()
+	// don't override existing one
+	if ctx.Value(PageInfoQuerier) != nil {
+		return w, r
+	}
+	ctx = context.WithValue(ctx, PageInfoQuerier, &Querier{Store: h.Store})
+	return w, r.WithContext(ctx)
+}
+
+// Querier provides page queries with method chaining, intended for use in templates:
+//
+//	{{$q := (($.Value "pageinfo.Querier").Query "/blog/").Where "tags" "contains" "go"}}
+//	{{range (($q.OrderBy "-date").Limit 10).Pages}}
+//	  <a href="{{.Path}}">{{index .Meta "title"}}</a>
+//	{{end}}
+//
+// Each method returns a new Querier and the original is not modified.
+type Querier struct {
+	Store Store
+	q     Query
+}
+
+// Query returns a Querier for pages under pathPrefix.
+func (qr *Querier) Query(pathPrefix string) *Querier {
+	ret := *qr
+	ret.q = Query{PathPrefix: pathPrefix}
+	return &ret
+}
+
+// Where adds a condition, op is one of the tmpl.Op... constants ("=", "contains", "<", "<=", ">", ">=").
+func (qr *Querier) Where(key, op string, value interface{}) *Querier {
+	ret := *qr
+	ret.q.Conds = append(append([]tmpl.Cond(nil), qr.q.Conds...), tmpl.Cond{Key: key, Op: op, Value: value})
+	return &ret
+}
+
+// OrderBy adds keys to sort by, a "-" prefix means descending.
+func (qr *Querier) OrderBy(keys ...string) *Querier {
+	ret := *qr
+	ret.q.Sort = append(append([]tmpl.SortField(nil), qr.q.Sort...), tmpl.ParseSortFields(keys...)...)
+	return &ret
+}
+
+// Limit sets the maximum number of results.
+func (qr *Querier) Limit(n int) *Querier {
+	ret := *qr
+	ret.q.Limit = n
+	return &ret
+}
+
+// Offset sets the number of results to skip.
+func (qr *Querier) Offset(n int) *Querier {
+	ret := *qr
+	ret.q.Offset = n
+	return &ret
+}
+
+// Paths runs the query and returns the matching paths.
+func (qr *Querier) Paths() ([]string, error) {
+	return QueryPages(qr.Store, qr.q)
+}
+
+// Pages runs the query and returns the matching PageInfos.
+func (qr *Querier) Pages() ([]PageInfo, error) {
+	paths, err := qr.Paths()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PageInfo, 0, len(paths))
+	for _, p := range paths {
+		fn, meta, err := qr.Store.ReadPageInfo(p)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, PageInfo{Path: p, TmplFileName: fn, Meta: meta})
+	}
+	return ret, nil
+}
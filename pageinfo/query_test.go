@@ -0,0 +1,110 @@
+package pageinfo
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryPages(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestQueryPages")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.Mkdir(filepath.Join(tmpDir, "blog"), 0755))
+	files := map[string]string{
+		"blog/index.gohtml":  "---\ntitle: Blog\n---\n",
+		"blog/post1.gohtml":  "---\ntitle: Post 1\ndate: 2018-01-10\ntags: [go]\n---\n",
+		"blog/post2.md":      "---\ntitle: Post 2\ndate: 2018-03-01\ntags: [go]\n---\n",
+		"blog/_draft.gohtml": "---\ntitle: Draft\ndate: 2018-04-01\ntags: [go]\n---\n",
+		"blog/styles.css":    "",
+	}
+	for name, contents := range files {
+		assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(contents), 0644))
+	}
+
+	hfs := &tmpl.HFSStore{
+		FileSystems: map[string]http.FileSystem{tmpl.ViewsCategory: http.Dir(tmpDir)},
+	}
+	s := &PageInfoFromTmplStore{TemplateStore: hfs}
+
+	paths, err := s.FindByPath("/blog/", -1)
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/", "/blog/post1", "/blog/post2"}, paths)
+
+	fn, meta, err := s.ReadPageInfo("/blog/")
+	assert.NoError(err)
+	assert.Equal("/blog/index.gohtml", fn)
+	assert.Equal("Blog", meta["title"])
+	_, _, err = s.ReadPageInfo("/blog/_draft")
+	assert.Equal(ErrNotFound, err)
+
+	qr := (&Querier{Store: s}).Query("/blog/")
+	pages, err := qr.Where("tags", tmpl.OpContains, "go").OrderBy("-date").Pages()
+	assert.NoError(err)
+	if assert.Len(pages, 2) {
+		assert.Equal("/blog/post2", pages[0].Path)
+		assert.Equal("/blog/post2.md", pages[0].TmplFileName)
+		assert.Equal("Post 1", pages[1].Meta["title"])
+	}
+
+	// the original Querier is unchanged
+	paths, err = qr.Limit(1).Paths()
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/"}, paths)
+
+	// with a store that matches the file name prefix exactly, like the SQL based ones
+	ps := &PageInfoFromTmplStore{TemplateStore: prefixStore{hfs}}
+	for _, prefix := range []string{"", "/", "/blog", "/blog/"} {
+		paths, err = ps.FindByPath(prefix, -1)
+		assert.NoError(err)
+		assert.Equal([]string{"/blog/", "/blog/post1", "/blog/post2"}, paths, "prefix %q", prefix)
+		paths, err = ps.QueryPages(Query{PathPrefix: prefix, Conds: []tmpl.Cond{{Key: "tags", Op: tmpl.OpContains, Value: "go"}}})
+		assert.NoError(err)
+		assert.Equal([]string{"/blog/post1", "/blog/post2"}, paths, "prefix %q", prefix)
+	}
+	paths, err = ps.FindByPath("/blog/post", -1)
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post1", "/blog/post2"}, paths)
+
+	paths, err = QueryPages(PageInfoListStore{
+		{Path: "/a", Meta: map[string]interface{}{"n": 2}},
+		{Path: "/b", Meta: map[string]interface{}{"n": 1}},
+	}, Query{PathPrefix: "/", Sort: tmpl.ParseSortFields("n")})
+	assert.NoError(err)
+	assert.Equal([]string{"/b", "/a"}, paths)
+
+}
+
+// prefixStore is a tmpl.Store whose FindByPrefix and Query only match file names
+// which start with the exact prefix.
+type prefixStore struct {
+	tmpl.Store
+}
+
+func (s prefixStore) FindByPrefix(category, fileNamePrefix string, limit int) ([]string, error) {
+	names, err := s.Store.FindByPrefix(category, "/", -1)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, name := range names {
+		if strings.HasPrefix(name, fileNamePrefix) && (limit <= 0 || len(ret) < limit) {
+			ret = append(ret, name)
+		}
+	}
+	return ret, nil
+}
+
+func (s prefixStore) Query(q tmpl.Query) ([]string, error) {
+	return tmpl.MemQuery(s, q)
+}
@@ -0,0 +1,34 @@
+package search
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/gocaveman/caveman/tmpl/tmplregistry"
+)
+
+//go:embed includes
+var embeddedAssets embed.FS
+
+func init() {
+	tmplregistry.MustRegister(tmplregistry.SeqTheme, "search", NewTmplStore())
+}
+
+func NewIncludesFS() http.FileSystem {
+	sub, err := fs.Sub(embeddedAssets, "includes")
+	if err != nil {
+		panic(err)
+	}
+	return http.FS(sub)
+}
+
+// NewTmplStore returns a tmpl.Store with the "/search-results.gohtml" include.
+func NewTmplStore() tmpl.Store {
+	return &tmpl.HFSStore{
+		FileSystems: map[string]http.FileSystem{
+			tmpl.IncludesCategory: NewIncludesFS(),
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gocaveman/caveman/webutil"
+)
+
+// SearchResults is the context key for the Results set by SearchHandler.
+const SearchResults = "search.Results"
+
+// SearchHandler runs searches against an Index.  Requests to APIPath get the Results as
+// JSON.  Requests to Path get the Results in the request context with the key
+// "search.Results" and continue down the chain, so the page at that path can render them
+// with {{template "/search-results.gohtml" .}}.  The query is read from the "q" parameter
+// and the page of results from "offset".
+type SearchHandler struct {
+	Index *MemIndex `autowire:"search.Index"`
+
+	Path       string                       // search page, defaults to "/search"
+	APIPath    string                       // JSON API, defaults to "/api/search", set to "-" to disable
+	PerPage    int                          // hits per page, defaults to 10
+	LocaleFunc func(r *http.Request) string // optional, locale to search in for a request
+}
+
+// NewSearchHandler returns a SearchHandler with the default settings.
+func NewSearchHandler(index *MemIndex) *SearchHandler {
+	return &SearchHandler{Index: index}
+}
+
+func (h *SearchHandler) ServeHTTPChain(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, *http.Request) {
+
+	p, apiPath := h.Path, h.APIPath
+	if p == "" {
+		p = "/search"
+	}
+	if apiPath == "" {
+		apiPath = "/api/search"
+	}
+
+	switch r.URL.Path {
+
+	case apiPath:
+		if r.Method != "GET" {
+			webutil.HTTPError(w, r, nil, "method not allowed", 405)
+			return w, r
+		}
+		webutil.WriteJSON(w, h.search(r), 200)
+		return w, r
+
+	case p:
+		ctx := context.WithValue(r.Context(), SearchResults, h.search(r))
+		return w, r.WithContext(ctx)
+
+	}
+
+	return w, r
+}
+
+func (h *SearchHandler) search(r *http.Request) *Results {
+
+	perPage := h.PerPage
+	if perPage <= 0 {
+		perPage = 10
+	}
+
+	q := r.FormValue("q")
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+
+	var locale string
+	if h.LocaleFunc != nil {
+		locale = h.LocaleFunc(r)
+	}
+
+	return h.Index.Search(q, locale, offset, perPage)
+}
@@ -0,0 +1,176 @@
+package search
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gocaveman/caveman/pageinfo"
+	"github.com/gocaveman/caveman/tmpl"
+)
+
+// PageTextFunc returns the plain text of a page for indexing.  It can be used to index
+// the rendered output of pages rather than the template body.
+type PageTextFunc func(path, tmplFileName string, meta map[string]interface{}) (string, error)
+
+// TemplatePathMapper is implemented by pageinfo Stores which know the page path for a
+// template (e.g. pageinfo.PageInfoFromTmplStore), allowing single pages to be re-indexed
+// when their template changes.
+type TemplatePathMapper interface {
+	PathFor(fileName string) string
+}
+
+// Indexer reads each page from a pageinfo.Store and puts it in a MemIndex.
+//
+// The text of a page is its template body with template actions and HTML tags removed,
+// or the result of TextFunc if set.  The title comes from the "title" meta key and the
+// locale from "locale" (DefaultLocale if not set).  Pages with "noindex" set to true in
+// their meta are not indexed.
+type Indexer struct {
+	PageInfoStore  pageinfo.Store      `autowire:"pageinfo.Store"`
+	TemplateReader tmpl.TemplateReader `autowire:"tmpl.Store"`
+	Index          *MemIndex           `autowire:"search.Index"`
+
+	Category      string       // template category page templates are in, defaults to "views"
+	DefaultLocale string       // locale for pages without one in their meta
+	TextFunc      PageTextFunc // optional, overrides reading the template body
+
+	mu sync.Mutex // serializes IndexAll
+}
+
+func (ix *Indexer) category() string {
+	if ix.Category == "" {
+		return tmpl.ViewsCategory
+	}
+	return ix.Category
+}
+
+// IndexAll clears the index and indexes every page in PageInfoStore.
+func (ix *Indexer) IndexAll() error {
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	paths, err := ix.PageInfoStore.FindByPath("/", -1)
+	if err != nil {
+		return err
+	}
+
+	docs := make([]Document, 0, len(paths))
+	for _, p := range paths {
+		doc, ok, err := ix.document(p)
+		if err != nil {
+			return err
+		}
+		if ok {
+			docs = append(docs, doc)
+		}
+	}
+
+	ix.Index.Clear()
+	for _, doc := range docs {
+		ix.Index.Put(doc)
+	}
+	return nil
+}
+
+// IndexPage indexes a single page, removing it from the index if it no longer exists.
+func (ix *Indexer) IndexPage(path string) error {
+	doc, ok, err := ix.document(path)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		ix.Index.Remove(path)
+		return nil
+	}
+	ix.Index.Put(doc)
+	return nil
+}
+
+// document reads a page, ok is false if it does not exist or should not be indexed.
+func (ix *Indexer) document(path string) (doc Document, ok bool, err error) {
+
+	tmplFileName, meta, err := ix.PageInfoStore.ReadPageInfo(path)
+	if err == pageinfo.ErrNotFound {
+		return doc, false, nil
+	}
+	if err != nil {
+		return doc, false, err
+	}
+
+	if noindex, _ := meta["noindex"].(bool); noindex {
+		return doc, false, nil
+	}
+
+	doc.Path = path
+	doc.Title, _ = meta["title"].(string)
+	doc.Locale, _ = meta["locale"].(string)
+	if doc.Locale == "" {
+		doc.Locale = ix.DefaultLocale
+	}
+
+	if ix.TextFunc != nil {
+		doc.Text, err = ix.TextFunc(path, tmplFileName, meta)
+		if err != nil {
+			return doc, false, fmt.Errorf("search: error getting text for %q: %v", path, err)
+		}
+		return doc, true, nil
+	}
+
+	if tmplFileName != "" && ix.TemplateReader != nil {
+		body, _, _, err := ix.TemplateReader.ReadTemplate(ix.category(), tmplFileName)
+		if err != nil && err != tmpl.ErrNotFound {
+			return doc, false, err
+		}
+		doc.Text = TemplateText(body)
+	}
+	if description, _ := meta["description"].(string); description != "" {
+		doc.Text = description + "\n" + doc.Text
+	}
+
+	return doc, true, nil
+}
+
+var (
+	tmplActionRE = regexp.MustCompile(`(?s){{.*?}}`)
+	scriptRE     = regexp.MustCompile(`(?is)<(script|style)\b.*?</(script|style)>`)
+	htmlTagRE    = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// TemplateText returns the plain text in a template body, without template actions,
+// scripts, styles or HTML tags and with entities decoded.
+func TemplateText(body []byte) string {
+	s := tmplActionRE.ReplaceAllString(string(body), " ")
+	s = scriptRE.ReplaceAllString(s, " ")
+	s = htmlTagRE.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Watch keeps the index up to date with changes to the templates in ws.  If PageInfoStore
+// implements TemplatePathMapper only the page for a changed template is re-indexed,
+// otherwise every change re-indexes all pages.  When TextFunc is set any change re-indexes
+// all pages, since the output can depend on any template.
+func (ix *Indexer) Watch(ws tmpl.WatchableStore) (unwatch func(), err error) {
+	mapper, _ := ix.PageInfoStore.(TemplatePathMapper)
+	return ws.Watch(func(ev tmpl.Event) {
+		var err error
+		switch {
+		case ix.TextFunc == nil && ev.Category != "" && ev.Category != ix.category():
+			// template text is all we index, other categories do not matter
+		case mapper != nil && ix.TextFunc == nil && ev.FileName != "":
+			if p := mapper.PathFor(ev.FileName); p != "" {
+				err = ix.IndexPage(p)
+			}
+		default:
+			err = ix.IndexAll()
+		}
+		if err != nil {
+			log.Printf("search: error updating index after %v of %q: %v", ev.Type, ev.FileName, err)
+		}
+	})
+}
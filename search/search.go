@@ -0,0 +1,342 @@
+// Full-text search of the pages on a site, without an external service.
+//
+// Pages are read from a pageinfo.Store by an Indexer and kept in a MemIndex, an in-process
+// inverted index.  Words are stemmed according to the locale of each page (see Stemmer).
+// SearchHandler runs searches for a search page (the "/search-results.gohtml" include
+// shows the results with highlighted snippets and pagination) and as a JSON API.
+package search
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Document is a page to be indexed.
+type Document struct {
+	Path   string `json:"path"`   // path of the page on the site, unique within an index
+	Title  string `json:"title"`  // title, matches here score higher
+	Text   string `json:"text"`   // plain text content of the page
+	Locale string `json:"locale"` // locale of the page, determines stemming
+}
+
+// Hit is one search result.
+type Hit struct {
+	Path    string        `json:"path"`
+	Title   string        `json:"title"`
+	Snippet template.HTML `json:"snippet"` // text around the first match with matches in <mark> tags
+	Score   float64       `json:"score"`
+}
+
+// Results is a page of search results.
+type Results struct {
+	Query  string `json:"query"`
+	Total  int    `json:"total"`  // total number of matches
+	Offset int    `json:"offset"` // index of the first hit in all matches
+	Limit  int    `json:"limit"`  // maximum number of hits per page
+	Hits   []Hit  `json:"hits"`
+}
+
+// PageNum returns the current page number, starting at 1.
+func (r *Results) PageNum() int {
+	if r.Limit <= 0 {
+		return 1
+	}
+	return r.Offset/r.Limit + 1
+}
+
+// PageCount returns the number of pages of results.
+func (r *Results) PageCount() int {
+	if r.Limit <= 0 || r.Total == 0 {
+		return 1
+	}
+	return (r.Total + r.Limit - 1) / r.Limit
+}
+
+// Start returns the number of the first hit on this page, starting at 1.
+func (r *Results) Start() int { return r.Offset + 1 }
+
+// HasPrev returns true if there is a previous page.
+func (r *Results) HasPrev() bool { return r.Offset > 0 }
+
+// HasNext returns true if there is a next page.
+func (r *Results) HasNext() bool { return r.Limit > 0 && r.Offset+r.Limit < r.Total }
+
+// PrevOffset returns the offset of the previous page.
+func (r *Results) PrevOffset() int {
+	if o := r.Offset - r.Limit; o > 0 {
+		return o
+	}
+	return 0
+}
+
+// NextOffset returns the offset of the next page.
+func (r *Results) NextOffset() int { return r.Offset + r.Limit }
+
+// indexedDoc is a Document with its term frequencies.
+type indexedDoc struct {
+	Document
+	titleTF map[string]int
+	textTF  map[string]int
+}
+
+// MemIndex is an in-process inverted index of Documents.  It is safe for concurrent use.
+// The zero value is ready to use.
+type MemIndex struct {
+	mu       sync.RWMutex
+	docs     map[string]*indexedDoc
+	postings map[string]map[string]struct{} // term -> set of paths
+}
+
+// NewMemIndex returns a new empty MemIndex.
+func NewMemIndex() *MemIndex {
+	return &MemIndex{}
+}
+
+// Put adds or replaces a Document.
+func (ix *MemIndex) Put(doc Document) {
+
+	d := &indexedDoc{
+		Document: doc,
+		titleTF:  termFreq(Tokenize(doc.Title, doc.Locale)),
+		textTF:   termFreq(Tokenize(doc.Text, doc.Locale)),
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeLocked(doc.Path)
+
+	if ix.docs == nil {
+		ix.docs = make(map[string]*indexedDoc)
+		ix.postings = make(map[string]map[string]struct{})
+	}
+	ix.docs[doc.Path] = d
+	for _, tf := range []map[string]int{d.titleTF, d.textTF} {
+		for term := range tf {
+			p := ix.postings[term]
+			if p == nil {
+				p = make(map[string]struct{})
+				ix.postings[term] = p
+			}
+			p[doc.Path] = struct{}{}
+		}
+	}
+}
+
+// Remove removes the Document with a path, if it exists.
+func (ix *MemIndex) Remove(path string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.removeLocked(path)
+}
+
+// Clear removes all Documents.
+func (ix *MemIndex) Clear() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.docs = nil
+	ix.postings = nil
+}
+
+func (ix *MemIndex) removeLocked(path string) {
+	d := ix.docs[path]
+	if d == nil {
+		return
+	}
+	for _, tf := range []map[string]int{d.titleTF, d.textTF} {
+		for term := range tf {
+			p := ix.postings[term]
+			delete(p, path)
+			if len(p) == 0 {
+				delete(ix.postings, term)
+			}
+		}
+	}
+	delete(ix.docs, path)
+}
+
+// Len returns the number of Documents.
+func (ix *MemIndex) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.docs)
+}
+
+// Paths returns the paths of all Documents, sorted.
+func (ix *MemIndex) Paths() []string {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	ret := make([]string, 0, len(ix.docs))
+	for p := range ix.docs {
+		ret = append(ret, p)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func termFreq(terms []string) map[string]int {
+	ret := make(map[string]int, len(terms))
+	for _, t := range terms {
+		ret[t]++
+	}
+	return ret
+}
+
+// titleWeight is how much more a match in the title counts than one in the text.
+const titleWeight = 3
+
+// Search returns the Documents which contain all of the words in query, best matches first.
+// Query words are stemmed using locale, and if locale is not empty only Documents with
+// that locale (or no locale) are returned.  Offset and limit select the page of results,
+// limit <= 0 means all.
+func (ix *MemIndex) Search(query, locale string, offset, limit int) *Results {
+
+	ret := &Results{Query: query, Offset: offset, Limit: limit, Hits: []Hit{}}
+
+	terms := uniqueStrings(Tokenize(query, locale))
+	if len(terms) == 0 {
+		return ret
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	// start with the term with the fewest documents
+	sort.Slice(terms, func(i, j int) bool { return len(ix.postings[terms[i]]) < len(ix.postings[terms[j]]) })
+
+	n := float64(len(ix.docs))
+	var hits []Hit
+	var matched []*indexedDoc
+	for path := range ix.postings[terms[0]] {
+		d := ix.docs[path]
+		if locale != "" && d.Locale != "" && !strings.EqualFold(d.Locale, locale) {
+			continue
+		}
+		score := 0.0
+		for _, term := range terms {
+			tf := d.titleTF[term]*titleWeight + d.textTF[term]
+			if tf == 0 {
+				score = -1
+				break
+			}
+			idf := math.Log(1 + n/float64(len(ix.postings[term])))
+			score += (1 + math.Log(float64(tf))) * idf
+		}
+		if score < 0 {
+			continue
+		}
+		hits = append(hits, Hit{Path: d.Path, Title: d.Title, Score: score})
+		matched = append(matched, d)
+	}
+
+	idx := make([]int, len(hits))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		a, b := hits[idx[i]], hits[idx[j]]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Path < b.Path
+	})
+
+	ret.Total = len(hits)
+	start, end := offset, len(idx)
+	if start < 0 {
+		start = 0
+	}
+	if start > end {
+		start = end
+	}
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	for _, i := range idx[start:end] {
+		h := hits[i]
+		h.Snippet = snippet(matched[i].Text, matched[i].Locale, terms)
+		ret.Hits = append(ret.Hits, h)
+	}
+
+	return ret
+}
+
+func uniqueStrings(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	ret := make([]string, 0, len(in))
+	for _, s := range in {
+		if !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// SnippetWords is the number of words in a snippet.
+var SnippetWords = 30
+
+// snippet returns the text around the first match of any of terms, html escaped
+// and with matches wrapped in <mark>.
+func snippet(text, locale string, terms []string) template.HTML {
+
+	spans := wordSpans(text)
+	if len(spans) == 0 {
+		return ""
+	}
+
+	stemmer := StemmerFor(locale)
+	termSet := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		termSet[t] = true
+	}
+	isMatch := func(sp span) bool {
+		return termSet[stemmer.Stem(strings.ToLower(text[sp.start:sp.end]))]
+	}
+
+	first := 0
+	for i, sp := range spans {
+		if isMatch(sp) {
+			first = i
+			break
+		}
+	}
+
+	// put the first match a few words into the snippet
+	start := first - SnippetWords/5
+	if start < 0 {
+		start = 0
+	}
+	end := start + SnippetWords
+	if end > len(spans) {
+		end = len(spans)
+	}
+
+	var buf bytes.Buffer
+	if start > 0 {
+		buf.WriteString("&hellip; ")
+	}
+	pos := spans[start].start
+	for _, sp := range spans[start:end] {
+		buf.WriteString(html.EscapeString(text[pos:sp.start]))
+		word := html.EscapeString(text[sp.start:sp.end])
+		if isMatch(sp) {
+			buf.WriteString("<mark>" + word + "</mark>")
+		} else {
+			buf.WriteString(word)
+		}
+		pos = sp.end
+	}
+	if end < len(spans) {
+		buf.WriteString(" &hellip;")
+	} else {
+		buf.WriteString(html.EscapeString(text[pos:]))
+	}
+
+	return template.HTML(strings.Join(strings.Fields(buf.String()), " "))
+}
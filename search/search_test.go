@@ -0,0 +1,164 @@
+package search
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gocaveman/caveman/pageinfo"
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemEnglish(t *testing.T) {
+
+	assert := assert.New(t)
+
+	for _, words := range [][]string{
+		{"search", "searches", "searched", "searching"},
+		{"page", "pages", "paging"},
+		{"run", "runs", "running"},
+		{"story", "stories"},
+		{"quick", "quickly"},
+	} {
+		for _, w := range words[1:] {
+			assert.Equal(StemEnglish(words[0]), StemEnglish(w), "%q and %q", words[0], w)
+		}
+	}
+	assert.NotEqual(StemEnglish("bus"), StemEnglish("bu"))
+
+	assert.Equal([]string{"dont", "stop", "believ"}, Tokenize("Don't stop BELIEVING!", "en-us"))
+	assert.Equal([]string{"häuser", "laufen"}, Tokenize("Häuser, laufen.", "de"))
+
+}
+
+func TestMemIndex(t *testing.T) {
+
+	assert := assert.New(t)
+
+	ix := NewMemIndex()
+	ix.Put(Document{Path: "/go", Title: "Searching with Go", Text: "Go makes it easy to write a search engine. <Fast> too."})
+	ix.Put(Document{Path: "/python", Title: "Python", Text: "Python programs can search text as well."})
+	ix.Put(Document{Path: "/other", Title: "Other", Text: "Nothing to see here."})
+	ix.Put(Document{Path: "/es", Title: "Buscar", Text: "search en español", Locale: "es"})
+
+	r := ix.Search("searches", "en", 0, 10)
+	assert.Equal(2, r.Total)
+	if assert.Len(r.Hits, 2) {
+		// title matches score higher
+		assert.Equal("/go", r.Hits[0].Path)
+		assert.Equal("/python", r.Hits[1].Path)
+		assert.Contains(string(r.Hits[1].Snippet), "can <mark>search</mark> text")
+	}
+	assert.Equal(3, ix.Search("search", "", 0, 10).Total)
+
+	// all words must match
+	r = ix.Search("search fast", "en", 0, 10)
+	if assert.Len(r.Hits, 1) {
+		assert.Contains(string(r.Hits[0].Snippet), "&lt;<mark>Fast</mark>&gt;")
+	}
+
+	// paging
+	r = ix.Search("search", "en", 1, 1)
+	assert.Equal(2, r.Total)
+	assert.Len(r.Hits, 1)
+	assert.Equal(2, r.PageNum())
+	assert.Equal(2, r.PageCount())
+	assert.True(r.HasPrev())
+	assert.False(r.HasNext())
+
+	ix.Put(Document{Path: "/python", Title: "Python", Text: "Replaced."})
+	assert.Equal(1, ix.Search("search", "en", 0, 10).Total)
+	ix.Remove("/go")
+	assert.Equal(0, ix.Search("search", "en", 0, 10).Total)
+	assert.Equal([]string{"/es", "/other", "/python"}, ix.Paths())
+
+}
+
+func TestIndexer(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestIndexer")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	write := func(name, contents string) {
+		assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(contents), 0644))
+	}
+	write("index.gohtml", "---\ntitle: Home\n---\n{{template \"/main-page.gohtml\" .}}\n{{define \"body\"}}<h1>Welcome</h1><p>Cats &amp; dogs.</p><script>var cats = 1;</script>{{end}}")
+	write("about.md", "---\ntitle: About\nlocale: en\n---\nAll about our cats.")
+	write("_hidden.gohtml", "---\ntitle: Hidden\n---\ncats")
+	write("private.gohtml", "---\ntitle: Private\nnoindex: true\n---\ncats")
+
+	ts := &tmpl.HFSStore{
+		FileSystems:  map[string]http.FileSystem{tmpl.ViewsCategory: http.Dir(tmpDir)},
+		PollInterval: 10 * time.Millisecond,
+	}
+	ix := &Indexer{
+		PageInfoStore:  &pageinfo.PageInfoFromTmplStore{TemplateStore: ts},
+		TemplateReader: ts,
+		Index:          NewMemIndex(),
+	}
+	assert.NoError(ix.IndexAll())
+	assert.Equal([]string{"/", "/about"}, ix.Index.Paths())
+
+	r := ix.Index.Search("cat", "", 0, 10)
+	if assert.Len(r.Hits, 2) {
+		assert.Equal("Welcome <mark>Cats</mark> &amp; dogs.", string(r.Hits[0].Snippet))
+	}
+	assert.Equal(0, ix.Index.Search("var", "", 0, 10).Total)
+
+	unwatch, err := ix.Watch(ts)
+	assert.NoError(err)
+	defer unwatch()
+
+	write("new.gohtml", "---\ntitle: New\n---\nA new page about birds.")
+	for i := 0; i < 100 && ix.Index.Search("birds", "", 0, 10).Total == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(1, ix.Index.Search("birds", "", 0, 10).Total)
+
+}
+
+func TestSearchHandler(t *testing.T) {
+
+	assert := assert.New(t)
+
+	ix := NewMemIndex()
+	ix.Put(Document{Path: "/go", Title: "Go <3", Text: "Go makes it easy to write a search engine."})
+
+	h := NewSearchHandler(ix)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTPChain(w, httptest.NewRequest("GET", "/api/search?q=engine", nil))
+	assert.Equal(200, w.Code)
+	var r Results
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &r))
+	assert.Equal(1, r.Total)
+
+	w = httptest.NewRecorder()
+	_, req := h.ServeHTTPChain(w, httptest.NewRequest("GET", "/search?q=engine", nil))
+	assert.Equal(0, w.Body.Len())
+
+	// render the include with the results
+	b, err := ioutil.ReadFile("includes/search-results.gohtml")
+	assert.NoError(err)
+	tmpl := template.Must(template.New("test").Parse(string(b)))
+	var buf bytes.Buffer
+	assert.NoError(tmpl.Execute(&buf, req.Context()))
+	out := buf.String()
+	assert.Contains(out, `<a href="/go">Go &lt;3</a>`)
+	assert.Contains(out, `<mark>engine</mark>`)
+	assert.Contains(out, `1 result for`)
+	assert.False(strings.Contains(out, "search-pages"))
+
+}
@@ -0,0 +1,146 @@
+package search
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Stemmer reduces a lower case word to its stem, so different forms of the same
+// word ("search", "searches", "searching") match each other.
+type Stemmer interface {
+	Stem(word string) string
+}
+
+// StemmerFunc adapts a function to implement Stemmer.
+type StemmerFunc func(word string) string
+
+func (f StemmerFunc) Stem(word string) string { return f(word) }
+
+var (
+	stemmersMu sync.RWMutex
+	stemmers   = map[string]Stemmer{
+		"en": StemmerFunc(StemEnglish),
+	}
+)
+
+// RegisterStemmer sets the Stemmer for a locale.  Locales follow the i18n package
+// conventions ("en", "es", "en-gb", etc.) and are case-insensitive.
+func RegisterStemmer(locale string, s Stemmer) {
+	stemmersMu.Lock()
+	defer stemmersMu.Unlock()
+	stemmers[strings.ToLower(locale)] = s
+}
+
+// StemmerFor returns the Stemmer for a locale, falling back to the language without
+// a subtag ("en-gb" -> "en").  The empty locale uses English and locales with no
+// Stemmer registered are not stemmed.
+func StemmerFor(locale string) Stemmer {
+	locale = strings.ToLower(locale)
+	if locale == "" {
+		locale = "en"
+	}
+	stemmersMu.RLock()
+	defer stemmersMu.RUnlock()
+	if s, ok := stemmers[locale]; ok {
+		return s
+	}
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		if s, ok := stemmers[locale[:i]]; ok {
+			return s
+		}
+	}
+	return noStemmer
+}
+
+var noStemmer = StemmerFunc(func(word string) string { return word })
+
+// span is the byte offsets of a word in text.
+type span struct {
+	start, end int
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// wordSpans returns the position of each word in text.
+func wordSpans(text string) []span {
+	var ret []span
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		// keep apostrophes inside words ("don't")
+		if r == '\'' && start >= 0 {
+			next, _ := utf8.DecodeRuneInString(text[i+1:])
+			if isWordRune(next) {
+				continue
+			}
+		}
+		if start >= 0 {
+			ret = append(ret, span{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		ret = append(ret, span{start, len(text)})
+	}
+	return ret
+}
+
+// Tokenize splits text into lower case words and stems each with the Stemmer for locale.
+func Tokenize(text, locale string) []string {
+	stemmer := StemmerFor(locale)
+	spans := wordSpans(text)
+	ret := make([]string, 0, len(spans))
+	for _, sp := range spans {
+		ret = append(ret, stemmer.Stem(strings.ToLower(text[sp.start:sp.end])))
+	}
+	return ret
+}
+
+// StemEnglish is a light English stemmer which removes common plural, verb and adverb
+// suffixes.  It does not always produce real words ("create" -> "creat") but maps the
+// common forms of a word to the same stem.
+func StemEnglish(w string) string {
+
+	w = strings.Replace(w, "'", "", -1)
+	if len(w) <= 3 {
+		return w
+	}
+
+	switch {
+	case strings.HasSuffix(w, "ies") && len(w) > 4:
+		w = w[:len(w)-3] + "y"
+	case strings.HasSuffix(w, "sses"), strings.HasSuffix(w, "ches"), strings.HasSuffix(w, "shes"),
+		strings.HasSuffix(w, "xes"), strings.HasSuffix(w, "zes"):
+		w = w[:len(w)-2]
+	case strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") &&
+		!strings.HasSuffix(w, "us") && !strings.HasSuffix(w, "is"):
+		w = w[:len(w)-1]
+	}
+
+	for _, suffix := range []string{"ingly", "edly", "ing", "ed", "ly"} {
+		if !strings.HasSuffix(w, suffix) || len(w)-len(suffix) < 3 {
+			continue
+		}
+		w = w[:len(w)-len(suffix)]
+		// "running" -> "runn" -> "run"
+		if n := len(w); w[n-1] == w[n-2] && !strings.ContainsRune("aeioulsz", rune(w[n-1])) {
+			w = w[:n-1]
+		}
+		break
+	}
+
+	if len(w) > 3 && strings.HasSuffix(w, "e") {
+		w = w[:len(w)-1]
+	}
+
+	return w
+}
@@ -1,202 +0,0 @@
-// Code generated by vfsgen; DO NOT EDIT.
-
-// +build !cavemandev
-
-package paleolithic
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"os"
-	pathpkg "path"
-	"time"
-)
-
-// EmbeddedAssets statically implements the virtual filesystem provided to vfsgen.
-var EmbeddedAssets = func() http.FileSystem {
-	mustUnmarshalTextTime := func(text string) time.Time {
-		var t time.Time
-		err := t.UnmarshalText([]byte(text))
-		if err != nil {
-			panic(err)
-		}
-		return t
-	}
-
-	fs := vfsgen۰FS{
-		"/": &vfsgen۰DirInfo{
-			name:    "/",
-			modTime: mustUnmarshalTextTime("2018-05-08T07:16:25Z"),
-		},
-		"/views": &vfsgen۰DirInfo{
-			name:    "views",
-			modTime: mustUnmarshalTextTime("2018-05-08T07:05:21Z"),
-		},
-		"/views/_404.gohtml": &vfsgen۰CompressedFileInfo{
-			name:             "_404.gohtml",
-			modTime:          mustUnmarshalTextTime("2018-05-10T03:01:12Z"),
-			uncompressedSize: 77389,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xbb\xc7\x8e\xf4\xda\x92\xa5\x39\xee\xfb\x14\xa7\x13\x35\x63\x77\x51\xab\xac\x9b\x17\xa0\x96\x4e\xad\x67\xd4\x5a\x2b\x27\x13\xf9\xee\x0d\xff\x4f\x57\x0e\x2b\x80\x40\x20\xe8\xce\xbd\x8d\x46\xdb\xcb\xbe\x45\xf1\xcf\xff\x9b\x37\x39\x2f\xb6\x84\xbf\x9a\x63\x1c\xfe\xf5\x8f\x7f\xfe\xef\x3f\x65\x5a\xfc\xeb\x1f\xff\xd7\x3f\x8f\xf6\x18\xca\x7f\x59\x69\x5d\xfe\x65\xcc\xc7\x5f\xe2\x7c\x4e\xc5\x3f\xc1\xbf\xb7\xfe\xe3\x9f\xe0\xdf\x5f\xfb\x67\x36\x17\xcf\xbf\xfe\xf1\x8f\x7f\xe6\xe5\x74\x94\xdb\x5f\xfb\xf1\x0c\xe5\x7f\xfc\xdb\x92\x16\x45\x3b\xd5\xff\xef\x31\x2f\xff\xfe\x17\x5a\x8e\xff\xeb\xdf\xfe\xf5\x8f\xff\xfc\xcf\xff\x51\x9d\xc3\xf0\xd7\xbf\xff\xc7\x5f\xff\xe3\x7f\x06\xe9\x70\x96\x7f\xfd\xdb\x56\x4e\x45\xb9\x95\xdb\xff\x14\xcf\x61\xf8\x4d\x25\x6c\xdb\xbc\xfd\xdb\x7f\xfd\xd7\x3f\xfe\xf3\x3f\xdb\xea\xaf\x3f\x3b\xfc\xd7\x7f\xfd\xe3\x9f\xed\x58\xff\xb5\x6f\xf9\x7f\xfc\x5b\x91\x1e\xe9\xbf\x1f\xe5\xf7\x00\x97\x21\x6d\xa7\xff\x95\xa5\x7b\x49\x60\xff\x4f\x1b\xb0\xa6\x73\x43\x9a\x54\xcf\x0c\xc3\x30\x86\xeb\x37\x82\x5f\x33\x0c\x13\xc7\x0c\xc3\xc8\x2d\xc7\x28\x0c\xc3\xb0\x5d\x03\xbe\x00\xc3\x30\x1c\x13\x0e\x7e\x28\x3b\xb1\xe7\xdf\x44\x8c\xd0\x87\x3e\x8a\x7d\x86\xa8\x83\x3e\x35\x47\xce\xfc\xfd\x63\xf1\x34\x96\x4b\x62\x97\x22\x01\xa4\x48\xea\x90\x20\xc3\x69\xb9\xfa\x75\x92\x54\xab\x48\x43\x6f\xb9\x6a\x64\xf8\xd0\xed\x45\x2c\x9f\x84\xcd\xe2\xca\xcb\x93\x04\x06\xe1\x0d\x4e\x57\x8e\x47\x67\x86\x76\x6b\xbd\x98\x66\xc9\x35\x51\x4a\xf0\x9d\x85\x01\x14\xbb\x2c\x96\x85\xdf\x33\x7f\x17\xcc\x72\xd5\x26\x91\xe8\x36\xf1\x96\xdf\xff\x47\x12\x39\x8d\xfe\x28\x75\xc9\x2f\x58\x16\xb1\x50\xfa\x42\x6d\x28\xc0\xb6\x22\x18\x57\x3e\xfa\xb5\xe1\x62\xb0\xde\x31\xad\x55\xcf\xb5\x25\xab\x7d\xd2\x2d\xae\x23\xc4\x75\x29\xc1\x7b\x36\x7d\x88\x7c\x74\x46\xcb\x55\xe7\x42\x76\x6e\xb3\xa5\xae\x02\x2d\x50\x7d\xca\x5f\x7d\xa4\x9f\xe4\xa1\xbe\xa6\xd7\xe3\xfa\xcb\x3c\xfa\xab\x3c\x7a\xa4\xf6\x49\x0b\xbf\x65\x88\x43\x71\x54\x1f\xd9\xf4\xe9\x94\x0e\xd3\x14\x8e\xa1\x7e\xe3\x98\xbd\x33\xe4\x88\xf1\xa4\xbf\x38\x42\xfa\x54\xfe\x9e\xaf\x89\x47\x1a\x2e\x78\xa8\x55\xea\xb9\x56\x38\xe6\xbf\xe7\x2e\xa4\x61\x4c\x3a\xa8\x4d\x65\x07\xca\xf9\xf9\xd2\x11\xfc\xfd\xef\x9c\x8e\xc6\x95\xb9\x34\x94\x86\xc9\xa8\xbf\xc2\xf9\xe1\xa8\x96\x6b\x99\x5a\xe1\xfe\x3b\x17\x43\x29\x0d\xa3\x13\xd4\xb4\x32\x36\x50\x21\x33\x84\xfe\xd0\x5d\x1a\xb1\x8d\x3e\x2e\xb7\x8e\x04\x58\x1a\xc6\xd7\xc7\xc5\x6e\xfd\x51\x7e\x31\xd6\x8a\xdc\x1c\x99\x84\xbf\xe6\x28\xc2\x25\xff\x7f\x9c\xf7\xcf\xd8\x7f\xcf\x4b\x37\x45\x54\x5f\x7f\xc7\xce\xfe\x89\xc7\xec\x87\x23\x0e\x8b\xc1\x93\x82\x33\x41\x9d\xd9\x72\x15\xdc\x78\xf3\xbf\xe3\x93\x9d\x25\x19\x63\xc2\x0d\xe1\x26\x41\x82\x28\x0d\x1d\x28\xe5\xa1\xd6\xf4\xe2\xfb\xff\x1f\xe3\x7f\xc7\x4d\xfc\xf6\xcf\x21\xf8\x4a\xa4\x60\xb7\x5c\xe5\xef\xfd\xa5\x5f\x6c\xcb\x27\x09\xf1\xf7\xb7\x4d\x7f\x31\x4d\xe1\xef\xeb\x4f\x7e\x07\x55\x70\x3a\x4c\xb3\x38\x1a\x33\x7f\x35\x27\xc1\x43\x21\x09\x00\xd7\xdd\x60\x29\xb3\x4d\x8c\x1c\x43\xc1\xb1\x43\x36\xda\xb4\x32\x29\xad\xf5\x12\x0d\xb5\x5c\xc8\xaf\x88\x6b\x69\xe0\x6d\x98\x7d\xfd\xbe\xe0\x14\x61\x10\xed\x37\xfe\x9a\x5e\x8c\xe8\x9e\x72\x7e\x6c\x86\xd1\x54\x11\xee\x33\x5a\xb7\x07\xc1\xff\xbe\xf4\x3a\x2f\x4e\xe3\x0b\x06\x5f\x43\x36\x9c\x26\x0d\x0b\xb3\x5a\xdf\x30\xbe\x13\xd7\x49\xa2\x57\xdb\xe3\x10\x3c\x52\x7e\x28\x25\xb5\xe1\xb4\x89\xb5\x79\x09\x68\x1e\xb4\x43\x98\xdd\x61\xd6\x67\x1d\xd6\xe0\x6d\x58\x24\x43\xff\xfa\xe0\xfa\x1d\xb7\xd4\x80\x5e\x16\x8e\x92\x97\x19\x17\xef\x7b\x07\x9b\xae\x55\x3d\xed\xc6\x20\xce\x1c\xa2\xee\xcc\x66\x79\x66\x2d\xf5\x25\xe5\x0f\x9a\xe6\xa6\xe4\xba\xbe\xec\xc0\x3a\xab\x25\xf3\x29\x63\xe7\xc7\x68\x91\xe6\xac\xc6\xf4\xfc\xa2\x24\xd0\x25\xee\x38\x68\x08\xfb\xe5\x66\x6a\x5d\x87\xd8\x9f\x1a\xae\x0c\xdb\x73\x55\x47\x4a\x2f\x9e\x2e\xf9\xaa\xfa\xbd\x66\x6c\xd4\xdf\x9a\x98\x41\x10\x1d\x96\x7e\xd4\xbf\x46\x00\x76\x6a\xd2\x42\x01\x1f\x4b\x90\x47\x8f\x0e\x90\x57\x4a\xa4\xc1\xfd\xa1\x3f\xf7\xb5\x3c\x65\x15\xc2\xc1\x4a\x27\xf5\x95\xa7\xbe\xb6\xbf\x46\x7b\x22\x01\x3c\x3b\x9f\xc1\x63\x19\xab\xc7\x6e\x2f\x9e\x6b\xd6\x19\xed\xea\xee\x4b\xa6\xea\x26\x47\x31\x48\x50\x78\x41\xa3\xdd\xed\x75\x5f\x4f\x1f\x12\xeb\xd1\xc6\xe3\x36\x1a\xc4\x48\x8d\x0e\x2d\x13\x37\x68\xf8\xdc\xb9\x9a\x59\x60\x05\x9e\xeb\x72\xe7\x3c\x5c\x3e\xa7\x62\xb4\x15\x6f\x9a\x87\xf8\xfd\x66\x3e\xd7\x67\xdf\xb3\x6c\xe2\xc0\x35\x6f\x2b\x56\x44\x2c\x20\xc0\xcf\xcc\xf2\x3a\xa0\x47\x2b\xbe\x59\xd5\x55\xa3\x33\xf7\x3e\xe4\x1a\xa2\x8a\xfc\x7a\x65\x43\xf9\xac\x91\x62\x7d\xf9\x3c\x99\x36\x42\x20\x4b\x30\x2b\xfc\x4d\x75\x40\xc2\xe7\x60\x7d\x91\x0d\x95\x0d\x9a\xcf\x44\x04\x20\xea\x05\xa1\x09\xd6\x02\x0d\x44\x47\x89\x25\x4e\x70\xa6\xb4\x1c\xf6\xfb\xd3\x29\x2e\x62\x95\x30\xfa\x30\x0c\x87\x75\x0c\xc3\x9c\xca\x2d\x62\x8b\x47\xff\x6a\xa5\x66\x84\x41\xb0\x03\x07\x9b\xcc\xa7\x48\xe0\xdb\x87\xc1\xea\x2e\xc8\xf4\x35\x54\x09\x28\x04\x87\x3d\x1b\x3b\x60\xf3\xf0\xbc\xd3\xce\x41\xd8\x80\xec\x0a\x78\x36\xd8\xa5\xf5\xe2\x7b\xa0\x1b\x35\xd3\x45\x89\x6f\xe3\x59\xd4\x9e\x19\x71\xd4\x59\xd3\xda\x96\xd6\x71\xe8\xc8\x54\x30\xc1\xa9\x88\xda\x49\x90\x7c\xd9\x38\x44\xb4\x8d\xac\x4a\x59\xbe\xfc\x1b\xff\xbc\x07\x89\x1f\xdf\xd7\xc3\x84\x5e\xa8\x27\xb3\x65\x85\xce\xb9\x6c\xfe\xd2\x30\xf1\x23\xe9\x42\xab\xca\x60\x1c\x38\xaa\x33\xdf\x31\xe4\x9a\x1f\x6d\x1f\x15\xc1\x35\x08\x56\x94\x23\xa6\xe9\x1c\xd5\xd1\x54\x56\x10\x6c\x5a\xa4\x21\xd6\x9a\x2d\x8b\xc5\x2c\x3b\x61\xcf\xaf\x67\x3d\x6c\xa8\xfa\x6e\x42\xe1\xc2\x3c\x08\x3a\x1b\xeb\x8d\xaf\x35\xbe\xd8\x64\x21\x7c\xf8\x81\xe5\x2d\x10\x51\x51\xa0\xf5\xba\xd0\xa1\xa5\xe3\xb2\x49\xdf\x3d\x0d\x87\x26\x83\x36\x5a\x2d\x34\xd6\xd6\x1a\x5f\xf9\x7e\x3c\x79\x81\xb5\xd1\xdb\x52\xe4\x98\x09\xb3\x5b\x89\x73\xfb\xfd\x8e\xf8\x99\xed\xa4\xf9\x1e\x99\x0f\x8f\xfe\x3d\x0a\x73\xdf\x0b\xf3\xc0\x28\x26\xff\x75\x7a\x2b\x6b\xf9\x95\xed\xb8\xe3\x9c\xbe\x94\xdb\xfa\x76\x2d\x48\xce\x98\x0c\x04\x5d\x5e\xd1\x89\x66\x17\x0e\x54\x7d\xdb\xfb\xdd\x91\x50\x72\x47\x45\x09\x52\x6c\x04\x70\xb5\x42\xa3\xc4\x12\x5b\x07\x08\x59\x4c\x01\x54\xe8\xee\x77\xf7\x84\xef\x27\x97\x9b\x5c\x6b\xc5\x24\x73\xdf\x4e\xb7\x83\x19\xda\x88\x4a\x6b\xd7\x75\x5b\x20\xa2\xd0\x06\x17\xcf\x81\xaa\xda\x04\x0c\x94\x85\x78\x93\x42\xd6\xe7\xa6\xf2\xa9\x05\x66\x16\x19\xc5\xe8\x12\xc4\xf6\x45\xd6\x86\x0a\x73\xf2\x84\x96\x15\x4e\xbd\xc5\x25\x3e\x0c\xfb\xc0\xc3\xd0\x21\xb6\x6b\x57\x0c\x82\xc0\x87\x0d\xde\x76\xd8\x6e\x26\x4c\xbe\xc6\xcc\x09\xac\xac\x59\x27\x00\xf0\xd0\x06\x17\xa8\xac\xe9\xa1\x56\x6d\x54\x9e\xb1\x58\x22\xfb\x59\x38\x85\xe5\x3f\x6f\x0e\xd1\x96\x87\x4c\xbf\x4c\x66\xc5\x0c\x99\xef\x4e\x46\xfe\xd1\x3b\x02\x88\x7b\xa2\xed\x76\x54\x4e\x56\x56\x77\x47\x28\x48\x07\xc1\x92\x3e\x63\xfa\xc6\x4e\x27\x61\x11\x2b\x28\x87\x9c\xa3\xc6\xab\x3e\x6e\xbf\x6a\xe3\xa6\x0d\xdc\x48\x97\x60\x3e\x0a\x1d\x25\xa3\xdf\xfe\x73\x13\xe3\xb2\xa6\x85\x39\x45\x1b\x41\x4b\xc3\x90\xb8\x61\xf5\xd2\x25\xec\x48\x08\xef\xae\x21\x71\x66\xa3\x21\xf1\x62\x8b\xe5\x9a\x88\x1e\x64\x79\x36\x99\xdc\xca\x78\x43\x71\x8b\xaa\xb2\xfa\x81\x64\xc6\x04\x82\x24\xcd\x33\x09\xff\x84\xb1\xcd\xca\xd1\x89\xea\xc3\x06\x13\x24\x0d\x5c\x7e\x66\x84\x8b\x5f\xca\x35\x36\xfa\x6f\x38\x2e\xec\x6a\xbb\xb5\x6f\x4d\x2f\x46\x19\x0f\x9e\x3f\x74\x79\xfd\xf2\xdb\xeb\xc1\xd6\x8b\xbc\xba\x98\xf4\xa5\x7f\xa5\xae\x79\xf0\xdc\x14\xb9\x02\xad\xd0\x97\x3e\x4b\x23\x55\xda\x66\x45\x5c\xb7\x5f\x34\xb5\x27\xf2\x51\x25\x4a\x74\x4d\xb7\x9a\xe3\x15\xf8\x88\x11\x85\xe3\xea\x0a\x04\x81\x1b\x8b\x0d\xee\xc6\x4c\x78\xac\xe0\x86\xe3\x15\x88\x28\xac\x17\x87\xc8\x01\x77\xfd\x40\x14\x1d\x28\x23\xaf\x16\x62\xea\x5f\xe2\x0b\xf3\x0a\xb7\xe0\x44\xb3\x06\x2b\xe5\x16\x3b\xf5\x23\x0b\xe1\x25\x0d\x87\x31\x19\x93\xce\x4e\x3b\x5f\x6b\x7a\xa1\xee\x57\x3f\x65\xe2\xa0\x9e\x45\x66\x16\x98\x96\x88\x1e\x40\xbc\x11\xe9\x8b\xf8\xb0\xe1\x05\x41\x10\x38\x7e\x20\xa6\xe1\xb0\xe4\x91\xf8\x0d\x83\x21\xf1\x61\xd3\x93\x16\x2a\xe7\x66\xdf\x95\x1b\xec\x57\x2f\x50\x46\x82\xf4\x31\x59\xd3\x7b\xce\xc4\xc6\x8a\xfc\x57\x64\x83\xbe\xb7\xdb\xb2\x92\xdb\x6f\x56\x55\xd5\x70\x78\x3e\x6d\x3a\x18\xc1\x9c\x0b\xd6\x2a\xad\xda\xc6\xa3\xda\xc6\x92\x85\x7e\x73\xbf\xee\xfb\x59\xe3\xea\x7a\x8f\xf4\xe1\x01\x2a\xbe\xbf\x74\x02\x38\x22\xdc\x4c\xc6\x64\x44\x0f\xb4\xb8\x73\x99\x9d\x53\xad\xbd\x36\x02\x88\x06\xbc\xa4\xaf\x0c\x21\xf0\x15\xa6\xac\x0d\xf8\x2d\x22\xe8\xd0\xdd\x79\x35\x3b\xbe\xb9\x01\xfc\xcc\x06\xbc\x8c\x86\x21\x09\xfd\x62\x82\xf4\x14\x29\x46\x43\xee\x60\x82\x3c\x1e\x7c\x4e\xa5\x86\x65\x54\x56\x2f\x94\xf4\xe3\xde\x1f\xbf\x0d\x54\x55\xd5\xe5\xee\x0b\x58\x92\x67\x4a\x6f\x98\xe9\xe0\x12\x28\xaa\xbc\xfc\xc6\xc0\x4a\x81\xb1\xbf\x12\x73\xb7\xf3\xaa\x8d\xcb\x99\x05\xde\xb8\xb7\xf1\xa4\x9e\xb2\xdc\x89\xed\x37\x16\x58\xc1\x99\x73\xab\x62\xb0\x8a\x2f\xab\xee\xc6\xe2\xde\x53\x71\xcd\xed\x6b\x9b\xab\xe3\x85\x9c\xb3\x19\x36\xf9\x16\xf3\x70\xea\x4f\x9e\x38\x1b\x33\x8f\x4d\x7b\xa0\xb5\xcd\x15\xa6\xe5\x0c\xde\x87\x7f\xdb\x89\x02\x21\x0b\x04\xac\xae\x1d\x35\xde\x1c\xb5\x5e\x02\x38\xb7\x91\xa8\x4e\x1c\x56\x54\x2b\x5b\x20\xa4\x72\xe6\xde\xff\x69\xc8\x88\x9f\xc9\x3a\xd0\xa9\x62\x8d\xa6\xad\xb5\xfd\xfb\x41\x2d\xa5\xe7\x44\xc3\xf3\x91\x38\xf7\xd6\xc2\x9a\xe4\xf6\x6b\x8c\x6f\x02\x5e\x38\xe0\x8f\x93\xe2\xdd\xa5\xfe\xfa\xb0\xe1\x0a\x0d\x56\xc9\xdd\x17\x1d\xf0\x32\x85\x25\xc2\xe4\xbf\x00\x27\xf2\xcd\xf5\x52\x14\x05\xfc\xf4\xc4\xcd\x9b\x6f\xfd\x3b\xc7\x95\xfc\x52\x50\x6a\xfc\x29\x9b\xa0\xe8\xcb\xba\x65\x7f\xb2\x05\x56\x20\xc0\x32\x77\x25\x7d\x3f\xb6\xc0\x32\xae\xed\x72\x8e\xc1\x11\x7d\x4b\x18\xea\x54\x4e\x0b\x91\x8e\xcb\xfa\x3b\x69\x3f\x99\x28\x22\x1f\x96\x54\x6e\xe1\x7e\x02\xd7\x1e\xe1\xa6\xd6\x50\x9a\x68\x3f\x49\x90\x70\xd5\x16\x9b\x53\x4e\x91\x63\xfb\x69\xe6\xb3\x6f\x3a\x77\xef\xfa\x43\x5d\x1a\x71\x76\x73\xd8\x3e\x7e\xd8\x62\x83\x30\xcf\xa1\xd8\x24\xa6\xe7\x43\x8d\xe2\x4a\x4e\xbf\x68\xe5\xb4\xd0\x69\x0f\xcc\x9a\x2d\xb1\xf3\x87\x5d\x0d\xb9\xfb\x12\xf1\x4a\xf0\x4e\xaa\x1d\xe1\x16\x6a\xdf\xf0\xb3\xa1\x6f\x7e\xe9\x21\x29\xb6\x4e\x51\x9e\x51\x70\x16\x77\xc3\xa9\x5f\x7d\x78\x71\x38\x09\x82\x70\x6a\xda\xdd\x69\x73\x1f\x37\xdf\x59\x11\x39\x9a\xa9\x6f\x38\x6e\x7a\xbf\x2d\xa7\x85\xf4\x96\x1e\x21\xab\x6a\x47\x3a\xaa\xf2\xea\xd9\x2e\x58\x48\xb2\xa9\x4b\x7f\x72\xa0\x32\xbd\x04\x22\xe0\xa2\x8a\x0e\x08\x41\x3a\x72\xc4\x01\x9f\x02\xc1\xc0\x49\xc3\x79\x1b\xaf\xb1\xe6\x24\x25\xdd\xd6\x75\x3b\x51\x12\x00\x2b\x6b\x78\x7e\x47\x14\x04\xb8\xc8\x32\x7a\xd3\x7c\x69\x00\x93\x35\x5c\x6a\xf4\xa5\xa5\x22\x26\x50\x55\x45\x15\x57\x34\x6b\xd0\x11\x8b\x58\xa8\x90\x5f\x9c\x5a\xa3\x95\x85\x04\x1b\xb7\xa2\x0d\x7e\x8d\xe9\x9d\x1c\xa4\x18\x92\x33\x38\xba\x04\x29\x10\xaa\x0c\x3e\xbb\x38\x5e\x73\x3e\x3b\x5c\xec\x27\xd2\xf7\xe2\x9b\x1b\x0e\x13\x15\xd7\xd5\x44\xa0\xad\x17\x88\xf4\x01\x05\x31\x4a\x10\x5f\x0b\x22\x8f\x5b\x4a\xdf\x0d\x06\x40\x6b\x22\xa9\x52\x2c\xa2\xe0\x5e\x45\xe8\x5c\x61\x0c\x65\x30\x61\x1e\xa1\xe2\x44\xd3\x76\x9e\x35\x23\xc0\xc8\xba\xe5\xe0\x23\x8b\x40\xf0\x3a\xae\x0b\x25\x69\xa4\x2b\x82\xe9\x2d\xae\xa9\x67\x6a\x5f\xcd\x29\xa7\xb5\xa5\x05\xd7\xcb\x4b\x1e\xf9\x8f\xeb\xe7\xb2\x83\x66\x59\xbe\xd7\x9c\xa8\xa6\xe1\xb6\x3c\xb8\xbf\xfc\x6a\x44\xd4\x68\x46\x60\x46\xd9\x99\xfb\x60\x35\xa0\x54\xb0\x47\x91\x28\xa7\xe5\xce\x65\x6e\xe3\x5f\x84\xb5\x75\x0e\x3e\x02\x84\x2a\x65\x51\x75\xe3\x81\x48\x0a\xc6\x93\x9a\x39\xa5\xcf\x37\xc1\x80\x6b\x73\x09\xcb\x09\x67\x88\x57\x54\x4b\xfd\x69\x0e\x6f\x43\x86\x1c\x38\x1f\x7b\x15\x6e\xf5\x29\x26\xd2\x68\xbf\x72\x54\xe1\xea\x93\x4f\x2b\x70\x84\x92\x2a\xc4\x43\x3f\xbb\xad\xef\xbd\xf8\x0e\xf1\xd3\x9d\x78\x3e\x6d\x71\xf7\xae\x99\xf2\x77\x60\xfb\x8f\x27\x38\x5c\xa4\xbf\x2f\x4c\xd2\xe3\x77\x49\xcb\x09\x6b\x7f\xa5\x6b\xbf\xfc\x2f\x30\x34\x5b\xe0\x14\x0c\x92\x05\x66\xe6\x8f\x27\x7c\x09\x59\xcf\x06\x8c\x65\x14\xbd\xb9\xb1\x58\xbb\x36\x04\xd8\xd2\x5d\xf7\x61\xcb\xab\xef\xd8\x3a\x3e\x10\x2d\x28\x87\x64\x48\x6f\xe5\x13\xea\xbb\xc2\x2b\x9c\xa5\xe1\xb8\x20\x27\x28\x89\xd8\xde\xac\xa9\xd7\x62\xa3\xba\xa5\xc8\xb5\xcc\x10\x53\x0b\x7a\x72\xa1\x04\x29\x61\xd8\x25\x62\xbb\x29\xf3\xcf\xa9\xf9\x95\x0e\xd1\xd2\x77\x4e\xcd\xae\xd6\x02\x02\xac\x4e\xf4\x22\x69\x60\xd5\x4b\xbe\xc1\x22\x19\xa7\xc6\xb0\xf1\xb5\x36\x24\x5e\x65\x17\x18\xa7\xf9\x2c\x13\x49\x03\x00\xba\x67\xa2\x93\xcf\x7b\xcd\xc9\xea\x9d\xeb\xcd\x17\xc3\xb2\xd5\xf9\xa6\xfa\xd7\x2f\x4d\x99\x17\x50\x03\xce\x69\xf5\xb0\xb4\x67\x5d\xa2\x0d\x26\xbb\xef\x8c\xe8\x6d\x3c\x2d\xd0\x1a\xea\x4a\x43\x79\xbd\xdf\x7c\x3c\xe1\xf5\x51\x1e\xc5\xe7\x8e\xe9\x87\xc8\xc3\x28\x49\xbe\xcd\x8a\x87\xe9\x22\x14\x06\xa2\xdc\x37\xee\x6e\x95\x5e\x3f\x7b\xa8\x9c\x20\x7a\xa7\x4b\x62\x5d\xd7\x9f\xba\x0e\xd9\x79\xb6\x9a\xf7\x78\xc6\xf8\x5a\x1d\x44\x14\x66\xb4\xc6\xf7\xcd\x31\x2e\x47\x9a\xef\xfe\xd3\x0b\x8d\x1c\x3a\x96\x51\x1a\x8b\xbf\x29\xf5\x29\x64\x20\x15\x6c\x96\x1f\x10\x52\x6d\x8f\x2d\x44\xb6\x67\xef\x42\x78\xca\x9c\xb9\x8f\xac\xa9\x43\xb6\x95\x38\xb6\x34\xec\x59\xf9\x65\xe2\x66\x38\xd2\x70\xd3\x9e\xbd\x8e\x3f\x12\xc7\xa3\x27\x78\x4d\x13\x4a\x41\x95\x70\x02\x1c\xf3\x95\xd4\x4a\xc6\x41\x55\xe8\x1c\x68\xad\x18\x4e\x73\x4e\xc9\x92\xf9\x1a\x29\x5a\xaa\xea\xb2\x82\xa0\xc2\x92\x84\xee\x62\x4b\xcd\xf6\xd3\xcd\x10\x2c\xc7\xa4\x95\xb8\x78\x7e\x1e\x19\x77\xfb\x96\xaa\xef\x7a\x89\x5a\x28\x5f\x33\x8a\xc5\x7e\x64\x17\x3c\x8a\x95\x8d\xb3\xb6\x9d\x4f\x6d\x9a\x50\xa2\x85\xc2\x56\x23\x17\x42\x6d\xf3\xb1\x77\x93\x8f\x8f\x64\xfc\x5c\x89\x00\x4e\x93\x4b\x76\x20\xb8\xdc\x35\xf7\xa2\xb7\x78\x98\x78\xca\x2c\xcc\x7d\x69\xc9\x75\x2d\x81\xc9\x90\x8c\x19\x42\x9b\x83\x57\x14\x00\xa3\xdf\x04\xef\xfb\xc3\xaf\x6d\x9e\xd9\x48\xd4\xe9\xda\x1e\x5b\x11\xe5\x44\xee\xb4\x54\x8e\x64\x06\x02\x1f\x59\xe8\x57\x11\xf4\xb3\x0c\xb9\x25\xaf\xcd\x23\x68\xa2\x7d\xca\x20\x58\xb5\xf9\xa4\x3e\x3e\xd8\x34\x18\x5d\x0e\x0f\x5d\x2e\x91\x9a\x48\xdb\x19\x76\x98\xf5\xd3\xd5\xdf\x5a\x65\x73\x32\xff\x14\x5d\x8a\x5c\xe4\xf3\x3c\xc0\xc3\x9e\x71\x55\x81\x4f\xf3\xf0\x83\xa8\x2e\xc6\xf4\xe2\x44\x31\x26\x93\x8a\x83\x56\xa6\xf5\x34\x50\x6d\xc4\x6e\x3f\x33\x64\xe8\x2e\x70\x0d\xb8\x11\x80\x8f\x18\x77\xcc\x4a\xe4\xa1\x2e\x02\x1c\x0f\xa7\x85\x25\xd3\xc3\xf1\x23\x24\xe8\xb0\x3c\x92\x06\xc0\x0a\x40\xd3\xc3\x2d\xac\xa9\xb3\x51\x03\xad\xee\xc5\x98\x12\xe4\x87\x7d\x99\x66\xed\xcb\x98\x84\xd1\x97\xba\x2c\x95\xfe\xc9\x95\xc4\x2a\xee\xbc\x77\x64\xfd\xa9\x3a\xf6\x86\xd2\x41\x75\x7d\x58\xc1\xaa\x1b\xcf\x3f\x35\x25\xc7\xd7\x84\x90\xc2\x1e\xf5\x38\x50\xa0\x18\x15\x1b\x44\xec\x6e\x7b\x26\x3b\x28\x4d\x57\x45\xf7\x59\x87\x6c\x41\xc8\x62\xe5\xea\x59\x33\x47\x2d\xba\x50\x12\x40\x0f\xdd\x13\x64\x79\xaa\xae\x09\x5e\xcf\x23\xbe\x44\xc5\x70\x5c\xbf\xb4\xe4\x25\x00\x88\x2f\x23\x7c\xf3\x4c\x7a\x46\xfc\xc5\xf7\x3b\xf9\x08\x1e\x73\xef\x5a\x1b\x91\x34\xf0\xd3\x0a\xcd\xed\x17\xd2\xc6\xca\x35\x5d\x57\xa9\x3b\x90\xcd\xbc\x5f\x2f\xb5\x9c\xae\x81\x48\x76\x37\x6f\x92\x23\x82\x21\x09\x3d\x15\xa2\x43\x43\xfc\xd4\xc2\xdc\x77\x0e\x86\x53\xc0\xe5\x2e\xa8\x8c\x18\xab\xa0\xaa\x6e\x3e\xba\xaa\xbf\xfb\x5e\x19\x2a\x8e\xa9\xcd\x7e\x6a\x96\x16\x0a\x9a\x09\xaf\x46\x5b\xb0\x8a\x80\xab\xd4\x9c\x3a\xe3\x39\x12\xcb\x43\x22\xf9\x13\x0a\x02\x52\x64\x51\x53\x07\x93\xc5\xf5\x2d\x00\x2e\xe8\x3e\xdc\xb2\xfc\x78\x1a\x1a\x81\xc2\xe6\xd8\x1d\xdd\xbe\x09\x39\xc6\x3f\xa2\x8a\xb6\xe1\x49\x8c\x70\x0f\xcd\xcf\xc5\x63\x11\x67\x76\x33\xe6\xa9\x10\x46\x15\xe5\xe2\x57\x13\x0e\x84\x38\xda\xea\x2e\x74\xfc\x08\x7f\x20\x03\x54\x63\x3d\xed\xa7\x31\x76\x18\xc9\x78\xa4\x58\xb2\xb7\xf6\x04\x0b\x9b\xea\x62\x74\xf1\xa8\x95\xd6\x04\xaf\x05\xdb\xc0\x32\xe3\xb0\x3f\x10\x6a\x6a\x28\x5b\x76\xdb\x34\xbc\xcf\xcb\x80\x68\x22\x30\xae\xd8\xc4\xe1\x42\x83\xe4\x5b\xf2\xef\xa7\x47\x92\x31\xb9\xb2\x13\xf5\x0e\x58\xae\x28\xfd\xcb\xb3\x7e\xdf\xd7\x3d\x33\xa1\x99\xca\xea\x0b\xf5\x93\xa8\xa9\x6a\x28\x70\xb2\xa6\x0e\xce\xf6\x50\x15\x7d\x91\xb1\xcf\x0f\x2e\x2a\x2c\xc7\xd5\xb3\x82\x64\x46\x77\xe3\x9f\xde\x83\x3d\x08\x8b\x37\x19\xbb\x67\x5f\xdf\x87\x98\x90\xfa\x4e\x8d\x37\xb1\x96\x3e\xe4\xe7\x55\x1e\xad\x1d\x74\x88\xba\x6b\x9d\x43\x2f\x88\x50\x45\x47\x4a\xfb\xa8\xf6\xd9\x1b\xb8\x9c\x25\x41\x8a\xe9\xcc\xf2\x62\x7e\x7a\x24\xa3\xc9\x7c\x59\xf8\xe8\xbb\x67\x12\x6d\x79\x50\xf9\x53\xc4\xec\x40\xf5\x67\xdf\x5a\xca\x6a\x1e\x03\xde\x81\xcb\x5b\x46\x25\xa4\x52\xba\x3c\xd7\x72\x22\xae\xde\xfe\xd8\x4f\xf0\x35\xd5\xa7\xb4\xa2\x43\xbb\x50\x45\x6b\x22\x7d\x85\xb9\x29\x28\x1e\xea\xd4\x0f\xe2\x1b\x43\x65\x65\x4d\x10\x51\x94\xb3\x60\x75\x6b\x61\x4e\x61\x6a\x4d\x28\xf1\x26\x3b\xfa\xf3\x5e\x81\x37\x98\xc1\x90\x86\x91\x08\xc2\xbb\x24\xf3\xd5\xb5\x2d\x2a\xd9\xb3\x9e\xc3\x08\x40\xd5\x61\x13\x0f\x93\x41\x6b\xbb\x47\x55\xad\x5d\xe6\x70\x41\x10\x05\x07\x4d\x03\x2b\xe2\x18\x5e\xe5\x07\x5c\xe2\xfd\xe8\x06\xa7\x80\xa2\xa3\x6a\x97\x28\xcc\xe9\xf9\xe5\x05\x89\x51\x10\x38\xf7\xeb\x15\x0b\x90\x3a\x1d\x95\x0e\xd0\x5c\xc4\x8a\x66\xd5\x5c\xa9\xa9\x93\x2a\x82\x69\x5b\xfd\xf5\x40\x5d\x28\xbe\xc8\xb0\xa4\xd9\x00\x11\x85\x7e\x63\xb9\x75\x90\x25\xcc\xd6\x38\x2d\x28\x33\x14\x7f\x4a\x3d\xdc\x82\x1d\x36\xbc\x48\xe3\x9d\x5e\xaa\x69\x89\x2e\xd1\x42\xec\x5e\x4c\x32\xdf\x93\x9c\xbc\x03\x95\x1a\x34\x0e\x6b\x4e\xc2\xe2\x3d\xd2\x9f\xe5\x5b\xa2\x07\x51\xae\x69\xbf\x68\xcd\xbc\xc4\x9b\x88\xad\xeb\xba\x5e\x1d\x56\xea\xce\xd3\x13\x66\xa7\x80\x2b\x54\x98\x60\x79\xa5\xc4\xc9\x6f\xc6\xb7\x98\x62\xe4\xbb\x1e\x30\x7d\x7e\x13\x3e\x78\xdb\x01\x53\x7f\x71\xae\x6b\xbb\xa0\x05\x5a\x2d\x01\x44\x0c\xfe\x71\x4e\xfd\xea\x31\x9f\x9f\xcb\xea\xd4\x2f\xd6\xdb\x3f\xc7\x7a\x2b\x31\xcd\x28\xca\x9d\x1a\xee\x0c\x1b\x41\xa0\x36\x5d\x99\xbd\x31\xa1\xb5\x83\x52\x16\x42\x39\xf8\x67\x65\x8c\x48\xf1\x11\xe8\xfc\xe1\x99\xb8\x53\x5a\x95\x83\x82\x82\x57\x59\x0d\xcd\x8c\xc9\x5d\x3a\xd6\xe7\xe7\xea\x05\xae\x68\x4b\x0b\x73\x9c\xa3\xc4\xa6\x01\xb2\x48\x91\x65\xfd\x18\xa7\x3f\x2c\x69\x3a\x26\x23\xab\x92\xcb\x8b\x63\x78\xaa\x03\x97\x7e\x77\xac\xef\x8f\xc3\xb2\x66\x08\x7d\x55\xf6\x66\xbc\xc2\xd7\x2f\x0a\x48\xf6\x25\xbb\x9e\x35\x2e\xcc\x8c\x71\x4c\xce\xac\x46\x53\xaf\xc6\x2c\x1e\x4e\x8f\x48\xf4\x10\x80\x99\xe5\x1a\x41\x00\x70\xa8\xae\x19\x03\x65\xec\xe7\x09\x56\xbe\xf6\x0c\x4d\x51\xe1\xf5\x9b\xcc\x84\xf3\xe4\x3f\xb7\xa3\xed\x5b\x3c\x6a\xdf\xf1\x23\xd6\x9b\x48\xd0\xc8\xe1\xf9\x81\xb5\xc2\x3f\xbe\x9d\xdb\x30\xf8\x94\x5e\x8f\x2b\x6c\x28\xcc\x01\xf7\xe4\xef\x07\xfe\x3c\x41\xe5\x08\x71\xd3\x6f\x5c\xa2\x97\x8b\xef\x06\xaa\xed\x70\xfd\xbe\xa9\xcf\xaf\x66\x67\x43\xf6\x7f\x3e\x0c\x35\xbe\xf9\x59\x5c\xfc\x47\xff\xee\x88\xa7\x27\x2a\x4a\x2a\x3d\x27\xaf\x62\xfe\x55\x4c\xa8\xf9\xfc\x7c\xae\x3e\xd2\x5d\x0c\x1b\x65\xd4\xe2\xa8\x83\x8b\x4a\x61\x92\xb9\xe9\x3f\xca\xa6\x9f\x24\xf0\x5b\x98\xdf\xc0\x00\x4e\xbd\xc3\x28\x99\xd0\xa6\x04\x29\xf6\x78\x47\x15\xbc\x96\xb8\x75\x59\x34\x5b\xd8\x94\xc5\x58\x02\xfd\x0f\xc3\xf1\x09\xa2\x7f\x81\xcf\xa0\xfe\x4c\x47\xb7\x68\x70\x02\xb4\x35\xf3\x89\x9b\x09\xa4\xe9\xa0\xfa\x60\x10\xd6\xf6\x36\x56\x5a\x5a\xdd\x20\xf1\x33\xff\x2a\x79\x9b\x01\xab\x7b\xb4\x23\x91\xc4\xa6\x4f\x90\x82\x0f\xb1\x4b\x60\x9a\x70\x1b\x1e\x95\xb2\x37\x63\xe2\xc7\xf7\xd0\x1d\xbf\x2c\x3c\x05\x5a\x03\xdd\xae\xae\xf4\xa5\x68\x0b\x3e\xe8\xde\x91\xfd\xdf\x7a\x0c\x02\xb8\xe4\xaf\xd5\x41\x4e\xb2\x08\x16\x22\x1c\x92\x0a\x04\xc0\xb2\x4d\x39\x4c\x64\x46\x9e\x6d\xea\xe3\x89\x16\xb1\xde\x7f\x3e\x85\xe5\x92\xea\xce\x75\x6e\x5e\xdb\xd9\xd5\xac\x56\x0a\x24\x91\x38\x97\x55\x75\xc2\x45\xe7\xa0\x23\x22\x61\xa2\x09\xf1\x24\x84\x7f\x5d\x03\x3a\xcc\xaf\x75\xc1\xda\xe0\x39\x82\x27\x8c\x9e\x31\x0d\xaa\x3b\xde\xbe\x03\xf0\x6c\xff\x7c\xcd\x86\x27\x99\x56\x9a\x13\x2d\xf6\xb8\x24\x46\xc5\x58\xd7\xfa\xe9\x89\x40\x51\x2d\x64\xc8\xe8\xa8\xd2\x92\x3b\x38\x25\xc7\xf8\xe6\x45\x80\x2c\x6a\xb4\xc3\x98\xfb\xc3\xf3\xae\x2f\x6c\x6e\x65\x90\x21\x7c\xe4\xa6\xcc\xc3\x65\x3f\x84\xdf\x3d\x13\x55\xd7\x6f\x6d\x97\x8b\x2f\x11\xb8\x64\x59\xc6\x03\x6c\xfe\x59\x95\x4f\x6a\xca\xee\x4e\xb6\xdf\x5c\x6b\x02\x8d\x97\xc6\x28\xd9\xc7\xb0\x72\x40\x3b\xa9\x5b\xfe\x42\x49\x10\xc4\x30\x0a\x0c\xd6\x6f\xfc\x89\x50\x82\x36\x27\x6f\x79\xe8\xec\x38\x5f\x7b\xf6\x3f\x89\xe4\xf4\xa9\x9e\x3a\x8b\xc4\x2d\xaa\xce\xa6\x7c\xe8\xc0\x6b\x00\xf5\x6d\x5f\x76\xda\xe0\x5e\x3f\x66\xf3\x60\xd7\x18\x92\x30\xa2\x81\x2b\xe0\x8f\x42\xdb\xc3\xcc\xea\xc9\x19\xab\x1f\xdd\x98\xde\x97\x69\xdc\xe7\x08\x37\x9e\x1b\x3b\xb3\xe6\x3d\x2e\x79\x89\xc3\x5d\xda\xc9\x35\x7a\xdc\xf0\x7e\x7a\x86\xeb\x80\xd8\x2d\x9f\xc9\x83\x88\xcc\xd8\x51\x0f\x82\x8d\xd3\xd7\x5b\xb1\xc9\x43\x3c\x07\x2a\xa6\x7e\xf2\x41\x0d\x05\x7f\x4c\x45\x99\xb1\x58\xec\x52\xf6\x48\xbf\xb1\xca\x3a\x5e\xf6\xf6\xe5\xd3\xf3\xb9\xcc\x17\x1c\xd9\xc1\x29\xa2\xf0\x67\x8f\x71\x09\x69\x4e\x94\x5e\xd8\xc7\x33\xca\x0e\x66\x88\x3c\x82\x96\x46\x58\xd6\x53\xfc\x8c\xfe\xf4\x6a\xa0\x9a\xb0\x9f\xbd\x21\x69\x00\x7f\x32\x54\x14\x1d\xdb\x4f\x57\xa1\x6f\x7b\x9f\xc8\x4d\x4d\x9e\x7d\x79\xc0\xcb\x52\x6e\xb0\x40\x69\x3c\xff\xfb\xe9\x34\x7f\xa5\x15\x37\x1c\x96\x58\xe4\xbe\xf1\xcc\xd5\xe4\x8c\x6e\x30\x69\xfb\x02\x7b\xea\x70\xac\x6b\xc4\xb9\x4d\x24\x4c\x1c\x91\xd8\x7e\xab\x68\x83\xa9\x4a\x6e\xb0\x13\x8c\x04\x53\xf2\x14\xa1\x93\x57\xa3\x8b\x21\x91\x87\xce\x0e\x0a\xe6\x03\x5d\x5d\x14\x36\xb2\xf0\xbb\x47\xe2\x5a\xe0\x88\xa4\x28\xb5\xf1\x96\xe8\xaf\x8f\xac\x70\xfe\x95\xe2\xaf\xf6\xec\x9d\xd2\x0b\x99\x77\x74\x01\x11\x3e\x38\x5c\x44\x51\x76\x8c\xf0\x9b\x47\x22\x9b\x11\x04\x78\x81\x80\xcc\x7f\x29\x3f\xab\xde\x2f\x06\x55\xbe\x75\x82\xf9\x19\x19\x43\x92\x86\xed\x9b\xac\xd9\x88\x82\x14\xe5\x57\x0b\xd0\x1f\x82\xa3\xd8\x5f\x6f\x50\x2d\xcc\x12\xb1\x1f\x67\x06\x95\xd3\xfd\x7a\xf6\x44\x68\xad\x32\x55\x17\xba\x04\xa1\x9a\x67\x1d\xf5\x87\x5d\x22\xc0\x75\xef\xb0\x44\x1b\xac\xfe\x54\x0b\x92\x7d\x26\xa4\xae\xe2\x5d\xe2\x5e\xc4\x3b\x50\x72\xf5\x3c\x22\x5d\x66\x65\x18\xb9\x7a\xfe\x41\x94\x15\x18\x01\x04\x4b\x6a\xe4\xa3\xc6\xbd\xeb\x55\xa2\xa8\x8b\x89\x66\x07\x92\xfe\x96\xfd\x90\x44\xf0\xaf\xd9\x34\x73\x2a\x19\x7b\x08\x72\xb6\xcb\x61\x54\x69\xcd\xd8\xe0\x08\x8c\x8b\x9f\xe4\x89\x4a\xa3\x69\x28\x9f\xf6\x08\xc9\xca\x66\xd1\x6d\x47\x74\x51\xdb\xfb\x8f\xcd\x7e\x26\xf3\x6d\x30\x24\x63\xdb\xc0\x3d\x51\x67\xc6\x2a\x07\x22\x7a\xf0\xc7\xf1\x4e\xbc\x87\x5a\xa1\x6a\x3e\x51\x98\x5f\xff\xcd\x62\xa7\x35\x5b\xec\xec\xfc\x75\x72\xb3\x3c\xfd\x48\xb6\x31\x9e\x1d\x4c\xd0\x4c\xfb\xee\x9b\x88\x90\xc1\xca\x8f\x04\x5a\x5d\xd1\x8e\x2a\x1c\xcb\xd0\xa5\x06\xe6\xad\xe4\x3d\x3f\x3f\x22\x8a\xba\x11\xb8\xbc\xef\xf7\x6e\x1b\x7a\x17\xba\xc1\x4f\x76\x97\xaa\xa3\x6b\xe8\x01\x80\xd9\x01\x80\xe9\x11\x9e\x95\x25\x8f\x6d\xa4\x8f\xd0\xa1\x7b\x9f\xa9\x83\xb2\x86\xb2\x1a\x2a\xaa\x41\x8b\xa4\x78\xf6\x26\xe6\xd0\x2c\x2a\x8d\xf5\xcc\xb1\xf3\xf5\x86\x32\x93\x51\x6d\xe7\x78\xb9\x51\x83\xf7\x7c\x98\x2e\x40\x79\x21\xcd\xf7\x4e\x1d\x57\x71\x93\xb4\x1f\x59\x45\xea\xf4\xa1\x45\x6a\x7b\x17\x7c\x79\x12\xbf\xb9\x2d\xf0\xdd\xee\xb4\x54\xca\xb2\x35\xc5\x74\x82\x32\x08\x37\xbe\xae\x30\xed\xf9\xaf\xda\xbf\x0e\x3a\xbd\x6c\x78\x6c\x04\xf8\x33\x2d\x42\xe7\xa0\x24\x49\x66\x79\xe7\xcc\xb7\xc2\xd5\x1b\xb7\x99\x2e\x03\x5a\x5d\xd7\x7d\x61\x90\xfd\x9e\xae\xfb\xa7\x9f\xea\x08\x3d\xa4\xeb\x26\x0b\x98\xcc\x61\xab\xd6\x2f\x4e\x61\xd7\x65\xf7\x83\x53\x68\x1f\x7b\xf4\x82\x89\x29\x1a\x9e\x25\x44\xae\x83\xd3\xda\x79\xb3\xdd\xe2\x8f\xaf\xf8\x90\x63\x30\xb3\x4c\xc0\xf6\x86\xd4\x39\xd4\x15\xb8\x6d\x3c\xaa\x5d\x71\xa1\x8b\x73\x4a\x4e\x9f\x70\x15\x19\xbc\x39\x64\x64\x1f\x3a\xf0\x45\x8e\x79\xf2\xd3\x68\xe7\xf9\x19\xcc\xb5\xe6\x14\x3d\xbf\x50\xaa\x7a\x95\xd6\xbe\x9c\x9f\x3e\x84\x64\x79\x85\x2b\x69\xaa\x69\x15\xa1\x34\x7d\xa2\xd9\xc7\x0d\x92\x45\xfb\x16\x27\x99\xf2\x52\x99\x4a\x8b\xaa\xf4\x42\x3f\x9c\xd9\x88\xfb\x81\xe8\x6e\x51\x28\x1c\x3a\xf7\x02\x60\x55\x54\xa1\x7d\x0d\xcd\x97\x06\x6e\x39\xac\xc9\x86\xfa\xdc\xbf\x46\x4c\x55\x4d\x6e\x65\x08\x7d\x2c\x4b\x23\x1d\x82\x7d\xdf\xf1\x47\xe0\xce\x60\xd3\xbe\x7b\x3e\x59\x2f\x54\xa2\x40\x69\x5a\x16\xc5\x4c\x6f\xbe\x69\xeb\xd6\x62\x92\x85\x78\x21\xf7\x02\xf4\x71\x5c\x9f\xea\x02\x80\xb2\xac\xce\x6d\xcd\x8d\x5d\xec\x3f\x4d\x7e\x84\xab\x37\x1f\x88\x71\x66\x1d\x0e\x80\x72\xb7\x0c\xee\xca\xdd\x80\xfc\x99\x5e\xba\xda\xe6\x10\x2e\x00\xb0\xec\xb6\xe7\xe6\xfd\x7b\x98\x0b\x2f\x82\x01\x30\xde\x53\xe3\xe7\xbb\xb4\x82\x35\x1d\x57\x79\xf4\x16\xb7\x8a\x8f\xc7\xdc\xd0\x47\x66\xb1\xaa\xaa\x2a\x02\x58\xd6\x70\x90\x6b\xdc\x6a\xb1\x51\xd1\x9a\x58\x97\x31\xfa\xf0\xc9\x8f\xa6\x5c\xbb\x3c\x51\xdf\x40\xf4\xed\x58\x64\xfd\x61\x92\xc1\x09\x07\xaa\x0b\x21\x7f\xb1\x01\x40\xa4\x07\x3b\x92\xd1\x18\x45\xe4\xf6\xa1\x3d\xbb\x0e\x11\x2e\xfa\x63\x4e\x76\x10\xe1\x93\xe4\x6e\xc1\x34\xdd\x01\xd2\x06\x7f\x2d\x8c\x91\x4e\x47\xc8\xfa\xbc\x09\x45\x2a\xb0\xbf\x4d\x2f\x4e\x5f\x07\x75\x71\x8a\x18\xba\x83\xb1\xa5\xe1\x31\x48\x82\xdb\xb1\x77\x2e\x19\x5d\x8c\x3c\xe7\x6b\x43\x06\x63\x0b\xac\x50\x57\xf3\x2f\x9f\xd8\x21\x28\x47\x70\xc9\x98\x52\x0b\x4f\xf2\xb9\x4d\xf1\x0e\xc9\xe2\x0c\xca\x73\x90\x0c\x01\xe1\x99\x98\x8f\x42\x20\x48\xca\xc9\x5d\xfc\xb9\xe4\x6c\x42\x66\xee\xec\x9c\x37\x27\x6d\x61\x74\x52\x98\xd6\xed\x55\xa6\xa6\x1f\xc4\xfc\x02\xa6\xcc\x3b\x57\x78\x0d\xef\xf7\x02\x49\xc2\x7d\x13\x6a\x75\x8d\xd3\xb0\x8d\xd0\xb5\x7d\x91\x8d\x37\x02\xc2\xb0\xdc\x0c\x54\xb5\x96\x86\x2e\x26\x2c\xff\x63\x0f\xac\xa0\x04\x1c\x51\x48\xdd\x97\x08\xf4\x01\x76\xf2\xfd\x03\xea\x0f\x61\x39\xa9\x41\x03\x00\x81\x3a\x45\x88\xce\x97\xbc\xc1\xdc\x60\x05\x1e\x38\x7b\x57\x04\x82\xd7\xaa\xd1\xc7\xf8\x26\xf5\x32\x41\x9d\x73\xe3\xfe\x80\x1a\x3d\x1f\xc8\x91\xf8\xe4\x28\xb7\xa0\xd9\x88\x9f\x69\x38\x2c\x72\x32\xac\x59\x31\x3e\x4e\x10\x6f\x48\x71\x65\xda\x3d\x46\x7e\xac\x35\xeb\x6a\x47\x3a\x4c\x2a\x74\xf5\x78\xae\xe6\x19\xe3\x5b\xa2\xd2\xc5\x9c\x02\xd3\x96\x56\xb4\x23\xc9\x7e\xc7\x94\x16\xb0\x73\xc0\xef\xa4\xf9\x48\x7c\x03\x13\xe4\x71\xe3\x6a\x21\x0c\x9c\xa2\x7e\x26\x6f\x86\x4d\xbe\x8b\xcf\x0f\x0f\x4a\x11\xdf\xdc\x75\x88\x37\xb3\xa9\xb6\xf1\x90\x57\xd4\xca\xb3\x37\x75\xaf\x59\x0f\x49\x27\xea\x2d\x10\x9d\x8a\xe4\x6d\xb4\x9f\xa8\x35\x23\x6b\x7c\x18\x54\x44\x37\xb1\x6b\x08\x85\xbb\xd6\x95\x57\x3e\xad\xaf\x05\x46\x5f\x45\x1f\xba\x04\x93\xfd\x8b\x80\x82\x22\x06\x6e\x38\xac\x29\x6a\x28\xde\x9c\x4a\x0d\x51\x4e\x4b\x56\xe8\xa8\xce\x66\xdb\xcf\x1f\x8c\x6f\x72\xdc\xfd\x73\xaf\x81\xb3\xba\x42\xab\xcc\x7d\x79\x2c\x69\x38\x60\x30\xd0\x0b\x11\xc4\xf0\x1e\xe1\xa6\x2f\x2e\x38\x7e\xd0\x64\x2a\xd0\xce\xad\x9a\x38\xd4\x63\x48\x6f\x05\x21\x01\x16\xb6\xbe\xe3\x2d\x10\x11\xc0\x7e\x51\x9c\x93\x83\x83\xd5\x0a\x9f\xad\x37\x51\x51\x43\x03\x97\xc7\x4e\x8c\xc6\xcd\x8a\xaf\x8b\x64\x06\x8b\x4a\x3a\xf5\x5b\x93\xeb\xe7\x66\x30\x95\xfc\xa5\xfc\x41\x71\xcd\x56\x41\x5c\xc5\xe7\x9a\x8f\x2b\x74\xec\x08\x7e\x52\x43\xa2\xfd\xf9\x74\x64\xba\xf9\x54\xdd\xe3\xe1\x8e\xa6\x83\x34\xbd\x1c\x90\x1b\xec\xbb\x38\x53\x95\xf5\x25\xde\x3b\x4d\x8c\x11\x74\xf1\xb5\x9b\x3f\xf7\x6b\x8c\xef\x87\x99\x23\xfe\x2e\x2d\xf4\x42\x32\x3d\x92\x35\xd8\xf1\x83\x4f\xb7\xa6\xaf\x96\x54\x3f\x1f\xe0\xc5\xc5\x17\x64\x7d\x7f\x30\x3c\x81\x48\x12\xcc\xdc\x82\xf5\xdd\x9f\xfe\x5b\xea\xdf\x78\x54\xdb\x0a\x25\xe8\xc4\x00\x72\xc8\x6e\x3f\xde\x4d\x59\x1c\x76\x7a\x5b\xba\xae\x89\x6c\x09\x75\xca\x2b\x51\xce\x76\xbd\xd6\xb8\x06\x02\xfd\xa4\x6c\xe4\xa6\xac\x61\x7a\x8f\x96\xdf\xe7\x1a\xb7\x40\xdb\x1f\x4d\x52\x03\xcd\x8a\x5e\x6c\x57\x32\x9f\xf8\xb5\x31\x0a\x35\x5e\x4f\x46\xa1\x4f\x24\x36\x79\xd0\xae\x11\x66\xdf\xb0\xa1\x5a\x2c\x7e\x3d\x8b\xbd\x60\x7c\xe4\xa5\xba\xd7\x7d\x31\x00\x8d\xa1\xa0\xd2\xd9\xac\xba\x26\x84\xfc\xe9\x04\x21\x0b\x0a\xfb\xd1\x18\xc6\x36\xcc\xe9\xc5\x8b\x70\x58\xd7\x97\x48\x31\xc1\x47\xec\x71\x99\xd7\x82\x8c\x84\x97\xa2\xac\xa2\xb0\xcc\x91\x17\x78\x99\xa5\x9e\x84\x69\xb3\xb7\x48\x61\xf8\x33\x63\xc5\x79\xc7\x23\x83\xf0\x37\x20\x10\x05\x80\x53\x18\x15\x1b\x5d\x82\x43\x37\x16\x1b\xfb\x62\x09\x48\x66\x4c\x2b\x48\xf0\x9f\xee\x09\x54\x55\x54\x7e\x4c\xd2\xe0\x43\xd3\xfb\x6d\x7f\xea\x2d\x66\x9c\x21\x26\x36\x7e\xe0\x25\xf5\xcd\x54\x91\x0a\x85\x2d\x24\x79\xc6\x98\x48\xc1\x20\x79\x91\x33\x50\xef\x17\xa3\x2a\x76\x98\x68\x57\x6c\xe1\xf4\x6b\x56\x6f\x73\xdf\x49\x3d\x11\x82\x22\x75\x10\x61\x88\x47\x79\x19\xdb\x44\x16\x17\x8a\xe8\x1a\xed\xcd\xb8\x99\x0f\x07\xb0\x3a\xe5\x29\x7e\xcf\x50\xac\x63\xbc\x4e\xdd\xa7\xf4\x50\x92\x06\xb6\x92\x71\xf9\x40\xe8\x6f\x5e\xb1\xe8\x53\x94\xef\xd2\x7a\xdd\xc5\x3f\x82\x31\x17\x1a\xce\xd2\x67\xc8\xfd\x40\xea\x1c\x68\x8b\x4f\xc4\x1f\x4e\xb8\x74\x88\xd2\xe3\xe6\x72\xf2\xdb\xe6\x98\x3c\x66\x86\x17\x4f\xc5\x07\xcf\x4d\xb9\xbb\x15\x05\x96\x76\xd2\x7c\x11\xf2\x8b\xef\x38\x16\x95\x2c\xa7\x3a\x6c\x4b\x63\xe6\xbb\x4f\xea\x9a\xae\x0b\xf2\x2e\x44\x3a\x3e\x5d\xd2\xd0\x95\xf5\xfa\xa0\x34\x4a\xa9\xa3\xf5\x7d\xdd\xcb\x0c\x3e\xdd\x0a\xc3\x8e\x9f\x43\xb2\xaa\xe7\xf0\x4e\xf0\x42\xb3\x2b\x5a\x83\xcf\x74\xa8\x91\xf2\xe3\x8f\xc3\xd5\x2e\x21\x37\xeb\x6d\x25\xcd\x87\x3a\xf4\xa0\xbd\x1c\xa2\x72\x2d\xf9\xa5\x1e\x89\x79\xe9\xee\x05\x5c\x97\xf3\xb2\x63\x84\xf8\xa8\x58\x91\x63\xcb\xac\xa9\x43\xbd\xa5\xe6\x9e\xde\x9f\x6d\x96\x87\x29\x40\xe4\x82\xca\x86\x03\xd5\xef\xfb\xbe\xa6\x2a\x6b\x6f\xa4\x41\x60\xec\x43\xf2\x54\xa1\x0d\xbe\xee\x4f\x78\x22\x9b\x40\x73\x9d\x53\x5a\xec\xf4\x16\x30\x72\xe7\x77\x6e\x4f\xaf\x4f\xf4\x3e\xc8\x7e\x60\x94\x6e\xd3\xb7\x11\xb4\x9e\xea\x85\x05\xb9\x21\xa9\x85\xbe\x9f\x40\xd6\x3f\x17\x8b\x5d\x2a\x67\x0b\x9f\xdb\x28\x64\xe3\xf5\xa1\xb0\x91\x48\x56\xb3\x1b\xbe\xff\x71\xfa\x3e\x53\x66\xed\xf3\xf1\x2e\x49\x3c\x6a\xd6\xbd\x21\xc4\x9d\xc1\x3b\xbe\x9f\xf6\x8c\x02\x25\xb5\xa4\x62\x68\x86\x93\x7b\x8e\x45\x32\x06\x82\x38\xfe\xa5\x0b\x97\xce\x35\x4e\xf9\x01\x74\x63\x4b\x5b\xa0\x28\x2c\x67\xca\x2f\x70\x0a\x37\xb8\x50\x40\x65\x25\x2e\x24\x05\xf6\x3d\x30\x70\xa2\x0a\xf3\x20\xa8\xac\xda\x68\x7d\x5b\xf7\x9f\xa9\xb7\xb1\xdc\x24\x82\x99\x50\xb0\x54\xb3\x39\x86\x31\x01\x55\xa1\x2a\xfd\x81\xae\x8f\x26\x7c\x50\xe3\x0d\x79\xf9\xfe\x69\x36\xb2\x42\x85\x4e\x3f\x62\x4b\x97\x9d\x3e\xb2\x3f\xbd\xda\xf2\xa5\x43\xb8\x45\x55\x16\xd1\x0f\x86\x44\x0b\x71\x8d\x1b\xd5\x35\xfd\xd5\x6b\x40\x57\xae\xe4\x89\x7e\xbf\x68\x2e\x6e\xa6\xd8\x81\xf4\x74\x08\x5e\x3b\x42\x16\x96\x36\x2e\x75\x2c\x71\x5b\x15\x41\xb0\x3b\x06\xc3\x92\x3e\x95\x84\x20\xc0\xfc\xab\x33\xa8\x77\xdb\xd2\x5b\xe1\xf4\x78\xa6\x2f\x46\x4c\x01\x1e\x06\x6b\xb2\x11\xf1\x1d\x6b\xad\x21\x75\x2a\x4b\xaf\x5c\xed\xbb\x93\xfa\xec\x2b\x2e\xf6\x37\x60\x7d\xef\xfd\xc3\x0b\xde\xd0\x70\xcc\xac\x3c\x06\x0f\x7f\x75\xba\x7a\x66\x37\xa9\xa2\x15\xce\x17\x9e\xe3\x29\x79\x70\xed\xb0\x25\x0a\x63\x7c\x32\x92\x16\x18\x1b\xd6\x8d\xc9\x43\x61\x60\xf9\x4e\x4e\xf2\xcc\x9b\x46\x89\x6a\xaf\x7a\x35\x26\xf1\x34\xaf\x2a\xbb\xd6\xae\xee\xdb\x34\x08\x79\xbc\x49\x77\xb2\xcd\x11\x18\x51\x80\xe5\xaa\xad\x87\xf7\x2e\xb2\x65\x28\x2c\x66\xbe\xf1\xac\xed\x32\x76\x75\x81\x34\x4d\xe0\xc5\x89\xe8\x92\xea\xa8\xaa\xd3\xa7\x52\x24\x96\x83\xe0\x9a\x87\xbd\x56\x5e\x42\x21\xa9\x9a\x9c\xce\x88\x23\x45\x55\xc9\xad\x93\x20\xca\xc2\xd8\x82\x6d\xff\xf1\x3b\x2e\x34\xeb\x3c\xf6\xd3\x35\x33\x33\x27\x77\x47\x07\xb8\xd3\x11\xa3\x8b\x37\xb1\xfd\x32\xcd\xb7\xd9\x83\x36\x19\x93\x49\xa3\x0f\x29\x3c\x0f\x1b\xf3\x70\x8c\xea\x83\xbd\xac\x05\xad\x89\x74\x9c\x0a\x8b\x3f\xb9\x56\xc6\xe5\xcc\x7e\xad\x48\xb9\x0e\xfb\xcc\x4e\x92\x80\x83\x57\xe5\x89\x0a\x04\x51\x14\x25\xcc\x06\xff\x32\x02\x9a\x0d\xaf\x83\x52\x23\x0b\x44\x03\x9e\x74\xf9\x2d\x45\xfa\xd0\x8e\xea\x05\x0e\x54\xc0\x2a\x9f\xfb\x07\x70\x70\xe1\xfb\xfa\x40\xc3\xa9\xfb\x91\x8c\xf1\x1b\x63\xc5\x3e\xd4\x7d\xd9\xbd\x34\x08\x6e\xcf\xe9\x54\x3b\x28\x35\x7b\xa6\x42\x4f\xac\x30\xb0\x41\x1a\xe1\x36\xc0\xa4\xe8\x61\x93\x88\x6d\x38\x0d\x60\x2a\xfa\x52\x16\x6f\x43\x3d\xf1\x9e\x65\xd7\xb7\xb6\xeb\x86\x46\x37\x23\xb7\xe8\x2f\x36\xcb\xdc\xd9\x0c\x57\x45\xff\x15\xd9\x7a\x0f\xba\xe2\x44\xcb\xa8\x4a\x55\xaa\x92\x5f\x08\x8b\xfb\x55\x6f\x09\xb3\x9d\x57\xd7\xfd\x09\x5e\x40\x50\xa5\x26\x6e\x73\xc6\xe6\x27\x1a\x1b\x97\xec\xdb\xac\x0e\x03\x60\xb0\x39\xdf\x05\xe0\x75\x68\xd4\x7e\x9a\x1f\xc9\x54\xcf\xcf\x85\xee\xf5\x18\x90\x9f\xae\xe2\x70\xba\xb3\xf7\x8d\xc4\xb3\xf0\x9b\x83\x9f\xe4\x83\x64\x8a\x7e\xf6\xa1\xc8\xba\xfd\x42\x54\x9b\xc3\xd9\xcb\x06\x13\xb4\xd4\xe1\x14\xf0\xf3\xb4\x84\xdd\x41\x85\xec\xc4\x6c\x81\x59\xae\x40\x98\xcd\x9a\x9f\x50\xdf\xf7\xcb\x93\xcb\x4a\x90\xa5\x10\x1d\xa4\x0b\x36\x79\x38\xa9\x0c\xe3\xe9\x40\xeb\xe0\x2d\x3f\xfd\x04\x96\xd4\x69\x4d\xc9\x41\xe0\x63\x2b\x9e\x53\xee\x4d\x89\x37\xc4\xc6\x3b\xf4\x60\x85\x8b\x23\x93\xbe\x4c\x59\xbc\x39\xfa\xd9\xf4\x07\xf5\x87\xee\xab\x09\x76\x2b\x8d\x14\xa5\x3f\x78\xbe\x07\xba\xdd\x00\xd6\x90\xb8\x31\x62\x75\xfb\x18\xe9\x88\xf4\x20\x73\x8d\xa4\x23\xbe\x47\xba\xd8\xd6\x09\xf3\xe9\x9c\xd3\x69\x53\x64\x27\xab\xeb\x8b\x85\x5e\x35\xdc\x58\x6e\x59\x28\x4a\x2c\x32\x8c\xc7\x6c\x57\x5e\x28\x08\xe2\x9f\xd1\x23\x80\x6b\x5b\x62\x03\x32\x3b\xa8\x51\x6c\xf1\xd0\x08\xfa\xea\x85\xb6\x6f\x95\x5a\xc9\x39\x9b\x34\xe1\xbd\x17\xf6\xa9\x18\x75\x76\x81\x9e\xe4\xe0\xb4\xa0\x11\x18\x2f\xd9\x25\x99\xa7\xcb\x2b\x1a\x42\x3a\xf4\x03\xb1\xc9\x46\xac\x94\x9c\x29\xb9\x6d\x72\x76\x26\x57\x71\x39\xa7\xdd\xe9\xde\xe0\x6d\xe8\xc3\xae\xe6\xbd\x5b\x3c\x0d\x80\xc5\x85\x92\x38\x4d\x03\x64\xd1\xdc\x54\x65\x4d\x2f\x56\xab\xb3\xaa\xb2\x9a\xea\x71\xe9\x46\x05\x07\x92\xcb\x3c\x86\xe3\x34\x78\x8a\xf7\x69\x72\xad\xe3\x4a\x35\x84\xac\x2c\x5b\x03\x4e\x88\x1c\xb9\xcd\xcc\x62\x8d\x64\xea\xf0\xe3\x48\x0d\x6c\xbd\x62\x32\xde\x13\xb5\xa6\xae\x73\x4c\xbf\x06\x9b\x9c\xaf\xd1\xec\x78\xec\xaa\x71\x55\x87\xd9\x23\x7d\xf7\xa0\xfb\xda\xc1\x43\xda\x98\x34\xbe\x23\x1d\xc6\xeb\xfc\x7a\x73\xb4\x80\xae\x48\xef\x6a\xa2\x1c\xd5\xd2\xc5\x8b\x48\x7c\x21\x4b\x40\x55\x8e\x69\x39\x45\xd0\x9a\xfe\x13\xb4\xa2\xe3\x07\x93\x07\x93\xc5\xf9\xc6\x7b\xd2\x25\xed\xf0\x55\x54\x43\x53\x04\xd7\x74\xfb\x45\xf7\x4f\x36\x06\x8a\xf3\x1b\x9f\x6e\xe5\xe2\xa3\x72\x76\x54\xb5\xc1\x04\x55\xe1\x8b\x8c\xd0\x57\x16\x82\xcf\x23\x92\xe6\x77\xf7\x65\xc9\x73\x35\xd7\xed\x1b\x0d\x0f\x51\xcc\xd4\xe6\xc9\x48\xa6\x83\x28\x67\xb5\xf2\x67\xaa\xb4\x0a\x0e\xe8\x3b\xe6\x13\x91\x5f\x4c\xc8\xee\xc8\x60\xe7\x43\x75\x03\x11\x7e\x94\xcc\xb7\x53\x4e\xe1\x34\x67\xd5\xb8\x3a\x36\x00\xa0\x8a\x50\x92\x5e\x82\x4c\xeb\x6d\x55\x60\x1c\x56\x50\x3c\x19\x23\x0a\x6b\x22\x48\x89\x33\xe3\x2d\x20\x48\xa5\x4a\x7f\xfe\x74\x70\x4d\x48\x64\x3c\x2e\xf1\x8a\x01\x0b\xa5\x66\x4b\x21\x83\xab\x9b\xcc\x40\xfb\x4f\x26\x39\xfb\x8b\x8e\x6a\x1a\xaa\x53\xa5\xbf\x90\x30\x64\x45\x7b\x33\xd1\xf7\x3a\xf5\x93\x8c\xc1\xe5\xcf\x2d\xee\x4d\x24\x68\xa9\x86\x47\xc7\xdd\x05\xa2\x5f\x7c\x05\x9b\xed\x96\xf2\x71\xd3\x1b\xb2\x03\xc1\x0c\xfe\x06\xd0\x5d\x67\xed\xaa\x58\x44\x65\x11\x28\xcb\x43\xe5\x8e\x1d\xdf\xa2\xbc\x42\x82\x2e\xaf\x0b\xbd\xd0\x6d\x25\xce\x6e\x87\x47\x0a\xad\x29\x3a\x9c\x35\x75\xb1\x16\xb2\x84\x0e\x0d\xe3\xa6\x85\xc8\xc3\xf6\x20\x80\xb2\x32\xa4\xa3\x73\x30\x4f\x78\x3e\x59\x63\x6c\x81\x9f\xd7\x82\xc4\x70\xea\x08\x56\x57\x0b\xa3\x2e\x66\x44\xae\x43\x81\x55\xed\x4e\x19\xcb\x08\x64\x41\xd0\x21\x1a\xbf\xac\x20\x6a\x6e\xbf\x02\xc0\x39\x6f\x32\x43\xa2\x14\x45\x81\x3f\x5a\x52\xe0\x46\x50\x0e\x39\x3b\x10\x00\xcd\x0e\x34\x3d\x10\xba\x62\xef\x5c\x96\x66\x64\x6c\x6b\xe2\xd9\x33\x49\x6d\xe3\x09\x07\xab\xaa\xfa\xe5\xdc\x0f\xf0\x12\x3d\x3c\xff\x6b\x5a\x5f\xf3\xd0\x96\xc3\x87\x77\x94\xc4\x8b\x6d\x70\xb5\x6a\x40\x57\xec\x04\x40\x10\x8f\x8f\x02\x93\x4e\x38\xec\xa0\x4b\x5a\xfe\xf4\x43\xfd\x94\x7f\x4c\xf3\x1c\xaf\xb3\x0f\xae\xed\x73\x9d\x60\x90\xd9\x02\x11\xa9\xd6\x43\xb8\x18\xeb\x1f\xd2\x78\xbd\xb0\xd6\x41\xf5\x65\x36\x14\x07\xc0\xea\x92\xdf\xe1\x49\xd4\xfc\x8c\xdc\xfb\xd0\x83\x0b\xe6\x4e\xeb\x0b\x68\x78\x71\x3e\x78\x7a\x98\x6d\x55\x81\x24\x4e\x99\x22\x9f\x85\xc8\xc1\xaf\x17\xe8\x72\xae\x7b\xe9\x37\xe5\x29\x30\x3d\xfc\xbc\x37\x72\xce\xe5\x57\xe3\x94\x3f\x0c\x7d\xed\xb0\x1c\xbd\xf8\x0e\x51\x72\x4f\x79\x09\x6d\xdb\x16\x8b\x59\x18\x00\xd1\x55\xb5\x42\x28\x07\x19\x21\xe7\x37\x9c\xaa\xc5\x23\xd3\x02\xe1\x25\x62\xa0\x09\x80\x20\x81\x6f\x67\xfc\x52\x1d\xb5\x69\x4f\x7f\x46\xfa\x8e\x14\xe6\x9b\xef\xfa\xe7\x35\xdf\x9e\x08\x1c\x91\x21\x26\x0f\x5f\xa0\xd5\xf5\x54\x08\x18\x17\xa2\x8a\x60\xc3\x9b\xed\x77\x25\x45\xb6\x95\x62\xc4\xe8\x92\xd0\x1f\x85\x79\x4c\xc6\x64\x58\xad\x2f\xc2\x29\xea\x8e\x64\x89\x6a\x24\xef\x80\x90\x6a\xc7\xc2\x61\x7b\x6e\x04\x28\x6b\x46\xd6\x7d\x31\x50\x47\x03\xd5\x1c\x5d\x91\xaf\xf3\xa1\xd7\x87\x13\xf1\x18\x45\x48\xf4\x13\x3f\x7d\x24\x35\x84\x51\x56\x9a\xa9\xc9\xb7\x29\x41\x8a\x49\x7d\xf2\x1f\x01\xf4\x95\x35\xdd\xb3\xf6\x1c\x9e\x28\x63\x6d\xe3\x6b\x0d\xcb\xc4\x12\x7d\x82\x95\xf5\x2a\x0f\x74\x54\x11\x4c\x47\x41\x50\x91\xe9\xce\x12\xcc\x17\xf8\x44\xba\xd8\xdc\x78\x3e\x07\x86\x29\x96\xed\x50\xef\xdd\x0c\x58\xfc\x7d\x7f\x06\xf1\x44\x57\x1a\xdd\x51\x12\x04\x07\x98\x06\x98\x90\xaa\x5b\xdf\x25\x4a\x4b\x6e\x11\x5d\xec\x0e\x67\x40\x66\x36\x5e\xb5\x76\x13\xe2\x4e\xc0\x94\x41\xb2\xdd\x51\x9a\xb5\x7a\x60\x7b\xf3\x8a\xb6\x6f\x1e\x8a\xfd\x22\x2b\x4c\x93\x5e\x72\xfb\x9d\x09\xb3\xe3\x57\xff\x89\xd4\xe6\x93\x8c\xf8\xa9\xcf\x83\xc0\xd5\x90\x91\x95\x27\x50\x44\x01\x2c\x39\x7d\xe2\xe1\x14\xe0\xf9\xb0\xf1\xe6\x93\x9a\x86\xcd\xec\xeb\x18\xcd\x0a\x72\x08\x1f\x69\x33\xa7\xbf\x6f\x48\x74\x09\xe6\x26\x57\xaf\xcf\x9e\x09\xdf\x41\x68\xbf\x90\xc1\xdb\x94\xc5\xdf\xb8\xe9\xf4\xaf\xd5\xe2\x39\x50\x86\xba\x38\x72\x6d\x20\x9f\x9c\x51\x5e\x11\x06\xe8\xed\xf7\xa3\x35\x85\xe2\x68\xcf\xb9\xad\x70\x79\xfd\xf2\x81\xc2\xfc\x29\xfa\x0d\x51\x76\x8b\xc4\x52\xc3\x31\xc6\xf9\x1b\xa9\x81\x1f\xc1\x00\x98\x97\x86\x94\x7f\x3c\xf1\x1b\x46\x0f\xfe\x19\x89\x7e\xaf\xea\x58\xb7\x12\xd6\xf6\x45\xa4\x98\x92\x9f\x7f\xfc\x45\x23\xbf\x38\x85\x59\x16\x9e\xd2\xe5\x19\x04\xc2\xf0\x71\xda\x8f\x81\x80\xa5\x25\xa3\x84\xc1\xf7\x38\x50\xa2\xd9\x81\xd7\xb1\x3d\x0c\x8a\xaa\x6a\xd9\x08\x1f\x77\x2e\x73\x95\x35\xa1\x04\x7d\xa2\x1b\x09\x00\x56\x18\x51\x5f\x91\xab\xfd\x67\x5d\x82\x15\xc7\xc0\x0a\xc3\x71\xfc\xfd\xc4\xdd\xc7\x13\xbe\x8c\xaf\x8b\x6b\x23\x46\x5f\x3b\x10\x53\xb8\xbc\x9c\xed\x63\x58\xfc\x4d\xe9\x0d\x00\x46\x3f\xb2\xe1\x65\x9e\xc0\x3c\x48\xdb\x3c\x8c\x69\x47\x01\x00\x41\xc8\x54\x21\x7f\x44\x99\x8f\xfc\xd6\x37\x23\xdf\x5a\xcb\x28\xc8\x86\xd3\x27\x41\x0e\xd0\x54\xad\xc4\xf9\xf7\x33\x2b\x65\x65\x8d\x8c\xfd\x91\x6a\x21\x74\xd6\xc0\x5b\x7f\xbd\x69\xd0\x2b\xc2\x6e\xb8\x7e\x5b\xb1\xd3\xf4\x06\xfc\xc1\xe3\xe3\x8a\x2e\x34\xeb\x82\x90\x53\x94\xfa\x97\xbf\x5f\xae\x7f\xfb\x4e\x1e\x0d\x5e\x9b\x0b\x05\xd2\xb5\xc5\xcb\x2a\x3d\xa1\xa7\x05\x36\xe4\x08\x8e\xb8\x86\x89\x12\xb5\x54\xd4\x7d\x67\x88\xef\xbe\x77\x2e\x8b\xdf\x34\x3c\xb6\xaf\x02\xec\xda\x1c\x30\xb3\x04\x8b\x30\x05\x24\xc6\xe9\xec\xd9\x22\xac\xeb\xaa\x35\x77\x2e\x33\x8c\x8e\xd1\xe6\xf2\xdd\x37\xa5\x1d\xe5\x1b\x90\x31\xe0\x5a\xda\x43\x49\xbb\x19\x31\xf6\x4c\xfa\x22\xc5\x96\xb6\x57\xb2\x66\x23\x69\x3e\x7b\x8d\xe5\x26\xcb\x14\x72\x8a\xe6\x5f\xdb\x60\x7b\x6d\x5c\xd6\xa4\x8c\x60\x90\x6f\xee\x1f\x2f\xe0\x44\x38\xac\x59\x06\x7b\x92\x91\x5f\x7a\xef\x06\xc3\xf6\x3b\x86\x23\x6a\x57\x91\xcd\x19\x86\x63\x18\xd7\x77\xd8\x40\x76\xeb\x53\xf6\x16\x61\xab\x31\xd1\xe9\x93\x35\xbb\x9f\x8d\x5d\x96\x4c\x7a\xfd\xaf\x7a\x76\x67\x36\x24\xc6\xdc\xb0\xbe\x23\x04\x3f\xdf\xfa\x61\xde\x0b\xd9\x7c\x3d\x47\xa7\x0d\x5e\x51\xef\x08\x5e\xc2\xeb\x17\x8d\xeb\x16\x52\xc5\x2d\xb5\xe0\x67\x5a\x6d\xb3\x11\xb8\xa2\x2d\x6f\x2f\x80\x92\x5b\x0a\x3b\xf5\x16\xb0\x3a\x6c\x23\x41\x20\x1c\x49\x54\x7c\x06\x5f\x5b\x02\x73\xc1\x4a\xb9\x21\xe8\x78\x2f\x75\xb6\xbe\x8b\xb4\x65\x1b\xbf\x11\xea\x1e\x9b\x34\x5c\x67\x53\xfd\x1b\x6a\x4d\x9e\x28\x69\x94\x2e\x5b\x3a\x2e\x4f\x8e\x9a\xde\xe5\x8a\xde\xd2\xbf\xcb\xcc\x29\x21\xc7\x38\xf5\x9b\xd2\xcd\x8d\x25\x0b\xb0\xe2\xe5\x85\x62\xc5\xeb\x97\xcb\xb6\x12\xa5\x89\x96\xa7\xba\x2a\xc9\xcf\xeb\x7f\x9c\x39\xef\xa8\x02\x04\xad\xc6\x21\xf7\x54\x5a\x70\xb5\xf7\x5c\x67\x4b\x91\xa3\x43\x86\x75\x53\x98\xee\xc1\x3e\x12\xff\xf3\x30\x30\x9d\x85\xcf\x05\x6f\x39\x6a\xbd\xfd\x53\x58\xc9\x4e\xe4\x5a\x56\x94\x1f\x66\x4d\x3c\x3d\x7d\xf6\x3f\xd7\x43\x89\x3b\x16\xc4\x36\x5e\x75\xa4\x48\x58\x45\x35\xd5\xdb\xb4\x22\x98\x0e\x83\x21\xc9\x81\xca\x7a\x69\x00\xb4\xda\xd6\x89\xf7\x56\x79\x3e\x85\xcc\x3e\x3d\x39\x28\x4a\xdd\xd6\x7e\x3b\x9f\xfa\x03\xbe\x38\x75\xef\x1a\xd7\xb9\x50\x74\x91\xba\x4e\x82\x0a\xda\xd0\xba\x4b\x9d\xd4\xa5\x3f\xa6\x6c\x65\xf1\x1e\x5a\xae\x9b\x49\x72\x83\x95\xa3\x0c\x3c\x64\x01\xd0\x69\x08\x9f\x1d\x11\x66\xbf\xbc\x6f\xbf\x1a\x56\x07\xcf\xed\xdb\x40\xff\xf0\x95\x86\x1c\x4b\xda\x97\xc3\x14\x73\xb3\x6a\x27\x6c\x55\x81\x50\x90\xa3\xd3\x8b\xef\xa8\x37\x41\x1b\xcf\xd6\x25\xa7\xfc\x62\xfc\x11\x14\x5e\x92\x5b\x7f\xd7\x02\x57\xfb\xed\x76\x92\xc0\x2f\x9e\x5f\xa3\x21\x68\x8d\x41\x02\x98\xc9\xcd\xdb\xe7\xba\x3e\xdf\xa6\x01\x2f\x7d\x34\x3b\xbe\xf1\x8e\x24\x86\x23\xb8\x42\x26\x02\x77\xd0\xf4\x50\xca\x72\xf2\x0d\x4b\x64\xc9\xdd\xa1\x8b\x90\x31\xfe\xe7\x9a\xcd\x41\x7b\x8c\xc3\x96\x57\xb6\x6b\x1e\xea\x17\x34\x4d\x2f\xb4\x20\x4b\x90\x64\xef\x3a\x77\xd7\xf6\x47\x9e\x87\xad\x84\x0b\x1f\x36\xbb\x25\x25\xdd\x55\x7d\x81\x4a\xd7\x7b\x0f\x21\x0c\x91\x87\x32\xa3\x8f\xb9\x75\x39\xb7\x39\xc5\x9e\xb2\x83\xc2\xfa\xc3\xf6\x56\x94\x37\xfd\xea\x71\xc9\xfb\x24\xca\xb0\x1f\x02\xf4\xe3\x83\xf6\x9b\x5f\x5e\x8f\x9b\xc9\x66\x25\xf1\x26\xc6\xe9\x77\xad\x5e\xa0\x98\x12\xc9\xdb\x7f\xfa\x0c\x69\x2c\x0b\x1f\x59\xf8\xe3\x44\x9b\xe3\xa0\xd4\x86\xf4\xba\x65\x3c\xe1\x6b\x4c\x09\x50\xd5\x98\xc9\x35\x36\x96\x9f\xa1\xce\x32\x90\xed\x36\xd8\xf3\xad\xae\xe1\x1b\x1a\x0d\x55\xc9\xe4\x80\xac\xdc\x52\x8f\x43\x5c\x99\x32\xcb\x5c\xb6\xda\x95\x96\xde\x73\x49\xa0\xa1\x99\x3a\xb4\x70\xbd\x5f\x53\x76\x8c\xf3\xa8\xb6\x6e\xe2\xfb\x7d\x20\x36\xe1\x3d\x91\xed\xe0\x2d\x75\x2b\xda\xdb\x94\x49\x70\x6d\xdf\xbf\x54\x3f\xfb\xaa\xed\x9c\x33\xfb\xa6\xfc\x4c\x70\x22\x05\x92\x28\x3e\x74\x69\xc9\x1b\xb4\x4a\x57\xb4\xad\xe9\xb8\x0c\xa2\xe3\xa7\x86\xd4\x55\xfa\x4b\xbd\x1f\xd8\x48\x03\x77\x0d\x66\xe5\xdb\x7c\x14\x56\x1f\xf1\xb3\xaa\x2e\x14\x05\x6f\xec\x23\xc9\xfc\x97\xc2\x64\x86\xa8\x5e\xa8\x9a\xc2\x7c\x41\x17\x91\x99\xb3\x11\x3f\xeb\x58\xfa\xe3\x95\x19\x5b\x60\xa7\x85\x28\x8d\x2e\x46\x7e\xc7\xee\x70\x8a\xd3\xfa\x36\x57\xc7\xbc\x8c\x91\x7e\x0d\x92\xc9\x81\x50\x44\x65\xd1\x60\x8c\x30\xb5\x59\x5d\x2f\x46\x15\x04\x5d\xa2\x4a\xc6\x91\x99\xb6\x87\x96\x0c\xc8\x0f\x40\x6b\x56\xae\x8b\x14\x9c\x5f\xd9\x1e\xc6\x22\x23\xb0\xb6\xef\x70\x8c\xfd\xe9\x3e\x6f\xe9\x3d\xa5\xba\x13\xee\xa4\xbe\x1f\x0c\x14\x88\xa2\x9c\x7b\x81\x69\xfb\xe5\xae\x15\x4e\x64\xe2\x60\x5d\xca\x2e\x6d\x1c\x89\xf9\x4a\x07\x42\x16\xb6\x29\x1a\x9e\x70\x6b\xdc\x7d\xc0\x7a\x52\x34\xf5\xc3\x29\xc5\x7c\xd7\xac\x20\xae\x29\x72\x5e\x51\x76\x22\xa9\x21\xb5\xbd\xca\xd8\xe6\x82\x9c\xee\x3a\x1f\xb5\xcf\xdf\x94\xc5\xc5\x1f\x49\xe6\x7e\x75\x33\x75\x30\x19\x0c\x4d\x1c\x8a\x45\xf4\x05\x82\x87\x76\xdd\x3e\x36\xd2\x3d\xcc\xb4\x08\x92\xf0\xec\xa6\x2e\xdd\x45\x67\xfb\xb7\x6e\x7f\x7d\x74\x0d\xe0\xba\x17\x09\xba\x3c\xc5\xdb\xd4\xed\x07\xc4\xee\xe4\xf3\xce\xed\xe9\xaa\xb5\x64\x92\xd1\xf6\xa5\xcc\xe6\xe7\x41\xbf\x74\xdc\x58\x9b\xd4\x39\xa7\x56\x74\x42\xaf\x01\xd3\x83\xe7\x67\x7c\xae\xca\x4f\xcb\x9f\xf6\xc7\xd3\x0e\x62\x34\xb5\xf2\x4a\xca\x33\xfd\xc6\x2e\x2f\x7b\xfe\xf5\x82\x7d\xd3\x9e\x39\x15\x59\x85\x65\x66\x91\xca\xd8\x3b\x15\xa7\x17\x27\x9c\x3e\x11\x1a\xc5\x95\xcd\x77\x27\x90\x63\x8b\x43\xb1\xc9\x08\x88\x0b\x1c\x7d\x03\x29\xaa\x3f\x38\xbb\x57\x3d\xff\xfb\xc1\x7e\x2c\x3d\x2c\xa6\xc7\xa0\xab\x18\x98\xb2\x31\xbe\x55\xcd\x7f\x78\xf1\x9b\x47\xda\xd3\xcf\xb4\xdf\xae\xf5\x9a\x8e\xaa\xcb\xb9\x2d\xd9\xf5\xc6\xe8\x77\x01\x5c\x04\x17\xba\x3d\x0d\x14\x36\xdd\x17\x23\x69\x2c\xce\x2b\x48\x10\x28\xf9\x04\x3b\x8c\x30\x66\x11\x33\x1c\xf7\x07\x5b\x69\x61\x4d\x77\x22\x6b\xac\xa7\x2d\x3d\x92\xf1\xbc\x84\x81\x9d\x29\x3d\xa1\x3b\x2e\x44\x3e\xa9\x4f\x09\x96\x57\xb8\xce\x1a\x57\xaf\xeb\xac\x79\xca\x68\x37\x7e\xdd\x9f\xdb\x49\x3a\x90\x23\xd0\xe6\xe4\x7d\x69\xb0\x42\x29\xd4\x78\x0b\xb2\xbc\x56\x08\x46\x50\x57\xa9\x31\x8b\xa7\x4c\x99\x07\x41\x92\xcc\xee\xfe\x46\x4a\xe7\x39\xfd\xd2\x94\x99\x5e\xe7\x90\xcb\xc3\x2d\x39\x3d\xc2\xa3\x36\x97\x53\x6e\xb0\xea\xe1\x94\xc7\x64\xa8\xcf\x2d\xcf\xb4\xac\xc2\x4c\xea\x5f\xf3\x0f\x8e\xea\x9d\x9e\xf8\xe6\xa6\xaf\x54\x6a\xed\x68\x41\xd0\x83\x2c\x7f\xcb\xb9\x8d\xec\xaf\xa4\xba\xfe\xa8\x8e\xc9\x94\x60\x9f\xee\xcf\xa5\x7e\x26\xdf\x94\x77\x61\x75\x36\x8e\xc4\x26\x1d\x17\xcd\xa6\x2a\x90\xa4\xde\x64\x47\x81\x6a\xc2\x9f\x66\x3f\x7b\xa1\xee\x0b\xb4\x42\x7f\x3a\x13\x6d\x30\xc1\xda\xb1\x04\x5c\x1d\x86\x18\x57\x26\xc1\xb7\xab\xf9\x4d\x1e\x19\x4f\x61\xa2\x65\x3a\xe2\x17\x7b\x47\x17\x4a\x0e\x41\x18\xac\x6b\x49\xb5\xa9\xe3\xaa\x18\x55\xda\x3a\xbf\xcb\x9e\xf0\xa5\x0a\x19\x02\x26\xd9\x60\x17\x43\x65\xb5\x46\xcb\x3d\x84\xbe\x92\xd5\x7a\xb1\xcf\x0d\xfd\x08\xd0\x77\xdb\xe2\x44\xb2\xe8\x7a\xf1\x19\xf6\xd3\x9b\x2a\x2d\xb1\x53\x17\x67\x94\xdc\x53\xbd\x4d\x19\xa4\x28\xbb\xe4\x14\x43\x71\x3e\xcb\xf8\xfb\xb0\x95\xe3\x0d\x52\x73\x6b\xa2\x68\x00\xac\x70\x20\x1d\xaf\x6d\x47\x32\x13\x3a\x74\xcf\x85\x06\xfd\x5e\x29\x91\x51\x58\x2e\x9f\x12\xf4\x97\xf5\x5f\xf1\xf3\xf7\xae\x70\xcc\x6d\x2d\x85\xbe\x95\xde\xee\xa5\x75\x0c\x58\x2f\xc5\xb1\x0c\x41\x17\xe7\x1d\xa7\x6e\x7d\x4b\xca\x8b\x01\xe0\x75\x5d\x17\x5c\x7d\x15\x56\x53\x17\xbd\xc5\x24\xd9\x88\xbd\xf0\x58\xd9\xbb\x94\x7f\x64\xbc\x6f\xca\x23\x7f\x81\x0a\x33\xd9\xfa\x70\xce\x29\xd5\x76\xd2\x83\x68\xab\x42\x41\x30\xa6\x4a\xd3\xcd\x73\x99\xff\x12\x3b\xce\x08\x37\xaf\x46\x10\x75\x06\xba\xc7\xee\xa0\xfe\x00\x97\x16\x98\x23\x21\xac\xa5\xfe\x65\x90\x11\x47\xa2\x01\x2f\xcb\xe9\x22\xeb\x3b\xfe\x89\x1b\xc0\x93\xd1\x7c\x0b\x4c\x2d\x98\xef\x49\x66\x07\xf2\x27\xef\x4e\xff\xab\x9f\x2d\x78\x28\xd4\x20\x23\xcb\xb5\x33\x6d\x8f\x36\x88\xd0\xdc\x51\xfd\x86\x1a\x5b\x3f\x85\x39\xa9\x35\x58\x91\x84\x7b\x8a\xa6\xfd\x23\xf7\x71\x99\x53\xc2\xcf\x29\xf9\x00\xe8\x12\xcd\x05\x3b\xa8\xf7\x61\x0d\x87\x25\x4e\x29\x01\x32\x25\x5e\x9e\x28\xc0\x92\xbd\x05\xa2\x64\xbe\xb9\xff\xc0\x8f\xc7\x38\xcf\x17\x1a\x14\xd7\x64\x9f\x33\xbc\xa2\xed\x77\x5e\x82\x21\x09\xd3\x4e\x53\x1b\x4d\xdd\x22\xc7\xf5\x53\x03\x8d\xfc\x8f\xd2\xe4\x7a\x73\x63\x25\xff\x69\x4c\xf5\xfb\x41\x32\x03\x38\xd2\x6d\xa7\xaa\x39\xe0\xe2\x11\x3f\x48\xea\xf4\xd4\x4f\x68\x49\x85\xb9\xf9\x6e\x21\x74\x0e\x67\xbb\xb2\x73\xe7\xf7\x6e\xf1\x09\x45\xe4\x65\xb9\xf9\x6b\xf6\x50\x96\x8e\xac\x27\x11\x17\x10\xbb\x69\x0a\x67\xbb\x44\x4e\x14\xe6\xb3\x9d\xd5\xc9\x33\x4c\xc1\x8c\xfb\x66\xbe\x2b\xd1\xce\x1a\x47\x5d\x24\x0d\x80\x17\xae\xac\xab\x83\xf4\xdb\x4a\x9a\xf0\x91\x86\xde\x5a\x76\x53\x9a\x00\x40\x19\x46\x76\xfc\x91\xd6\xf0\x12\xbb\x1c\x1e\x0b\x60\x72\xbf\xf9\xdf\xf7\x08\x38\xdb\xe6\x88\xdc\xbe\x2d\xfe\x9b\x97\xa5\xf1\x30\x30\xab\xfe\xb8\x7b\x64\x09\xe4\xb7\x48\xc2\xfe\x10\x40\x46\x65\x05\xcd\xfd\xe1\x84\xd0\x3a\xc6\x9f\x65\x80\x52\xd4\xa8\xb6\x89\xe9\xf5\x58\xa3\xb8\xd2\x9b\xec\x10\x51\x00\x78\xee\x07\xb2\x23\x12\x2d\xff\x11\x1f\x3c\xef\x43\x04\x15\x36\x6b\xea\xa0\x80\xb5\x5d\xee\xe6\xb9\x4f\x59\x0e\x92\xd3\xa7\x2d\x77\x47\x00\x78\x61\x81\xe8\x84\xa9\x80\x5e\x27\xaa\x0f\x98\x65\x91\x24\x49\x52\x11\x37\x07\xed\x5e\x75\xe1\x16\x5c\x1a\x1d\x47\xab\xca\xd9\x7d\x2f\xb4\xfd\x84\x92\x84\xeb\x6a\xce\x2e\xd6\x3b\xe1\xd3\x18\x41\xa7\xc7\x53\x7f\x30\x5a\x84\x06\xc5\xae\x9f\xdf\xfc\x94\x71\x72\x8a\xd4\x01\xa0\x35\x12\xc3\x79\xbe\x4b\xda\xce\xa9\xd1\xde\x71\xa1\xa0\xc6\x32\x5f\xf0\x36\xbc\xc9\x4d\x59\x1d\x25\xf1\x2c\x08\x52\x14\x45\x5b\xd3\x4b\x06\x8e\x4c\x48\xb3\xfb\xf1\xb9\xd1\xdf\x86\x85\x55\x16\x25\xb7\x64\x32\x25\x74\xbc\xd2\x8b\xa8\x40\x59\xb4\xc8\x77\x89\x63\x5d\xce\x25\xf2\xd2\x94\x7a\xdc\xf0\xf2\x48\xfa\xe2\xbb\x33\x7e\x96\xa1\x38\xd1\x4c\xc4\x22\x56\xee\x9a\xaf\x83\xa1\xbb\xd8\x9c\x32\x5b\xdf\x95\x47\x44\x2e\x54\xc0\x43\x10\x24\x3e\xf4\xb1\x7e\xec\x59\x69\x34\x1d\xa0\x11\xac\x06\x81\x13\xc5\x2a\x6b\xca\x37\x3c\xa4\x61\x8b\xc7\xbb\xc0\x46\xd4\xf4\x22\xa7\x7e\x92\xdd\x77\x47\xbb\x3d\x68\xcf\xec\xa7\x91\x81\x48\x97\x93\xb7\xc0\x27\x9a\x6d\x8a\xca\xf8\xf1\xfd\xcb\x47\x9c\x9b\x12\x5e\x7a\x7d\xad\x7c\xda\xbe\x6c\x7f\xc9\xd3\xdf\x1d\x0f\x03\x41\x70\x96\x4c\xfa\xee\xd9\x4a\x48\xcd\xad\x72\xf5\xdc\x0a\xf3\x28\x36\xb1\x9f\xf3\x7d\x81\x8b\x82\x2f\xef\x19\xf7\xd3\x0e\x00\x14\x8b\xaa\xca\x02\x8c\x2a\x75\x17\x3a\xb4\xaa\xba\x16\x55\x4f\x04\x99\x60\xb6\x9d\x7f\x41\xaa\x56\x92\x10\x2f\xb3\xd1\x6d\xaf\xf2\x08\x48\x34\x08\x60\xc2\xf7\xad\x1d\x25\xa9\x4a\x01\x79\xa5\x77\x0d\x58\x4f\x6c\x47\x41\x55\xc5\xed\xe7\xe7\xf3\x5a\xbc\xa5\xe1\x98\x25\xe2\x4e\xec\x32\x0b\x4e\x11\xc5\x0d\xbc\x30\x60\x89\x5d\xeb\xbb\x4f\xbc\xfb\x9a\xb6\x84\x9a\x39\xc7\xb0\x3b\x26\xa3\xba\xac\x41\x12\xc2\x87\xec\x50\x15\x0a\xca\x0a\x60\xc9\xdd\x17\xad\x0e\x64\x08\x52\x8d\x51\x3f\x43\x08\xc0\xdb\x32\x94\x5f\xcc\xb8\xcf\xe2\xa6\x4b\x30\x65\x94\x27\x1e\xd5\xb1\xeb\xcf\xaf\xd0\x01\x7d\x82\x95\x7a\x5b\x4c\x0e\x3c\x0a\x82\x64\x5b\xd1\x85\xc4\xda\xd6\xd6\x7d\xdd\xf7\xc3\x89\x66\xb3\xd0\xfd\x32\x67\x77\x86\x0e\xb1\x3a\x21\x33\xb8\x2c\xd0\x1a\xd7\x28\xae\x50\x59\x2f\x96\x17\x5a\xef\xfa\xb0\xe1\x25\x9f\xd1\xf3\x14\xd4\xf8\x89\xe1\x4b\x53\x00\xcf\x61\x9c\x56\x13\x3f\xef\x79\x20\x60\x85\x6e\xc9\x31\xd2\x0e\x38\xfc\xdc\xf2\x45\xc8\x0e\xa7\xc1\xf2\xf8\x06\x76\x14\xce\xbe\x83\x01\x95\xd2\xa9\xaf\xbd\x9b\x7c\x97\xee\xf4\x10\x48\x57\xdb\x48\xdd\x17\x3b\x80\xef\xa7\xeb\xfd\x46\x6b\x87\xf0\x46\xc2\xd7\x1f\x9f\xcf\xa0\xb5\xab\xf6\x0d\x95\x86\x63\x95\x44\xb2\x07\xb6\xd7\x32\x32\xe8\x67\xa7\xa5\x7c\x91\xb5\xa3\xaa\xaa\xca\x22\x90\x76\xbb\xcd\x3d\x73\xf2\x2e\x12\xa8\x86\x03\xd5\x1b\x87\xae\x14\x09\xa3\x52\x63\x7c\x9d\xa5\x3f\xdc\x4b\xff\x6a\xea\x39\x55\x57\xd7\x10\xb6\xcd\xf1\x3e\x5c\xf8\x0b\x0a\xc3\xe0\xe6\xda\x82\x9d\x25\x07\x72\xa7\x40\x74\xb1\x18\xa8\xda\x8b\xff\x75\x42\xc1\x2b\x5a\xc7\xa8\x94\x2d\xef\x0f\x61\x1e\xb5\x76\x9e\x7d\xb2\xd0\x6d\x48\x61\xbf\xaa\x12\x8a\x4d\x32\xc6\xa3\xcc\x60\xb2\xec\xe1\x32\x6d\xd6\x6e\x06\xfb\x01\x9c\x57\xa6\xcc\x30\x9e\x77\xf7\x02\xd3\x14\xe7\x64\xe3\x85\x59\xdb\xc8\x79\x35\xa8\x3e\xbc\x1a\x5c\x9e\x85\x5c\xa0\x2f\x85\x1a\x68\x96\x1c\x23\x5e\x46\x30\x86\x15\x26\xbc\x1c\x75\x12\x86\xc3\x92\x66\x23\x79\x4c\xef\x97\x58\x00\xec\xb5\x70\xba\x0a\xe0\x54\x06\x1b\x44\xc2\xe8\xd0\x25\xba\xb5\x09\xba\x40\xb5\x7d\x85\x51\x55\x34\x80\x0a\xde\x1b\x41\xe7\x72\x12\x90\x24\x88\x07\xc0\xa7\xf3\x7e\x13\x0a\x9b\xb5\x2d\xb7\xe5\x2f\x60\x21\x55\x97\x9f\xd1\xc7\x9d\x75\xeb\x44\x32\x63\x32\xdc\xfc\xee\xa8\x5c\xe7\x99\x37\x3f\x65\xa9\x9b\x47\x46\x64\x05\x41\xe3\xee\x3f\xef\x3d\x29\xf0\x8a\xbb\x30\x43\xbb\xcf\xee\xaa\x02\x6e\x7a\x3d\x25\x39\x3f\xfd\x87\x3e\xa1\x94\x2c\xf1\x6c\xfb\xc0\xa1\x32\x0e\xab\x0f\x04\xd5\x8a\xc3\x26\xf1\x62\xdb\x68\xe5\xb4\x10\xb8\xf0\x19\xb5\x3e\x28\x1b\xe8\x1c\xee\xed\x5a\xdd\xde\x91\x48\xb3\xf3\xed\xf6\x8c\x50\xd2\x18\x63\x9b\x2c\x56\x7b\x4a\x10\xa3\x99\x3f\xdb\x86\xa3\xb6\x9d\x36\x3e\xb1\xdc\x78\x0e\x66\x3b\x12\x59\x00\x28\x8f\xea\xc5\xab\x81\xc0\x38\x78\x39\xcd\xa5\x15\x6d\xd0\x82\xd3\xc0\x7d\x5a\xdf\x9f\xce\xc7\x9e\x96\xb4\x33\x11\x8e\xcb\x4c\xc4\xbb\xad\x78\x66\xbc\x24\x87\x74\xc3\x15\x9e\x96\xeb\xea\x8e\xc2\x3b\xa2\x1b\x4c\xde\x8e\xe8\xf9\xb4\xb9\xf8\x44\xb5\xba\x0d\xd6\x39\x83\xc7\xc2\x47\x12\x6e\xd7\x1e\xea\xe5\xbc\x9a\x14\xd9\xe4\x9a\x7b\x46\x5f\x9b\x98\x62\x0c\x91\xb6\x06\xa7\x9e\x74\xed\x54\x17\xfb\x1e\x11\x47\x1a\xe3\x8b\x16\x6f\x6e\x8c\x6f\x4c\x59\x24\x5d\x3e\x61\x06\x56\x3c\xcf\x3f\x42\xa3\x24\x61\x30\xf8\xcb\x18\x5d\x19\x42\x0f\xe6\xe4\xed\x61\x8f\x0f\x81\x1f\x28\xbc\x77\x05\xb1\x47\x83\x8e\xaf\xa6\xe1\xb8\x8c\xea\x0a\x59\x1d\x0e\xf8\x81\xe0\x98\x8d\x26\xb7\x86\x14\x68\xa2\xb7\x40\xf8\xa3\x22\xb8\xaf\x0d\xc1\x29\xf3\x0f\x7f\xa7\xed\xba\xd6\xaa\xac\xb4\x4a\xfb\x7e\xde\xcf\x9f\xfb\xdf\xdf\x8f\x97\x7b\x75\x3e\xfa\x4f\x08\xcc\x31\xdc\xa8\x63\x82\x24\xf5\x37\x46\x96\x2d\xfd\xf3\xec\x57\x3e\x54\xed\xfe\x8c\xde\x97\x02\x64\x56\x77\xa1\x7d\xf8\x04\xf1\xcf\xb0\x3f\x87\xab\x3b\x60\xdd\xb9\x1d\xde\x7c\x78\x71\x82\x7c\xd7\x57\x59\x45\xe9\x1c\x8c\x60\x14\xb4\xa6\xaa\x33\xfb\x8c\x79\xca\x58\xae\x41\xf0\x4b\xa0\x0d\xd5\xa9\x17\x91\xfa\xf4\xe3\x5e\xb8\x52\x31\xf9\xad\xd2\x29\xf8\x99\x8e\x4b\x7c\x46\xc5\x16\x23\x42\x5d\x0b\x52\x66\x4e\xda\x28\x1a\xa0\xfc\x19\x3d\xeb\x20\xcd\xef\x4f\x58\x61\x1c\xa7\xc1\x67\x8b\x46\x5f\x5f\x60\xe2\x1b\xa7\xd2\xb1\xae\xde\x2e\x30\x70\xe3\x71\x18\x28\x1d\xf0\x1a\x79\x0e\x1e\xf8\xfe\xa0\x71\x63\xc8\x75\x4d\xa6\x42\x6a\x4c\x39\xca\xb3\x72\xe2\x05\x84\x72\x55\x21\xf4\x92\x6c\x6a\x81\x90\xc5\x0d\x74\xcc\x0f\x78\x8f\xf4\x8a\x7e\xfe\x87\xad\x03\x31\x8a\x9a\x7b\x8e\x7e\x2c\xba\x9d\xe3\xec\x6a\x07\x41\x95\x95\x75\x97\xd6\x8b\x90\xc5\xf5\x52\x97\xe2\x67\x7e\x3a\x41\x44\x61\x4e\xc5\x3a\x4a\x6d\xec\xb6\xae\x8d\x1a\x70\x45\x08\x29\xe4\x20\xd7\x0c\x94\xb5\xff\xb9\xc1\x0b\x21\xf3\x8f\x22\x39\x7b\x96\x22\x8f\x9e\x08\xce\x76\x5b\xa0\x03\xaf\xe5\x79\x66\x43\x52\xd4\xbe\xd4\x51\x95\x9e\x25\xa0\x91\x6c\x16\x0d\x5a\x2f\xa3\xff\xb9\xf1\x94\x7a\x99\x1a\x31\x78\xa4\x3a\x07\xe8\x2a\x82\x6b\xf9\x92\xb5\xc9\x38\x68\xb1\xb4\x8e\xce\x84\xd9\x84\x14\xce\xc3\xfa\x5e\x1a\x37\x20\xe4\x16\x8a\xe5\x32\x27\x79\x4f\x64\x4d\x6f\xe7\x87\x07\x1f\xb9\xd9\xc8\xc4\xe2\x7c\x89\xca\x22\x2a\x7f\xde\xc1\xcd\xb5\x71\x59\xb3\x95\x38\x57\xad\x9d\x37\xed\xd9\xff\xbc\x7e\x9b\x22\xc7\xf6\xf7\x93\x2c\x4d\x7c\x0e\xd8\x79\xb2\x3f\xf9\x98\x5c\xb6\x13\xea\x7e\x6e\x3e\x5f\x65\x91\x94\x62\x44\x1a\x28\xac\x05\xa6\x16\x0d\xa0\xcb\x61\xa3\x44\xab\x0b\x04\xfd\x35\x30\x87\xfc\x3b\xb2\x36\xc7\x65\xfd\x91\x6e\xc1\x03\xe0\x6d\x80\x0e\x78\xe9\xc7\x0e\xac\xc6\xa1\xd8\x4d\xef\x02\xc1\x39\x63\xa4\x5f\xca\x13\x70\x8b\x6f\xee\x1f\xb7\xe9\x03\x49\x03\xa4\xd8\x7e\x8b\x9f\x37\x3a\x86\xdd\x78\x4c\xf6\x7b\x27\xe7\x76\x90\x05\xb2\x96\xf0\x75\x05\xc6\xff\xc7\xd2\x55\x2b\x6b\xce\xec\xc0\x07\x72\x60\xa6\xf0\x33\x33\x73\x66\x66\x66\x3f\xfd\xad\xb3\xff\x0d\x9c\xec\x56\x7d\xc7\x63\x49\xad\x6e\x8d\x46\xa3\x6d\x30\x41\x81\x95\x55\xff\xba\xa9\x21\xca\x3a\x16\x98\x72\x50\xa5\xd6\x22\x3a\xde\x6e\xef\x7c\x88\x81\x5a\x65\x66\xed\x6f\x09\x2d\x66\x72\x39\x6a\xbc\x7a\x87\x81\x28\x49\xbf\xfe\xa0\x84\x8d\x7a\x1e\xb3\x00\x58\xd7\x75\xd1\xe5\x11\xc2\x85\xed\x8b\x4c\x17\x63\x16\x87\x85\xcb\x96\x52\xe2\xe9\x7c\x75\xce\xcd\x6a\x5d\x94\x57\xf8\x26\xa8\x71\x45\x17\xca\x0d\x7a\x59\x80\xdd\x8d\x79\xeb\x6e\x3e\x98\xcd\x33\xbf\x48\x20\x0b\x88\x28\x34\x98\xa0\x73\x4b\xea\xbe\x18\x2e\x42\x18\xa3\x8a\x7e\xa7\x87\x9a\x97\xf5\x75\xf2\x25\xd8\x7d\x43\xc5\x51\x55\xbe\xf9\x79\x7a\x2c\x3e\xfb\x26\xaf\x03\x10\x3f\x54\xb5\x72\x62\x85\x1a\x5f\x8e\x18\x9d\x8c\x59\xcc\xfa\xf7\xe5\xff\x3d\xed\xbc\xaa\xeb\x1a\x77\xbf\x98\xae\x22\x78\x95\x45\x4e\x6d\x0d\x68\x6a\xc3\x22\x0b\x07\x53\xed\xee\x92\x04\xa2\x81\x2e\x82\x28\xc2\xa0\xd2\xf2\xe6\x5e\xe1\x9d\x55\x29\xa3\x01\x0f\x05\xb5\x83\x8f\x38\xe4\x1d\xc9\xa1\x4e\x98\x2a\xf8\x57\x2a\x19\xf9\x5f\x2d\x66\x3f\x2e\x6f\x81\x08\xba\x60\x1b\x9b\x1c\xf1\x33\x03\x69\xfa\xb8\xfe\xd8\x31\x59\xf5\x3f\x64\xbc\x91\xb4\x4b\x17\x7e\x3e\xcf\xc8\x1d\x97\x3d\xd3\x1e\x88\x70\x64\x2c\x1e\x87\xc5\x8c\xa4\xd6\x11\x50\x25\x86\x5c\x63\xb6\x92\x3f\xa2\xa1\xfd\xa5\xdc\xbf\x27\x0b\xe1\x23\x0b\xa1\xa3\xa6\x84\x5a\x67\x1a\xb8\x88\xfe\xfe\x21\xf8\xfb\x4f\x5d\x59\x2c\x0d\x8f\x37\x21\x9e\xdc\x55\xa9\xa0\x28\xf3\xd2\xc9\x06\x84\x23\x13\xef\x56\x6e\x55\x00\x38\xd7\xed\xd5\x1b\xd9\x81\x0a\x49\x9c\xa1\xb1\x80\x21\x50\x76\x79\xc6\xf8\xcd\x35\x6b\xac\x74\x49\x56\xb9\x80\x2c\xff\x88\x8d\x5b\xcf\x58\xbd\x57\x9d\x1e\x42\xea\x11\x03\xc3\x52\x86\x58\x5e\x9a\x48\x56\xec\x23\x3f\x4f\x08\x79\xa0\x1b\x09\x50\x60\xa2\xb7\xf6\xd1\x90\xfd\x13\xef\xd1\xb7\x93\x66\x2a\x39\x41\x18\xf4\x89\x28\x94\x42\xb1\x26\xde\xb5\x1d\xc0\x9a\x7a\xd3\x87\xd3\x5d\x3f\x03\x5c\x3d\x2b\x7e\x06\x1a\xb5\xc3\x18\xb2\xc2\xa8\x8b\x80\x65\xa4\x84\xdc\xc8\x48\x05\xad\x9e\x0c\xba\x30\x5f\xc1\xdf\x52\xff\x9c\x08\x5c\x40\x25\xd1\xb4\x24\x13\xa7\x2a\x6c\xb8\xa7\x02\x9a\xfc\x22\xdd\xa0\x02\x49\x3c\xa1\xdd\xd9\x4f\x61\x73\xfd\x57\x1f\xa9\x40\x70\x82\xef\x45\xbc\xa2\x6d\xd1\x27\x0f\x4f\xc9\x3c\x10\x9c\x3e\x7c\x0d\xc5\x18\xd8\xdf\xb1\x49\x28\x0d\x54\xce\x6a\x19\x23\x27\x75\x00\x00\x80\x0a\x2e\xc8\xf5\x9d\x5b\xd9\xba\x67\x05\x00\x96\xd7\x5f\xcc\xf6\x41\xe0\x4f\x38\x60\xad\x2d\xc7\xd6\xbb\x16\x3b\x4d\x88\x98\x79\xd8\x50\xd6\x32\xf8\x5b\x4b\x81\x53\xb4\x0d\x28\xc7\xb1\x78\x7a\xf0\x0d\x0e\xa4\xfb\xf5\x8b\xec\xb7\x3e\x66\x4d\x15\x9b\xc4\x9f\x85\x5f\x6d\x91\x29\x53\x81\x0c\x0e\x1a\xb8\xa5\xe0\xb6\x6a\x7a\x20\xf3\x6d\x32\x0e\x9a\x82\xbd\x4a\x10\x08\xd4\xd8\x3a\xfa\x7f\x31\xbc\x76\x6c\xcd\xc4\x04\x3c\x17\x3c\xd1\xab\xd4\x67\x7c\x7f\xc2\x2f\xba\x20\x82\xe1\x73\xc9\x78\xff\xb4\x16\x31\x79\x38\x4a\x1e\x4f\xb4\xc1\x64\x93\x6b\x5d\x8a\x0c\x4b\x10\x71\xff\x6a\x1d\xe2\xf9\x3a\xb6\xaa\xd8\x9d\x83\x61\xf3\x3a\xb7\xe1\x5f\x6e\x7d\xf3\x0f\xc3\x97\xe3\xa2\x4d\xc1\x11\xee\x9a\x4f\xe9\x74\x27\x8a\xf4\x08\xe1\x21\x11\x83\xcd\xde\x7b\x68\x5d\xd3\x75\x25\x0e\xb1\x73\x68\x0d\xc0\x90\x1f\xff\xfb\x11\x52\x8a\x2c\x2b\x3f\x0f\xfc\xbb\xeb\x29\xcd\xd7\x43\x10\x14\x11\x4a\x0e\x1f\xf3\xbc\xcf\x4e\x1a\x5b\x43\x23\x73\xf6\xd9\x88\x71\x92\xf1\xee\xea\x87\x66\xc3\x0c\xcf\x39\x67\xe3\xd7\x3d\x76\x09\x18\x2c\xb1\x76\x60\x57\x35\x2b\xcb\xb5\xbc\xf0\x32\x6c\x47\x6e\x45\xf8\xc1\xb1\x79\xb5\x61\xf5\xbb\xec\xa8\x0a\x7a\x90\x25\x78\x91\xc0\xb5\x11\xff\x36\xf2\x0a\x34\x41\x96\xc3\x5a\x8c\x2e\x46\x46\x39\xab\xa1\xe6\xcc\xfa\x45\x7e\x4b\x30\xd9\x71\xd7\x9f\x89\x73\x5b\x75\x09\xf1\x98\x1a\x4a\x1b\x2b\x2d\x87\xfa\x1c\xd3\x67\xe7\x6d\x5b\x17\x39\x83\x95\xaa\x29\xe1\x9a\x9b\x80\x8f\x2d\x3e\xb5\x97\xbe\xd2\x71\x5e\x55\x77\x58\x08\x80\x88\x34\x98\x1e\xf5\x65\x9c\x73\x08\x6a\xfb\x90\x6d\x27\xfd\x33\xbe\x24\xcc\x5c\xae\xa5\xfd\x7e\xb4\xa4\x8f\x3e\x33\xf1\xe6\x1d\x70\x43\xc8\xf4\x5a\xa4\xdd\x49\x31\x53\x13\x5a\xf2\x40\xc8\x42\x85\x05\x3e\x03\x1c\xba\x3c\x23\x4f\xff\x74\x78\x37\xec\xc4\x21\x9a\x82\xa0\xca\xdc\x1a\xf2\x25\xdc\x16\xcb\x9a\x3e\xb8\x08\x83\x7e\x06\xc7\xe5\xcc\x32\x7a\x7c\x9d\x44\x0a\x6e\x20\x78\xff\xe2\xf4\x76\xfe\x04\x47\x1b\x66\x85\x8e\xa7\xe6\xd9\x13\xe7\xb2\xd6\x3e\x63\x07\x41\x14\x2c\x4d\x59\x3a\xac\x8a\x17\x27\x94\x8e\xfe\x77\x42\xab\xaf\x71\x73\xd5\x51\xe0\x38\x2c\x69\x54\x33\x9b\x31\x7e\xe1\x16\xa0\x87\x1d\x0d\xb9\xd7\x51\xde\x0f\x3e\x2e\x01\x03\x15\x79\x70\x41\xaf\xb9\x0a\x81\x59\xfa\x37\xc9\xaf\x0b\x21\xa3\x0b\x25\x9b\x31\xfc\x41\x3e\x58\xbb\x9c\xfc\x17\x3b\x78\x07\xec\x9b\x7a\xff\xe9\xcb\x3d\x46\x0c\xee\xff\x3d\xdb\x6a\x18\x33\x51\x23\x8f\x4a\xeb\xae\x1e\x5f\x0e\xae\xef\x0f\x8a\x1b\x18\x5e\x8f\xab\xb2\xec\xb4\x22\x06\x58\xdd\x5d\x7a\xee\x9f\x4e\x52\xe3\x21\x07\x2a\x6f\xe1\x89\x16\xcc\xb9\x26\x77\xf9\x5a\x16\xfb\xb4\x56\x88\x5f\x68\x0e\x5b\xe8\x5f\x81\x70\xff\x3b\x32\x5d\x5c\xe8\x1d\x4b\x0c\xa1\x1f\xfd\xf0\xb3\x66\xd0\xbd\xfe\xec\xd9\x3e\xf9\x65\x2c\x2d\xb6\xc8\xab\xda\xb6\x85\x35\xcd\xbf\xae\xe0\x56\x3a\xa3\x01\xe0\x5c\x14\xa9\x8b\xbc\x13\xb5\x26\xb1\x73\xae\x3f\xbd\x4b\x96\x16\x53\x87\xc3\x9c\xd6\x14\xff\xd3\xaa\xf8\xe2\x7f\x2d\x0b\x09\x19\xf4\x5f\x9d\x54\x75\xde\xf0\xdf\xfc\x8a\xb1\x27\x06\x5f\x9d\x03\xd6\xae\x68\x01\x84\xf4\xb1\xc7\x29\xb0\x7a\x49\x9a\x4e\x14\x79\x11\x2d\xa9\x9b\xa1\xca\xa2\x49\xfd\xfb\xbd\x9d\x4f\x37\x3b\x7d\x4e\xde\x72\xef\x1a\x0b\xfb\xce\xad\x4b\x8c\xed\x84\x81\x22\xf8\xcf\x1f\xc8\x05\x41\x10\x10\xca\x51\x69\xf5\xbf\xde\x05\x94\x5c\x50\x68\x26\xc6\x85\xf8\xd7\xd7\x4b\xf7\xcd\xa5\x11\x74\x78\xe8\xe9\x71\xf6\x6e\x62\x41\xeb\x75\x8e\x53\x02\x15\xa3\xff\x8c\x3a\xd3\xfe\x2d\xf3\x10\x40\x0e\xec\xfe\xb8\xf3\xc6\x25\xa4\x21\x76\x0e\xa1\x87\x89\x2f\x30\x75\x66\xfb\xac\xed\x30\xac\xdd\x2b\xe9\xcf\x9f\xf1\xb2\xd5\xdc\x69\xc3\x28\x96\x8f\x8d\x3a\x61\x7c\xc1\xf1\x87\x1f\xc9\x3d\x87\xad\xf5\x7e\x9d\xeb\x22\xf7\xab\x7f\x3c\x17\x33\xf5\xa9\xbe\x07\x49\x03\x78\x51\x4c\x19\xb2\x40\x40\xf5\x97\xa5\xc4\x66\x4e\xc7\x65\x4d\x43\xf6\x09\x5d\xe8\xd4\xf8\xc1\x17\xc0\xb8\xca\x2f\x42\x0b\x8f\x6c\xdd\x75\xce\xd1\xc3\x42\xa8\x14\x47\x55\x7c\xb6\xe1\x9d\xd8\xaf\x5f\x23\x0a\xe0\x5c\x63\xeb\xfe\x1e\x67\x6a\x13\x1e\xdd\xe5\x9d\xd5\x4c\xde\x90\x69\xe2\x50\xf8\x7b\xce\x6c\xc4\xc7\x64\x4c\xd6\x74\x5c\xc6\xa4\x4d\x02\x9b\x5f\x80\x76\x46\x8c\x2e\xd6\x25\x0e\x4f\xd7\x45\xe6\x39\x06\x22\x52\x83\x1f\x91\xc8\x99\xcd\xe6\x35\xbd\xb7\x20\x4b\xb4\x5d\xd7\x45\x66\x7f\x3f\x21\xdc\xb3\x8f\xad\x9d\xa9\x0f\x88\x46\x9f\xbc\x99\x19\x6a\xeb\x2f\xa6\x76\xea\xb4\x2a\xa1\x7d\xaa\x1a\xea\xda\xc4\x63\x30\x2c\x57\x99\x12\x77\x73\xcc\xfc\x37\x94\xa3\xf5\x87\x24\x2a\xae\xe8\x40\x9f\xf9\x4e\xd6\xe3\x3e\xf7\xa0\xcd\x07\xc5\xe1\x15\x46\x93\x3a\xa6\xe7\xec\x80\x09\x82\x22\x54\x05\xf7\xb2\xcb\xbb\x61\x20\x79\x0e\x54\x70\x83\x88\xd4\x72\x8d\x2c\xde\x72\x28\x32\x72\x6d\xc8\x3d\xcd\xb0\xf5\x65\xa2\xa8\x33\xd0\xa2\xfc\x1c\x2c\xdb\x66\xed\x60\x48\xc2\x48\x79\xa9\xa3\x7d\x62\x4a\xcc\x76\xb1\x73\xe6\xc5\xf5\x07\xf3\xdb\x49\x59\x65\xeb\x45\x75\xfb\x71\xc1\x07\xa5\x8c\x84\xc7\x0f\xa2\x8c\x06\x22\x17\x09\xf8\xe5\x2d\xa6\xf0\x58\xd3\xa3\x55\xd3\xb0\x99\x45\xee\xc1\x74\x6d\x7e\x18\x5e\xb2\xa6\x0f\x8c\x50\x11\x5a\xd4\x77\xe7\x50\xe2\xc8\x0e\x04\x0f\x06\xc9\x15\x65\xbd\xb1\x2c\x10\xe9\xdf\x3a\x60\x79\xc9\xeb\x9e\x1a\x0e\xfa\xa3\xfa\x35\x87\xa3\xae\x6d\x8f\x18\x9c\xfb\x6b\xc1\x02\xb3\x2c\xf2\xc5\xf3\x43\xe5\xf6\x6b\xa2\x3e\x09\xce\xb9\x03\x25\xf7\xa7\xd7\x87\x33\x70\xa8\x63\x47\xb5\xda\x11\x42\xf0\xc5\xf5\x91\x2c\x4e\x38\xbd\xa2\x0d\xde\x84\xf1\x1b\x34\xaf\xac\x2a\x6f\xe6\x63\x58\x14\xbf\x60\x1d\x84\xdf\xac\xb1\xf3\x6a\xd7\x97\x33\x47\x0d\x59\x12\x67\x69\x10\x79\xa4\xae\x63\x3d\xff\x7e\x9f\x5f\x82\xee\x12\x9f\x67\x53\xfc\xab\x7e\xff\xd6\x65\x56\x18\x5d\xb3\x2c\x10\xd8\x81\xed\x0f\x1b\xc1\x13\x60\xe4\x97\xe1\x85\x0d\x5e\x1f\x07\x9c\xac\x21\xe7\xba\x5a\x03\x36\x75\x70\xf7\xe2\x0a\x3f\x61\x51\xcb\xa9\xfe\x32\xff\xbf\x9e\xa0\x8f\xa2\x4c\x81\x0b\x02\x72\xfc\xcb\xd9\x7f\xbc\x09\x0e\x74\x8d\xf9\x69\xf7\x58\xbc\x60\x45\x02\xad\x16\x81\x38\xee\x14\x77\x77\x66\x07\x99\x22\x54\x12\x25\x7f\xfe\x34\x42\x61\xa6\x8f\x9c\x78\xbc\xab\x41\x33\x3f\x85\xf1\xf8\x5b\x2f\xc5\x91\x63\x6e\xfa\x4c\xc5\xc6\x3e\x2c\x14\x22\x0a\xbd\x61\xb5\x7f\x67\xf7\x91\xa2\x4b\x0b\x6f\xa9\xce\x24\xc4\xcf\x6d\x9f\x8d\x81\x90\xa3\xef\xd4\x25\xee\x21\x7e\x29\x02\xd9\x0c\x1f\x88\xce\xb9\xd8\xd2\x00\x3b\xd1\x40\x02\x54\x69\x48\xb4\x05\x14\xe6\x14\xaa\x5d\x0c\x07\x55\x01\xa6\xc8\xb1\x9a\x1e\xfd\xe8\x1e\xff\x8c\x5f\xb2\x0e\xa8\x36\x87\xec\xfc\xc7\x93\xa2\x3f\x5d\x24\xf8\x57\xe0\x46\x03\x76\x4e\xf9\xed\xb2\x3f\xe7\xca\x10\x6a\x10\xa1\x82\x77\xd4\xda\x0d\xbc\xe3\xc3\xfb\x7b\x52\x88\x22\x34\x90\x63\x49\x6d\xa9\xdb\xd9\x50\x15\x70\xe2\x8c\x36\x48\xfd\x76\x59\xe4\x1e\x62\x47\x45\xd3\x9d\x16\xcc\x54\x16\xf8\xc7\xab\xf6\x3c\x09\x2a\xda\xeb\x53\x1f\x92\xc5\x59\x55\x1f\x96\xe7\xab\xaf\x16\x59\x08\xcf\xba\xc8\x55\x1b\x8a\xa2\x54\x00\x54\x9d\x10\x5f\xff\xea\xa4\xb4\xe5\xc1\x8b\x21\xac\x95\x35\x21\x3d\x91\x3b\x33\x28\xad\xd9\xbc\xc0\xf0\x86\xab\xbc\xdd\x0a\xe3\x16\xc3\xba\xb2\xee\x1b\x5f\x8c\xb7\xca\xf6\x4b\x5f\x2d\x46\xca\x39\xe9\x88\x6b\x2c\x14\xb2\x03\x57\xcf\x77\x69\x71\x75\x30\xee\xf1\x3e\xd4\x3b\x64\x9e\x13\x54\x61\x89\xe0\xf8\x01\x74\x81\x19\x62\xb4\x35\xf1\xb5\xea\x2c\xa8\x0b\x8a\xa2\xe8\x14\x29\xdc\x5b\x6c\x69\xb9\x97\xa6\x94\xce\xb1\xff\xf9\xb0\x91\xae\xd0\x58\xa1\x18\xe5\x5a\x33\x22\xc7\x35\x6b\x85\xbd\x6a\x4c\x03\x9e\x9f\xd1\xfc\x9b\x3f\xe3\xcb\x2d\xf6\x3e\x36\xda\x77\x89\xfb\xb0\x3c\x8a\x52\x05\x16\x0d\xe0\xe2\x2f\x6f\xf8\xab\x9b\x9c\x56\x87\x11\xe7\xba\x8e\x35\x84\x2c\xf5\x13\x53\x9c\x98\xff\xe9\x0a\xa5\xff\x12\x88\xc8\xbe\xb5\x21\x87\xdc\xa9\x75\x81\x00\xab\x1a\x31\x4f\x6d\x25\xaa\x65\x08\xfd\x1e\x37\xbb\xc9\x4e\x10\x8a\x34\xb7\x1e\x55\x9e\x3f\xf4\x69\x50\xa8\xa3\x95\xf9\x60\x03\x6d\xd8\x5c\x22\x0c\xe1\x43\x0b\x6b\xe0\x30\x16\x61\x79\xcd\x9f\xea\x14\xa6\xd7\x93\xea\xf0\x1d\xb6\x44\xac\xee\x44\x96\x52\xf3\xaf\x3f\x8b\x0e\x02\xcb\x11\x1c\x3f\xac\xdb\x21\x87\xd4\x8a\xa4\x69\x1a\x64\x4e\x40\x5a\x08\x77\x44\x33\x5c\xf6\x7b\xbf\xf5\xc7\xa4\x57\xba\xae\x26\xff\xc2\xdf\x24\xf6\xb6\xaf\xf1\x85\x2e\x78\x22\x2f\x55\xde\x65\x3d\xf5\xcd\x7b\x86\xc4\xd2\x6d\x05\xae\x3f\xcc\xca\xce\x45\x9d\x4b\x3c\x82\x68\x73\xf0\x72\xae\xe7\xea\x95\x29\xaf\x68\xa1\x99\x53\x90\x49\xfb\xa7\x17\x69\xe4\x11\x21\x42\xb0\x0e\x56\x4a\x0d\x0a\x29\x7e\x20\x3a\x35\x96\x9b\x1a\x17\x7c\xde\x6f\xa8\x3a\x5b\xe2\x74\xab\xae\x01\x69\x26\x8c\x6e\xbd\xff\x78\x48\x49\x96\x97\x77\x9c\x28\xd7\xe9\x5b\x65\x3d\x94\x37\x80\xe0\xf4\x98\xde\x4c\xae\x3a\xd6\xee\x1b\xfb\xdc\x72\xfb\x65\xa1\x73\x4a\xf7\xcb\xcb\xd4\xc5\x35\x36\xcc\x7c\x8e\xef\xf0\xaa\x50\xe0\xa6\xd7\xe3\xb3\x39\x4a\x37\x20\x88\xdd\x83\x1f\x04\x7b\x69\x43\x12\x06\xa2\xd3\x27\x23\xcf\xb7\x7d\xd4\xad\x30\x3b\x6c\x29\x70\x22\x52\x8f\x4a\xb6\xfd\xbb\x39\xd1\x62\x29\xb6\xf7\xdf\xab\x7b\x30\xd4\x9f\xfb\x15\xb3\x5b\x2a\xa8\x41\x0d\x5b\xc1\x9a\xb0\x21\x83\xb1\xff\xd2\xb8\xc7\xa6\x13\x31\x34\x71\xa8\x3e\xd9\x01\x11\x81\xf0\x6c\xa3\x40\x47\x0f\x92\xca\xad\xc3\xca\x0e\xa1\xb6\xef\xbe\x29\xae\xe3\xdd\x9b\xfa\x1e\x10\x3f\x08\x9e\xff\xea\x61\x4b\xa1\xc6\xf7\x27\xfd\x42\x78\xcf\x84\xcd\x88\x6f\xd6\x71\x59\x3b\x11\x9d\xa9\x5e\xf2\x89\x4e\xc3\x60\x4c\x96\x34\x18\x62\xbe\xab\xef\xd8\xf0\x2f\xbc\x75\xf9\x41\xf9\x95\x39\x2c\xfc\x16\x21\x9b\x8d\xfc\x07\xc9\x6d\x1f\x7e\xf9\x29\x88\x88\x50\xa8\xaa\xe0\x1e\x7e\x99\x5b\xca\x3c\x9a\x41\x20\x14\x51\x43\x0a\x1d\x59\xa2\x87\x85\x99\xd4\x31\x65\x36\x74\xc8\xec\x39\x23\x01\x00\xc1\xfd\x9e\xb9\xd0\xc4\x31\xcd\x3d\xf3\x1c\x43\xaf\x93\xfc\x26\xe6\x64\xb4\x60\x05\xd2\xa1\x01\xe9\x36\xa3\xe8\xed\xac\x4d\x2a\x24\xaa\x8f\x68\x7d\x70\x38\x0c\xc9\x1a\xd6\xff\xca\x1a\xc2\x53\x46\x5d\xe3\xda\xb5\xc8\xc7\x9e\x28\x4a\x32\x5f\x23\x0e\x4c\xb5\xbd\x73\x13\xc5\xaf\x83\xd8\x65\x4c\xc6\x2c\x48\xcf\x86\xf2\xfa\xd2\xab\x31\xf5\x37\x95\xae\x77\x34\xfb\xd0\xa3\xdb\x83\xfb\xaf\xe2\x01\xbf\xe9\x00\xe8\xd4\xfc\x01\x0b\x56\x2d\x6e\x31\xc5\x88\xb2\xf1\x25\xc9\x01\x87\x2a\x35\x28\x8b\x45\x6c\xbf\x8d\x32\x25\xad\xb4\x3a\xb1\xa7\x78\x84\x57\x74\xac\x60\xdd\x2d\x40\x15\xc1\x4f\x3e\xb1\xb3\xd5\xf3\x75\xaf\xb6\xd8\xc9\xf4\x55\x8a\xac\x34\x5b\xe4\x4e\xab\x17\xf5\xeb\x09\x82\x7a\xb9\x59\x4d\x81\x63\x7d\x85\x43\x13\xbb\xe2\xd6\x4f\x35\x25\x1d\x5b\x18\x78\xb3\x2b\x34\xb1\xa8\x4d\xa4\x26\x37\xb6\x8a\x92\x0f\xfe\xc3\x48\x88\x3c\xe0\x2c\xc0\x83\x03\x8e\x15\x5e\x3e\x46\x24\x0b\x0e\xce\xbe\x75\x2c\x15\x83\xa1\x28\xbf\x78\x8f\xd2\x28\x2a\xdc\xc7\xdd\x96\x17\xb7\xe7\xb1\xb3\x42\x8d\xf4\x5d\x56\x68\x02\x06\x84\xa8\x9a\xe7\x16\x98\x38\xbc\x50\xa6\xb9\x85\xe6\x8c\xe9\x9b\x1a\xac\xd4\x9c\x45\x43\x8e\x8d\x20\x61\x92\x26\xb6\x39\x2e\xa3\x0f\x48\xba\x3d\xd7\x51\x7a\x1e\x7f\x31\x33\xa1\x53\xc2\x29\x49\x0a\x4a\xc6\x9f\x8f\xc6\x92\x1c\x8a\xab\x68\x22\x65\x46\x03\x60\x19\x1f\xc8\x04\x82\x17\x12\x10\x14\x84\x66\x34\xf1\x3b\x01\xee\x01\x24\xa9\x7b\xce\x21\x80\xa4\x24\x5b\xd3\x76\x06\x2c\xcb\xb2\x3e\x91\xbb\x40\x14\x19\x51\xb9\xba\x50\x05\x5a\x13\x35\xf6\xc4\xe4\xcd\x3b\x19\xfb\xd7\x6b\xfb\xf0\x9d\x73\x36\xb2\xc4\x20\x1c\xdc\x2e\x0c\x88\x81\x12\x46\x1f\x93\x7a\x65\x27\x5a\x5d\xe5\x15\x5d\x5c\x53\xc3\x1e\x14\x05\x6d\x1a\x8e\x78\x05\xd2\x00\x1c\x56\xaa\xf4\xc3\xa4\x80\xef\x6d\x46\x8b\xb8\x71\xd0\x9d\x83\x63\xb5\xa6\x26\x4e\x89\x73\xa9\x10\x5c\x9f\x12\xac\xce\x91\x93\x7f\xfc\x3c\xf5\x9f\xd2\x7f\xe3\xcf\xad\xaf\x09\x25\xd5\x2d\x87\x7a\x5c\x75\x6d\xed\xb2\xbe\x9d\x38\x03\x82\x2e\xd3\x46\x49\x18\xac\xe1\xe7\x2e\xd8\xe0\xf1\xc7\x42\x50\x6a\x88\x5d\xd0\x3d\xf3\x6b\x12\xc2\x50\xac\xeb\xbf\x3d\x43\xbd\x7c\xe3\x5a\x56\xd9\x23\x3b\x50\x8b\xaf\x5b\x16\x59\x10\x1e\xc9\x82\x0d\x86\x4f\x34\x5b\x20\xa2\xc8\xb7\x0a\x85\x41\xdc\xf6\x6e\xca\xb2\x48\x82\x20\x28\xe4\xf0\xfc\x4b\x6d\x67\x76\x8b\xec\x4f\xff\x4c\x43\xe8\x65\x7e\x42\x6e\x48\x86\x84\x0f\xc7\xe5\x56\xf8\xcd\x95\x35\xdd\x72\x77\x79\x33\x64\x9b\x5d\x3f\x2c\x69\xd8\x60\x54\xf9\x63\x4d\x93\x9b\x89\x63\x9c\xd3\x3f\x5a\x53\x4b\x3a\x5b\xa1\x34\xa8\x8b\x95\x80\x69\x0d\x49\xbf\xc4\x88\xaf\x2a\x57\x53\xe6\x19\x65\x3b\x71\xca\xec\xec\xe2\x01\x84\x53\x58\xa1\x1b\x3f\xb3\xa3\xf2\x4d\x8d\x26\x18\x89\x2d\x09\x23\xa2\x2b\xfc\x39\x4c\xa0\xbe\x9f\xec\xe4\x6d\xef\xd4\x99\x08\x5e\x04\x5d\x5e\x7f\x58\x0b\xd2\x45\x10\x15\xdc\x5c\x44\xbf\xb8\xe5\xe7\xe9\x0f\x4e\xb5\xe1\xa5\x2d\x17\xda\x33\x33\x52\xfa\x11\x23\x29\xfa\x84\x33\x9f\x05\x20\x23\x6a\x57\xcf\xf9\xc9\x8c\xc0\xec\xdc\x5c\x76\x52\x12\xf1\xf1\x26\xaa\xed\xbc\xfd\xfa\x94\xad\x65\xfd\xd9\x2e\xf2\x0f\x7d\x5e\x4a\x62\xbe\x05\x26\x80\x8a\xda\x7a\x64\xa2\x28\xa9\x7e\x97\xc7\x54\xee\xf5\x89\x2c\x78\xf8\x5b\x54\x1f\x6a\xc2\x2c\x39\xcb\xcf\x66\xb1\xb2\x73\x66\x18\x21\x8b\x13\xad\xfb\x28\x44\xb0\x36\x0e\xdb\xbf\x37\xb4\x57\x73\x54\xc6\x84\x73\x66\xc8\x15\xaa\x97\x60\xde\xbb\xf8\x74\xfd\xc6\x1b\xc4\x3c\x91\x9f\xcb\x7a\xe2\x3f\x56\xa0\x3b\xb2\xc2\xc8\x62\x0d\x4a\xff\x3a\x6b\x80\x69\xfe\xf4\xcf\x00\x2b\x94\x8e\x02\xba\x40\x36\x39\x95\x1d\x55\x83\x68\xc9\xb9\x1f\x03\x2d\xa2\x10\x2f\xaf\x61\xae\xf9\x5f\x57\xe7\x03\xee\xf9\x8f\xc9\xca\xc2\x3a\x63\x8a\x18\x0e\x75\x92\xd6\x7f\x2c\x7a\xa9\xc5\x9e\xab\x73\xf2\xcc\x8c\x11\x6f\x50\x67\x72\xa0\xc3\xd2\x84\x07\xcf\xaf\x88\xce\x02\xd8\xef\xc1\x70\x58\x52\xae\x81\x88\x80\x41\x5f\xa2\x66\x19\x5b\x63\x5e\xdc\xdf\x74\x41\xac\x16\x68\xf5\xff\x5e\xad\x28\x30\x74\xf1\xd8\x20\x08\xe0\x6a\xc2\x81\x92\x93\x21\xdc\xf4\xae\x39\x0f\x53\x7a\x81\xd4\x38\x22\x2c\xba\x11\xf5\x84\x42\x1f\x88\xa9\x5b\x46\x72\xa0\x2a\x58\x69\x6c\xae\xf7\xa1\xff\xf3\x59\xa1\xf9\x1e\xfa\xca\x42\xd4\x92\xe7\xd6\xb7\x59\x3a\x80\x8b\xc8\x81\xf4\x1b\x5f\x4f\xaf\x45\x1c\x57\x27\x4a\xcb\x3e\x4d\x87\x0f\x84\x38\x5e\xb7\x37\xcb\x90\xba\xfd\xcd\x06\xe7\xf8\x40\x4a\xfd\xbd\x83\x03\x8b\x32\xf3\xb3\x25\x42\x22\xdd\xa3\x35\xcc\xc9\x43\x4c\xf7\xd6\x16\x88\xa8\xb4\xef\xfb\x80\x0d\x2f\x2e\x07\xc9\xbd\xe3\x4f\xc3\x8f\x92\x36\xf3\x0e\xef\x84\x63\x03\x6e\x5b\x19\x8f\xc9\xc8\x0c\x1d\x35\x82\x7c\x5e\xad\xea\xbb\x0f\x62\x2c\xcc\xa0\x37\x43\xbe\xf1\x25\xf5\xa9\x38\x3f\x35\xa2\x88\x75\x5d\x7b\x46\xea\xe4\x74\xde\x08\x1a\xa4\x4a\x59\x30\x39\xe5\xc5\xf9\x56\x47\x56\x64\x59\x73\x91\xf2\xfe\xde\x3b\xd8\x0d\x0d\xdd\x60\xa2\x89\x66\xbd\xa1\x90\x4c\xa9\x91\xfb\xd9\x7c\x57\x48\x42\x78\x4f\x0d\x91\x12\x36\x3b\x3f\xbf\x23\x34\x8d\x90\xc5\x08\x1f\x9a\x1e\xaa\x92\xea\x20\x91\x14\xdc\xec\xe6\x54\x80\x94\xf9\x06\xe2\x51\x69\x7f\xdb\x04\x07\xe6\x80\x2f\x63\x59\x59\x13\x66\x5d\xa5\x77\x97\x1b\x2c\x6e\x43\x5f\xf7\xf5\x92\x14\x45\x14\xc2\x99\xdc\xde\xc8\x34\xc1\x44\xe3\x8f\xf1\x1b\xbf\x7a\xa3\x74\xe0\xca\x78\xb1\xc4\xf4\x90\xca\x30\xef\x15\x68\x2e\xb4\x6b\x23\x0b\xf3\x73\xc0\xa5\xc4\x1e\x79\x3c\xd9\xe4\xe4\x2c\x0b\xec\xc7\xc0\x26\x44\x94\x16\xdb\x93\x07\x02\xa1\xe7\x20\x28\x16\x43\x5e\xe4\x81\x90\x98\xdc\x0e\x4b\x18\x56\xd6\x04\x43\xf2\xfc\x01\x3f\x6d\x16\x5d\xe8\xd0\xee\x90\x39\x27\x57\xb7\x59\x06\xa3\xcb\xda\x35\x4b\x69\x21\xce\xed\x24\x53\x80\xd0\x53\x7d\x50\x09\x3f\x1f\x7b\x6c\x52\xd0\xe1\x47\xc6\x9b\x84\x41\xaa\xc0\x61\xca\x59\x47\x24\x0d\xec\x57\x70\xfc\x69\xe9\x2e\xed\x39\x1d\xe1\x7e\x3f\x33\x34\x89\xe9\x42\xc9\x59\x0c\xb2\xd8\x64\xeb\xce\x21\x88\xcf\x93\x9e\x19\xca\x76\x52\x7d\x6f\x85\x6f\x6c\x2c\xe3\x9f\x3d\x5d\xf5\x6c\x51\x8d\x77\x5d\x36\xf5\xd6\x31\x92\x84\x29\xd0\xd8\x52\xec\xab\x70\xac\xac\x26\x1c\xf4\x09\xea\x69\x77\x0f\xa7\x00\x95\xc9\x4a\xff\xf7\x67\xc6\xe7\x3a\x21\xa0\xaa\x96\xb9\x3c\x67\x66\xad\x4d\xc5\x91\x95\x64\x0f\x64\x12\xa8\x26\x7c\xd4\xe3\x26\x07\x32\xfd\xce\xbf\xf4\x14\xee\x73\xe9\x6b\x8a\x9b\xab\x8f\x06\x8b\xa8\x3a\x5d\xbe\xf9\xd9\xf7\xb3\x2e\xd5\x85\xe2\x2a\x3b\xa1\xb3\x66\xf7\x0a\xeb\x16\xe6\xf4\xe3\x82\x3d\x45\x0e\x32\xe9\xbf\x84\x5e\x7d\x8b\xae\x57\x73\x60\x94\x3f\x1c\x7e\xe2\x3d\x1a\x45\x19\x2a\x0f\x8d\x77\xb7\x0a\x03\xac\x46\xbe\xdc\x9c\x92\x4e\x70\x78\xf1\x92\x18\xec\xbc\x57\x5c\x5f\xe8\xd1\x1f\x59\x98\x8d\xed\x79\x67\x76\x65\x2f\x65\xab\x48\x34\x26\xe3\x02\xf9\x98\xaa\x40\x44\xfe\xb3\x90\x6c\x59\xd3\xf1\x8f\x7b\xdc\xdb\x07\xaa\xef\xbc\xea\xec\x6e\x79\x3d\x6e\x6e\x19\x6e\xfc\x7d\x4b\x2e\xcc\x74\xce\xe9\xdf\xc7\x64\xd9\xa3\xdd\x37\x95\x2e\xf7\xd0\x36\x46\x29\x50\x17\x58\x33\xa6\x0f\x4d\x28\xc4\x76\x39\x6e\xb3\x7f\x75\xcb\x46\xda\x00\xb1\x5f\xa8\x7f\x3a\x69\xce\x2b\xdb\x1d\x2e\xa1\x5c\x7f\xdc\x76\x1e\x11\x2d\xaf\xa8\xe0\x3a\xd8\xc3\x12\x64\xfe\x57\x0b\x69\x22\xc4\x44\x3c\xe2\x27\xba\x61\x14\xf9\x25\x4d\x8c\x58\x1d\x51\xf6\x0b\xf8\x27\x8e\xd2\x1e\x2f\x60\xa5\x89\x4b\xa9\xaa\x40\xca\xe8\xda\xa7\xbc\x84\x1a\x01\xbc\x1e\x35\xc8\x72\x59\xed\x3e\x17\x3d\x67\xee\x2d\x65\x78\xc0\x8d\x28\x3b\xae\x47\x0b\xb4\xb0\xfe\x8d\x1b\xb2\xda\xb4\x29\x15\x47\x53\x20\x22\x50\xf4\x03\x0b\x05\xf6\x29\x56\x79\xc6\x19\xe6\x5d\x97\x33\x9b\x70\xe0\xcf\xd5\xe5\x96\xbe\x32\x94\xa9\xfc\x31\x9d\xff\x24\xec\xca\x71\x26\xbb\x6f\x2c\x6e\x75\x6b\x7b\xbd\x14\xa8\x3f\x11\x3d\xfd\x6a\xdb\x84\x21\xa9\xd2\x51\xe3\xce\x2b\x8e\x79\xf3\x49\xf5\x8b\x7b\x5b\x58\xbd\xb4\xa4\xbb\xf0\xb2\x4a\xc0\x40\xe5\xc6\x73\xc4\x9d\x14\x1d\x28\xad\xdc\x88\xd3\x5a\x97\xb8\xc1\x39\x35\x37\xee\x6b\xb2\x2c\xe8\xfa\xf7\x93\x7f\x8c\xe2\xf0\x82\xaf\xc8\x0c\xae\x48\x28\x41\x73\x22\xd6\xac\x03\x49\x03\xb8\xe1\xf1\x37\x8f\x42\x3d\x5d\x82\x59\x7e\x88\xa3\xa7\x1c\x49\x56\xa4\xac\xdb\xce\xab\x7a\x1b\xca\x67\x32\xef\x59\x00\x60\x49\xba\x1d\x4c\x5b\x3d\x63\x17\x5c\x7d\xc7\xe6\x10\x7d\x66\xf6\xe5\x09\x5f\x01\xad\x3a\x7e\xc5\xa5\x37\xb5\x4e\xf8\x39\x5b\x7b\x0c\x4e\x8d\xf6\x61\x68\xbf\xa9\xa7\x86\xda\xe6\x00\xe9\xa6\xc4\x1d\x52\x4f\x6d\x52\xee\xdc\xb3\xb6\xf9\x10\x27\x2f\x31\x2a\xb8\xf1\x95\xc6\xed\x67\x35\x39\x38\xeb\x83\x40\x45\xe3\xef\xb6\x0f\x5e\xb2\xa8\x51\x3f\x5e\x21\x31\x28\xe7\xec\x8f\xbb\x98\x68\xb6\xff\x38\xe8\x80\x8f\x2c\x74\x30\x2c\x87\x12\x9b\xf4\xac\xc8\xfc\x85\x33\xd3\x9c\xfc\xbf\xfd\xf4\x5f\xf3\x93\x9b\x3f\xbf\x40\x96\x84\xd3\x90\xe1\xd4\xea\x18\x64\x43\xbe\x91\x6d\xb9\xf1\xb5\xa2\x1d\x1c\xcf\xa7\xcd\xce\xb9\xc1\x5b\x25\x2c\x8f\x70\xef\xd0\xfd\x8e\xe9\xa3\x50\x83\xa9\x2c\x4d\xe9\x89\xfc\x35\xab\x0f\x8e\x76\xb5\xf7\x6b\x28\xce\x65\x9e\xfa\xa9\x4b\x20\x2e\x8c\x1d\x4b\xfb\xd0\xfa\x83\x92\x74\xaf\xfc\xd9\xe9\x96\x12\xa7\xb7\x4f\x0c\x63\xab\x4c\xc1\xa9\xe6\x0d\xf0\xb3\xc0\x2f\x48\xb1\x25\x04\x5e\xe4\x73\xc5\x61\x15\x05\x40\x1e\xfb\x73\x21\x4d\x29\x45\x48\x4b\x03\x45\x39\xa4\x7f\x7c\x89\xe6\x6c\x9f\x45\xb4\x39\x2b\xb1\x53\x6b\x7f\x4b\xe1\xbe\x3b\x69\xc0\xe4\x20\x12\x05\x40\x97\x5e\x4f\x98\x34\xab\xf3\xb6\xa3\x4f\xde\xe2\x64\x80\xe0\x7b\xa7\xd6\x62\xb4\xf9\x25\x3e\xb8\x3d\x54\x69\x49\x22\x4b\x93\x0f\x39\x50\x80\xa7\xcc\x9d\x2f\xdb\x87\xc4\x35\xf6\xc1\x4a\x4a\x1a\xce\xeb\xaf\xeb\x5c\x73\x4b\x76\xa2\x7a\x35\x0d\x7c\xa9\x49\xbe\x21\xb6\xaf\x65\xf5\xd7\x1d\x65\x08\x7d\xc4\x12\xac\xdf\xaf\x01\x10\x4b\xea\x16\x88\x2c\x08\x4c\xf4\x88\x1e\xcf\xdc\x32\x7a\xf7\x45\xfd\xac\x9b\xd2\xd8\x47\xa7\xb3\xe4\x8b\x6e\x0d\x25\x69\x4a\xc2\x21\xcf\x81\x0a\x61\x3d\xee\xc3\x6f\xa8\xa0\x8d\x8b\xb5\x1e\xf1\x33\x4b\x41\x9d\xee\x5e\x4e\xfe\xcb\x71\xf6\x4f\x40\xc8\xe3\x5d\xdc\xd1\x90\x05\xb7\x5f\x54\x5b\x3c\x44\xe6\x36\x71\xa0\x9b\x43\xe1\xc8\x54\xb4\xea\xae\xe8\x42\xe3\x33\x99\xff\xec\x69\x4b\x17\xa7\x8a\x1a\x4a\x1e\x68\xad\xad\x47\xb8\x39\xaa\xe2\x98\xb3\x1b\x1d\x7a\xaf\xb8\x41\xb3\x9c\x65\x39\x2d\xf8\x91\xee\x5b\xc8\x6d\xee\xd1\x77\x3f\xa1\x9f\x7e\x18\x6a\x6d\x30\x41\xfa\x7b\x8d\x93\x1e\x6c\xb8\xfc\xea\x8d\xda\xd0\x56\x11\xeb\xe4\x67\xa4\xff\xf8\x5f\x8c\x15\x26\x9c\x69\xfa\xe2\x46\x03\x66\x29\xd9\x46\x00\xd7\x19\x0d\x78\x38\xe3\x7b\xff\xab\x6f\xf5\x4e\xf8\x78\xe0\x95\x0c\x5e\x17\x08\xc9\x98\x11\xd3\xed\x82\xf5\x14\x08\xf0\x25\x81\xea\xf9\x51\x54\x43\xf0\xfb\xbe\x8f\x5e\xfb\xe0\xd6\x60\xa3\x8a\xe2\x27\xce\x5d\xd7\x73\xb7\xe0\x44\x0d\x09\x2e\xb7\x74\xee\xf7\xa0\xa5\x62\x2a\x37\x29\x56\x53\x12\x4e\x4b\x31\x8a\x97\x5f\x81\x06\x41\x3b\x04\xc2\x09\xa6\x2a\x66\xce\x44\xd2\xbf\x98\xc5\x52\x1e\xab\x76\x3e\xfd\xd3\xa5\x0e\x26\x16\xd1\x7f\x1e\x51\x7e\x96\xfd\x1a\xbf\x0f\x04\x51\x49\xe0\x58\x5f\xb4\x9d\x78\x0f\xe3\xf4\x2d\xfd\xd4\x10\xd5\x4c\x21\x00\xe9\x01\xfe\xcd\xd6\xe2\xf8\xb2\x7b\xa9\x53\x1b\x92\xb7\x3d\xb3\x31\x39\x38\xd4\x61\x53\x0f\xf2\xee\x06\xf4\xf3\x59\xd0\xd7\x10\x0f\x5a\xb0\xda\xe0\x58\x33\x32\x68\xcd\xc2\xf5\x9f\x1e\x69\x53\xe7\xcb\x27\x65\x4d\xd5\xd6\x5e\xf6\x57\xfc\x39\xff\xe6\x80\xd2\xca\x1a\xa8\x70\xb1\x62\xe1\xf4\xdb\xc5\xca\x92\xfa\x8f\x22\x86\xa2\xaa\xd2\x48\x74\x13\x6b\x4d\xc4\x60\xa8\x3d\x5d\xce\x51\xeb\x4b\x28\xa0\x2c\x2a\x36\x0e\xc9\xe2\x7c\xf2\x47\x45\x0d\xb4\x10\xed\x57\x9c\x4f\xf4\xfb\xc3\xa8\x60\x36\xe9\x2b\x83\xdf\x89\x32\x33\x03\x01\x7f\x49\xa5\x96\x56\x74\xac\x59\x30\xd6\xf5\x73\x53\x25\xc3\x76\xdd\x2b\xb7\xf2\xb2\x66\x23\x19\xdc\xe9\x8a\xac\x8b\xbc\xaa\x6c\xff\xc0\xd7\x2a\x70\x0d\xb2\x81\x03\x12\xdb\x0a\x1b\x8f\x75\x4b\xdf\xb8\xa3\xab\x5e\xc5\x3d\x18\x54\xe8\x8d\x4f\x6b\x2e\x75\x49\xdd\x43\x6f\xfe\x1d\x2f\xac\xac\xd4\x4a\x8f\x96\xe8\x41\x08\x4d\x1c\x1d\xd6\xc9\x5c\x17\x88\x88\x61\xdc\xa3\xfa\x67\x90\x54\xa9\x0a\xfd\x00\x9f\xa8\xd6\x32\x65\xb4\x4c\xff\x6a\x4c\x8a\x08\x65\xc5\x8e\xe4\xd6\x15\xac\x70\x9e\x35\xac\xb5\xfc\x11\xd4\x91\xc5\xac\x80\x5d\x82\xf4\xc6\xf9\x3f\x5b\x7a\x06\x36\x72\x73\x95\x5d\x28\x00\x1a\xb5\x7f\xcb\x56\x43\x59\x67\x22\xa5\x22\x7c\x4c\x89\xea\xce\x0b\xf2\xdd\xdf\x6f\x0f\x31\x7c\xc3\xf7\x37\x79\x27\x80\x21\x4e\xcd\x9e\x7d\x8b\x06\xfe\xe3\x1f\x1c\x43\x5d\xea\xdb\x31\x42\x51\xa1\x14\x68\x69\x2f\x75\x2c\x44\xfe\x73\x98\xa1\x85\xb3\x66\x13\x2d\xf6\xe7\xb1\x6b\x60\x4e\x03\x73\xfe\xfe\x68\x31\x83\x56\x0c\x06\xf2\x68\x76\x3c\xf6\xbe\xc1\xb6\xaa\xbb\x3b\xc7\xb4\xbc\x4c\x8c\xcf\xee\xf1\x98\xc9\x74\x9f\x09\xf9\x5a\x17\x1e\xd2\xcf\x44\x16\xc5\x18\x65\x85\xd1\xbd\xfe\x2b\x2e\x74\x6c\x8c\x9f\x6c\x76\x97\x76\xe7\x3e\x10\x69\x5f\x0e\x1d\x96\x07\x17\x2c\x51\x9a\x2d\x76\x71\x9a\xfc\x12\xe7\xb6\x00\xa0\xd5\x38\x2c\x51\x9c\x99\xf8\xf0\x35\xf4\x6f\x64\xa6\xeb\xc3\x16\x63\xe1\x10\x5d\x3e\x49\x87\x74\x35\x6e\x56\x28\x0c\xc3\xc0\x2a\x91\x6f\x3b\xb7\xf2\xaa\xf6\x33\x32\x82\x5a\x14\xa1\x74\x90\x6d\x80\xfc\x84\xaa\x5e\xad\x92\x93\xb2\xfe\xa0\xf8\x0b\xb2\xa6\xe3\xf2\xab\x4c\x50\xfe\x35\x04\x0d\x10\x05\xb1\x6a\x2b\xf3\x6f\x9d\x78\xd1\x4e\x7d\xe0\x25\x10\x31\xe4\xd7\x90\xf6\x90\x48\xa6\x7d\xaf\x2d\x39\x99\x1e\xf6\x4c\x09\x32\x6d\x71\x77\x2a\x36\x2b\xa4\xcc\x1c\x53\xbb\x8c\x1c\x8f\x72\xeb\x74\x21\x76\x66\xc3\x52\x5e\x09\x44\x1c\xf6\xf2\xfd\x5e\xc1\x63\xe3\xca\x8a\x16\x7f\x7e\xcb\xe0\x3e\xad\x67\x31\x86\x4f\x6a\xb0\x32\xd5\x82\x4b\xd0\x84\xf8\x12\xb7\x40\xed\x6f\xf7\x87\x0b\xb2\xc4\x31\xb5\xad\x5e\xe8\xa2\x89\x0f\x95\xe7\x55\x85\xbb\xd9\x9b\x09\x38\x85\xa4\x7f\xf0\x4c\xd1\xf8\x9b\x4b\xfc\xcd\x7a\x55\x75\xa2\x49\xb0\xed\x41\x79\x45\xb3\x88\x8c\x72\x29\x35\x68\x42\xe9\x0e\xda\xbc\x8b\xbf\x8d\xd2\x4d\x9b\x0b\x5c\x06\x41\x38\x78\xce\x08\x2d\xb8\x1c\x73\xbf\x6c\x74\x1f\x88\x2c\xd6\x2f\xdc\x78\x9e\x63\xe2\xac\xf9\xf3\x9f\x18\x25\xf8\xbf\x5c\xf4\x60\x54\x05\x63\xb6\xc5\xcd\x59\x03\x11\x85\xf5\xbe\xa9\xe3\x2d\x18\x15\x26\xd7\xf5\x96\x00\x08\xc2\x38\x41\x8c\x64\x71\xce\xe2\x16\xb0\xd0\xa1\x65\xc6\xf8\x25\x32\x9c\xdf\x35\x6f\x8f\xcd\x4c\x98\x99\x31\xc2\x00\x50\x19\x53\x8e\xca\x8f\x79\xe7\x4e\x9b\x47\x03\x0e\x24\xfe\x16\x20\x4a\x17\x03\x3e\x52\xbc\xaa\xf7\xfe\xeb\xbd\xf5\x07\x6b\x22\xff\x34\x7b\x5c\x50\x26\x92\x15\xa7\x4f\x82\xda\xb6\x91\x00\x8b\x50\x95\xf8\x9c\xf3\x7d\xf5\x77\xc0\xd6\xb3\xaa\xff\xc4\x96\xff\xd9\x83\xf2\x16\x5b\xe9\x0b\x5c\xf9\x2e\x8a\xd1\x5d\xcb\x1f\xde\xc0\xc6\x96\x70\x4e\x1e\x37\x4d\xbe\x87\x32\xe7\xb8\xad\xed\xa6\xf9\xea\xab\x69\x38\x2c\xe1\x4c\xf1\x49\xa5\x75\xf1\xa9\xf5\x10\xd2\x73\xbf\x5e\xf1\x4b\x40\x09\xcf\x08\x00\xab\xa3\x96\x85\x7f\x78\xcd\x50\xdc\x6d\x36\xa4\x41\x01\x91\x3c\x28\x85\xa1\x7b\x3e\x52\x4c\x09\xf4\x67\xdf\x5c\x72\x90\x40\x31\xb5\x04\xa1\x8f\xbf\x0f\x6e\x08\x39\x62\xca\xbf\x7a\xac\xe4\x41\x9c\xdf\x01\xa5\xb1\x85\x97\xf7\xbb\x05\x36\xa1\x7b\x50\xf7\x0d\xd5\xbe\xad\x38\x12\x1c\x11\x00\xb8\xcb\xb6\xe3\x9c\x1d\x49\x0d\x7a\x86\x14\x5c\x05\x4e\xd3\xb2\x3e\xa6\x4a\xfb\x22\xf4\x34\x6c\x66\x7e\x1a\xd3\x31\xfd\x97\x28\xa1\x70\x65\x28\x3d\x75\x30\x49\x9c\xd7\x66\xa2\x0d\xc5\xf0\xe2\xbb\x6f\xb2\xc8\x3d\xd8\x0e\x90\x07\x5c\x4c\x09\x40\x97\x6b\x50\x70\xd6\xa1\xc9\xc0\x8b\x94\x4f\x73\x63\x29\x13\xd7\xb6\xc8\x0c\x49\x18\xf4\x33\xca\xcb\x97\xf0\x67\xc3\x81\x80\xf2\xb9\x5b\x39\xe6\x06\xc2\x91\xf2\xc7\xe1\x9f\xcf\x3c\x5e\x84\xd0\x57\x06\x04\xa3\x8c\x59\xcc\xcd\xba\x66\xc1\x3d\xab\x9d\x5a\x3b\x92\xe9\xd2\x0c\x04\x9c\x0d\x19\x59\x38\xac\x90\x86\x8d\x61\xc0\xa8\xd5\x87\x53\x70\xfa\x24\xd8\x1d\xe0\x65\x34\xc7\xee\x4c\xf0\x16\x2b\x47\xac\xe2\x9f\xe1\x2b\xa1\x13\x42\x77\x71\x66\x8c\xaf\xe2\xe5\xe8\xf1\x40\xda\x27\xaa\x0f\xa0\x9b\x12\x07\x9d\xa1\xa6\xf3\x58\x07\xff\x9b\x77\xe2\xf6\x8b\x66\x6b\x95\xf5\x51\x70\x79\x46\x6a\x0f\x77\xb4\x76\x20\x64\xfe\x50\x54\x55\x5e\xd1\xd1\xd5\x73\xf6\x52\xa0\x25\x75\xce\x3e\x05\xa8\x22\xd8\x0c\xe7\x1f\xe1\x15\x51\x40\x65\x1d\xd0\x88\x66\xc7\x8b\xf1\x9e\x11\xa0\x9a\x32\xb4\xa0\x52\xfc\xa1\x83\x5d\x8d\x8a\xa3\xaa\x23\xf5\xfd\x08\x7b\x58\x52\x71\x4f\x17\x68\x1b\xbe\x04\x40\xf6\x64\x6f\x5b\x25\x89\x7c\xc4\x68\x65\xd5\x69\xce\x82\x75\x61\xc1\x76\x98\xe2\x04\xcb\xcd\xf5\x14\xfd\xfd\xd5\xb1\x51\x9e\x91\xd3\x27\x62\x11\x05\xb0\xbf\xe5\x42\xdd\xb2\x39\xd7\xe6\xf7\xae\xb1\x2e\x9e\x9f\x8e\x5f\x48\xea\xbb\xe0\x36\x51\xfd\x6b\x2c\x68\x4f\xf2\x25\x8d\xfe\x33\xbf\x9d\x64\x65\x05\x21\x8d\xb6\x99\xe2\x6b\xa0\xa2\xf6\xcd\xa2\x5a\xfa\xb3\x53\xfa\x68\x23\x6e\xba\xba\xf4\x51\xa5\xe0\x03\x8a\xde\xfd\xbf\x77\x91\xa4\x31\x2a\x54\x32\xce\x43\x18\x5b\x66\x96\x18\x39\x96\xb4\x18\x56\x4a\x17\x1d\x19\xaa\x7e\x51\xf4\xd0\x55\x16\x2d\x9d\xb8\x7b\xd2\x8f\x98\xfe\xf8\xe9\xac\x39\xbc\x7c\x49\xfd\x9b\x98\x44\xdf\x17\x9a\x7a\x4e\xff\xea\x5c\x06\xf8\x2d\x10\x51\xc2\x39\xb0\x59\xdd\x9f\x38\x61\xa8\x6a\x82\x33\x6d\x11\x96\x24\xac\xa2\x15\x0e\xaf\xf1\x53\x86\x27\x8f\x24\x92\x3e\xa1\x7f\xe7\x90\xef\x58\x97\x0e\xe4\x4b\xf4\xc9\x1a\x3f\x85\xb5\x7b\x21\x23\xed\x7f\xf3\x82\xa6\xc9\x28\xc1\xea\x82\x4a\x7a\x1a\xed\x17\xc8\x55\x81\x85\x57\x12\x9a\xa9\xca\xa2\xe6\xd0\xaf\xd0\x71\x44\xc0\x7e\x1b\x2e\x46\xb7\xbe\xf6\x89\xcf\xc1\xc5\x4b\xb1\x89\x43\xfc\xcc\x26\x87\x88\x6c\x62\x2a\xc9\x9d\x34\xbf\x09\xff\x8a\xe1\x5d\x3c\x0c\x90\x5a\x28\x0c\x31\xb2\x95\x01\xeb\xa1\x9b\xcd\x0f\x04\xe7\xed\xd0\xf6\xdf\x9c\x1b\xae\x76\x50\x31\x6a\xee\xd9\x27\xb4\x4f\xed\x5a\x35\xd0\x06\x44\xd9\x6f\x4d\xe8\x1e\x60\xbe\x17\xc1\xd0\xd4\xb6\x07\xac\x86\xa0\x92\x4e\x4d\x36\x31\xb4\x88\x32\xe7\x07\xea\x28\x76\x24\x31\xc6\x37\xde\xd7\x5e\x1f\x7b\x3c\xbf\xa2\x97\xa4\xc1\xc0\xf9\xf3\x7b\x0e\xbf\x65\xf6\xb7\xd9\x14\x0c\x92\x7f\x3c\x67\x10\xe8\x72\xf4\x16\x64\xf5\x1c\x4a\x7a\x36\xde\xb0\x0b\x4e\x68\x9f\x32\x20\x69\x2d\x47\xcd\xef\x20\x6d\xf4\x2c\xaa\x6c\x0f\x2b\x14\xca\x0a\x00\xcf\xef\xb2\xcb\xb5\x03\x22\x52\xb9\x5f\xa1\x58\x4b\x79\x7d\xb8\x3d\x56\x6f\xa3\x07\x2e\xa2\x28\x68\xcb\x6a\xcf\x09\x15\xb8\x26\x92\x02\x2c\x61\xdd\xd8\xd9\xb2\x03\x06\x2b\xb9\x36\xce\x8f\x2e\x47\xd9\x1b\x26\xfc\xbe\xff\xf3\x3f\x9c\x74\xa8\xce\x57\x9b\xdc\x20\xa8\x32\x53\x5b\x6d\x68\x6f\x9b\x3b\x2f\xaa\x28\xaf\x08\x31\x89\x5d\xf2\x16\xa8\x0f\x1c\xc2\x91\x17\x92\x26\xe8\x9b\xec\xb8\xc3\x49\x10\xf4\x8d\xf9\x5f\x2d\x8e\xc5\x63\x2a\x8e\xa2\x10\x1c\x1a\x0a\xc6\xe0\xba\xe4\x4f\x53\xeb\x1f\xcf\x75\x32\xd1\x77\xb3\x5e\xbb\x82\xe1\x41\x74\x7a\x84\x1f\x9f\xb8\x21\x24\x70\x6e\x3f\x6b\x2d\x26\x5e\x8f\x3d\xf6\xf7\x80\x04\xce\x0d\x54\x5a\x63\x2e\x0d\x31\x8b\x4c\xcb\x50\x51\x47\xad\x43\xb8\x1d\xd6\x4f\x7e\xdd\x8d\x36\xc2\x2b\x3c\xb4\x25\xb2\x7b\x99\x7d\x7a\x73\x9a\x50\x93\xdd\x48\x10\x10\x27\x03\x4e\xe9\xf2\x84\xb3\x1e\x5e\x6e\x24\x08\x84\x20\x60\x92\x64\xae\x59\x6e\x86\x61\x1a\x84\x73\x05\xbf\x9e\x07\xb3\x04\x1c\xa8\x2e\xd2\x9c\xbc\xf3\xbf\x3e\x1b\x75\x16\xec\x84\xcd\x46\xfa\x7a\xf1\xfc\xf0\x7c\xd8\x1c\xf6\x38\x70\xc7\x65\x4d\x6d\x09\xed\x31\x93\xb5\xfb\x44\x6c\xfe\xbb\x03\xe2\xfa\xe8\x2a\x12\x5e\x9e\xea\xf9\xd3\x6a\xb1\xce\x23\x00\x62\x9c\x57\xfd\x07\x0d\x25\x99\x9f\x99\xbe\x86\x91\x9f\x19\xe3\xfd\xa3\x49\xf5\xdb\x69\x8b\x33\x73\xb1\xaa\x40\x42\xc9\x4b\x5a\x74\xfa\x6c\x5d\x7e\xb1\xc9\x4c\x11\xd2\x08\x06\xef\x07\xbc\x83\x58\x27\x63\xb3\xac\x2e\x72\x02\xcb\x02\x15\x9d\xaf\x8c\xbb\xfe\xe1\xd5\x78\x85\x9b\x30\xd2\x65\x17\x66\x5f\xf8\x6f\x1c\xfe\x9a\xae\xeb\xf9\x6f\x10\x73\x19\xdc\xa6\x58\x1f\x65\x77\x97\x19\x22\x34\x71\x78\x6c\xe9\x1f\x36\x22\xc1\x1f\x92\x0e\x49\x38\x2c\x6a\x50\x80\x41\x50\x44\x0f\xe5\xf1\xb3\x39\x4a\x26\xf3\x9a\x36\xcb\x51\x80\x69\x5a\xb2\xcb\x37\xc8\x46\xac\x20\xc9\x3e\x7b\x34\x41\x84\x21\xda\xb2\x6f\xfd\xca\xed\x98\x49\x17\x4a\xbb\x2a\xe3\xb8\x8f\x9e\x09\xed\xfe\xb7\x16\x84\xdc\x9a\x5c\xab\x1d\x19\xca\x64\x63\x03\x05\x59\x11\x64\xbd\x93\xdf\x5b\x91\x6c\x48\xe0\x86\x2b\x62\x61\x0e\xb3\x18\x5b\xdf\x5d\x97\x2f\xf7\x4d\xbd\x4d\xa9\x7b\x67\x81\x80\xbd\x43\xe5\x10\xae\xa1\x00\x4e\x21\x1d\xf8\x48\x11\x38\xb1\x5b\x99\x9c\x5b\xf3\xdb\xb3\x1e\xf6\xef\x1c\x48\xf4\x2a\xe8\x7e\xdb\x8a\x1c\x92\xc7\xd4\x3f\x33\x5b\x0c\x8f\x7f\xcc\xa8\xbb\x67\x36\x31\x03\xb8\x88\x1a\x90\x97\xfc\x74\xf0\xf1\x22\xd0\x7c\x73\xdb\x88\x4f\x2c\x94\xad\x38\x10\x22\x89\xb2\x7a\x06\x26\x0c\x58\x8a\x78\xe2\xb0\x18\x2b\xad\x74\x9e\xec\x71\xc9\x95\x78\xe0\xe5\xfe\x7b\x28\x06\xa3\xcb\xb3\xa1\xae\x71\xe1\x85\xa6\x15\x41\x70\x9a\x24\xe8\x43\xd7\xb3\x9b\x09\x99\xd8\xaf\xbb\xe6\xeb\x65\x4d\xc5\x36\xf5\x56\x27\x06\x05\xc5\x52\x40\x93\x20\xc9\x8f\xf3\x38\x4f\xff\xf6\x7f\xb3\xdd\x3d\x0a\x63\x7f\x72\xbd\xbf\x85\xf9\x54\x5d\xf2\xf4\x74\xb9\xd9\xeb\xda\xf5\x9f\xfe\x98\x90\xe1\xfe\x64\xbb\x3b\x6b\xc2\xee\xe5\xfa\xfc\x92\xfd\x2e\xbe\x34\xd1\x27\x8f\x4e\xab\x35\x8d\x44\x05\xc1\x0e\xcd\x85\xeb\x0c\xdd\xe5\x7a\x5b\x50\x12\xc1\x6f\x34\xce\xc1\x92\x6e\x76\xfa\x48\x8f\x10\xac\x2e\xf4\x06\x10\xe2\x9e\x4d\xae\xc3\x1f\x6c\x76\xd5\xd2\x39\xda\x3d\x6b\xf7\xcd\xfc\x68\x00\xcc\x2b\x0b\xfd\x17\xbb\x5d\x19\x0d\x78\x19\xf5\x78\x4d\x09\xf5\x4e\xff\xbb\x7f\x44\x5d\x02\x75\x09\x18\xdd\xe3\xff\x6b\x0a\xfb\x72\xe4\xd8\xd2\x27\x8f\x06\x8c\xfd\xd5\x72\x2b\xb3\x12\x0d\x56\x4f\x40\x6e\xf0\x11\x87\xe6\x57\x5d\x1f\xd5\xca\xa3\x56\x9c\x53\xe4\x5a\x6d\x30\xbf\xcb\x29\x34\xb6\x3f\x3a\xe9\xde\xfd\xfd\xc4\xac\x85\xc6\xc2\x1a\xbc\x3c\x49\xce\xdc\x47\xea\x49\xaf\xad\xc0\xf8\x41\x1d\x20\x2b\x6b\xdb\xec\x72\x20\xbf\x6c\x9f\xcc\xaf\x4f\xb8\x8f\xf0\x3b\xf2\x24\x4f\x84\x2c\xae\xec\x0b\x7c\x2f\x3a\xe6\xf0\x2c\x83\x41\x71\x7f\x6e\xd1\xf0\xf2\x25\x5a\x16\x48\xda\x34\x3f\x1a\xeb\x8f\x60\x65\x73\x5a\x88\xa4\x69\x6d\xae\xce\xb5\xee\x77\xeb\x5c\x8e\x06\xb8\xdf\xdd\x1c\x43\xe1\xf9\x1a\xdc\x3a\xf7\x2b\x2e\x1b\x68\xdc\xdf\xac\xb0\x5e\x4f\x9d\x91\x96\x79\x6a\xf7\x27\x61\x97\x1e\xa8\xb8\x3e\x19\xff\x5c\x7c\x4d\xf7\xd9\xfc\x7d\xdc\xde\x23\xcc\x75\x6d\xab\xfa\xa5\xd9\x96\x6f\xe7\xf8\x15\x17\x33\x95\xbd\xcf\xb6\x04\x57\xe9\x4c\xec\xa9\x09\xef\xf0\xce\x2c\xd5\xfc\xef\x94\xc2\x2d\x38\x08\x23\xbc\x9c\x93\x5f\x54\xb7\x9f\xd9\xcc\x95\xd7\x21\xdd\xda\x1a\xc1\x79\xf6\xb3\x21\x43\xfc\xca\x4b\xe8\x91\x66\x65\xac\xe9\x23\x55\x02\x37\x14\xdb\xe1\x7a\x48\x15\xb8\x75\xa9\xad\x77\xe9\xef\xd8\x57\x33\xe2\x92\x6b\xd6\xd2\x20\xa2\x30\x98\xfa\x4e\x14\x2b\xaf\x42\xf8\x48\xd6\x35\xa4\x23\x7f\x50\x59\xa2\x34\x54\x99\x61\x4e\x94\x24\x0e\xec\x24\xe2\x53\x84\x24\xe6\xce\x69\xc2\xee\xc5\x1b\x64\x47\x2f\xd9\xef\x03\x45\x8d\xb1\x8b\x08\x61\x48\xcf\x3c\x09\xa1\x12\x2c\x4e\x8a\x89\x31\xb3\x9b\x53\xc7\x9a\x9a\xef\xc1\xce\x01\xbc\xae\x0b\xa4\x11\xb2\x98\x03\x0a\xfb\x5a\xaa\xaa\x15\xfe\x4d\x67\x69\x0d\x8b\x7e\x51\xdb\xed\xcc\x96\x38\x14\x1a\xc7\x0f\x84\x2c\x9b\x55\x51\x4e\x2b\x0b\xa5\x80\xcc\xa8\x6f\xc3\x8a\x2c\xa4\x18\x93\x37\x74\x11\x30\xcc\xa8\xea\x2e\x73\x1d\xc9\x4d\xa7\xe3\x02\xcf\x75\x1b\x9f\xa9\x43\xa1\xce\x4e\x84\xa4\xc1\xea\x43\x1e\x3b\xc1\x80\x2a\x5e\x94\xde\xf2\x60\xba\x88\x38\x71\x5e\xd3\xc0\x1f\xda\x3d\xea\x08\xda\x1c\xe3\x07\x22\xba\xe4\xf3\x5c\xb5\xb9\x4d\x9c\x22\x0a\x13\x6f\x7c\xbf\x71\x34\x97\x3a\xff\xb8\xda\x0a\x36\x06\xcb\xff\x3c\xef\xdc\x56\xce\xe1\x0b\xe9\x20\xc0\x90\x9d\x2b\xae\xac\xa6\x17\x77\x68\x10\x34\x04\x66\xa8\xbc\x05\x7a\x8b\x84\x07\x26\xa6\xbe\xf3\x95\x1c\xf0\x22\x8a\x91\xc5\xcb\x9e\x17\xf7\x17\xda\xe7\x87\x7f\xba\x58\xff\x61\xef\x68\x3f\x21\x69\xd8\x95\xa1\x9e\x6e\x62\x96\x27\x32\xd2\x7a\xca\xdb\x6a\x4b\xf9\x44\x51\xae\x7e\x8d\x99\xdc\xc6\x62\x35\xff\x6b\xe9\xbf\x6f\x97\xe2\xcc\x13\x06\x63\x82\xaa\x06\xdc\x18\x08\x59\x20\xd7\x4c\x0d\xbb\xd0\x9c\x7c\xa2\x23\x49\x8d\x5b\xd3\x38\x22\xb4\xdf\x61\x92\xfc\xe9\x1f\xdf\xfc\x88\xb9\xf2\xc2\x5b\x72\x7f\xf2\x3e\x92\x85\x39\xfc\x56\x56\x96\x4d\xe9\x23\x76\x85\xb8\x00\x91\x73\x49\x6c\x4f\xda\x3d\xdf\xd4\x77\xb5\x2b\xd6\x76\xd9\xcc\xd2\x39\x19\x5e\x7f\x2f\x10\xfd\x9b\xa1\x78\x81\xdf\xdb\xc2\xf5\xfe\x5c\xb7\x52\xb7\x4c\x16\xd2\x47\xf7\x60\x04\x1c\x53\xe1\xba\x6b\xec\xbd\xa4\x81\xe0\x79\x1a\x20\xe1\x2d\x0b\xd2\x73\xaf\xbd\xd4\x25\xbb\x3c\x23\xc9\x7c\x9d\xea\x48\x6a\xa4\x0b\xf9\xae\xeb\xb6\xa2\x3d\x35\x5d\x76\xd7\xfb\xdd\x0e\xaf\x48\x66\xf6\xc1\x2e\x60\x7f\x3c\x26\xe3\x99\x2b\x3b\xd1\xe8\x62\xea\xda\x5f\xb5\xc9\x59\xc5\x1c\x59\x17\x75\x5d\xd7\x3f\xd8\xbf\x80\xa2\xe3\x3b\xbe\xe0\x29\x78\x1e\x47\x2c\x32\x49\xb1\x73\x76\x4b\xf2\x66\xbe\x39\x17\x75\xcf\x52\x04\xbc\x50\x14\x25\x0a\x7d\x4c\x95\x56\xac\x67\xb1\xf7\x5b\x5e\xe6\x5b\x29\xa9\xee\xea\xef\x27\xa0\x01\xfb\x21\x63\x35\x7d\x0b\x12\xe7\x26\x1a\x23\x46\x8d\x34\x29\x1c\x05\x82\xfb\xf3\x1d\xa7\xd7\x9b\xc1\x13\x7b\x94\xcc\xb5\x29\x1f\xdc\x23\xda\x8f\x61\x27\xe2\x76\x58\xca\x68\x78\xf1\x2a\xdb\xbd\x77\x55\x3c\x67\xad\x71\x08\xcf\xef\x98\x1a\xc8\x11\x3f\xe3\x6d\x33\xf9\x98\xe0\x96\xcb\x88\xb6\x03\xd9\x52\xd5\xcd\x48\x98\xa0\xfd\x45\x75\xcf\xa5\x0e\xa5\x04\xa8\x3a\x62\x3c\xe0\x59\x6a\x63\xc4\x80\x8b\x68\x7d\xe8\x13\xcd\x3e\x3e\xc9\x4a\x78\xce\x31\x4f\xc9\x36\xf3\xdd\xd7\x75\x59\x0b\xd1\xbe\xf5\xa5\xd1\x97\xd1\x92\x3a\xc8\x6d\x7d\x7b\x0b\xd6\xef\x47\xb3\x2d\x9d\x9f\xa1\xea\xf5\x8e\xcb\xe7\xc8\xaa\x68\x5c\xc0\xfc\x08\x29\x47\x03\xd8\x48\xbf\xc1\x68\x5a\x51\xff\xf4\x8f\x76\xf9\x68\xf7\x11\xe8\xd0\x9c\x1d\xd9\x1c\x65\xdb\xb4\x9b\xba\x64\x22\x37\x99\x5f\x1e\x89\x4f\xb8\x61\x32\xc3\x0b\xe1\x36\xbc\xcb\xb6\x33\x11\x3b\xfb\xed\x16\x9a\x4b\xe5\xb1\xc2\x28\xf1\x18\xaa\xcc\x70\x4a\x2f\xc4\xf0\xfd\xa4\x3c\x4a\x10\x78\xc2\xce\x6f\x2d\xac\xe9\x8e\x75\x91\xf9\xfd\x51\x8d\x20\x48\x42\x7f\x90\xbd\x07\xee\x81\xca\x1a\x50\xab\xc2\xf4\x34\xe0\xfb\xb6\x0f\xbb\xfe\x55\xa4\x9a\x2c\xbb\x64\x27\xaa\xd1\x5b\x8b\x92\xff\x39\x8a\xcb\xff\xbb\x1e\x2f\x36\x23\xb5\xff\x65\xbe\x8c\xa3\x2a\x6e\xa2\xd5\x59\x48\x01\xcc\x14\x01\xbf\x40\x44\xf1\xcc\x7f\xf4\x4e\x62\x9b\x58\x65\xeb\x21\xe5\x7c\xa9\xa6\xd0\x15\xce\x0e\xe0\x4f\xb8\x16\x20\x38\x3d\xc1\x7e\x01\x78\x64\x92\xe5\x15\x5d\x41\xbf\x62\x78\x79\x39\xb3\x50\x54\x29\xec\xe3\x61\xd0\x64\xa2\xe6\x23\x8d\x6f\x2c\x8e\x16\x5d\x18\x65\x4a\xb5\xed\xca\xad\xbc\xb9\x38\xaa\x67\x43\x7e\x56\xd6\x04\x01\x83\xbf\x9a\xed\xfc\xc7\x2c\xa6\x9a\x92\xc6\x2c\x44\xb2\x60\x0b\x40\x44\x41\x60\x97\x67\x86\xc0\xcd\x51\xfd\x7b\x96\x89\xa6\x8b\xa2\x4a\x01\x1c\x4a\x57\xf7\xd9\x23\xe9\xc5\xf5\xbe\xe7\xf7\x29\x47\x85\x0f\x66\x42\xb6\xa0\xc0\x0d\x4e\x1f\xaa\x92\x1e\x55\xc1\x4d\xad\xd5\xa7\x0f\xa3\x18\x1a\x04\x2d\x49\x1d\xdc\x1d\x37\xbd\x71\x85\xa5\x3a\x60\x7f\x36\x9a\x1d\x77\x6c\x45\x2b\x64\x18\x5e\xf9\xed\xb8\x49\x87\xe7\xb5\xa6\xa3\xd2\x61\x80\xd5\xcd\xf8\xd9\x50\x95\x8f\x1c\x1b\x71\xcb\x3c\x27\x7d\x34\x00\x8b\x62\xb6\xd3\xe3\x97\x60\x7a\x6b\x3b\xec\xbf\xb9\xf3\x47\x8b\xcb\x7f\x3c\x97\x3e\xc7\x40\x9f\x50\xa2\xf8\x41\x8a\xa6\x5f\xd9\x81\xfc\xeb\xa1\xbe\x4d\xe1\xd1\xbd\xdf\x93\xb2\x68\x07\x13\x47\x58\xdf\x54\xe7\xc3\x86\x5d\x4b\x63\x6c\xac\xab\xab\xb6\xf5\x7c\x3b\xb5\xc9\xce\x7c\xa8\x09\xe3\x74\x16\xe6\xd6\xde\x58\x6e\x11\x42\xae\xd9\x05\xd7\x3e\xf1\x05\xcb\x69\xc3\x3b\x41\xbd\xc3\xe7\x15\x6d\xc8\xb1\xa6\xc7\xd2\x8f\x5e\x0e\x19\xe6\x97\x1e\xf6\xe1\x8d\x5a\x70\xa1\x24\x56\x59\xd3\x33\xaf\xf7\xaf\xed\xcb\x0e\x00\xab\x33\x0e\x79\x69\x00\xac\x0e\x5b\x0d\x82\x72\x79\xb3\x5f\x88\xa2\xf3\x58\x87\x2c\xc1\xf3\x8f\x5a\x22\xd2\x5c\x09\xf1\x28\xaa\xaa\xe6\xa5\xd5\xa0\x14\x7f\xb2\x06\x31\xba\x18\xb3\x24\x2c\xcc\x8c\x71\x0e\x2c\xe1\xd0\x6c\xa8\x33\x47\xa2\x38\xe1\xb4\x26\x39\xd4\x48\x5b\xf7\x8f\x10\xdc\xc1\x0d\xc8\x8b\x88\xe5\x40\x61\x7e\x09\x6c\x6a\xa1\xd3\x27\x7c\xbb\x7d\x55\x74\xa2\xd9\x79\x6e\x33\xa9\xe1\x6e\x3c\x29\xc0\x91\x6a\x33\xfa\xc3\x22\xd6\x94\xb8\x91\x55\xa3\x40\x55\x16\x2b\xda\x84\xc9\xeb\xed\xde\xef\xa9\x21\x3f\xc6\x37\x51\xcf\xd8\x9d\x7c\x3c\xda\xae\x2e\xce\xac\x8c\xa0\xcb\x7f\xe7\x9c\xb0\xac\x38\x91\x49\xb9\x73\x2e\x83\xca\x78\x34\x3a\x3b\xed\x65\x86\xd7\x48\xf7\x5a\xc3\x01\x2f\x39\x35\x9f\x6d\x46\x40\x88\x82\x9f\x0e\x68\x54\x7e\xbc\xe9\xf1\xad\xa3\x43\xe2\x0c\xf3\xf2\x85\xde\xf7\x4d\xcf\x88\x03\x3c\x21\x73\x97\x52\x8d\x97\x81\x01\x00\x95\x8d\x0a\x4a\xba\x30\x43\xf4\xaf\x07\x50\x2f\x8e\x6b\x78\xf1\x1c\x2e\xc8\xd5\xec\x71\xa4\xd0\x02\xe4\xa5\x2b\xed\x7d\xf7\x4b\x8b\x3f\x35\xc9\x81\x4c\x9f\x0b\x6b\xc9\x68\x82\x02\xf2\x3b\x8d\xd3\x74\x58\xf2\x71\x44\xa6\x85\x28\x0f\x02\xac\x48\xb0\xbb\xd0\xb7\xf1\xb5\xe6\xc1\xa0\x6c\x00\xe6\x46\x5d\x12\x0c\xac\x60\x12\xcf\x8b\x66\x3f\x3a\x7b\xbc\xff\x6d\x43\x01\x20\x08\x67\x64\x09\x93\xe1\x78\x71\x30\x1d\xc2\x95\x89\xeb\xff\x7a\x2d\xe0\x26\x55\x1c\x43\x81\xde\x10\x79\x42\xed\x99\xbe\x07\x5b\x07\x7f\xed\xa0\x2a\x3b\x51\xf7\x2c\xd2\x90\xcc\x8e\x1a\xc9\xf7\x61\x48\xc2\x7f\xe7\x96\x16\x9f\xef\xc2\xd6\x4e\x42\xfe\x0d\xb1\x4f\xff\x4c\x8f\xee\xd1\xc0\xa6\xfe\xf2\x84\xa3\x05\x26\x79\x92\x23\x58\x5d\x2b\x72\x6d\x0f\x85\x66\xc3\x1b\x69\x03\xf2\x47\x61\x42\x6d\x40\x57\x02\xb8\xc8\x32\x70\x4b\xfe\x5e\x05\x07\xa3\xca\x27\xf5\x52\xbe\x6e\xf9\x7f\xf3\x45\x66\x68\x83\x7b\xb5\xc9\xcd\x2f\xde\xe3\x39\xc4\x40\xe6\xbf\x3d\x25\x78\xa6\x59\x05\xb4\xfe\x62\xe1\x92\xec\xeb\xcf\xfe\x05\x40\x97\x46\x9a\x2a\x72\x5d\xbf\x69\x21\xf7\x3e\xf7\x7b\xc7\xe2\x3b\xa9\x3e\xfd\xc3\x49\x96\x24\xd6\x64\x4c\xfa\x35\x88\xa4\xac\xf3\xeb\x1e\xd9\x9c\x85\xc7\x07\xfd\xd8\x5c\x88\x51\xce\x71\x50\xca\x28\xbe\x67\x84\x2c\x08\x1a\xc1\x38\x8a\x7b\x16\xdf\xad\xac\xe9\xbb\x4b\x30\x63\xf7\x4d\x25\x59\x69\x1f\x48\x26\xde\x04\x02\xf4\x40\x04\xed\xf4\xb3\x5d\x77\x03\x50\xc8\xe4\x3b\x49\x77\xa0\x31\x47\x60\x02\xc1\x11\xc4\x67\x56\x19\x91\x34\xda\xdb\xf8\x38\x31\x5b\x65\xb1\xa4\xdd\xb9\xf5\xa4\xe7\xd8\x43\xd1\x91\xd1\x22\x96\xa9\x81\x6e\xb1\x9a\xfd\xa9\x6c\xdd\x79\xcb\xe5\xd2\x59\x42\x63\x16\x8e\x4e\xa6\x0e\xd0\x6e\xa4\xa4\x05\x6a\x47\x13\x88\x49\xd5\xc7\x31\xe4\xb7\x8c\x05\x12\x5e\xe6\x19\xae\x95\x35\xb2\xb9\xd6\x62\xe7\x9a\x8e\x0b\x63\xfb\x42\x35\xe1\x7f\xa1\x05\x10\xe5\xb4\x84\x64\x71\x1a\xde\x1f\xe2\x57\x27\x0b\xc0\x6e\x04\x03\x60\x61\x0e\x2e\xe3\xe5\xb8\xe5\x21\x74\xe7\xec\xe8\xda\x21\xd4\x4f\x77\x02\x23\x71\x54\x55\xde\x08\xe0\x9a\x91\x70\x26\x0e\xb9\xba\x50\x12\xae\x30\x40\x4d\x8c\x91\xf6\xe0\x6c\x6e\xcd\x6e\x48\xef\xde\xa6\x72\x53\x60\x06\xad\xda\x41\x10\x7c\xb8\x4f\xc9\x5b\xfe\xd7\xbc\xf9\xa4\xf9\xfa\x4e\x88\x2d\xef\xb6\x78\x7e\x46\xfa\x53\xeb\xb5\xcf\xdc\x24\x8d\xd1\xa5\x70\xaf\x83\x8d\xe5\xa6\xc2\x60\x4e\x6a\x88\x5d\xa1\x86\x52\xa0\xc3\x2f\xa3\x28\xfa\x32\xec\x88\xc6\x34\x8f\x0a\x8a\x77\x2e\x30\xed\x9b\x06\xc9\xc2\x29\x09\x07\x82\x20\xa7\xc7\x89\x56\x9c\xa8\xbf\x68\x2e\x6a\xbc\x79\x77\xd3\x56\x58\x59\xd3\x00\x47\x01\x5a\xdd\x18\xcf\x93\xe6\x77\xda\xad\xb6\x1e\x32\x2e\xc8\xf8\xfe\x26\xf3\xfb\xeb\x3a\xf9\xd5\x5f\x76\x8f\xc3\xa0\x88\x0c\x54\x6a\x1d\x51\xd5\x59\xf9\x12\xc6\x8e\x69\xea\x4b\x21\xba\x64\x4d\xc7\x39\x0e\xdc\x95\x73\x17\xc5\xb7\x17\x84\xf9\x19\x5a\x31\xae\x8e\x1f\x0a\x7d\xa6\x97\xe2\x30\x25\x50\xe9\x02\x7d\x3c\x0b\xb2\x9c\xac\x6a\x38\x24\x91\x03\x19\x96\x50\x09\x2f\x9e\xcb\x02\xe1\xec\x19\xff\xac\x9e\x0e\xeb\x2d\xa3\x29\x46\x14\xc2\xdb\x4a\x9e\x8f\x09\x4a\x3e\x6d\x79\xb8\x46\xa4\x6d\xf8\xec\x99\x54\xc3\x05\x02\x56\x28\xf0\x84\xd6\x87\x53\x48\x21\x39\x54\x55\x9c\x68\xd1\x89\x52\x76\xa2\xda\x68\x4a\xde\x89\x70\x18\xfd\x8d\xfe\x87\xa0\x51\x55\xa9\x79\xdd\xfd\xe5\x65\x2e\xff\x80\x7c\x32\xbe\x0a\xdc\x96\xe5\xee\x3d\x65\x7d\x92\xfd\x8d\x61\x23\x0b\x07\x89\x02\x95\x37\xec\xe3\xe1\x90\x88\xb4\x40\x32\x03\xa1\xfc\x60\x05\xbb\x07\xa3\xaf\x2e\x25\xd7\x01\xa6\x01\xd9\xdd\x60\xb4\xf8\xf2\x57\x6f\x7d\x85\x49\xb5\x3a\x7e\x91\x7d\x53\x5c\xfd\x87\xf9\x82\xe3\x3b\xc4\x6d\x76\xaa\xba\x29\xc4\xee\xad\x75\xfb\x3a\x81\xe0\xf8\x8b\xc8\xa9\xa2\x97\xec\x22\x3f\xd0\x47\x9c\x11\xc0\xb5\x2c\x10\x91\x36\x2a\xb7\x3c\x58\xef\xde\x68\x8b\xad\xea\xbc\x6a\x2d\x65\x3c\x16\xe7\xc3\xff\xee\x10\x23\xd0\x58\x4d\x66\x49\x11\x16\xaf\xe7\xf3\x51\x69\x33\x15\xd7\xaa\x88\x71\x64\x8f\x7f\xc2\x15\xa0\x95\xf9\x10\xe2\xf0\xd7\xd4\x64\xd1\xbe\x8f\x6d\xeb\x5c\x8d\xa7\x62\xf7\xb6\x35\xaf\x5e\x35\x01\x1c\x9a\xb3\xd6\x74\xcb\xc8\x7f\xb9\x4f\xbd\x1b\x94\xd3\x65\x1f\x62\x3a\x63\xfa\x0e\xcf\x28\xfc\xe9\xeb\xd9\xd0\xfd\xd3\xd4\x02\xa7\x90\xff\x6a\xf6\xa9\x0b\x84\xfd\x9f\x3f\xa8\xda\x93\xcc\x64\x81\x90\x1c\xfc\x9a\x62\x1c\xa2\x6c\x07\xe9\x09\xba\x3d\xf8\x0c\xb3\x68\x43\x55\x1d\x9a\xc1\xe4\x71\xc3\x8b\xe5\x50\x74\xb9\xda\x76\x1f\xd2\x73\xaa\x36\x6f\x5a\xa4\xfd\xc2\xba\x82\x1a\x28\xb2\xcb\xd7\x9b\x12\xb7\x2c\x35\x79\xe9\x21\x8e\x47\x9a\x42\x8d\xbf\xf6\xbe\xc8\x4f\x78\x25\xe1\x1c\xf1\xc7\xd1\xfd\xab\xfd\x1d\x53\x8c\x28\x4d\x7c\x6a\xb5\x61\x27\x89\xdc\xce\xa7\x26\x93\x64\xe8\x33\x4e\x71\x8c\x77\xe2\x46\x08\xdc\x4c\xd6\xf4\xf5\x48\x69\x09\x93\x34\xa3\x78\xea\x06\x41\x30\xc4\x2a\x2d\x41\x91\x33\x43\xa5\x45\x8c\x29\x31\x89\x29\x56\x5a\x62\x5b\x0f\xde\x4b\x59\x1c\x16\x77\xd3\xee\x34\x3d\x59\xb4\xcf\xf3\x8d\xcd\x99\x5d\xe4\x83\x51\x45\xc8\xf5\x54\x33\xd2\xe8\x0a\xa7\x47\x86\xb8\x3f\x67\x0d\x6c\xd5\x3d\x41\x37\xd5\x9c\x68\x83\xb1\xe5\xe2\xcf\x75\x5d\x67\x3f\x1f\xbf\xfc\x9c\xc2\x8d\xcb\xe0\xab\x55\x7e\x43\x3f\x92\xd5\xd5\xc3\x79\x40\x5b\x0b\xad\x40\x64\x81\x6b\x7e\x80\x1c\xb2\xd7\xe3\x67\x08\xc6\xb2\xe0\x35\x14\xa0\x71\x6c\xdf\x42\x98\xa9\xd5\x87\x77\xf6\x0f\x67\x9c\x70\xfa\x14\x4e\xe3\xbf\x1d\x94\x25\x27\x5a\x6c\x6e\x71\x68\x2e\xd4\xb8\xa3\xcb\x81\x00\x20\x5a\x1b\x93\x39\x6c\x6d\x9c\x40\x55\x1d\x29\xe2\xfe\xf8\x9b\x64\x8f\x90\x2c\x59\x89\xf6\xfe\xf2\x54\xf6\x2e\x33\x5d\x82\xb1\x5d\x21\x24\x73\xe7\xc2\x01\x23\xa2\xa0\x29\x02\x84\xeb\xb5\x83\x9d\x70\xb3\xd3\x03\x08\x56\x7e\xe4\x46\xb0\x63\xf0\x06\x63\xc7\x62\x36\x3e\xd7\x13\x6d\x2d\x76\x9a\xcd\xd8\x46\xcb\xc0\xcb\x03\x8f\x51\xa5\x15\x90\x6d\x2e\xcc\xa1\x1b\x37\x35\x92\x97\x0a\x3f\xcf\x2f\x72\x64\xc1\x91\xe6\xe4\xe5\x9c\x53\x76\xbc\xf4\x13\x8b\x0b\xc4\x69\x38\x0d\x7c\xa9\x74\x43\x82\x53\xe8\xa6\xc8\xd9\xf0\x44\x33\x14\x58\xb5\x76\x78\x72\x39\x6c\x14\x41\xe8\x34\x2e\x6c\x0b\x49\x6a\x86\xf2\xeb\xda\x0e\x23\xfe\x5e\xb1\x3f\xa3\x9f\x6a\x78\x1a\x5d\x5e\x91\x85\x7e\x98\xcd\xbd\x6f\x75\xc1\x84\xf9\xed\x64\x16\x23\xd3\x90\x71\x09\x69\x45\x1b\xfc\x58\x5a\x2e\xa1\x00\x68\x4d\xcd\x8d\x55\xea\x01\x33\x71\xf8\x6b\x5b\x0f\x10\x1d\xc6\xde\xc3\x43\x23\xfd\x71\xb8\x4f\x05\x4f\xae\xf8\xfb\xc5\xc4\x3e\xf7\x74\x35\x7d\x38\x14\x6a\xc2\x3c\xf0\x73\x83\xd1\xe5\xfa\x64\x07\x84\x35\x88\x43\x88\xb1\x77\x20\xa4\xde\x59\x1f\x56\xd1\x53\x87\xd3\xe3\xd5\xd1\x07\x37\xc9\x7c\xdf\xe3\xa6\x37\xf1\x91\x9d\x72\x73\x15\xf7\x9f\xe3\x54\x8b\xa6\xbe\xd4\xf5\xe1\x14\x65\x77\xd1\x80\x97\x9f\xd7\x04\xc7\x3d\x52\x97\xf6\xca\xe8\xfc\x73\xdb\xd2\xfb\x77\xe1\x69\xd5\x64\x12\x65\x43\x99\xd1\x30\x9e\x66\x6c\x19\x42\x01\x85\x49\x56\xb0\x1a\xd0\x82\xdc\x08\x8e\xa2\x09\xb1\xa6\xf9\x7e\xd6\x88\xda\x13\x5e\xe1\xa6\x4f\xe6\x77\x26\xef\xe9\x16\xd6\x74\x7a\x4f\xf9\x9b\x27\x54\x3b\x50\x92\x5a\x03\x15\x65\x50\x68\x75\x94\x3f\xae\x97\xd1\x16\x3a\x3c\xae\xd3\x98\x3a\x26\x18\xfb\x4f\x59\xac\x64\x69\x03\x54\x7c\x7d\xdc\xf4\x7a\xc2\x5c\x16\x0c\xd7\xae\xeb\x1a\xf7\xf5\x43\x9c\xac\xf6\xd3\x8e\x73\x30\x6a\xb4\xda\x0d\x00\xbb\x67\xc6\xd5\x4a\x73\xfb\xe5\x8c\x48\xda\x9c\x44\xf5\x90\xbc\xc5\x9f\xc3\x54\x5e\x17\xc7\x87\xf5\x4e\xf8\x6d\x8d\x36\xd7\x64\x8a\x22\x1f\x4c\xd0\x7f\x19\x87\x36\x67\xb9\xfe\xb3\x7f\xf6\x75\xc7\xba\x9e\x1b\x42\xe2\xb4\xf9\xb8\x02\x7c\x64\x61\x09\x66\x3b\x54\x40\xfa\xb1\x2f\x4c\xdb\xb7\xae\xbb\xfa\x83\xe2\x46\xbc\xfc\xdd\xa5\xf7\x8a\x9a\x2a\xfb\xe2\x29\x5d\xa8\xd6\xf1\xe4\xe5\xff\x6a\x91\xa3\x81\x4a\x39\x80\xc6\xd6\x76\xb2\x44\x14\xf7\x24\xd6\xb5\x5d\xf1\xc8\x22\x05\x0f\xe0\xb1\xea\xe2\xea\xbb\xd2\xde\x7c\xea\xf8\x6f\xc7\x4f\xe0\xf2\x96\x74\x1f\xd5\x08\x0e\x02\x27\x7f\xce\xef\x14\xee\x70\x00\xa3\x59\x75\xfb\x44\x3f\x43\x2d\x54\x1f\xc4\xd8\x52\xe4\xf0\xfc\xc2\xca\x76\xc4\x5e\x93\x6f\xb7\xb8\x1b\xe0\xb7\x86\x1a\x7a\xf0\xda\xb6\x0d\x72\xa2\xd7\x8b\x55\xd5\x55\x14\x8d\x49\xa2\x00\xce\xff\xc8\x5c\x2c\x72\x6e\x9e\xc3\xaa\xed\x8e\xf0\x41\xe6\x67\xa4\x61\x83\xd4\xd4\x54\x85\x76\x78\xc9\xe9\x3f\x82\x99\x8a\xc5\x50\xe6\x37\xdf\xd2\x76\xee\xe5\x46\xac\x3e\x14\x45\x81\x6b\x3b\xe8\xbb\x8c\x7a\xdc\x77\x9f\xd7\x64\x6e\x01\x85\xb0\xc0\x94\x48\xca\x34\x7e\xdc\xb7\x40\x64\x01\x66\x3b\x92\x03\x95\xf5\x96\x6b\xfa\xdb\x35\x16\xac\x4e\xd4\xbe\x86\x59\x01\xaa\x09\x5d\x36\x62\xec\x35\xbf\x77\x5d\x97\xb5\xdd\x96\x8a\x78\x99\xca\xc4\x67\x5b\x92\x39\xf5\x0b\x1f\x7e\xf2\x49\x09\x03\x26\xfa\xfb\xdb\xeb\xb5\x11\xf4\x3a\xf8\x0d\xd4\x55\x1e\x54\x98\xaa\xff\xe8\x1a\x6b\x2f\xe3\x44\x00\x64\x71\x42\x76\xb0\xe0\x63\x12\x06\x8c\xdd\x6b\x73\xf9\x92\xea\xab\x8f\x0a\xa3\xd6\x84\x34\x03\x6c\x86\x0c\xff\xd4\xbb\x29\x7d\x84\x8e\x91\xcd\x4f\xfe\xd5\x0a\xeb\xf1\xaf\xbe\x2b\x8f\x64\xda\xf1\xcf\xad\x76\x44\xe3\x39\x74\xa0\x3d\x9f\x36\x68\x00\x4c\x96\xbd\x20\x7c\xb1\x15\x04\xcf\xf0\x22\xf8\x31\xb4\xe7\xfd\xc9\xf3\xc2\xc2\x1d\x24\x73\x7f\xc2\x71\x48\xc2\xe0\x0e\xa0\xb4\x4b\x53\x9b\x22\x59\x43\x5e\x2a\xed\x8f\xe3\x79\xdc\xcd\xee\xf3\x0f\xe9\xa0\x3f\xde\x76\xa0\x1a\x28\xc9\xdd\x07\x02\x2b\x9f\x7a\x2e\x32\x2c\x6a\x24\x86\x8c\xa4\xae\x47\x9a\x31\x3e\x51\x98\x78\x99\x8d\x72\xdd\xb5\x64\x09\x1f\x19\x02\x54\x7d\xc2\x37\xbd\xdf\xd5\x7e\xab\x37\xb2\x2d\xf4\x23\x24\xdb\x0c\x1f\x39\x7f\xfa\x5d\x3d\x6d\x21\xcb\x4e\xdb\xf0\x0f\x97\xe7\x87\xd5\x75\x0a\x0b\x72\xd5\x75\x25\xf6\x00\x5f\xb7\x03\x7b\xa1\x54\x78\x2a\x14\xdf\xa1\xec\xd4\xaf\x8e\x8a\x63\x5d\xac\x34\xc1\x23\xc2\x61\xc9\x60\x10\x7c\xc8\x53\x5f\x26\xdd\x4d\xf4\x71\x8d\xa4\x31\x1a\x14\xd7\x6e\x1c\xe5\xd9\x33\x71\x73\x04\xa5\x88\x02\xf8\x2f\x68\x7f\x8d\x32\x11\x87\xbb\x18\xcc\x7c\x58\xdc\x97\xc3\x86\xe7\x7f\x14\x65\x69\x82\x14\x6d\x03\xa4\xb9\x14\x8e\x97\xd3\x7c\x46\xd9\x0a\x15\xb9\x15\xc9\x79\x9b\x2a\x6e\xef\xeb\xab\x33\xba\xc9\x2a\xbd\xa1\xed\x56\xa8\x8c\x75\x4d\x1e\x0a\xe3\x32\x28\x92\x8a\x5b\x9c\xfe\xf5\x48\x43\x64\x37\xf4\x6f\xcf\x4a\x73\x11\xe1\x24\x88\x73\xab\xe7\xa4\x6e\xb6\x81\x36\x27\xcf\x91\x8f\xd8\xe5\x5b\xb9\x8b\x11\x23\xdc\x63\x4e\x65\xb1\x53\x99\xe7\x9e\x7f\x4c\x0f\x1e\x5a\x60\x1b\x10\x72\x58\x90\x82\x2b\xb6\x05\x25\x69\x30\x1c\xb6\x74\xf2\x0e\xb4\xba\xa6\x41\xf8\x18\xed\x9c\x36\x98\x58\xf4\xc9\xa3\xd8\xa4\xe0\x36\x1a\x86\x69\x30\x73\xa8\xd5\xae\x7f\x8b\xbc\x8e\xed\x3c\xab\xd8\xb6\x66\x4e\xaa\xd8\x52\x6d\xc6\x8f\xc1\x79\x3d\x05\x0e\x77\xf0\x12\xd1\x85\x76\x8f\xbd\xa1\x42\x9a\x81\x72\x05\x82\x34\x0e\xa0\x47\x16\x3a\xfd\x9f\x1e\xa6\x4e\x04\x45\xdf\xa5\x13\x90\xb3\x4c\xe9\xf2\x04\x2b\xcb\xb2\x50\x36\xba\xc3\xdf\x1e\x6e\xde\xdc\xde\xe1\xab\xbe\xf3\x2a\xb0\xcb\xf6\xe1\xf5\x33\xe3\xbe\xa6\xde\x81\xea\x42\xa7\x22\x5e\x45\x68\x5d\x3f\x87\x77\x84\xba\x39\xaf\x1a\x53\xd9\x69\xf9\x57\x03\xb8\x69\x2b\x43\x14\xfe\x2d\x59\x10\x21\x8b\xb3\xf1\x53\x7f\xac\xcc\x89\x1b\x30\xca\xe4\x42\x5a\x0d\xb6\x9e\x55\x5d\xb5\xdd\xb7\xd6\xa6\xaa\xc9\x27\x26\x47\xd1\x46\xfd\x8f\x33\x83\x9c\x7b\x4d\xde\xe2\x8f\xc1\xb0\xd8\x29\x31\xec\xd7\x20\xbc\x74\x49\x1a\x6c\x73\x53\xad\x23\xd3\x00\x55\xda\x86\x1b\x46\xe0\xf7\x79\x85\xd4\x35\xdf\x82\x90\xd5\x05\x5a\xd3\xc7\x3f\xb2\x1b\x88\x35\xa2\x7b\xc2\xf3\x26\xf2\xf0\xe8\x83\xf9\x87\x6d\x4b\x0f\x54\xe0\xee\x5b\x4a\xeb\x0f\xbc\x13\x65\x46\x17\x23\x68\x76\xa0\x01\xc2\xff\xbe\x94\xb1\xcf\x6c\x87\x9b\xed\xcb\x51\xe3\x8b\x36\x18\xe0\xb2\xa0\x88\xe0\xd7\x90\xba\xde\x11\x9d\x91\x2c\x2f\x66\x98\x2d\x90\xc4\xb0\x1b\xc0\x7e\x9d\x8f\x1a\x30\x00\x56\x1b\x4c\x24\xe9\x85\xc8\xae\xa1\x2a\xee\xbd\x77\x46\x2b\x4c\x0b\x59\xfe\xf9\xf2\x6b\xb6\xf2\xdc\x4c\x7d\x7e\x4d\xea\xe0\xee\x41\x76\x95\x22\xe7\x1c\xf2\x97\xd5\xed\xe0\xaf\x57\xb4\xa1\x2b\x56\x8a\xcd\xac\xa6\x89\x19\x19\x63\x35\x61\x40\x27\x64\x13\x05\xf6\x20\x58\x39\x5e\x0b\x69\xc1\x4c\x98\x9d\xe0\xba\xbb\xcd\x32\x18\x70\xb9\x8b\xbe\x80\x34\x95\xe7\x55\xeb\x80\xff\x7a\xf0\x90\x0d\xe0\x67\xdf\x94\xf8\x6d\xc7\xf2\x52\xe5\xdf\x1e\x2a\x4c\x34\xd6\xc3\xf3\x22\xe8\x13\xd5\x70\xd0\x2c\xa6\x04\x66\xf8\xb4\x22\x80\x69\x6d\x63\xa0\x3c\xa3\xec\x4a\x23\x6e\x95\x34\x48\x55\x5d\x6b\x83\x61\x18\x50\x0e\xaa\xfa\xdd\x2a\x4b\xc3\x0d\xea\xe6\xa9\xda\xcd\x4f\x08\x71\xd2\x42\x94\x5d\x71\xa1\xad\xdb\x69\xc4\xe0\x97\x17\x4a\x14\x0e\x55\x4d\x58\x4c\x0c\x82\xd8\x39\xb3\xf4\xfa\x98\x2e\x72\xb1\x2c\x89\xf6\x27\x0e\xec\xe0\x0d\x7c\x7f\xc8\x4d\xaa\x15\x00\x58\x6e\xc9\xaf\x1f\xa9\xa0\x25\x0b\x00\x8c\x9e\xb1\x09\x86\x23\x73\x3f\x4f\x99\xdd\x46\x75\x86\x5e\x1d\xb5\xbd\x28\x24\x09\x25\x07\x24\x18\x61\x75\xf6\x7f\xac\x66\x8a\x7c\x7c\xfc\xd9\xbd\xed\x36\xcb\xbb\x29\x64\x72\xb0\x2c\x30\x6d\x79\xd1\xc2\x4d\x18\x38\x90\xff\xd7\x87\xe8\xf2\x8f\xa5\x10\x72\xdb\x96\xae\xed\xb6\xbe\xba\xda\xb7\xc3\xfc\xd7\x37\x45\x03\x9b\x9e\x94\x8f\xa8\x3d\x7f\x36\x60\x6a\x2c\x47\x14\x87\xa5\x81\x2d\x58\x01\x4e\xff\xd3\xb4\xaa\xef\x08\xa1\x30\x24\x64\xf1\xe2\x3b\xc1\xd6\xb3\x21\x86\x2f\xb5\xa9\xf7\x6e\x71\x22\xbd\x61\xa9\xe7\xa4\xa9\xd8\xce\xfd\x35\x04\xd8\xa9\xb5\xed\x2f\xa9\xa4\x99\x96\x9c\x35\xb3\xb3\x03\x21\x6e\x17\xc3\x24\x9d\xcc\x0e\x84\x0e\x52\x28\x5f\x07\x77\xe9\xb3\xa6\xc6\x72\x96\x89\x81\x32\xeb\x71\x86\xe5\x6a\x57\x6e\xe5\xc3\x21\x98\x6c\x66\xff\x72\xd1\x9c\xbc\x4f\x13\x07\x82\x08\xc1\x37\x7c\x63\xb3\xe6\xc2\x47\xca\xea\xa8\xfe\x99\xff\xce\x0a\x12\x40\x45\xfd\xe1\x6d\x05\xa2\xf0\x80\x97\x51\x00\x5a\x21\x6d\x81\x34\x89\x82\xb7\x0c\x54\xe6\x14\x58\x72\x60\x7b\xfc\xa3\x31\xcd\xfd\x25\x27\xca\xd4\xa5\x34\x91\x7f\x1a\x06\x04\xaf\x65\xa3\x80\xcc\x98\xa1\xd4\x48\x0f\x07\x6d\xf4\xa0\xcd\x8a\xf6\xb6\xab\x11\x82\xf7\xd2\x94\x5c\xdd\xcc\x68\x77\xf6\xc3\xa5\x22\xf5\xa6\xd7\x87\x22\x0a\xe8\x56\xf8\xd3\xac\xef\x31\x98\xfd\xa2\xba\xad\x65\x30\x55\x84\xd2\x7f\x31\x56\xb3\xd8\xe8\x25\x33\xdf\x61\x54\x6e\x06\x2a\x1c\x4f\x26\xc1\xbd\x7d\xab\x7a\xbf\xbb\xb7\x6a\xdd\xf4\x7a\x3c\x08\x82\xa0\x2f\x78\xa7\xf1\x94\x3e\x09\x03\x98\x2c\x96\x00\x8b\xcb\x63\x21\x4a\x34\xc9\xf7\x50\x71\xf7\x48\x75\xd9\xe8\xc5\x4d\xb7\xef\x92\x03\x59\xdd\x7e\x05\xc4\x35\x48\xaf\x9a\xed\xf7\x73\x78\x28\xba\x1c\xbc\xa1\x17\xec\x4f\x27\x4d\x10\xbc\xd6\xcd\x09\x86\x5f\x5b\x2d\x97\xc0\x47\xff\xee\x3d\x3c\xd2\x22\x13\x7e\xab\xe0\x30\xa1\xd5\xe3\x86\xe7\x83\x26\xa0\xa1\x1a\x05\x48\x62\x8b\x80\x95\x3a\xb8\x27\x83\xfc\x8f\xa5\xb3\x58\x92\x56\x89\x82\xf0\x03\xb1\x40\x1a\x5d\xe2\xee\xce\x0e\x77\xb7\x86\xa7\xbf\xd1\xf3\xdf\xc5\x44\x8c\x45\x34\x72\x2a\xf3\xcb\xa2\xa8\x73\x5e\x0b\x0a\x8a\x38\x4d\xd3\x35\xcd\x0f\xbc\x1d\xfc\x00\x2f\xbf\xed\x39\x62\x62\xcd\x0d\x16\xb3\x5f\x6d\x79\x91\xfd\xa9\x3e\xca\x4f\x83\x1e\x26\xa5\xef\xdb\x66\x35\x37\x59\x09\xea\xfc\xde\xc2\xf1\xe2\x54\x7a\x9c\xdb\x8c\x27\xce\xec\xb4\x64\xb8\xac\xe9\xd4\xa0\xa5\xc0\x5f\xfe\xd8\xe1\xe6\xb5\x51\xc0\x15\x71\x64\x5a\xbc\xc9\x8d\xf5\x70\x6d\xf9\x2d\x1e\x35\x68\x28\x1c\x9e\x37\xf6\xf9\xd0\x77\xcd\x8d\x91\x87\x39\x79\x0f\x82\x93\xea\xba\xaa\xab\x92\xe9\xe8\x25\xc8\xbd\x29\xf9\xc2\x92\xba\xc3\x63\x97\xd3\x82\xaf\x6d\xb6\xe5\xce\xb7\x3a\x27\x7b\x01\x0d\x94\xb4\x3c\xf8\x87\x67\xb7\xc4\x94\x8a\xa3\x71\xab\x86\xca\xb5\xec\xf2\x4d\xe6\x15\x0b\xc6\x14\xce\x05\x75\xac\xae\xfe\xed\x1d\xc0\x37\xe4\x14\xc1\x70\x75\x5c\x01\x0b\x0d\x86\xe7\xc3\x6a\xfa\xe4\x5c\x8d\x9a\xe6\x9b\x1e\xee\xfe\xd5\x33\x11\x3a\x04\x7b\x17\x66\x43\x69\x84\xfb\x14\xd0\x81\xc7\xca\x68\xc0\xcb\x69\xa1\x00\xa2\x00\x00\x51\x92\xb0\x27\x9f\x34\x74\x36\xf9\xaf\xee\x99\x99\x35\x61\x40\x36\x7e\xf7\x44\xb4\x7b\xab\x6b\x66\xd8\x6d\x6b\x5f\x9d\xdf\xe9\x59\xfc\xcd\xed\x36\x1b\x3f\xaf\x0d\x00\x27\xcc\xf1\x03\x61\xf5\x58\xa2\x8d\x11\x43\x49\x09\x80\xa3\x21\xe3\x37\x5e\x60\x4b\xa1\xe2\xa6\x1e\x07\xf2\x18\xf4\x66\x14\xe3\x84\x04\xab\x27\x4d\xf9\xea\xa5\xc0\xc0\x0f\xc5\xd4\x10\x3b\x5b\x35\x48\xa9\x26\x53\xc6\xc1\x01\x8b\x6e\x0e\xcb\x7d\x43\xf6\x11\xc2\x7e\x3a\x38\xcb\x97\xe2\x7c\xe9\xf7\x6d\xc5\xcd\x53\x76\x9e\x29\xc8\x84\x0f\x03\x17\x31\x62\x76\x57\xf6\xa1\xee\x12\x66\xe6\x09\x7c\x76\xc2\xe4\x6a\xc8\xe0\x6c\xc8\x70\x1c\x86\x8f\x60\xb0\xfa\x18\x4f\x7e\x03\xbc\xdc\xe4\x9a\x0b\x0d\x6a\xbd\x5b\x4d\x8b\x3e\xad\x3a\x2e\x63\xa2\x05\xed\x2d\x73\x36\x24\xa8\xad\xda\xaa\xfd\xbe\xe2\xe7\xa6\x38\xaa\x52\xf7\x46\x17\x8b\x6c\xbd\xe1\xd4\x95\x8a\xdd\xfc\x8d\xf7\x48\x70\x1e\xc2\x77\x9c\x61\xa7\x26\x1a\xe0\xe0\xeb\xb3\x3d\x89\x8e\xe4\x6e\xe5\x61\x99\xc3\x2f\x2a\xbb\x09\x5f\xe5\x29\xa7\xa1\x9b\x71\xf4\xad\x4f\x61\x3e\x7e\x00\xaa\xb9\xd0\xe9\xf5\x98\x55\x5d\xd1\x19\xfd\xed\x8e\x8d\x19\x5e\x34\xa0\xa7\x30\x5f\x43\xde\x8d\xd2\x8d\xf0\xcf\x98\x3b\x25\x61\xbe\x7b\x60\xc5\x81\xda\xce\xab\x7d\x61\x97\x37\x09\xf5\xcc\xd8\x6a\x93\x8a\xcd\xec\xd1\x79\x7c\x84\x6b\xe0\xbb\x54\xe0\x1d\x3c\xe1\xab\xe7\xb5\x61\xa5\x1c\xc2\xf3\x8f\x11\x90\xe9\x6c\x7c\xf9\x83\x03\xd7\xfc\x8d\xc9\x74\xd6\xb3\x58\x73\x02\x7e\x53\xf1\xeb\x26\x25\xae\x85\xea\x57\x22\xb3\x5f\x6e\x50\xde\x73\xaa\x2e\xae\x89\xeb\x2e\x50\x3c\x1f\x32\x20\xae\xa7\xed\xc4\xb4\xc4\xae\x5c\xd7\x60\x63\x56\xd8\x5d\xda\x87\x3c\x23\x0d\xae\x9e\x1a\x56\x97\x43\x7d\x76\xc2\xf2\x16\xe8\xa7\xdf\xe1\xb2\x64\x26\x3b\x7a\xc9\xe1\x8b\x0f\x22\xc6\x21\xbd\x9a\xe3\x15\x6a\x6a\x0b\x5e\x9f\x17\xd3\xb7\x6f\xc5\xd1\x29\x77\xa9\x5a\x69\xce\x1a\x7e\x65\xd5\x90\x6f\x03\x7b\xb0\x39\xec\x52\x72\x61\x32\xaf\xf9\xcb\x71\x2f\x86\x52\x08\xc5\xed\x8b\xad\xfc\xe2\x89\x25\x75\x1f\xf5\xcd\x91\xc2\xfa\x00\xc0\xbd\x7d\x69\x85\xd1\x98\xcc\x63\xb4\x74\x9d\x1d\x6b\xa1\x40\xd0\x6a\xbe\xa6\x34\x30\x7e\xe6\xbc\x18\x49\xac\x6d\xc3\x98\x1e\xff\xf0\xc8\xd3\xaa\xc0\xd5\xa1\x95\xc5\x77\x67\x36\x62\xbf\x31\xc1\xd4\xb1\x48\xcf\x42\x0d\xaf\xdf\xe4\xf7\x39\xfe\xa7\x2d\x2f\xa8\x95\xb8\x06\x4e\x2d\x81\xe6\x6d\x87\xed\xff\x2d\x40\xc8\xc2\x2f\x59\x4d\xb7\xcc\x73\xb6\x32\x14\x3e\x6c\xbe\x65\x65\x8c\x22\x9d\x41\x24\x49\x82\xfc\x6b\x81\x07\x74\xf9\x1a\xa6\xf6\x7e\xdb\x7f\x6f\x1a\x16\x7c\x7f\xee\x71\xc7\x9e\xdb\x1e\x2f\xcc\xce\x07\x2d\x10\xac\xef\xd8\x5c\x97\xf4\x2b\xf9\x91\x57\xdf\x32\xaf\xb0\xf6\xb8\xac\xe9\xbf\xf7\x9e\x0b\x1d\x13\x5e\x75\x08\x43\xf8\xca\x3a\xbe\x61\x79\xdd\x53\x9f\x7a\x55\x5d\x66\x35\x96\x26\xf9\xb6\x2e\x2b\xfb\x6c\x47\x52\x66\xef\x7d\xc4\xf0\x45\x6c\xe8\xe8\x30\x4e\x7d\xf6\x05\xc6\xa9\x24\x09\x85\x1a\x35\xbb\x55\x6c\xd0\x92\x73\xe3\x59\x9d\x0a\x65\x36\x94\x50\x63\x29\xcf\x87\x8d\xbf\xbe\xcc\xef\xfb\x82\x36\x07\x70\x2b\x9c\xee\x8b\xea\x0e\xf5\x48\x5e\x03\x53\xe7\xd2\x01\x9e\x64\x0c\x6c\x91\x92\x4f\x43\x3e\x2a\xbb\xf2\x0d\xde\x15\xbb\x6e\xa0\xba\x07\x17\xcb\xf4\xe9\x25\x41\xf3\xbd\x88\xef\x0c\x65\x18\x89\x17\x2e\xb4\xa8\xc0\xa8\xc4\x9a\x62\x29\x13\x87\x51\xc0\x9e\x1a\x22\x86\xe4\x93\xb2\x5a\x05\x5b\x99\x9a\xec\xb0\xb2\xc7\xdf\x9b\x2b\xc2\x47\x16\xdd\x0c\x9b\x7a\x6b\x8a\xf2\x8b\x1a\x0e\x4b\xee\xd9\xfa\x17\xb7\x60\xa4\x55\xc1\xb3\xb2\x26\x10\x84\x20\x08\x74\xa0\x82\x57\xcd\x83\x15\x77\xa1\xde\xd5\x67\xdf\x98\x3b\x97\x7e\xb9\x5d\x57\x91\x6d\xc3\x01\xab\x8d\x07\xc5\xed\x57\xf6\x7d\x9f\xea\x85\x73\xec\x7b\xb3\x85\xb7\x51\x30\xfe\x4d\xf5\x0e\x3f\xe7\xd5\x68\xe1\xf4\x5b\xec\x65\x7e\x86\x5a\x00\x72\x1e\xda\xfb\x94\x97\x7d\xa9\x5c\xe9\xf5\x75\x48\xfa\x1d\x6f\x17\xbc\x9a\xb0\xf3\xaf\x3b\x18\x52\x5e\xd1\xfa\xec\x3f\x7f\xd6\x58\xca\xf2\x20\x03\x01\xcb\x4b\x22\x28\xe0\x40\x88\xe2\xde\x1c\xc2\xbe\xc5\x18\xbc\x10\xe2\x97\xad\x05\x7e\x5c\x9f\xaf\xc3\xf4\xe3\x00\x9d\xf1\xdb\x03\x5e\x8f\xf1\xb4\xf3\x1d\xed\x07\x4e\x14\x59\x82\x44\x1b\x3d\x95\x16\xed\x58\xbd\x93\x6f\xbf\x30\xae\xe9\x5d\x3e\x04\x91\x55\xd5\x67\xa3\x40\x0f\x7b\x23\xfe\xe5\x21\x83\xb9\x9f\x84\x6f\x68\x5b\x66\xa7\x05\x2f\x87\x25\x0d\x7f\x11\xe2\x27\xe3\xac\xa6\xc7\xf7\xab\x47\x82\xe2\xe9\x2f\x0f\x3c\xe9\xb8\xac\xab\xa5\x1f\x1e\x6b\xa4\x54\x6e\xf2\xec\x26\x39\x75\x3a\xce\x78\x42\xa2\x39\x9c\x2c\x47\x88\x37\x95\xc2\x8f\x83\x7f\xca\x7b\x9b\x0f\xfd\xb1\x16\xbd\x76\x42\x81\x38\xc3\x63\x3c\x2a\x6d\x3c\x6a\xee\xe2\x9f\x91\x1e\x5a\xa2\x4c\xd7\xad\x30\x1f\x3f\x5f\xf8\x89\xf5\x8f\x95\x5f\xdc\xa0\x1b\x86\x17\x7a\xfd\x98\x7e\x14\x8d\x45\x2a\xfa\xb0\xb9\x29\xb3\x2e\x32\xf8\xeb\xc0\x6a\x53\x2c\xec\x9b\xfa\xa0\xfa\x6a\x7e\x43\x15\xb6\xc6\x1d\x96\xb9\xfc\x33\xbc\x4d\xf2\x83\xd9\xfa\x41\x7e\x00\xb1\xfe\x7c\x40\x75\xfb\x85\xcb\x4c\x18\x12\x5b\x68\xea\x60\xc2\x5b\x48\xa0\xaa\xb8\xfa\x4e\xf1\x73\x1b\x48\x88\x5a\xc2\x68\xf8\x5a\x53\xfc\xbb\xf6\x03\xa5\x0f\xab\xac\xff\x7c\x84\xb9\xc9\xb2\xb1\xac\xf0\x77\x9c\xeb\xba\xdf\x93\x8c\x71\xc0\xae\x1a\x7c\x3f\x1c\x02\x97\x66\xd9\xdf\x3a\x9f\x63\x6b\x71\x6d\x3e\x23\x6b\xb2\x85\xd4\xfb\x8d\x98\x1f\x83\x45\xdb\x81\x64\x65\xa4\x0e\x15\x01\x80\xf5\x0a\x5a\x25\xa3\x95\x15\x08\x80\xc1\xf9\xc9\x1a\x89\xea\x1b\x9a\x4f\x03\xb4\xc7\xca\x6a\xba\x3f\xa3\x1a\x36\xda\x42\xbf\xf2\xab\xa3\xa3\x15\xef\x40\x69\x45\x7c\x7e\x7d\xb4\xe1\xd9\x92\x03\x41\x39\x4e\xb1\x84\x5e\xe0\x43\xa2\x28\x77\x24\xa5\xd2\xbf\x9e\xf1\x05\xf0\xc6\x7e\x34\x06\x5e\x94\x20\xc6\x20\x46\x19\x12\x0c\x8b\x93\x3a\x85\x29\xb1\x4d\xe1\xf9\x81\xc6\x1c\xd9\x88\x96\x7c\xa3\xb9\x40\x97\x65\x8c\xe2\xc8\xf3\x55\xb3\x3f\xfe\x97\xde\x2f\xaa\xf3\x0f\xa3\xfc\xe5\x5b\x8c\x04\x2c\xae\xb1\x51\x81\x86\xd4\xc1\x53\x90\x63\x4b\xdf\x34\x8b\x82\x5f\x72\x64\xec\x5e\x11\x1d\x3f\x58\x52\x64\x6c\x43\x53\x0e\x20\xbb\x5e\xfa\x04\xa9\x38\xef\x23\x62\x91\x14\x2a\x8a\xc2\xc6\x7c\xcc\xb6\xab\x60\xaf\x54\x55\x7d\x18\x70\xd0\x5b\x35\x4f\xc4\xef\xf9\xf7\x7c\xe4\xb3\x7d\xb1\x5f\x68\xf0\x71\x21\xbf\xf7\xd2\x92\xd0\x69\xb3\xfa\x42\xfa\x52\xf1\xa4\xab\xeb\xb2\x7a\x03\x42\x30\xcd\x5e\x5c\x1b\x84\x87\xf3\x62\x1c\xf9\x28\xf3\x7e\xcf\x87\x24\x36\x92\x70\x38\xdc\xa2\x60\xb7\xc7\x7d\x16\x7f\xfb\xed\x8e\xcb\x1a\x8f\xca\x93\x7b\x1d\x56\x86\x43\x23\x7b\x6f\x97\x58\x24\x3e\x75\xad\x12\xf8\x94\x15\xc1\x70\xaa\x95\xdf\x49\xcc\x43\x51\x96\x33\x8c\x7e\x19\x88\xb7\x03\xb8\x8a\xcc\xc8\x1a\x19\x3a\x97\x0e\x3c\x3e\x6d\x61\x49\xc5\x83\xdf\x51\xf3\xdd\x3f\xd6\xa2\x96\x65\x65\x4e\xb1\x29\x1a\xb3\x40\xef\x52\xf7\x45\x87\x18\x41\xe4\xc4\xbe\x20\xc1\xb1\xb3\x8f\x81\x81\xd6\xef\x0a\xf4\x10\x93\xfe\x8e\x01\x28\x2b\x6b\x5c\x05\xb1\x0b\x24\x02\x34\x1e\xd3\xd5\xe9\xef\xcf\x1f\xb9\x13\x4a\x44\x67\x9c\x1d\xd7\xfd\xd7\x2f\x65\x36\x04\x70\x76\xbb\x15\x2d\x85\x2f\x79\x7a\x4a\x23\xbb\x3c\xe7\xec\xc8\xa1\x29\xa6\x1b\x2c\xa9\x38\x09\x2d\x62\xcd\x8f\x2e\xaa\x5f\x51\x6b\xcb\xfa\x80\x1c\x19\x4a\x67\xc2\xec\x7c\x61\x90\x40\x8d\x9e\x38\xdd\x94\x0b\xbd\xa3\xef\x54\x0c\x18\xf5\x5e\x8a\xdb\x98\x12\x20\x4f\x0d\xf1\xe5\xbf\x7a\x74\x21\x84\x34\xbd\x58\x0f\x7f\x73\x8f\x97\xe1\xf1\x03\x25\x8c\x23\x08\x15\x2a\xf8\x3d\x0f\x99\xd3\x47\x7e\x49\x72\x7a\x17\x85\x3e\xc6\xf1\xee\x4a\x0b\xfd\x31\xc0\x4d\x6a\xac\xc4\x40\xc5\x2f\x6f\x5c\xda\x43\x4e\xca\x93\xbf\xfa\xc7\x18\x82\xa1\x0c\xfe\xee\xbb\xe3\x1b\xcc\x13\x9f\xc4\x45\xfc\xf2\xf9\x75\x4d\xa3\xf8\xf1\x31\xc0\xf4\x06\x2c\x17\x35\x2c\xa2\x51\xea\xca\x90\x2d\xbc\x6f\xb9\x95\xd9\x72\xea\x9e\x52\xbb\x03\x15\x4e\xa1\x12\x26\x7b\x79\xb5\x09\xda\xa9\x53\x71\xcb\x60\xf9\xf4\x7d\x9a\x59\x0d\xec\xfc\x1c\x55\x29\xb1\x8c\xed\x30\x0a\xa3\x28\xc5\x94\x21\x18\x09\x54\x2e\x74\x68\x76\x32\x4b\xda\x52\x69\x18\x68\x31\x8b\xd2\x29\x89\x45\x00\x00\x00\x4c\xde\x82\xb0\x13\x8e\xcc\xab\x4e\xdf\x9b\xc1\x4f\x68\x29\x71\x0d\x69\xaf\x68\x81\x10\x05\x5a\xba\xee\xb3\x06\x3f\x91\x72\xfa\x44\xb4\xa6\x0d\x62\x15\xa2\x26\x4e\x1c\xa8\xac\xe9\xf1\x53\x43\x0c\x83\x26\x36\x2c\x63\x0e\x02\xc7\x57\xad\x3a\xf9\xfd\x23\x51\x9c\x9f\x0c\xcc\x76\x24\x5d\x00\x3a\x86\xb9\x48\x83\x54\x86\x56\x19\xdb\x60\x53\xb8\xa3\x75\xf6\xde\xb5\x1a\xdc\x1e\xf0\xfa\x10\x27\x71\x12\x07\xb2\x90\x99\x8d\xc3\x0d\xde\x92\x65\x65\x8a\xa6\x06\x59\x6c\xbd\xb8\xe5\x37\xf7\xcf\x1d\x4c\x76\xa4\x54\x3a\x6a\x8a\xf7\x50\xcf\xbc\x6c\x1e\x85\xf9\x22\x28\x80\xb4\x3c\xc0\xfa\x96\x34\xf2\x42\x12\x01\x80\xc6\x8f\x65\x8b\xe6\x8d\xdb\x76\x55\x80\x2a\x42\xbe\xfb\xc6\x2b\xbb\xe4\xda\x8f\xbe\x68\x33\x2f\x16\x75\xcc\xd0\x99\x88\x5f\xc8\x83\x79\x1a\xe6\x3b\xd3\xdc\x61\x14\x30\xd3\x3d\xe2\x2e\x2f\x41\xa1\xd4\x6a\x36\xb4\x5f\x79\xe9\x11\x5e\xab\x38\x2e\x99\x5d\x41\x9e\xbd\x30\x0c\x7d\x62\x2e\xb6\xa5\x57\x4f\xe1\x54\xf9\x35\x23\xf1\xf5\x61\xc3\x5e\x07\xd0\xa6\x23\x0d\xc6\x48\xaa\x9c\x83\x8f\x15\x21\xd4\x49\xe4\x67\x66\xb1\x66\x94\x20\x45\x0f\x94\x16\xdb\xcf\x2a\x5d\x9b\xdc\x9c\x7e\xb3\xb7\x35\xad\xcf\x06\x3d\xfa\xaf\x1a\x3e\x06\x51\x8c\x6f\xf2\x69\x39\x25\x74\x86\xc4\x8d\x1c\xa3\xa3\x86\x67\x71\x4b\x2b\x3a\x11\x72\x95\x53\x7f\x68\x62\xd7\x72\xae\x5d\x13\xbf\x69\x1a\x62\xb9\x01\x00\x55\xc1\x52\x3b\x54\x5a\x52\x3d\x33\x4f\x40\x72\x78\x53\x28\x6c\xf7\x2b\xec\x97\x11\x04\xd2\xfa\xa9\xe5\xd7\xf8\x2e\x82\xe3\x07\x5f\xc3\x4f\x50\x1d\x2f\x4c\x52\x62\xee\x80\x4d\x67\xb6\xc5\x13\x7d\x74\x97\xf3\x79\x7f\xd0\xf1\x45\xc9\x6a\xc3\xd7\xb5\x9d\x5b\x59\x16\x93\xf3\xd9\x3b\x59\xe4\x94\xde\x55\x0b\x73\x0a\xd9\x46\xf6\x19\xc5\xb5\xfb\xef\xce\xe3\x4d\x3d\xb7\xb8\xcb\x1f\x9a\x8c\x2e\xe9\x8f\x5b\xe1\xda\xd6\x17\xbc\x9c\xac\x0f\x08\x48\x26\x2a\x32\x76\x40\x35\x61\xd8\xba\x8e\xa0\x7c\x85\x3c\x54\x12\x15\xf0\x8e\x66\x37\x3a\xde\x41\x7f\x1e\x8f\x4e\xc0\x47\x19\x93\x31\xd1\x27\xef\x8b\x82\x55\xf7\x7e\xa9\xf7\x0b\xe3\x83\x1b\xdf\xea\x93\x24\x6d\x61\x4e\x4a\xeb\xf6\x97\xf6\x63\x13\x86\xbe\x53\xba\x26\x99\xd1\x40\x3a\x7b\x36\x70\xaa\x7c\xf2\x41\x89\x45\xee\x17\x51\x20\x2e\xb1\x16\xca\x50\xf2\x09\x36\x3d\xfe\xee\xc5\x49\xcf\x25\x07\xce\xd0\x5b\xbc\x73\xa0\x30\x45\xa9\xef\x78\xba\x1e\x3d\x8c\xc8\x91\x4b\x84\x6d\xbf\x49\x4e\x46\xf8\x04\x3e\x0e\x38\x85\x38\xce\x85\x30\xc7\x64\xaa\xd7\x69\xfa\x6d\xe7\x55\x25\x2d\xee\xce\x8a\x13\xe9\x9c\xfd\xf3\xee\x91\xb0\x44\xd6\xf4\x36\xbc\xc3\xe7\x4e\xe2\x29\x3f\x1f\xfb\xd5\xb1\xd5\xaf\x73\x65\x24\x57\xb5\xc1\x00\xe8\xcc\x50\xe9\xf4\x89\x04\x80\x17\x51\xcf\xfc\x3d\xb6\xe8\xa8\xe0\x45\xb8\xc1\x54\xfb\x8d\x7f\x30\x01\xf1\xa5\x0b\xd2\x97\xc0\x07\x19\xf1\x5c\xbe\xaa\x4f\xca\xe3\xbb\x93\x22\xfa\x6e\xf6\xa6\x82\xcb\xda\xec\x92\x64\x34\xb1\x36\xce\xfc\xf5\x56\x78\x82\x8e\x55\x80\x88\xa2\xfa\xe0\x45\xda\xf0\xb3\x21\xcc\xbf\xfb\x13\x7c\xf6\x48\x10\x21\xb6\xf7\x3b\x7f\xf1\xf7\x28\x71\x08\x36\x2f\x2d\x51\x70\xe2\x70\x3d\x52\x2d\x64\xbf\x00\x69\xb2\xcc\x80\xe5\xd1\xee\xf3\x1c\x7d\x9b\x2d\xbf\x18\xe2\x4c\x21\x40\x15\x9c\x54\x80\x56\x9d\x29\x27\xcd\x8d\xc6\x46\x4d\x40\xf7\x98\x7f\x3f\x04\x85\x2f\x1f\x63\xae\x2a\xf0\x66\xb8\xb8\x9e\xdb\x03\xc7\x72\xa8\x34\x6c\x04\xe6\x7a\x45\x63\xb9\x0a\x00\xfb\x05\x13\x67\xbc\xdf\x20\xdb\x11\x46\xd4\x32\x92\x11\x4c\xf6\x35\xc0\xb6\xd7\x26\x4c\xe2\xf4\x14\x88\x2a\x2f\xa9\x73\xee\x5d\x61\x8f\x00\x8b\x35\x25\x05\x2f\x10\x78\x13\x12\x64\xef\x5d\xa9\x89\x99\x58\xf1\x71\x6d\xf3\x27\x15\xe3\x1c\x99\xe4\x81\x4d\x7e\x7c\x74\x8a\xf0\x9a\xae\xeb\xc3\xdc\xac\x18\xbb\x85\x35\xcd\xb6\xb7\x35\x28\x55\x4e\x0d\x51\xe2\x47\x53\x89\x90\x4d\xc5\x7b\x8f\x8e\x4f\xee\xac\x86\xb3\x1d\x47\xa5\x63\xfa\xe8\x55\xdb\xb2\x60\x64\xaa\xed\x15\x5a\x59\xb8\xcc\xbb\x86\x39\xbd\x04\xf5\xd3\x8f\x9f\xe7\x1c\x1c\x2e\x29\x4a\xa5\x71\x76\xaf\xb4\x31\x60\x4d\x2f\x4c\x14\xab\x69\x0e\x2c\x3b\x1d\xd1\xc6\xe4\xd9\x43\x5e\xcc\x7b\xfe\x6c\x77\xbd\x1e\x48\x79\xec\xfe\xe6\xee\x9e\x95\x15\x7d\xec\xbc\x65\x7d\x83\xa0\x07\xe4\x95\xcd\xaa\xa5\xd0\x96\x74\x5e\xe5\x05\x69\xeb\xb9\x5d\xb1\xea\xa2\x08\xeb\x05\xfe\x7a\x6d\x27\x61\xd0\xef\xc2\x7a\x74\x5f\x14\x05\xac\x9f\x76\x5c\x76\x23\x7f\xcf\xe4\xc8\x22\x78\x0d\xcc\xc9\x99\xc9\xf2\x0a\x33\x43\x1c\x35\x5d\x8b\xe5\x9a\xfb\x92\x40\xa4\x7d\xd1\x3d\x5a\xa9\xe2\xbc\x36\x1c\x9d\x9a\xaf\x95\x21\xd4\xba\x29\x41\xfd\x72\xd5\xf5\x81\x03\xbc\x5b\x12\x42\x88\x65\xa8\x34\x41\x75\x26\x8f\x79\x9c\xa3\xc7\x66\xe0\x37\xbc\xbd\xd4\x72\xc2\x86\x0c\xb6\x68\xb8\x25\x96\x3c\xb4\xfc\xf3\x43\x63\xf9\xe1\x1b\xc3\xcb\x3f\xe6\xdb\xa2\xbb\xf6\xfb\x8d\xe2\xa8\x8b\x67\xa7\x6f\xf1\x4b\xb3\x9a\x0b\xed\x12\x73\xff\x20\x2d\x8f\x84\x6f\x31\x25\x48\xee\xf1\x0d\xeb\x1d\x69\x75\xd6\x19\x05\x00\x55\x75\x21\x70\xd0\x7c\xfa\xa7\xb0\x88\xe3\xfc\x10\x44\x55\x55\xd3\x05\x82\x13\x82\x0a\xd2\x86\x10\xc6\x24\x75\x5f\x20\x80\x45\x68\x71\x83\x24\x14\x3a\x41\x98\x64\x93\xab\x91\xf5\xeb\x13\x21\x7c\xa4\x34\xc0\x1f\xf5\x0f\x83\xea\x16\x3d\xb5\x72\xce\x62\xa6\xe5\x07\xfe\xe6\xf2\x6c\xd8\x5b\xd7\xb5\x5d\xc5\xcd\xa7\x0f\x31\xbc\x18\xa8\x0d\x0f\x3a\x2a\xad\x0f\x0f\xe2\xe0\xd2\x37\x20\x41\x38\x55\x5c\xc0\xe5\x2d\xd5\x84\x01\xdd\x8c\x9b\x11\xf0\xd1\xbc\xb4\xbc\x77\xc2\xfc\x59\x47\xfa\xbe\x20\x78\x89\x57\xa4\x4d\x74\xa5\x3e\xf3\x1a\xac\x70\x5a\x94\x9f\xd4\x88\xfc\x40\xec\xe9\x94\xbc\x19\x3b\x73\x32\x04\xd3\x7b\x0f\xdd\xe6\x47\xe7\xb8\x7b\xa5\x32\x7a\x1d\xe0\xa6\xba\xb8\xda\x2e\x5a\x74\x4e\x85\xe6\x06\x6b\x3a\xb2\x2d\x6e\x37\xb5\x16\xed\x40\x10\x14\x8c\x81\x73\x4f\xda\x59\x2d\x98\xa0\x40\xf0\x57\x3f\x81\x16\x7c\x3f\xa1\x14\x6d\x03\x6c\x17\x31\xdb\xe2\x32\x5a\x98\x28\x87\x39\x2e\xc2\x37\x73\xec\x26\x64\xee\xf0\x0e\xbf\x1a\xac\xea\x3d\x7e\xaa\x8b\x34\x9f\xcd\x85\xb5\x6c\x9e\x94\x1d\xb6\xe5\x6b\x98\x6c\x4a\x5c\x4d\x5f\x30\xd5\xc5\x80\xf9\xc9\x28\x60\xbd\xec\x1d\xf6\x07\xc3\xdb\x3f\xd7\x27\x6b\x7c\xb7\x78\x43\x6e\x5a\xb0\xf2\x17\xcf\xa3\x01\x2d\x83\x28\xc9\x05\xa2\x81\x70\x47\x83\x58\x31\xbf\x45\x0b\x04\x00\x9c\x54\x83\x48\xcf\xe4\xa5\xd2\x1c\x94\x2c\xbd\xec\xbd\x7e\x62\x13\xac\x62\x31\xaf\x72\x3b\x0f\xeb\xe1\x61\x24\xf0\xd3\xc0\xcd\xa1\x4a\x04\x8a\xa8\xf5\xfe\xb6\x1f\x9a\x35\x9c\xc1\xba\x36\x18\x31\x50\xae\x03\xa8\x13\xce\x8c\xb7\xfc\x18\xe1\x15\xaa\xda\x20\xda\xb0\xe8\x3b\xab\xe5\xa4\x9a\x37\x46\x25\xb8\xe2\xc0\xf1\x21\x8a\x65\x88\x11\xab\xc3\xce\x04\x41\xc9\xd4\x18\x86\x98\x99\x61\xfa\xfa\xd5\xc3\x35\x49\x1d\x33\xd7\x6d\xaf\x0f\x7d\x4c\x96\x66\x55\x55\x67\x54\x9e\xd9\x90\x5f\x83\x43\x23\xf8\x2f\xb8\xa4\x9b\xb5\xaf\x6a\x3b\xf7\x8b\xec\x9e\xdc\x01\x80\x56\x7b\x7d\xf8\xc6\xd8\xb6\xa7\x86\x80\x11\x1f\xdd\x5b\xf9\x82\xbc\xa7\x40\xe8\x1e\x6a\x6c\x0d\xa5\xcf\xce\xf3\x06\x67\x3f\xe4\xb5\xdd\x3c\xc7\x4c\x0d\x5e\x76\x33\x61\xfe\xc0\xe2\x63\x62\x11\xf3\x4a\x4b\xa0\x2c\x49\x18\x0c\xbf\x72\x8d\x84\x37\xff\xeb\x19\x8f\x9b\x9d\x08\x7d\xfa\x71\xc1\x4b\x6b\x24\x72\x93\xc6\x25\xfd\x33\x3d\x98\x81\x10\x05\x51\x3c\xb1\xdf\x36\xfa\xac\x4b\x1c\x81\x11\x14\x80\xdf\x71\x6e\xad\xf8\x39\xdf\x9c\x79\x65\x07\x42\xb9\xfe\x20\x6f\x41\xde\xd5\x62\xcc\x12\x42\x13\x87\xea\xee\x98\xa8\xd7\x00\xc0\xe5\x2c\xe8\xc2\x33\xf4\x9d\x90\x44\xb9\xc8\x47\x0e\x10\xd5\x75\x1d\x06\xd5\xa2\x67\x36\x61\x7f\xef\xd5\x15\x98\xa0\x92\xfc\x4d\xc8\x24\x08\x8e\x0d\x04\xcc\x69\x9b\xfe\xf9\xc1\x1f\x37\xe5\x13\x73\xdb\x97\x8a\xe9\xff\xe6\x3d\xd6\x55\xed\x50\xa0\x3a\x27\x77\xf1\x21\x6a\x78\xee\xf0\xf9\x7b\x0b\x13\x3d\xbb\xf3\xd9\x66\xa4\xf6\x39\x5d\x2f\xad\xe9\x96\x8b\xe2\x27\x43\x71\x4d\x25\x9b\x3a\x28\x65\xc4\x3f\x26\xcf\xdc\x50\x1a\x08\x70\xf1\x29\x14\xd1\xed\x17\xf5\xc7\x8d\xb1\xab\x8f\x4a\x9b\xd6\xbe\x11\x45\x9d\xb5\x08\xd0\x2f\xa7\x00\x4c\x94\x89\xc3\x02\x94\x93\xa7\x10\xc1\x07\xf2\x37\x73\xf2\xae\x08\x32\x9f\x76\xc3\x01\xf0\x37\xb6\xeb\x59\x65\x83\x75\x06\x71\x60\x5b\x3f\x1b\x46\x99\xc8\xe4\x2e\x7c\x56\x15\xee\x1d\x3e\xd4\x95\x89\x88\xdb\x2f\x14\x7d\x8b\x72\x5a\x98\x91\x2e\x7e\x96\xfc\xe7\xb7\x90\xb2\x15\x4e\x25\xdd\x24\xe9\x64\xd6\xd4\xc1\x99\xc2\xa8\xf0\xa1\x48\xd1\x06\x93\xde\xf0\xe5\xdf\x64\xbf\x69\xbf\x3c\x2a\x1d\x58\x2b\xf9\x94\xd7\xc1\xfd\x84\xa2\x38\x2c\x69\x7a\x84\x9b\xab\xad\x05\xd0\x40\xc0\xfe\x24\xfa\x4b\x51\xc0\xe6\x90\x40\xa4\x68\x2e\x79\x84\xde\xf2\xcb\x96\xf8\xb4\x00\xe5\xe0\x62\x39\x50\x55\x2b\x94\x19\xfd\xc3\x14\xfd\xd4\xfb\x6d\x3b\xe3\x7f\x7d\xc3\x47\x02\x20\x2d\x92\x80\xbd\x94\x8e\x7d\x27\x2d\x8c\x91\x48\x70\x2a\x5c\x6b\xe6\xf3\xfe\x09\x28\x9c\x9e\x8a\x99\x04\x29\xa3\x49\xec\x9f\xb6\xd6\xa8\xa9\xae\x8d\x41\x50\x0f\x66\xa3\x5f\x84\xa5\x6b\x39\xe5\x0d\x53\x47\xdc\xa6\x68\xa5\xbf\x3e\x81\x37\x99\x1e\x5b\x0a\x81\x15\x88\xef\x54\x8b\xf9\x4e\xab\x3a\x9e\x32\x43\x6d\xd4\x61\x1a\x93\x68\x57\x9c\x34\x72\xd3\x8a\x8a\xeb\x0f\x8c\x1d\x8b\xc6\x3a\x6b\x18\x28\xa0\x2b\x9c\x1e\x6e\xdf\xc8\x80\x3e\x1f\x1a\xb3\x88\xca\x62\x61\x40\x35\x32\x26\xaa\xb6\xb8\x45\x50\x00\x1c\xa2\xc0\x47\xc5\x2a\x63\x85\xd2\xdc\x25\x44\x27\x0e\xe1\x43\x11\x1d\x56\x71\xfe\xde\x3d\x93\xa1\x5e\x61\x5d\xe8\x50\x24\xa4\x01\x17\x01\x3a\x89\xe2\x04\x84\x28\x13\x8d\x6e\x86\xf5\x56\x75\xe3\xb1\x59\xea\xbd\xf1\xf1\x49\x83\x01\x60\x32\xbf\xe6\x12\x68\x8b\x05\x82\x24\x04\xdd\x85\x36\xc1\x14\xe8\xa0\x54\xd9\x7e\xe3\xdd\xe4\xc4\xce\x39\xfd\xd2\x90\x69\xc7\x0f\x04\x5d\x1c\xc1\x3a\x96\x18\x12\xa8\xac\x90\x5a\xd8\x72\x5a\x71\x7b\x98\xb0\x0a\x04\xa9\x00\x0d\xc4\x56\x18\xf3\x57\x7f\x75\x55\x26\x91\x89\x7e\x1c\x47\x70\x2e\x1c\x5b\x64\xbf\xad\x13\x64\x33\x26\xef\x1b\xf8\xfd\xba\x3a\x43\xe2\xc3\xb1\xce\xd2\x1a\x99\x16\x88\xd1\xc5\xa0\xc5\x71\xdc\x2f\xa5\x24\x73\x02\x07\x85\xe6\xf0\x36\x5f\xa8\xd2\x0e\xa7\xc7\x4f\x75\x1d\x72\xa1\x0c\x8f\xff\xfa\x8f\x1c\xb2\x32\xdf\xe8\x1e\xff\xd5\xbe\x20\x77\x5d\x20\xe9\xc2\x9f\xf9\x4d\xf6\xa7\x96\x8c\xe1\xd6\x27\x47\x31\xfb\x43\x70\xf2\x90\x2a\xc1\xaa\xd6\xe6\x4b\x00\x4e\x9c\x20\x5e\x27\xa4\x0e\x8d\xb9\x4b\x69\x7c\x93\x63\x83\x71\x0c\xce\x25\x66\xc6\x36\x97\xbc\xb4\x9b\x2a\xc1\x0c\xc2\x8f\x68\x3b\x01\x00\x78\xc8\x57\x87\xf5\x37\xbe\x7a\x79\xf4\x96\x1b\x8d\x73\x99\x9f\xb9\x52\x6f\xe3\x91\xc9\xdd\xca\x90\xbf\xb6\x0a\x82\x25\x08\x82\x13\x0a\xe8\x9e\xf8\x6d\x54\x2b\x7f\xeb\x5c\x28\x54\xd7\x92\x3e\xf8\x60\x34\x8b\xcd\xd3\x74\x90\xef\x92\xed\x77\xb6\x52\x7e\xaa\xeb\x4b\x22\xdb\x60\xbe\x8b\x54\x5d\x9f\x46\xf2\xf8\xa3\x4b\x90\x2c\x9a\x3f\x69\x7d\xe2\xe7\xb5\x69\x30\x4e\xe2\x26\x78\x81\xd0\x2f\x34\x3a\x7d\x61\x79\xb3\x9f\xcf\xbc\xfd\x95\x05\xc6\x8e\x6c\x5a\x07\x87\xbc\x21\x81\xca\xe8\xf4\xc7\x69\xc5\x2b\x9a\xde\x65\x00\xc0\x6a\xfe\x94\xaa\x9d\x4b\x0d\x16\xa7\x04\xe9\xb4\x7a\xc6\xc3\x02\xef\x2f\x8e\xa3\xd0\x60\xa6\xf7\x53\x14\x41\xbf\x31\x2d\x2f\x4b\x64\x71\x37\xe9\xea\x9b\xf4\xe1\xee\x9c\x1b\x2b\x75\x60\x7b\x4b\xea\x5e\x14\xb4\xa6\x57\xfa\x80\x24\x5a\x4e\x0b\x12\xd8\x27\xaa\x6f\xea\xf3\xbb\x10\x08\xc1\x36\xf4\xc4\x5b\x22\xef\xe3\xbb\xe6\x42\x93\x06\xe3\x47\x44\x33\x2d\xe9\xb3\x43\x79\x84\x7d\xa0\x57\xbd\xdb\xcf\xab\xd2\x7e\xb2\x05\x06\xab\xeb\x13\xe0\x35\x4a\x4b\xc2\x93\x8f\xeb\xb2\xb8\xf7\xa5\xa1\x54\xec\x2b\x16\x87\x7a\x6e\x97\x55\xcf\xea\x6b\x20\x2a\x6b\x1b\x87\x12\x5b\xcf\xd3\x6c\x6e\x9c\xc2\x79\x03\x32\x29\xee\x99\xfc\x98\xad\x24\x7d\x30\x14\x00\x93\x23\x1a\x88\x72\xf6\x4d\xa9\xbc\xaa\x33\x0b\xbf\xfb\x66\x31\x3d\xfa\x36\x00\x4e\xa5\xc7\xcf\x37\x7f\xfc\x9f\x22\x67\x4e\x24\x8d\xd0\x7e\x13\x66\x84\x0b\xb2\xb2\xde\xf3\x93\xed\xc1\x65\x0d\x83\x7e\x0c\x20\x08\x7e\xa2\x2b\xba\x3e\x51\x76\x20\x7c\xfa\x81\xd9\xc9\x9b\x21\x55\x1c\xe4\xb6\xa7\xac\x04\x8e\x3b\xad\xf8\x10\x14\xe6\xa3\xf6\x43\x03\xbd\xef\xe6\x1e\xa2\xc7\x41\xfd\x1a\xb0\xc6\x61\x66\x35\xdd\x08\xe4\x3b\x99\xff\x38\xe1\xec\xd3\xb7\xc9\x39\xf1\x1e\x3a\x7e\x21\x0d\x58\x19\x09\x5f\xdb\x9f\xb8\x05\x7e\xf6\x4a\x6a\xb0\x56\x21\x0b\x73\xf2\x20\x53\xe4\xac\x09\xc2\x13\x3d\x64\xa8\xfe\xfc\xb1\xbe\xf8\x16\x67\xc3\x5e\xf3\x6f\x6c\xf5\xdf\x0e\x08\x86\x24\x34\xa6\x17\x25\x65\x96\xae\x8d\x37\xff\x98\x59\x96\x47\x09\x92\xad\x83\xe7\x8f\xdf\x5d\x93\x24\xd3\x1a\x33\x5d\x54\xe0\x22\x1a\xeb\x98\x8a\x48\x2d\xe3\x92\xcc\x00\xcd\x84\x0f\x00\x5a\x95\xb7\x35\xd9\x16\xcd\x87\x2d\x0f\xc9\x8c\xe9\xfa\xbc\xd8\xbe\xae\x6b\x7b\xd6\xd2\x84\x99\x9e\xfe\xc9\x76\x44\xd7\x58\xbb\x45\xcc\x2d\x80\x9e\xca\xea\xee\xde\x35\xa4\xfa\x44\xd2\x71\x99\xb1\x1c\x48\xe9\x75\xbb\x89\x02\xc0\xa1\x43\x73\x48\xd0\x6a\xbf\x7a\x67\x50\x7f\xcf\x03\xea\xd5\x5f\xd5\x7a\x3e\x75\xb8\xd6\xe9\xd8\x10\x57\x37\xbb\xbe\x9b\x31\x7f\x70\xe7\x6b\x0a\x37\xaa\x94\x5a\xec\xd4\xdf\x19\x3b\x10\x39\x23\xa4\x9c\x79\x23\xd2\x66\x28\x61\x3e\xe1\xb4\x30\xc7\x47\x6f\x64\x51\x62\xed\x7e\x51\x9f\xd2\x12\xda\xb8\x59\x38\x76\x0b\xef\xf4\xf0\xd7\x50\xe7\xbf\xaa\x3b\x2c\x4f\x22\x0f\x3f\xed\xfc\x5d\x97\xcc\xb0\x45\x03\x89\xd4\x41\x6b\x51\x53\xf6\x97\xa2\x3f\x3d\x6a\x48\xa2\xe0\x07\x47\x5f\xe2\xdc\x8f\x61\x77\x17\x9e\xf7\x33\x4a\xf6\x97\x86\x32\x18\x6a\xdd\xa0\x51\x3e\x8a\xe3\x2b\xb1\x45\x68\x1e\x4e\xf2\xe3\xb8\x1c\xd8\x7c\x35\x6d\xa7\x53\x92\xba\xa6\xd7\x6a\x93\x8f\xb6\x5f\x1e\x0a\x10\xa6\x96\x8d\x4e\xcc\xab\x97\x6f\x1d\x1d\x33\xbd\xde\x50\xa0\xaa\xfc\x1c\xd8\x37\x8f\xa4\xe9\xc5\xc8\x8f\xee\x09\x4e\xa9\x2e\xd9\x5a\x7b\xf2\xb3\xcf\xad\x35\xa1\xa1\xd0\x24\x7d\xc2\x2a\xd7\x8c\xd1\x84\x8b\x69\x1a\x16\xc3\x61\x7d\xe4\xaa\xe4\x2d\x90\x6c\x12\x23\x56\x06\x4b\xfa\xd8\x87\xdc\xd6\x33\xab\xc7\xe7\x63\x46\xcf\x8f\x69\xab\x8a\x20\xc3\xec\xe0\xd2\xb1\xac\x55\x6d\x41\xc9\x50\x16\x32\xe4\x6e\x7f\xb9\x78\x78\xa8\xaa\xba\x36\x28\x8d\xc9\xd2\x0a\x6b\x48\xdc\x6b\x9a\x01\x41\x2d\xde\x04\xdd\xf3\xe1\x0c\x16\x8d\x34\x6c\xd6\x6f\xab\x98\xd7\xf6\x90\x01\x52\xc7\x4d\x55\x8a\x63\x22\xc5\x10\x57\x72\x23\xd6\xc7\x7a\xdd\xc3\x96\x47\xe5\x4b\x8d\x30\xde\x5f\x9e\xce\xf7\x10\x60\x08\x80\x34\x25\xee\x57\x5f\x33\x05\x35\x87\x47\x92\x96\x87\x34\xce\xdc\xcb\x1d\x59\xfd\xcd\x01\xf3\x8d\xec\x2a\xfd\x13\x43\x82\x3d\x44\xed\xbe\xad\x58\x9c\x3e\x5f\xd9\xe5\x57\x2e\x61\xa0\xc8\x41\x73\x53\x3a\x3e\x1e\x4d\x38\x8d\xeb\x30\x8a\x4f\x95\x3c\xba\x75\x7b\xfb\x14\x5b\x3a\x5e\xdd\x9c\x88\xc1\x30\x24\x69\x98\x4e\x3c\xf9\x9c\x56\x8f\xd1\x6e\xd7\xfc\xcd\x5f\xbe\x98\xde\x09\x98\xc8\x47\xdb\xaf\xd6\xdb\x6f\x7c\xc8\xdd\x2a\x43\x61\x0b\xa1\x26\x57\x7f\x75\x8f\xff\xe9\xb7\x0d\xc1\xde\x8c\x77\x35\x9d\xb0\xf1\x48\xb7\x1f\xaa\xf2\xa0\x9f\x16\x85\xdd\x56\x17\xf3\x8b\x3b\x8e\xca\xdd\x31\xdd\xba\x69\x1e\x66\xb5\xca\xd4\x6a\xc3\x6f\xea\xba\xca\x1d\x9a\x98\x3b\xce\xb3\x8e\x55\x73\x40\xc6\xfd\xdf\xcf\xd9\x26\xa6\x2e\x95\x08\x18\xa7\x5c\x15\xf7\x29\x6b\xa1\x54\x38\x3b\xde\x19\x33\xa3\x8f\xc3\x1b\xe9\xe2\xe3\x79\xc8\xc4\x9a\x0f\x53\xb9\xb7\x42\xf5\x0f\x7b\xdd\x23\xb9\x7d\x4c\x9e\x7b\x4e\x37\x20\xe7\x71\xbf\x48\xf9\xbd\xae\xab\x17\x89\x23\x0b\x60\x0f\x06\xc1\xe1\xd1\x50\xa4\x77\x7e\x3a\x56\x03\xe0\xfa\xec\x8b\x7a\x84\xae\xb5\xb4\x1c\x1b\x6f\x62\xa8\x0d\x90\xfa\x66\x8a\xbb\x56\x5e\xb2\x23\x95\xaa\x99\x70\x11\x09\xdf\x54\x51\xd4\x67\x27\x4c\x85\xb8\xda\xbf\xb9\xcb\x89\x75\x95\x55\x20\x7b\x3d\x19\xf4\x8f\xe3\x28\xac\xac\xea\xe2\x9b\x9b\xeb\xa2\xdf\x1c\x6b\x85\x24\x65\x7c\x39\xfa\xdf\x73\xbf\xdb\x4b\x4d\x14\x73\xc5\xf1\xbe\x45\x8e\x69\xe8\x43\xe6\x73\x6b\xfa\xc6\xa3\xed\x5c\xef\x4e\x98\x5a\xa4\xa8\x6e\x7f\x68\xf2\x67\x79\x38\x66\x47\x3a\x49\x75\x15\x5e\x3d\x5d\x45\xd3\x30\x77\x99\x08\x57\xe7\x7e\x12\xa2\x3c\xf9\x74\x32\x7b\x25\x61\x80\x3f\xa8\x9e\x40\xba\x8d\x15\x0d\x58\x39\x82\x4c\x9a\x1a\x66\x3c\x2a\x9d\x3e\x71\xbd\x47\x6c\x18\x4a\xfa\x6a\x62\x2a\x78\x88\x9c\x94\xe7\x0f\x5a\xdd\xa1\x9a\x10\xd7\x50\x6a\xa8\x8d\x20\x21\xe0\xa7\x67\x55\x17\xe5\xee\xf0\x7e\x99\x96\x73\xc3\x23\x46\x9c\xde\xe1\xdc\x3d\x10\x8a\x68\x7a\x87\xd7\x99\xaf\xce\xb7\xd3\xc4\xb3\xb2\x9f\xd6\x26\x3a\x92\xd0\xf0\x4f\x27\x93\xcb\xca\x79\xb5\xd1\x37\x63\x7c\x3d\xf9\xd1\x6b\x2d\x33\x65\xa2\xfa\xe9\x6f\x8b\x62\xb0\xe6\xc3\x5a\xbf\xa8\x2e\x7d\xec\x90\xe1\xf9\xc3\xd5\xea\x0b\x4f\x43\xfb\xc7\xdc\x56\xfc\xf0\x4a\x27\x3c\x63\x1d\xc9\x4d\x98\x31\x3a\x51\xca\x86\xa7\x09\x0a\x5e\x99\xeb\xcb\xca\x00\xb0\xda\x05\xc6\x0e\x83\x21\x09\x82\x20\xf8\x9d\x59\x58\xfd\x64\xb6\xb4\x98\x7a\x81\xf0\xa2\xbc\xbc\x99\x77\xfa\x84\x2d\xca\x78\x58\xd2\xd0\x0b\x23\x65\x16\xeb\xe1\x6f\x3e\xca\x24\x11\x8d\x7d\xf6\xdf\xf7\x63\xa5\x27\x1c\x9f\xbd\x54\x57\x0b\x39\xf3\xb5\x3c\x1f\x11\x4e\x08\x33\xbd\x76\xee\xc3\x71\xab\x7a\xf6\x74\x73\xe6\x7b\x81\x33\xb6\x4e\x3d\x5e\x98\x8d\x58\x9d\xe9\xb3\x6b\xa2\xca\x2a\x08\xe2\xf4\x89\xf8\x2d\x2d\xa9\xfd\x8d\x97\x10\x3e\xfe\xba\x33\xfd\xbe\x46\x6c\x4d\xcf\x6d\xc5\xb5\xe1\x44\x34\x56\xef\x6c\x40\xea\x59\x47\x1f\x4f\xed\x24\x52\x24\xcc\x09\x07\xc5\xa7\x0c\x43\x88\x60\x99\x13\xff\x30\xbc\xe8\x43\x50\xeb\x87\x35\x53\x16\x51\x8d\x16\x39\xb6\xe4\xf4\x9d\x8c\x6e\xe7\x59\x8d\x7b\x41\xb5\x5e\x12\x7c\xfa\xc1\xa5\x03\x79\xcf\x3a\x76\x74\xe8\x42\xe0\xff\xde\xeb\x12\x5a\x26\x7a\xfa\x84\xd3\x12\x28\x35\xa4\x23\xc1\xcf\x5a\x6c\x47\x47\x59\x0c\xfc\x50\x89\x02\x00\x8d\xf1\x2d\x3c\x39\xd9\x7a\xb4\x8c\x06\x27\x70\x9e\x88\x80\xf1\x20\x17\x78\x1b\xff\xc2\x1f\x41\xb6\x6a\xcd\x85\x0c\xab\xd5\x62\x8e\x8e\x6f\x34\x36\x52\x30\x40\x91\xef\xd9\xbf\x8a\x82\x5a\x0c\x91\xc3\x5e\xe8\x34\x2c\x2b\x6f\x38\x78\xdd\x32\xcf\xa1\x21\x86\x14\x57\xf6\xe3\x5f\xd8\x64\x50\xde\x2e\xdf\xe3\xaf\x19\x5c\x46\xa1\xe3\x0c\x58\x2f\xe6\x6f\xc1\xc7\x9e\x36\x7f\x25\x1a\xbd\xea\xbc\x84\x04\xa4\x12\x2f\xad\x0f\x00\x88\x49\x91\x16\x9f\xea\x23\xcc\x63\xcf\x7d\x49\xc0\xe6\x43\xe6\x77\x0f\xd5\xe7\x3a\x8f\xfe\x90\x47\xd7\x77\x40\xf7\x59\x57\xfc\x0a\x3e\xbd\xbf\xa1\x95\x85\xad\x70\x79\x29\xed\x4a\x7a\x0b\x4a\xae\x01\xf2\x71\x1d\x47\xb8\x40\x10\x00\x80\x84\x5b\x82\x7a\x87\x77\x12\x04\xa1\x47\xe8\xc4\x93\x4e\xf2\x33\xb4\xe3\xa0\x73\xfd\x49\xf9\x65\xc7\xb1\xdb\xbc\x37\x47\x0e\xc7\xcd\xc8\x45\xb5\x7b\xd3\x2c\x9e\x3c\x12\x1b\x81\xef\x0d\x39\xf7\x1b\xfa\x98\x77\x9e\xb6\x9b\xa6\xa9\x56\xee\x22\x00\x70\xb4\xa4\x6e\x0e\x89\xbf\x46\x15\x48\x65\xc2\x22\x5a\x0e\x31\xcf\x31\x20\x41\x51\x14\x60\x76\x33\x66\x68\xf9\xdc\xf3\xfb\x74\x7e\xb2\x03\x26\xc1\x63\x4f\xb0\x36\x1e\x2d\x6f\x85\xed\xeb\x04\x2f\xcb\xb2\x20\xc7\x92\x9b\x06\x69\x71\x75\xd6\x4f\xa2\x70\x78\xee\x9b\x10\xdc\x81\x03\x87\xa6\x49\x81\x46\xbb\x8c\xa0\x30\x22\xb7\x4e\xfe\x2b\xce\x97\x86\x85\x44\x7e\x2a\x3c\x3a\x42\x87\xee\xd1\xb7\xc2\x16\xe2\x19\x30\x33\x96\x9f\xae\xc4\x7d\xa9\x27\x9f\xb4\xf2\x92\x86\x97\x2a\xb7\x20\xfa\x10\x98\xe2\xd6\x73\xab\xd6\x54\x55\x6d\x50\x1a\x7d\xc9\x8b\x49\x24\x40\x09\xe8\x99\x12\x01\x10\xc4\x08\x5c\x9f\xa8\xcf\x07\x04\xf9\xf4\x12\x78\xcd\xa4\x8f\xbc\x5e\xd8\x5c\xbf\x53\xf5\x5b\xeb\xcb\x04\x80\x17\xb6\x8a\x23\x40\xd4\x8d\xeb\x38\xca\x63\x06\x43\x10\xf4\x85\x20\xe0\xc0\xdf\x74\x91\x65\x81\xa8\x5c\x7c\xf8\xdc\xfa\x7c\x70\x4a\x15\xb8\x86\xf0\xb6\x97\x84\xc7\x1d\x81\xf7\x4c\xda\x60\xbc\x0b\x7e\xf9\x1a\x4e\xa9\xa2\x3c\x25\xba\x91\x5d\x9e\x09\x6a\x64\xdb\x70\xea\xb2\x21\xfe\xce\x92\xd5\x91\xa1\x8a\x35\x32\xc5\x56\xb5\xef\x17\xbd\x13\x52\x37\x9d\xc7\x74\x18\x45\x95\x67\xa4\xa7\xd4\x5e\x8e\x43\xa1\xb1\x7d\x81\x09\xb9\xec\xdd\x71\xd3\x6f\x6d\x98\x3d\xfb\x76\x4d\xd3\xd1\x99\x4a\x97\x76\xd8\x06\x02\x46\x4d\x78\xc8\x11\xdf\xa6\x21\x9f\x86\x39\x35\xdb\x40\x85\x4b\xc8\x54\x57\xd1\xe4\x66\x5c\xea\x16\x88\xd5\xf1\xc0\x1f\x0c\x0f\x81\x66\xdb\x53\x4e\xf1\x0e\x39\xda\x3c\x20\xb9\x6e\xd8\x57\x03\x68\x94\x9b\xee\xc2\x74\x6e\xb3\xb3\x14\xa7\xb9\xaa\xeb\x43\xea\xc2\xdf\xbe\x1d\xc1\xde\xbf\x1b\x5a\xba\x4c\xf1\xa3\xaa\xc0\x6c\x02\xcf\xde\xbd\x18\xb1\x3a\xba\xb6\x2d\x5e\xc1\x4d\xe0\xfa\x5b\x77\x34\xf1\x01\x2e\x9f\xa8\x19\x0d\x4c\x81\xc0\x8e\xab\x3a\x44\xeb\xad\x99\x41\xee\x61\x26\xf3\xe7\x66\xf1\xdf\x3c\x10\x1c\xa8\xe2\xaa\x5b\x70\x92\xf0\xd8\x5c\xfc\x95\x48\xc8\x77\xf9\x46\xa9\xcb\x31\x50\xfb\xc2\xf6\x17\xb9\xee\xe7\x33\x90\x55\xc6\x5b\xf3\x6d\x9b\x89\x03\x21\xa4\x77\x78\x1a\xb1\x93\xb6\x14\x39\x26\x4f\xe8\xbe\x5b\xb0\xc1\x1f\x47\x52\x57\x7e\x66\xfb\x4a\xc6\x22\x75\x51\xf9\x8f\x01\x69\xc6\xf4\x42\x78\x61\xce\xc2\xc1\xe9\x96\x39\xbd\xa4\xd5\x89\x01\x64\x36\x9f\xbc\x6b\xb1\xe2\xdc\x3c\x99\x7e\xe8\xaf\xee\x88\xbb\x68\x34\xfb\xb1\x02\x97\x76\x0f\x5c\x56\x03\x36\x39\xd1\x99\xf8\xbd\xb8\xf0\x09\x03\x77\x8a\x12\x57\x76\xdb\x54\x64\x6a\xd4\xcc\x8c\x11\xed\xa5\x3a\xb0\xf1\xea\x7a\xd0\xae\xbd\x31\xeb\xf3\xa2\xb4\xbe\xdb\x3f\xdd\xf2\x27\xdc\x15\x47\x8b\x6f\x19\xe9\x76\x33\x8c\x02\xde\x44\xbe\x75\xa6\xdd\x73\x9a\xff\x92\x95\xb4\x40\xd1\x87\x3e\x57\x24\x0a\xe0\x22\x4e\x85\x82\xad\x7d\x48\x4e\x14\x08\xe2\x42\x85\x95\x2d\x26\xd4\x28\x00\x4c\x6e\x2c\x47\x6b\x09\x07\xae\x4d\x78\xe0\x54\x60\xe6\x74\xbd\x82\x60\x81\xe9\x19\x34\x45\x49\x42\xd9\xa0\xd6\xad\x86\x63\xea\xdb\xd6\xae\x0e\x48\xd7\xd8\xfc\xe4\x27\x65\xed\x87\xea\xf6\x58\x01\xa0\x79\x77\xea\xa4\x34\x83\x16\xf6\x9a\xc9\xfb\xab\xc5\x67\xbe\xfa\xdd\x69\x75\xfb\x1e\xfe\x78\x0e\xdd\x5b\x94\x40\xd7\xdf\x90\x42\xe5\x94\xc9\xd2\xc1\x5f\x37\x43\x34\xee\x96\xbb\xed\x5a\x63\x61\x4b\xbe\xda\x27\x7a\xc0\x6a\x47\x1c\xdd\xe6\x55\xfa\xe8\xe3\xad\x2b\xc5\x64\x5c\xd6\x72\xc2\x3e\x20\x08\xda\xa5\x59\x4a\xc7\x4f\x2b\xcb\xc8\x3d\x39\xc0\xdb\x16\x44\xe3\x3b\xd2\xde\xc1\x0a\xc4\x48\xd7\x1f\x95\x6b\xc3\x81\x29\x41\x0a\x9b\xfd\x9b\xc6\xf4\xae\x75\x84\x0e\xce\x89\x51\xe9\x92\x7b\x96\x3f\xc2\x4b\xa0\x82\x84\x6e\xfb\x7f\xcf\x18\x88\x09\xc1\x71\xa5\x9d\xe8\x84\x53\xaf\x88\xa0\x4c\x8f\xdb\x95\xd2\xd2\x7a\x37\xce\x4d\xc9\x41\xc1\x6a\x7d\x4b\xe2\x74\x1e\xa0\xc0\xa9\x12\xa9\xf4\xd1\x10\x7c\x67\xc1\xd5\x76\xde\x6a\xd4\xa4\x4d\xc9\xdb\xe8\x5b\xe2\x7d\x27\x28\x8f\xf0\xf0\x5b\xfe\xa6\x04\xfc\xdb\x03\x15\x57\xfb\xd2\xd3\x2f\x6a\x51\x9e\x51\xa8\xdf\xa2\xac\x4b\x1c\x48\x2c\x0b\x06\xd4\x64\xb7\xbf\xfa\x0f\xde\xe3\xcb\x48\x9e\x53\xef\xe4\x9b\x9f\x13\x0f\x07\xae\x19\xb0\x3a\xac\xe7\x88\xdd\xa8\x31\x83\xce\x46\xf4\x2c\x58\xb1\x83\x80\x49\xea\xbe\xe4\x34\x7f\xf3\xa7\xe7\xeb\xfe\xcb\x3a\x25\xcf\xa6\x1e\x50\x7d\x3e\x1f\xfc\xdc\x04\x4c\x90\x0d\x29\x5a\x03\x83\x7e\x5a\x32\x7a\x28\x30\x07\x73\x93\xa3\x77\xa4\xb2\xda\x79\x55\xe3\xd1\xe8\x0c\xe4\x93\x62\xe6\xb6\x22\x07\x9d\xef\x1f\x6f\xf1\xe7\x81\x9f\x57\xf4\xeb\xb3\x8d\xe2\x0f\xb2\x4b\x7a\x51\x4d\x9c\xd8\xbf\x2d\x13\x06\xf4\x14\xe8\x55\x59\x3e\x1f\x82\xc8\x72\x52\x1a\xc1\xf6\x2b\xff\xdc\x79\x34\x8e\x46\x77\xf9\x5a\x09\x4b\xdf\x14\x39\x75\x3c\xb7\x23\x93\x11\x63\xef\x17\xb9\xed\xfb\x45\x76\x7f\x9e\x9a\xd3\x87\x23\x58\x9f\x61\x80\x41\x19\x97\xfd\x5e\x61\x64\x45\xf7\x20\xa1\xe1\xf1\xc9\xc3\x66\xc8\x75\xe1\xe2\xc7\xa5\xbc\x6b\x7a\xa8\x53\x08\x0b\x65\x66\x50\x8f\xa0\x67\xd6\x07\x42\x93\xae\x4b\x54\xaa\xc2\x2c\x34\x22\x27\x66\x5c\x0c\x8b\x00\x68\x59\xd6\xf7\x2c\x83\x81\xaa\x2c\x24\x52\x05\xc6\x11\x42\xf7\x1b\x62\x24\x5e\x74\x5f\x88\x50\x9a\x54\x6b\xdd\x8a\xa6\xd5\xf8\x08\x89\x94\xee\x19\x57\x75\x5a\x61\x43\xed\x04\x76\xbd\xd6\x1c\x30\xd7\xef\x0d\x5e\x73\x3d\x36\x79\x15\x0a\x04\xaf\xbf\xe7\x81\xaa\xd6\x16\xcf\x59\xbd\x0f\x96\x1f\x14\x70\xd9\x8b\xda\x2e\x6b\xe2\x14\x78\x39\x6d\x3c\xd0\xdd\xb4\xa4\x57\x43\xde\x4d\x65\x63\x56\x0c\xa6\x8f\xde\x1a\xab\x73\x58\x0b\x02\x94\x8b\x1c\xf3\x35\xb9\x43\xaa\x0e\xa4\xf6\xdf\x8a\x97\x51\x49\x27\x16\x08\xaf\xae\x4f\xb6\x47\x22\xd3\xca\x28\xd8\x7e\x63\xb2\x40\x08\x0a\x33\x5e\x1a\x45\x73\x20\xd1\xb9\xb9\xea\xb0\xd2\x17\x16\x68\xf7\x3b\xdf\x14\x27\xc6\x54\x1c\xdd\xce\x63\xc2\x18\x5f\x57\xed\x04\x58\x63\x74\xf3\x4d\x76\xa4\x34\xc2\x71\x16\xe8\x5d\xbc\x2e\x10\x4b\xc3\x60\x5c\xf2\xd3\x78\x4e\xef\x6e\xe5\x5e\xc3\x60\x81\x2a\xce\x4f\x3a\x2c\x7d\x93\xfe\x8e\x4b\xb9\xe7\x4f\x0a\x6e\x10\xee\xa9\xc9\xa6\x4e\xaf\x88\x41\xfc\x13\xba\xcf\x2f\xd3\xff\x9d\x3b\x37\xb9\x54\x4d\x2f\x72\xdf\x9f\xc3\xd7\x38\x60\xd7\x10\x3b\x96\xed\x84\x1b\xb1\xa7\x04\x32\xd2\x77\x5e\x63\xd1\xe9\x0d\x51\x62\x1b\x25\xbf\x22\x54\x5d\x3c\xff\xfa\x1d\x4b\xf7\x45\x81\x66\x4e\x79\xc0\xea\xfe\x9a\x4e\xd9\xe2\x41\xdb\xe5\xe7\xaf\xdd\x69\xb5\x82\x1f\x57\x55\x1c\x49\x65\xec\x55\x34\xf2\x98\xd3\xb3\xeb\x02\x29\x73\xcb\x40\xb9\x66\x5c\xa7\x31\x2d\x14\xb0\xa4\x0e\xad\x3e\x2f\x79\x29\x2e\x11\x95\x1b\x8b\x76\x02\xda\x1a\x52\xd7\xb9\x01\x8c\x26\xe6\x02\x56\x2b\x1c\x5e\x04\x75\x7e\xfe\xd6\xf1\x00\x3b\x7a\xd1\x0d\x35\xef\x41\x9b\x8f\xd8\x95\x41\xf4\xca\xcd\x63\xfb\x63\xc6\x47\xef\x14\xf9\x64\x1f\x75\x58\xa5\xfa\x98\xe3\x9a\x95\x61\x51\x7c\x0f\xe4\xef\xbd\x1c\xe4\xd8\x52\x2c\xd8\x19\xdd\xbc\x1f\xbd\x93\xbf\xf1\x1e\x31\xb7\x2f\xbc\xc9\x0c\xa7\x14\x4e\x96\x29\xb3\x0b\xb7\xef\xec\xbd\xe7\xd6\xb5\xaf\xa1\x3a\xfc\x16\x53\x72\xe2\x4f\x2a\x76\xf2\xf3\xb7\x3e\xdf\x70\x2c\xa5\x4e\xd5\xf9\xcc\x26\x0c\xd1\x84\x0e\x04\xdf\xd7\xc3\xd8\xd1\x05\x27\x91\xbd\x77\xed\x37\x86\x16\x8d\x86\x50\xcb\xfa\xdb\x64\xa6\x88\x02\x18\x13\x20\xbf\xa9\x54\xbe\x41\xf3\xcf\xe0\x3a\x99\xcc\x30\xfa\xf4\x12\x14\xac\x0e\x1e\x41\xe1\xd4\x8a\xaf\x6b\xda\x35\xf0\xba\x3e\x7b\x27\xaf\xff\xf6\x05\x23\x1e\xea\x8a\xa7\xd3\x35\xb2\x10\x3e\xa6\x04\x09\x56\x4c\x12\x10\x51\x53\x44\x28\x2d\xf4\x11\x30\xc2\x6c\xc4\x8e\x29\x09\x43\x07\x56\x24\xb1\xd7\xaf\xe1\xc7\x60\xbf\x32\x59\x5e\x15\x77\xfd\x42\xfa\xe2\x81\x36\xc0\x38\x51\xb4\xd0\xa0\xa4\x21\x7e\x6e\x9b\x23\x46\x18\x5c\x54\x61\x66\x20\x14\x00\x5a\x82\xd4\x84\xae\x5b\x70\x5b\xf1\x25\xf3\xc9\xec\x96\x94\xa4\x0a\x19\x72\x1c\xc5\xf5\x27\x03\x03\x6f\x9e\x94\x6a\xd2\x78\xcb\x4f\x9c\x9b\xa2\x97\xa0\x64\x99\x91\x17\x0e\xc7\x34\xcd\xd2\xb4\xeb\x3b\x4c\xa0\xaf\xbe\x5a\x50\x24\x89\x22\xd9\xf8\x3d\xa0\x08\x56\x6a\x24\x53\x06\xd8\x50\x2f\xd9\x6d\x4c\x6f\xe2\x96\xe9\x03\x02\x67\xe4\x2d\xfe\xc6\x1c\x89\xe8\x40\xbf\x73\xfe\x6c\x30\xd1\x53\xa2\xd3\xec\xc2\x17\xb4\x36\x18\x87\xcf\x4f\xd6\xc3\xaa\x3b\xc8\x0f\x3d\x13\x1a\x96\xad\xf8\x39\xab\xeb\x50\xc7\x22\x33\xaa\x5f\x93\x23\x41\xeb\xe9\x17\xfc\xaf\x6f\x1f\xc4\xf5\x2b\xb2\x6f\x46\x7a\x84\x44\x31\x43\xa9\x32\x1e\xfc\xee\x8f\x7d\xde\xf6\x95\xb7\xc1\x37\x15\xaa\xb7\x08\xf7\xc3\xd2\xb9\x27\x11\xef\xa5\xc5\x35\x37\xb0\x1c\x7a\x68\xd8\x8a\x3a\x9f\x1f\x6f\xe1\xcf\x87\x7d\x80\x90\x2d\x18\xf0\x68\x93\x36\x1e\x15\xbe\x73\xbe\x73\x22\xa6\x93\x36\x8b\xc7\x9c\x6a\x4d\xff\x24\xaa\xda\xd6\xcb\x11\x7d\x07\x01\xfd\xbc\xbf\xac\x7d\x27\x4d\x9f\x88\x61\xd0\x27\xcc\x4d\x96\x06\x97\x20\xd9\x38\xcc\x2e\x9f\x73\x63\xb5\x23\x9a\x80\x95\xd1\x94\x5e\x2d\x5d\x34\xee\xe3\x50\x56\xf7\x8d\xc1\xb6\x4c\x5e\x35\xf9\xa2\x68\x21\xe3\x7d\x47\xb8\xaa\xe3\xb2\x58\x7e\x9a\xdc\x41\xe4\x9f\x89\x84\x23\x4d\xf4\xd5\x09\xba\x7d\xf6\xae\x36\x18\xc7\xcf\x75\xdd\x1c\xca\x70\x3c\x83\x77\xd0\x92\x5b\x7d\x6b\x24\x61\x00\x00\xe3\xc3\x54\x70\xbb\xe7\x98\xf3\xc3\xd5\x9d\x8c\x5b\xa3\x42\xc4\x73\x7b\x69\x18\xf8\x1b\xdf\x48\x31\x26\xe3\x06\x9b\x93\x5d\xec\x43\x2c\x2d\x6b\x3a\x6a\x4c\xac\x69\x22\xdc\xeb\xc3\x70\x66\x2d\xa6\x48\x61\x1f\x29\x8b\x9e\x08\xcc\x0f\xd6\x5c\x1e\xfd\x34\x40\x75\x11\x42\xfb\xcd\xaf\x68\x7b\xe2\x51\x69\xe4\xce\x7a\x68\x85\xb4\xf0\x08\xe1\xb8\x3b\x17\x9a\x67\xb1\x97\xdf\xdf\x97\x31\xbb\xb2\x58\x3e\xa3\x6c\xbb\x81\xe8\xb6\xed\x07\x77\x5c\x41\xf1\x7c\x48\x2c\x56\x84\x28\xce\x01\x21\x8a\x1a\x66\x7a\x43\x73\xa1\xf5\x88\x36\x18\xc7\xf2\xd6\x3f\xb5\x15\x27\x2f\xe6\xcd\xcb\x36\xf5\xf0\xd2\xeb\x05\x86\xb6\x79\xe6\xce\x09\x33\x1b\x9d\x6e\x50\xaa\x46\x7c\x0a\xbe\x4f\x44\x67\xa4\x4a\xb0\x14\xe7\x9c\xd4\x84\xf6\x46\x8b\xf3\x8e\x45\xe7\xb3\xc8\x92\x8a\x45\x6c\xea\x3d\x65\xf0\xec\x41\x93\x87\x41\xbf\x08\xf4\xcf\x98\xc6\x72\x5a\xf0\x65\x75\xc7\xe8\xb9\x03\x47\x7e\x68\x43\xb4\x42\x3a\x6d\x54\x2c\xb2\x88\xfe\x49\x4c\x86\xbe\xcb\x75\xd8\xf1\xef\xa1\x79\xfe\x43\xee\xda\xc2\x7f\x59\x5e\xf4\x8a\x73\xf2\x89\x02\xc0\x76\x8a\xbf\xf5\x1b\xe5\x4a\x71\xfb\x12\xad\x11\x7c\xd5\x0c\x95\xc3\x4c\xef\x2d\x0f\xa2\xa4\x99\x94\x1c\x47\xd3\xeb\x0b\x85\x69\x4d\x37\xf3\x6d\xc8\xd5\x16\x31\x89\x8b\xfb\xfc\x78\x9c\xd4\x75\x4b\x74\xfa\x64\x9d\xfb\xde\x87\x0d\xef\xaf\x49\xbb\xa2\xb5\xe8\xa9\xa6\xe6\x62\x88\x33\x2c\x26\x16\x1c\x8a\x1b\x4b\x99\x1e\x04\x58\x22\xab\x8b\x68\x2b\xc8\xf8\x7c\xb2\xe8\x7c\xca\x8e\xaa\xfd\x6a\x32\x1b\xb1\xdd\x53\x91\x10\x66\x19\x47\x38\x3f\xd1\xc6\x4c\x39\x58\x59\x6d\x4d\x65\xdb\x41\x38\xb2\xeb\x38\xf2\x77\xf1\x0d\xc5\xf5\x87\x22\x72\x60\xa5\x0a\x04\xbb\x52\x44\xe8\xd0\x5c\x7f\x50\x9c\xf3\x93\xad\xb0\x32\x15\xe9\xcf\xe7\x50\x47\xdd\x37\xf5\xab\x0f\xaf\x8e\x1e\xf0\x47\xe6\xfb\x41\x57\x0e\x4b\x7d\xea\xed\x84\x0f\xcf\x37\xe8\xa7\x5c\x56\xce\x16\xcd\xca\x9a\x70\xe0\xd8\xdc\x28\xdc\x05\x9f\x99\x0f\xa0\x0b\xd8\xf5\x58\xd5\xc6\xfe\x52\xf4\x0c\x4e\x80\xad\x24\xca\x6c\x28\x6b\x31\x39\x9f\x7c\xf0\xeb\x3e\x67\x04\xd3\x4e\x85\x46\x6d\xe7\x75\x1b\x70\xca\x5d\x2d\xe7\xaf\xaf\xed\x28\xc5\x6d\xe3\x6f\x42\x8b\xe5\xac\xb9\x47\x6a\x0c\x74\xf3\x63\xe6\x00\x74\x0e\xd0\x91\x4e\x6b\x2a\xcc\xac\x3c\xa8\xa6\x58\x4c\x09\x51\x1f\xbd\xdf\xd4\x9d\x0d\xa9\x6c\x8d\x78\xa3\xd2\xc6\x43\x12\x09\x68\xc4\xe8\x9e\x0f\x71\xf4\xad\x5b\x12\x57\xd7\x83\xbf\x04\xbb\xac\x24\x61\xd0\x63\xa6\x37\x32\x59\x36\xe4\xd3\xf2\xe4\x9e\x38\xc8\xb0\x8d\x2b\xf2\x3e\x29\x8f\x3d\x95\x45\x32\xe8\x4c\x7b\x89\xbe\xc0\xcc\xa3\x04\x82\x60\x90\xff\xb2\xdc\x40\x8e\x93\xb7\xcc\x02\x5d\xef\x78\x61\xe2\xbb\xbd\xef\x46\xbb\xdb\xb1\x25\xd5\xdf\x4f\x8c\x18\x9d\x9a\x88\x7d\xd1\xd7\xbe\x1b\x25\x48\x71\x70\x69\x3b\xf2\xf1\xc6\x34\x9c\x9d\x0a\x4a\xc3\x6b\xcd\xcf\x13\x79\xdb\x76\x5a\x32\x4c\xc6\x64\xaa\x67\xc5\xf4\x7a\x64\x41\xca\x89\x02\x2b\xb0\xf1\xbf\xa4\xc7\xfb\xac\xa2\x10\x09\x3d\xf7\xb5\xcd\xd2\x85\x34\x0f\x0b\x50\x79\x30\x56\x09\x8c\x63\xf8\x01\x63\x43\x06\x0b\xcd\x93\xda\x08\x6f\xfe\x31\xee\x5d\x63\x09\xb6\x18\x97\x45\xf5\xc4\x40\x9b\x9d\x44\x16\xf8\xfe\xc7\xc2\xb3\xfa\x4b\x4e\x3c\x1d\xec\x78\x65\x5b\x92\x8a\x5d\xa6\xc8\x25\x88\xd2\xce\xc3\xb2\x05\x43\x12\x42\xe2\x37\x0a\xe0\x5c\x9a\x62\x7e\x81\xe9\x10\x93\xe7\x31\xee\x34\xb1\x5f\x54\x17\x3a\x14\x97\x24\xda\x7a\x16\xe7\x48\xc3\x3c\x35\xf1\xb8\xb5\xf8\x6c\xc5\x15\xc1\x74\xdc\xf4\xf8\xb4\x7e\xbf\x51\xab\x2a\xb3\x2f\xd0\x36\xa2\x67\x59\xb8\x8e\x9a\x51\x8b\x8a\x3c\x4f\x1c\xea\xd5\xa8\xa9\x33\x45\xef\x77\x10\x5e\xe8\x13\xb9\xad\xdd\x2c\xd6\xbe\xb6\xe4\x44\x79\x79\xf3\x8b\x91\xe0\xf5\xd9\xe0\xf4\x48\xf7\xd0\x6e\xd9\x98\xe9\xc2\xb1\xde\xf4\xaa\xba\x10\xa6\xa4\x52\x49\x2f\xde\x7c\x92\xe7\x22\x51\x70\x39\xe0\xfb\xd9\xed\xfe\x04\xbb\x78\x7c\xd7\x8d\x94\x47\x9f\xa6\xc2\x0f\x68\xa8\x9d\x47\xe5\x29\x25\xae\xc1\x91\x63\x0b\x78\xff\x93\x61\xd4\x49\x88\x5f\x3d\x26\x3a\x2b\xda\xa0\x5d\x73\xa1\x8f\x36\xbc\x0b\x7c\x9f\x19\x32\x72\xf0\x91\x85\x93\x77\x7c\x98\x63\x57\x58\x7b\xf4\x5d\x41\xbe\x30\x3a\x96\xee\xee\x94\x64\xb9\xd1\x95\xfe\x50\x5c\x57\x84\xcd\x7b\x55\x9d\x59\x32\x55\x36\x1e\x95\x3a\x37\x25\x95\xf3\xf0\xe0\x3e\x0b\xca\x1c\xbd\x78\x8f\x34\x38\x6e\xae\xda\xe9\x17\x5a\xca\x32\x0a\x00\x02\x5f\x9c\x7d\xcf\xe6\xce\x62\xf0\xa3\xe0\x9b\x23\xe2\x8d\xe4\x8a\x25\xe8\xec\x4d\x59\xc9\xc7\xff\x5d\xc7\xb1\x2e\x04\xc8\xe9\xdf\xe4\xf2\xae\x0f\x05\xea\xaf\xfa\x6d\xee\x50\x1a\x58\xbb\x96\x51\x4b\x40\x7f\x5e\x4e\x56\xd2\x57\x5f\xc6\x7d\xf5\x67\x46\x60\x17\x9a\x68\x5f\xe5\xde\x55\x76\xe4\x6d\x4a\x7b\x00\x0b\x36\xd1\x59\x55\x3c\x06\x2a\xc4\xaf\x51\xc9\xb2\xed\x30\x9e\x0f\x1b\x6f\xfe\xd1\x79\x3b\x52\x9e\xbc\x6d\xe0\x46\x9a\x9b\x02\xcb\x78\x0c\x34\x8e\x4c\x7c\x6a\x07\x56\x18\x3b\x16\x64\xc5\x54\x6e\x53\x75\x10\xb7\x17\x18\xa3\xa3\x07\x61\x4e\x1a\x19\x4a\x75\x91\x5b\xa0\x55\x46\x0c\x2e\xae\xb8\x38\xb3\x12\x89\xc6\xa4\x9f\xa6\xbc\x61\xfa\x22\xe1\x63\xcf\x75\x45\x32\x79\x89\x45\x2c\xae\x7d\x6d\x0d\x64\xa2\x84\x56\xb7\x33\xa3\x4f\x57\x55\x18\xdc\xc2\x00\xae\x26\xcd\xcc\x10\x09\x26\x0b\x83\x31\x99\x16\xe2\x17\x44\x22\xbf\x3b\x87\x99\x65\xb3\x7d\x93\x9f\xd4\x42\x2f\xcd\x45\x8c\x56\x6e\x6b\x28\x6c\xeb\xd2\x20\x23\x8b\x3c\x6a\x5d\x69\x74\x65\xb1\x14\xc6\x92\xb5\xb6\x9d\xcb\x8e\xac\x86\xfc\x14\xc5\x6b\x43\x81\x89\x04\x23\x98\x2a\x3f\x07\x91\x57\x7a\x28\xf3\x23\x09\x8d\x40\xb2\x40\x4c\xa4\x08\xfc\x62\x29\x8a\xb8\xa2\x4b\xa0\x2d\x08\x51\x5c\x6a\x22\xf0\x50\x9a\x3a\xee\xea\x13\xc5\xf9\xcc\xeb\xba\xb5\x39\xd7\x32\x75\xcc\xbf\xc9\xfe\xde\x94\xe5\xf9\x10\xa5\x6f\x6a\xbb\xe0\x64\xa9\x09\x9f\xec\xf8\x1b\xab\xf9\xc7\x78\x47\x7c\x5d\x94\xc4\x52\x12\x4e\x49\x5a\xea\x01\x9a\x9b\xac\xaa\x53\xe3\xd9\xf0\xd8\xd2\x37\x28\x6a\x1f\xe3\xca\x8d\xbf\xe7\x9a\x75\x9f\xd0\xfd\xee\x99\x44\x1b\xa8\xcb\xce\x2d\xd4\xf6\xe4\xf4\x17\x0d\xfa\xa7\xb0\x7e\x86\x31\x75\xc3\xbb\xf4\x67\xa4\x5d\x4c\xf5\xdd\x33\x31\x1b\xb1\x53\x76\xf9\x46\x56\xe5\x57\xef\xe4\x47\x69\xe3\xd1\xf4\x46\x6c\x55\xdb\xf9\xf8\x59\xd9\xba\xae\xd7\x86\x95\xea\x12\xae\x90\x38\xac\xe9\xba\xc8\x8a\xa0\x55\x4e\x59\x23\x89\x52\xd0\x6d\x0c\x58\x0a\x89\x62\xcd\x0d\x65\xce\x94\x5a\x02\x63\xf7\xb2\x63\xab\x30\x8e\x15\x0f\x62\x3f\x0a\x84\xa4\x0a\x63\xab\x43\x45\xd5\x69\x38\x2c\xa5\xd4\xa0\xbe\xc0\xda\x63\x32\x24\xed\x07\x2f\x2b\x0e\x07\x3d\x8c\x04\x7e\xb0\xac\x00\x40\x5b\xd0\x1f\x37\x81\xc6\x70\x58\x7e\x67\x1f\xdf\x3a\x34\x10\x5b\x82\xf1\x50\x89\x2f\xab\xda\xc6\xd6\x07\x04\x2e\x10\x04\xce\xcf\x8b\x91\x07\x05\x00\x38\x2c\x70\x3d\xfc\x62\xfb\x83\x15\xe5\x19\xea\x63\xd9\xaa\xf4\xba\xba\x96\xb7\x23\x91\x85\x53\x66\xdf\x39\x28\xe1\xc3\x54\x11\x15\x79\x73\xf0\xc7\xef\xde\x80\x56\xf1\x09\xe0\xc6\x55\x97\x5d\x63\xef\x63\x4b\xc3\x17\x28\xbd\x8f\x4b\x0a\x8d\xb3\x28\x1e\x44\x56\x3a\x92\xe8\xe5\xb4\xe1\xe0\xf5\x21\x7e\x79\x7f\xd1\xbf\x52\xce\x3c\xd7\xba\x2e\xed\x1e\x34\xbf\xfc\xa3\x7e\x71\x32\x37\x27\x7b\x36\xb9\x0e\x4d\x3f\x09\x24\x6f\x08\xd4\x62\x78\x29\x0d\x62\x11\x08\x45\x44\x01\x44\x61\x12\x32\xb1\xa4\x8c\x2d\x13\xc5\xf9\x29\x37\x67\x86\x8a\x33\x3b\xff\x7e\x08\x06\x3e\x97\xeb\xd1\xe9\x13\xae\x46\x4d\x8b\x41\x2f\x91\x28\xce\x59\x9a\xbd\x2e\xcd\xb2\x50\x68\xe8\x7c\xc5\x8d\x30\x22\x3a\xf4\xd4\x6e\x02\x3c\x59\x26\x72\x1d\x47\x91\x4b\xfa\xfb\xb1\xa3\x1e\xdc\x7f\xf5\x2b\xd0\xd1\x0a\xa7\xc7\xdf\x3e\x1d\x33\x62\x78\xc1\x37\xff\x28\x74\x63\x63\x1e\x38\x6b\xe6\x4b\xa2\xba\xc4\xa1\x8f\xd1\x15\x00\x58\xdd\xa4\x6b\xf4\x7f\x7b\xfc\xf1\xfb\xdf\xdc\xe0\xaa\xb6\xe7\xb6\xe2\x8b\xea\xf6\x57\x87\x02\xfc\x19\x69\xe3\x27\x3d\xc4\x29\x3f\x25\xa9\x38\xa7\xa0\xd3\x99\x3a\xd2\x60\x82\x7c\x1a\x63\x4c\x55\x99\x9e\xb6\x93\x30\xe1\x2f\xfa\x00\xd1\x13\xe0\x54\x59\x95\xd1\x45\xc7\x33\x62\x87\xc1\x80\xe5\x2c\x43\x83\xd5\xf9\xf1\x0e\x84\x30\xda\x48\x83\x29\x04\x37\xd8\xc6\x71\x28\xc9\xcf\x9c\x0f\x41\x61\x49\x18\x4c\xda\xd0\x42\x9c\xe8\x7e\xbf\x92\x0a\xcc\xab\xd0\x7e\xb2\xe5\x57\xe8\x08\x4d\xdb\xba\x29\x79\x73\xff\xb7\xfe\xb4\xdc\x23\x81\xf1\xfc\x0d\x06\xc0\x71\x99\xd3\x33\xf8\x96\x41\x10\xc1\x47\x79\x64\x46\xca\xb0\xda\x33\xaf\x75\x3f\xf9\xbc\xed\x2d\xd0\x1a\x6a\x11\x4b\x44\xb6\x06\x1a\xa4\xc9\x31\x35\x85\x53\x25\x00\x56\x17\x0c\x10\x37\xce\xd9\x61\x49\x94\x57\x78\x2c\x69\xdc\xd7\xcf\xdf\xab\x7b\x47\x1f\x89\xa7\x0f\x58\x1d\x2a\x75\x30\x6e\x68\x4b\x2c\xbf\xcb\xdd\x7d\x6f\x4c\x6f\xe3\xd1\x69\x53\x6f\x2d\x7d\x17\x69\xdb\x94\xe7\x07\xb8\x88\x82\xee\x8b\xe2\x02\x45\x72\x31\xa2\xb5\xc0\x35\xa0\x1c\xe8\x25\xc2\x4f\x4b\x1a\x34\x97\xea\x96\x66\x68\x5b\x48\x25\x3a\x15\xb2\x56\x0e\x5b\x2c\x3f\x42\x32\x69\x77\xdb\x66\xef\x08\x04\xc1\xb4\x3c\x61\xa4\x28\xa2\xe2\xd3\xdc\x68\xa9\xc8\x09\x29\xd7\x67\xf7\x2b\x2e\x02\x80\x80\xc2\xbc\x22\x77\xf1\xcf\xca\xfa\x80\x21\xb0\x1e\xa9\x40\xb1\xbc\xab\xcb\x77\x66\x9c\x48\x96\x7b\x91\x3d\xfe\x74\x3c\xc3\xb3\x78\x8d\x34\x18\x60\x3b\x13\x03\xaa\x09\xfb\xfb\x3c\xae\x91\x23\x01\xa8\x0e\xc2\x46\xca\xae\x7c\x77\xc2\x88\x3f\xa1\x40\x55\xda\x37\xd5\xd0\x10\x1b\x93\x36\x86\x56\x4d\x4a\x08\xb6\xb0\xa6\xcd\x05\xda\x4f\x2a\x2a\x93\xa3\xa8\xf2\xca\x86\x76\x4f\xdf\x04\x07\x82\xd7\x61\xd1\x20\x84\x7c\x73\x53\xe2\x20\x1e\x2e\x1e\x3c\x5a\xc9\xa0\xf1\xcd\xda\x97\x17\x61\x7e\x31\x94\xba\x36\x1c\xc8\x23\xe1\x1b\x12\xc9\x7e\x46\xd1\x36\x6c\x08\x55\xb9\x57\xd6\xc9\xa3\x92\x47\x0e\x74\x68\xea\x23\x76\x5f\x94\x34\x56\x38\x05\xc0\xfc\x4c\xcd\x6b\xfb\x92\xa1\x70\x65\x28\x59\x5a\x18\x09\xe4\x57\x48\xa4\x3a\xa0\x9d\x35\xc0\x51\xb3\x73\x29\x14\x70\xb9\x0a\x60\xed\x95\x3e\xba\xf2\xd1\x64\x3a\x3f\x0f\x3d\xa6\xb7\x4e\xcc\x34\x3f\x42\x4f\x45\xdb\x14\xb6\x45\x8c\x47\xae\x81\x70\x81\xf6\x9d\x5f\x5d\x59\x5d\x7d\xdb\x06\x6c\x64\x7f\xfd\xfa\x85\xe9\x7b\x56\xef\x97\xc4\x0b\xb3\x2b\xaf\x68\xf7\xf8\x46\x05\xf2\x05\x76\x7c\xa4\x69\x87\xd9\xae\x5b\x65\x4c\xc2\xec\x37\xdc\x98\xd3\xe9\x63\x71\x54\x8b\x79\x5a\xd6\x54\x1c\x6a\x75\x32\xdb\xaf\xea\xa4\xda\xcf\xeb\x2b\xf0\x83\x17\xee\xa2\xa0\x42\x21\x44\xc1\xa7\xb8\xa6\x0f\xc1\xd4\x77\x4c\x9a\x6f\xb2\x03\x93\xb7\x40\x85\xe8\x5c\x1b\x01\xe0\xe7\x46\x56\x04\x05\x3c\x3b\x5a\x16\x55\x08\x77\x18\x4a\x8a\xdc\xf0\x2c\x3e\x55\x5e\xde\x4d\x5e\xb2\xca\xdd\xd4\xa5\x76\xdb\x07\x87\xfc\xb7\xef\xc9\x75\x48\xb1\xfc\x74\x14\xe4\x39\x85\xbf\xc5\xea\x86\x61\xc2\x74\xf7\x63\x3b\xb6\x2d\xf7\x50\x15\x4a\xa6\xae\x22\x98\x72\xf9\x5a\xae\x5b\x06\x32\xaf\x8f\x3d\xff\x68\x15\x6e\x7e\x97\xdc\xf1\x53\x31\xb0\x03\x65\x6e\xb6\x16\x3d\x39\x8c\x02\x5a\x5a\x8e\x47\xa3\xcb\x01\x89\x65\xb2\x60\x1b\x7e\xb9\x1e\x86\xf3\xc8\x64\x3a\xed\x4b\xcb\x82\xa2\x0a\x7b\x31\xed\x3f\xdf\x59\x89\xca\x3b\x66\x5c\x4c\xfa\x5e\x67\x56\xe3\xfa\x10\xd8\x1d\x3c\xb6\x7b\x45\x1b\xec\x76\x46\xa1\x3e\xb4\xdb\xc6\x0e\x2b\xc7\x72\xfd\xab\x09\x60\x96\xe3\x66\x81\x80\xaa\xda\x50\xb2\xd4\xe5\x7a\xef\x06\x72\x08\xb7\x60\x40\xf0\x42\x9c\xb8\xa6\x86\x8b\xf3\x93\x1a\xed\xbc\xb9\x4f\x4b\x2f\x8f\x9a\xae\x6b\x3b\x43\x86\xe1\x65\x08\x78\x1d\x08\x51\x32\x75\xfc\x4b\x03\xbf\x94\x25\xae\x70\x46\x1d\x4c\x83\x5b\x06\x52\x99\x92\xd8\x75\x11\x71\x20\x59\x94\x20\xd4\xd2\xbf\xc9\x93\x58\xd3\xf1\x2c\xfe\x53\x68\x2e\xb4\x7b\x3c\x66\x51\x00\x58\x6c\x29\x19\xd6\x33\xd2\xe8\x00\xeb\x4f\xca\xd7\xb8\x80\xec\x8b\x91\x2f\xad\x43\x70\x77\xf5\x34\x59\x59\x4d\x1d\x8b\x2c\x2d\xdb\x6b\xe5\x51\xc0\x9b\xd4\x80\x81\x86\xc3\x9c\x6a\xea\xa0\x7f\x0d\xcf\x37\x38\x14\x88\x2e\xbd\x5b\x58\x3a\x18\xfd\x2f\x42\x50\x28\xd5\xab\x59\xf8\xea\xef\x8e\xed\x48\xb6\x70\xb8\xf4\xd7\xe9\x48\x7e\x86\x2e\x86\x8d\x38\xe4\x9b\x32\x42\x60\xee\xb5\x21\x17\x92\xed\xaf\x91\x85\xc3\xd2\xe4\x4a\x37\xe3\x61\xbb\x2f\x31\x53\xeb\xcc\xc2\xea\x05\xb7\x15\x88\xd1\xc4\xea\x12\x9d\x08\x51\x5e\x50\x51\xfa\x5b\x0a\x1f\x0b\x84\x17\x06\x87\xd1\x6c\x23\x2f\x6a\xbb\x3d\xd4\x15\x97\xd6\xf4\x45\x7f\x7e\x97\x4b\xcc\x1d\x0c\x59\x88\x1b\x62\x07\x27\xc7\x2f\x64\xb4\xe8\x21\x76\xce\x6f\x54\x44\x1b\x05\x4c\x01\x59\x69\x5f\x5b\x65\x6e\x2c\x6f\xbd\x59\x6e\xc9\x8b\x00\xb3\x03\x41\xb6\x7d\xfd\x20\xad\x5e\xf2\x53\xef\x37\xe1\x26\xd4\x0c\xf0\xfa\x88\xd1\xa2\xb3\x6a\x30\x31\x81\xb1\xf9\xc4\xdc\x99\xf8\xdd\x79\xbb\xf2\x20\x8a\x95\x5b\xb9\x91\x3d\xfe\x7b\x19\x7a\x2f\x76\x50\xae\xb1\x77\x67\x83\x16\xbd\xba\x3c\xbd\x0a\xec\x37\xde\x69\xab\x4a\xa1\x42\xfa\xde\x68\x6e\x29\x3c\x62\xfe\xfc\x7e\x53\x9f\x1d\x95\x4d\x7e\x6e\xbb\x2f\x5d\xfe\xad\xeb\x1b\x12\xd7\x4f\xc0\x2c\xce\x0c\xae\xd0\xe2\x4e\x4d\xfe\xc6\xbe\x56\x3e\x7b\x27\x2f\xaa\x3f\x74\x97\x6f\xa1\x15\x47\xdc\xb3\xe8\xdf\xa2\x74\x03\xd6\x7b\x93\x97\xc0\x7b\x21\xd4\xc8\x8f\x72\x63\xf9\x6b\x03\x66\xbd\xc1\x32\x66\x29\xd4\xf9\x26\x3f\x27\xe7\xbf\x71\xe8\xac\x95\xe7\x61\x10\x4e\xfd\x78\x18\xc2\x0b\x73\x11\xfd\x86\x02\x7f\x88\x23\x3f\x74\x77\xa2\x5d\x63\x56\x3a\xdb\xce\xab\x52\x5e\xd3\x57\x71\x36\xf0\x60\xa0\x8f\x7a\x51\x9e\x1f\xa8\x32\x9e\x96\x91\x3a\x24\xdb\x2e\xb9\xac\xf7\xb8\x89\x31\xce\xf6\xdf\xfc\xd6\x4e\xb0\x37\xcd\xc4\xb6\xfa\x9c\xdb\xef\x7c\x76\xa4\xb2\xde\x65\xff\x64\xe0\xf4\x60\x2e\x55\x5d\x8b\x23\x2b\xca\x5c\x89\x68\x94\x9a\xd1\x85\x6c\x9a\xf9\xea\xe8\xda\x72\x74\xd2\x41\xa9\x22\x38\x7e\x20\x24\x56\x29\xc7\x45\x1b\xeb\xa1\x45\xf3\x14\x8e\xe5\x48\x29\xb0\x8c\xcc\xdb\x6e\x62\x4d\xeb\x5b\xd4\xec\x5c\x38\xb8\xde\xae\x59\x8a\x1c\x63\x65\x4d\x17\x57\x96\xd2\x82\x1f\x1d\x5e\xfb\x76\x68\x4a\x1d\xac\x56\xbc\x1b\x7b\x6c\x66\xe7\xb0\x09\x29\x18\x0a\x56\x2f\x36\x43\x2c\x7b\xa7\xcc\x0c\x54\x52\x43\x56\xda\x9b\x66\xd5\x97\x9d\x33\xce\x81\x1d\x52\x9f\x5e\xf9\xd6\xe5\x56\x6d\x9b\xf9\x93\x35\xdf\xaf\xb3\x67\x1d\x59\xe0\x54\x89\x57\x65\x9f\xd9\x93\xa1\x15\xb7\xfd\x65\xf9\x1b\x10\xff\xf6\x50\x27\xce\xac\xc7\x18\x3b\x12\x90\xe3\xa1\x69\x06\xac\x3e\x54\x9d\xd3\x12\x0a\x5c\xf3\x19\x12\x89\x4e\x92\x66\x2d\xcb\xe3\xbc\xaa\x8d\x33\x43\xb1\x21\x79\x1b\x84\x64\xc6\x24\x35\x68\x79\x20\x60\x05\x5e\x55\x55\xbd\x10\x69\x19\xce\x86\xbe\x14\x4e\xa9\x7f\x3d\xb2\x24\xae\xf9\xb0\xf7\x2e\xfb\x1f\x06\x92\x38\x67\xed\x17\xbc\x94\x64\xbe\x81\x7f\xf2\x96\x52\xe5\x19\x69\xc3\x83\xed\x77\x32\x4a\x21\xf1\xb7\x65\xd7\x0c\x65\x69\xf3\x55\x4a\xc8\xe2\xe4\x85\x8d\x2f\x14\x16\x67\x0a\x21\xcb\xc0\xe2\x68\x92\xf9\x46\x6c\xea\x65\xc5\x89\x70\xae\x2b\x88\x5c\x73\xcf\x5a\x9a\x1b\xed\xe4\xb3\x68\xd2\x6c\x30\x4e\x89\x5d\x71\x4a\x6c\x0d\xc6\xe1\xb1\x9d\x9f\xea\xfa\x6c\x2b\x6e\x76\x1a\xde\xef\x43\xef\xb0\xd5\xb5\x06\xe6\xc4\xaf\x1f\x22\x09\x83\x01\x12\x92\x2d\x11\x1d\x3f\x1c\x56\x15\xfd\x34\x48\x71\xa5\x64\x59\x59\x34\x62\x66\xd6\x04\x51\x42\xff\xba\xc2\xd2\x07\xae\x1f\xf0\x4e\x29\xf3\xf4\xd0\x7e\x93\xf9\xe4\x19\x5a\xb4\x9f\xd0\xff\xea\xaf\x61\x4e\x1e\xe4\xb7\x7d\x10\x8b\xb6\x2a\x88\x09\xa7\x25\x3f\x16\x76\x66\x28\x65\x92\x64\x19\x96\x34\x0c\x89\xe2\xd4\x5c\xf4\x54\x56\x4a\x3a\x83\xdb\x94\x1f\x9f\x89\xb2\x05\x6a\xe3\x47\x6f\xcc\x13\xed\x9c\xfd\x53\x9f\xfa\xdc\x0e\x38\x9a\xd3\x7c\xe9\x41\x8d\x7c\x4a\x20\x78\xd5\x89\x1f\x8e\x50\xe4\xc5\x7b\xdf\xfa\xf6\x93\x1d\x37\x5a\xb2\x56\xf1\x29\x90\x6d\xc5\x77\xd8\xa2\x53\xb5\x98\x0d\x5b\xd5\xbe\x30\x4e\xa5\xb6\xec\x9b\xf4\xbe\x67\xf9\x47\x7a\x0f\x64\x8b\x56\xc1\x4f\x0c\xc0\x5e\xf7\x50\xe3\x59\xa7\x90\xaa\x0a\x04\x8b\x0f\x45\x56\x1a\x3d\xc1\x54\xe5\x5b\xd2\x8b\xea\xfe\x22\x92\x15\x48\x96\xc8\xf1\xc9\x3f\x05\xb4\x54\x59\xf6\xa4\xc2\xdc\xc7\x4e\x83\xa0\xb9\x2c\xa8\x8a\xaa\x77\xf2\xed\xf2\x5f\xfd\xdf\xe7\xf5\x0c\x56\x56\x53\xb2\x23\x05\x13\x4e\xb9\xef\x84\xf0\x9e\x19\xe9\x15\xc1\x54\x10\xa4\x91\xa8\x35\x28\x58\x75\x5f\x9c\x12\xeb\x48\xc0\x8a\xeb\xba\xa6\x91\x30\xc6\x97\xbd\x30\x1e\x2d\xa5\x46\x95\x67\xa0\xaa\x16\x5f\x5d\x22\x73\xea\x90\xec\xae\x6a\xf0\x1b\xa1\x2c\x7d\x1b\x5d\x3c\xaa\xeb\x8a\x9f\x0a\x1f\xfc\x32\x86\xb0\x4a\x42\x47\x38\x87\xde\xb2\x79\xf5\xfd\x31\xbc\x5d\xe6\x5f\x81\x8f\xb8\x9b\xd4\x61\xeb\x4e\x55\xa3\x5e\xc5\x38\x1a\xb0\x32\x3b\x10\xc2\x96\x45\xb6\x59\x0d\xec\xec\x66\xc0\x3c\xaf\x0b\xdf\xa2\x42\x3e\x1a\x44\xc3\x28\x00\x67\x7d\x3a\xf2\x56\x98\xed\x57\xb5\x5e\x53\x22\x54\xba\x18\xe6\x7d\x2b\xd2\x7a\x56\x69\xc4\xac\x00\x00\xc9\xb6\x57\x3a\x7d\xf6\x4d\xae\xab\x9f\x87\x68\xa9\x4f\xfa\x8d\x7c\xb4\xac\xab\x38\x86\xfa\x8d\xa1\x02\x3d\x9e\xc4\x9c\xd4\x36\x88\xb9\x52\x3d\xe6\x80\x97\xfb\xc1\xf4\x16\x14\xa8\xbc\x45\x7e\xf2\xa6\xd6\xb9\x05\x21\xa4\x0e\xa7\xc4\x71\x09\xd4\x0d\xb9\x7e\x7a\x09\x49\xb1\x8c\x5d\xc6\xb1\xa9\x8f\xde\xd9\x10\x43\x1f\x9c\xb1\x1c\x14\x69\x32\x0c\x24\x1a\x49\xe8\x8c\xd5\x0b\x03\x68\xce\xf2\xb9\x2d\x28\xae\xbf\x52\xe5\xe5\xcc\x06\xd3\xd1\xb0\xe1\x42\x9b\x0a\x5b\x84\x69\xec\x43\xd8\x30\x8a\x28\x67\x7f\xfb\x7c\x90\x22\x91\x20\xc5\xb0\x28\x99\xca\xcf\x2b\x54\x0b\xf4\x72\x7d\xb4\xae\xc1\xcd\x5a\x96\x9a\x7a\xec\xa9\xf1\x8a\x08\xe0\x37\x4e\x43\xe3\xb8\xfc\x16\xa6\xdb\x3e\x66\x75\xc5\xbe\x45\xf9\x89\x47\x66\x2f\xbe\x67\xf0\x00\x65\x65\x8c\xa9\x92\x26\x7a\x68\x8a\x3e\xdb\xee\x2b\x7e\xbe\x07\x51\x5d\x10\x9e\x5b\x7a\x4c\x1e\xdd\x69\x59\x53\x07\x65\x7a\xa8\xf2\x27\x71\x12\x57\x06\x51\xbe\xf7\x16\xd7\x07\x00\x07\xdb\x02\xdc\x83\x65\x5d\x5d\xf9\x5a\xac\x3f\x30\x41\xc3\xdd\xc9\x29\x9d\xc3\xee\x77\x89\x5a\x4d\x2f\x75\x7d\xba\x17\xbd\xf1\xcc\xc3\xed\x3d\xd2\x6a\x1b\xa1\x8a\xf8\x8d\xc9\x54\x4f\x0b\x73\x84\x0b\xcf\x57\x99\xd7\x7a\xf5\x9b\xb7\x2e\x98\xa0\xce\xbb\x29\x87\x4a\xfc\xee\x09\xfb\x79\x94\x28\xa0\x2a\xf6\xee\xe5\x4b\x7b\x48\x8f\xc7\x8c\x51\xf8\x71\x3c\x05\x88\xef\xc0\x68\xc3\x83\x0f\x76\xad\xfa\xb6\xb7\xb7\xae\x7b\x87\xe6\x5b\xa3\x37\x53\xc9\x63\x17\x6c\x07\x27\xeb\xec\x81\xf4\xbf\xfc\x6c\x71\x6f\x8e\x14\x7d\x02\xaf\x22\x72\x64\xe1\x2f\x7b\xcf\x5b\x0a\x07\xc1\x37\xbf\xbb\x42\xec\xdd\x44\xf7\x2b\xa5\xa8\x8b\x63\xf5\x07\xc6\x15\x3c\x20\x1a\xb0\x2a\x3a\x3f\xce\x45\xc2\x14\x67\xef\xd2\xf5\x21\xda\x56\xc5\x4e\xaf\x37\x8c\x50\x73\x58\x6d\x81\x71\xb2\x32\x0d\x50\x7f\xd5\xa7\x5f\x78\xa7\x40\x11\x50\x4a\xf9\xbc\x47\xbd\x0e\x80\x20\x12\xb4\x13\xa9\x47\x4b\xb1\x19\xf2\xda\x65\x17\x88\xbd\x09\x7a\xf2\x30\x5c\x17\x39\x9e\xc5\x47\x14\x95\x74\x9c\x65\xec\xd3\xed\x31\x2b\x30\x09\x40\xee\x50\x70\x22\xa3\xae\x46\x02\x43\x06\x7e\xbe\x13\xc7\x86\x88\xc6\x75\xab\x21\x54\x95\x5a\x56\xc2\xc3\xc6\xfb\xe3\x13\xb4\xc1\xe7\x99\xac\xac\x37\xf3\x02\xc8\xe1\x19\xf9\x1e\xe7\x20\xe6\x9e\x95\x86\xfb\xc4\xb5\x7b\x9a\x8e\x30\x28\x11\x9d\x63\x3e\x66\xc8\x6d\x22\x47\x76\xf9\x59\xe5\x6a\x5c\x7a\x1a\x1f\x9f\x00\xb0\x43\x1d\xde\xe1\x7b\x4e\x3c\x6e\x8e\xd9\x6f\x99\x66\x1c\x65\x68\x33\x84\xb4\xf0\xc7\x0c\x06\xac\xe4\xf4\xb5\xf9\x29\xf1\xf1\xf4\xf1\xd8\xfb\xf5\x2f\x2b\xf1\x06\x4d\x41\xe6\x06\x63\x04\x08\xe0\x67\xb7\xd8\x73\xa3\x30\xbd\x15\x6d\x30\x66\x36\x9d\x48\x4d\x29\x0f\xf5\x3f\xd6\xb1\xb3\x3d\x6b\x57\x15\x04\x2d\x55\xfb\x8f\xa6\xeb\xd8\x71\x94\x5b\xc2\x0f\xc4\x82\x9c\x96\xe4\x60\x72\x86\x1d\x98\x9c\x73\x7a\xfa\x2b\xf7\xfc\x57\x1a\xab\xad\x69\xb5\x65\xa0\xea\x0b\xe7\xd4\xa9\xda\xbb\x84\x9b\x1c\xff\xe2\x85\xdd\xf4\x7a\x82\x2e\xc6\x30\xd2\x7a\xa4\x3a\xf3\x37\x80\xa3\x9f\xb8\x87\xe6\x8f\x3b\x76\xc3\xf7\x39\x23\x92\x32\x61\xcc\xb4\x45\x77\x0f\x2b\x96\xf4\xa0\x5d\xc3\x4a\x9e\xf8\x4c\x7d\x71\x46\x03\x2a\x09\xca\x5d\x23\xda\x8e\x92\x78\xbc\x85\x85\x36\xe2\x74\x4e\x31\x0c\x27\xc5\x1e\xbf\xed\x5d\x61\xc9\xc7\x0a\x8f\xd2\x12\xfd\xbe\x07\xe6\x35\x40\xfc\xd5\x25\x40\xb8\x74\xb9\x7d\xe2\xef\xfe\x62\xd2\xcc\xab\x89\x04\x96\x67\x8f\x80\xe5\x88\x58\x72\x8b\x54\xa9\xc6\xbd\x1b\x7c\xc0\xe9\x1e\xae\x01\x01\x2a\xf4\xa8\x84\xdc\x14\x5d\x4e\xfe\xd7\x96\x26\xd9\x90\x2f\x4f\x67\xe6\xbd\x79\xa2\x92\x8e\x2f\x42\x8a\x9d\x60\x3b\x95\xee\xf4\xaa\xdb\xcd\x9f\x88\xa2\x82\x37\xdb\x01\x80\x0f\xed\xa3\xa2\xa4\xca\x6f\x72\x50\x08\x91\xcf\xac\x35\x98\xa9\x35\x9d\x78\x55\x42\xcf\xee\xa8\x09\xc5\x93\xf8\xd3\xd3\xa3\x67\x0c\x6d\x74\x90\x07\x5e\xe5\x71\xda\x2c\x1f\x64\x27\xbf\x1f\x91\xbb\x29\xaa\xf8\x30\x89\x97\x7e\x38\x9a\x1a\x0c\xe9\xcd\x3f\x6e\xb7\xfe\xf4\x0a\x93\xf5\xac\xdd\x89\xd5\x16\xad\xf5\xf5\xd3\xce\x11\x87\x47\x6d\xb8\x4b\x8d\x6f\x73\x0d\xa0\xda\x8b\xbf\x80\x8d\x9c\x08\x5d\x77\x0d\x05\x08\x02\x60\x42\x83\x60\x35\xb6\x95\x59\x43\xe6\x1a\x20\x29\x0a\x76\x38\x90\x97\xe7\x78\xe1\x26\xdf\xc6\xf7\x16\x4b\x9a\x48\x4e\xb9\xb6\x68\x0d\x75\x72\xb5\x7d\x9e\x28\x09\x9c\x58\xd2\x8e\xa3\x7a\x7d\x07\xd5\x1e\x14\x8e\x61\x1e\xfd\xef\xdc\x07\xd3\xbd\x40\xf4\xa0\x28\x44\xb0\xf2\xe5\xca\x2a\x9c\x07\xe1\x09\x0d\x25\x46\x99\x52\x85\xd2\xc3\x9b\xc0\xa6\x83\x6e\x48\x9b\x26\x42\xd7\xfb\x4b\x69\x45\x1b\x52\x58\x7c\x55\x0f\xbd\x14\x05\x70\x4e\x81\xd6\x6b\x78\x57\xc1\xad\x81\xe7\xc3\xba\x27\xdc\xe6\x9b\x03\x74\x11\xc1\x74\x19\x5a\x8b\x78\x01\x22\x44\xd2\x38\x8d\x7f\x97\xa0\x4b\x24\x27\xec\xe5\xdb\x5d\x02\x77\xd0\x86\xf8\x0e\x95\x5e\xe5\x3c\x06\x23\x0c\xb9\xbd\x32\xd9\xf2\x2e\xaa\x32\x0b\x07\xd9\xce\x91\x34\x10\x52\x24\xde\x3c\x12\xef\xaf\x2b\xc0\x0e\xc4\x90\xf6\x28\x34\xee\x13\x3a\xf7\x37\x92\x2f\xba\x2d\x3f\x93\x20\xf1\xd6\x08\x02\xab\x26\xd4\x7e\xda\x68\x7f\x3d\x20\x34\x17\x6a\xee\xef\xe9\xcd\x10\x01\xe7\x6d\x2c\x19\xb8\x2d\xdc\xa6\x07\x9e\x28\x09\x82\x59\x96\xc1\x0b\xb8\xda\x54\x69\x4d\x4e\x2f\x47\xe5\x86\x08\xb2\xcf\xb8\xfe\xa0\x6e\x1f\x51\x0c\x6a\x67\x6c\xfb\xd7\x59\x11\x3a\x41\x82\xbe\xca\xb5\x8f\xc1\x2e\x3b\x1d\x91\x23\xe9\xec\x46\xc3\xd9\xfa\x45\x6b\x1e\x84\x94\xfa\x20\x48\xbe\x35\xda\x04\xfa\x45\x0d\x32\x77\x67\x1f\x02\x4a\xab\x47\xf0\xef\xf0\x8b\x7b\xc4\x68\x63\x78\x36\xd8\x45\xbe\xae\xce\xe4\x47\x12\xa7\x86\xc0\xf1\x1d\xb1\xd7\xf3\x20\xd1\x99\x67\x5d\xd2\x51\x7d\xb6\x85\x28\xad\xe1\xa9\xea\xba\xd9\xe8\x11\xa1\xcf\x84\xb5\x7d\x19\x25\x77\xd4\x13\x22\xb0\xc7\x0e\xb5\x19\x44\x73\xb7\xb1\xaf\xc9\x7b\x08\xf2\xe3\x46\xde\xf6\xc3\x9e\xda\x77\x74\x0f\x14\x23\x9b\x11\xd2\x5e\x53\x0b\xf5\xa6\xce\x98\xb2\x0c\x02\xec\x0f\xae\x5c\x3c\xb7\xcd\x9f\x60\x78\x12\xa6\xbf\x87\x6f\xd2\xe9\xeb\x8e\x92\x37\x96\xcb\x29\x7c\x6d\xfd\x75\xb4\xd8\xa1\x85\x24\x4e\x1d\xa9\xd9\x2a\x78\xa9\xb1\x4e\x37\xda\xdd\xcf\xed\x68\xcd\xb2\x2c\xcb\xc7\x92\xba\xdc\xb5\x5d\x37\x08\x02\xf8\x5b\x58\x32\x58\xee\xc8\x5f\x93\x0f\xa0\xf9\x24\xb0\x39\xff\x82\xcc\xf2\x7e\xee\xee\x67\x01\x7a\xbc\x08\x3a\x9a\x23\x02\xb7\xa5\x29\x40\x63\x37\x24\x53\xca\xf2\x6c\x6a\x27\x0a\xe5\x9f\x4e\xda\x69\x1a\xe0\xa8\x74\x9e\x89\x12\xfd\x3d\x07\xa7\x03\xb3\x03\xfd\x9e\x35\x65\xb0\x7e\xee\x95\x28\x4e\xe9\x18\x00\x0a\x8d\x63\xb1\x92\x99\x19\x03\xf1\x6d\xbb\x7e\x42\x95\x40\xe8\xe0\xbc\x8c\x32\x7a\x81\xdc\x42\x66\x65\x4f\xed\x1a\xfe\xa3\x26\xb1\x5c\xde\x98\x22\x72\xd8\x63\xb4\xb1\xc4\x3a\x0d\x63\xff\x6d\x76\xfd\xf0\xea\xf9\x38\xae\x09\xe3\xa8\xdb\xa5\x8e\x4b\x44\xbf\x7f\x3f\x72\x11\x9e\xdc\x6c\xd9\xea\x66\xe6\x59\xc6\xec\x8f\x7c\x85\x2c\x44\xec\xce\x5f\x7f\x9c\x99\x9b\xd8\x39\xd0\x66\x34\xdb\x51\xb2\x78\xb7\x58\x03\xd0\x6c\x87\x5d\xf0\x30\x40\x10\x84\xd0\x82\xa9\x1d\xf1\xaf\x6f\x3f\xf5\x26\x77\x1c\x88\x25\x8a\xa2\x28\xd5\x3d\xf1\x59\x96\x20\x95\x2f\xaa\x41\xd0\xc7\xad\x76\xbb\x73\xc8\x38\xd3\x74\x87\xf3\x00\x19\x4e\x03\x58\x3b\xc7\x77\xa5\xdf\xdb\x93\xe8\xd8\xa1\x35\xf0\xb1\x18\x89\xa7\x26\xc2\xa7\xd6\x79\xe5\xf9\x9e\x24\x05\xb8\xbc\x59\xe9\x62\x45\x98\xe7\x0a\x80\x2b\x21\xb7\xfd\x53\x8b\x1f\x79\xc1\xd1\x14\xd9\xe7\xf1\xc5\x89\x3d\xca\xce\xf0\xa1\x76\x2d\x82\x81\xf2\x0e\x95\x39\x31\xc4\x71\xa3\x07\x9e\xbd\x28\x20\x2f\x66\x3f\x0e\xa5\x3a\xe4\xae\xc1\xc8\x44\xfd\xa7\x75\x53\xa9\x9a\x28\x11\x12\x56\xd2\x31\x47\x6f\xba\x25\xe5\x21\x73\x80\x5a\x10\x5f\xf5\x03\xd6\xdd\xca\x1b\xb2\x85\x0f\x2b\xa2\x64\x31\x2d\x5c\x55\x41\x68\x47\xc9\x75\xa7\x5d\x68\xca\x03\x5d\xe4\xa2\x28\x08\xfe\x9c\x2b\x17\xe4\x4b\xc6\xc7\xa4\xc1\xbb\xd0\xf2\x69\x26\xfa\x0b\x1c\x21\x77\x1d\x5a\x83\x03\x80\xc5\xd7\xa8\xd6\xbf\x55\xa9\x70\x2b\x5b\x6e\xa4\x79\x71\xcc\x65\xf1\xd7\x5f\x57\x90\xcf\xc4\x25\xde\x03\x1b\x59\x78\x8e\x3f\xc9\x72\x51\x16\x67\x77\xb3\x58\x9c\x51\x9f\x84\x41\x15\x4b\x6c\x05\xd1\xc3\xdb\x7d\xbd\x1d\x00\xca\x5f\x1e\xb2\x42\x2e\x07\xf0\x37\x12\xef\x32\x82\x68\xa4\x6c\x36\xfd\x82\x09\xaa\xe0\x45\x8c\x92\x98\xa3\xbf\x4e\x29\x96\xd4\xe6\x7b\x78\x4a\x57\x6a\xcf\xf7\x60\xb0\x69\xf0\x9c\x29\xf9\xb8\x5d\x85\xe5\xe6\xc4\x84\xbb\x44\x2e\xcb\x82\xec\xb5\xbf\x42\x0f\xfe\x3d\x53\x54\xe8\xcc\x0a\xe3\x7d\x47\x8f\xeb\x1e\x21\xf3\x01\x85\x89\x3d\x48\xc2\xa0\xc3\xda\x46\x57\xe2\xa1\x9e\x0e\x49\xe6\xeb\xb6\x7f\xf1\x9a\x59\x45\xcd\x97\xc4\x69\xef\x93\x21\x1b\xfe\x56\xc6\x80\xef\x28\x4e\x87\xd6\x4c\x6b\x71\xa2\x54\x29\x38\xe2\x4d\x01\x99\xb1\xc0\xc5\x19\xad\x30\xd1\xfb\xc4\x48\x00\xe7\x3a\xe0\x4b\x8a\xec\x67\x56\xa0\x3b\xc9\xdf\x17\xb6\x49\xa5\x35\x6e\xce\xf2\x88\x54\x66\x2a\x78\xf4\x77\x59\x75\x32\x24\x48\x0e\x82\x56\x75\x1b\x9e\x0f\x5b\x1e\x44\x6b\x0f\x70\xfe\x2d\x9b\x2e\xe9\xb0\x2e\x98\x89\x5b\x53\x5d\x56\xee\x76\x97\x2b\x42\x7a\xfb\x85\x09\x8c\xc5\x5f\xf4\xbf\x35\x8d\x5b\xa7\x2d\x0f\x71\xd3\x4d\x5f\x7c\x51\x74\xbe\x25\x5f\x5d\x19\x08\x82\xed\xf6\xea\xb0\x7e\xf4\x84\x3e\x11\xc7\x38\x1f\x91\x35\xbe\x95\xc0\xb3\x15\xa7\x05\x51\x8f\x1f\xf1\xa0\x72\x99\xf4\xcd\x44\x5d\xa9\xff\xb8\xa8\x18\x66\xe2\xdb\x72\x92\x7a\x64\x7f\xb8\x14\xc6\x61\x67\xaf\x94\x5c\x51\xe6\x7b\x90\xa0\x75\x53\xac\x20\x37\xd8\xa1\x0c\x9e\xda\x54\xf7\x57\x9e\xf4\xa5\xe5\x04\xa2\xe3\xbe\xeb\x48\xbd\x33\x44\x4a\x6f\x7e\xb0\x1a\x56\x60\x1c\x57\xbe\x3f\xff\xc7\x55\x17\x28\x83\x14\xa0\x49\xd4\x29\x5e\x43\x87\x0a\xd8\xf8\xc1\xf3\x03\x21\xe3\x4d\x97\x0b\x74\x25\x03\x05\xa4\xc1\x46\x8f\x1a\xd4\x78\xbf\xb8\xe9\x75\x05\x58\x1e\x68\x06\x93\xc6\xa3\xb7\x0c\xa5\x5f\x14\x47\x0d\x1d\xd2\x77\x09\x67\xdb\x9c\xfe\x1a\xaf\xb7\xc3\xc4\xee\xc2\x29\x9c\xa3\x39\x9a\xff\xee\xa1\xc6\x5d\xe7\xa4\xef\xfa\x70\xc9\xe2\x57\x9b\x0b\xcd\xe1\x3e\x8e\xcb\xd9\x3e\x37\xec\x4b\xba\x2c\xeb\x5f\xa3\x98\x67\x5b\x3e\x7f\x3f\x56\xed\xf9\xf7\x5e\x7b\xa8\xf3\xf3\xfc\x80\xed\x58\x57\x02\x38\x01\x0d\x8c\x60\xda\x46\x7b\x7e\xe2\xc5\x78\x1f\x63\x44\xf6\x16\x58\x1f\xf9\x8b\xfa\x79\x2e\x34\x41\xf2\xf1\xa7\x49\x2b\xc1\x6c\x57\x82\x38\x50\x5c\xed\x9e\x00\x2c\x21\x22\x37\xe9\x02\xfc\x02\x05\x98\x6e\xd3\xa2\x70\x2d\x07\x5d\xba\xfc\xdf\x9a\x1d\x18\x77\xe8\xd2\xf5\x2e\x9e\x09\xf7\x37\xca\x8f\x31\xd4\x52\xd3\x58\x1b\xc1\x6e\xc2\xec\xe7\x12\x7b\x6f\x06\x9c\xc7\x4c\x40\xeb\x44\xf9\xfa\x5a\x7e\xde\xba\x01\x5b\x8e\x72\x44\xd6\x81\x88\x5c\xe9\x59\x41\x96\x5a\xb6\x7f\x7f\xef\x53\xd3\x6c\x15\x43\x59\xfe\x0c\x9a\x6e\xca\x3c\x99\xef\x08\x98\x2d\xb0\xbb\x4d\x6c\x46\x2a\xd0\x47\xe4\x49\x1a\x21\x1d\x5a\xcd\x82\x27\xf5\xf0\x22\xea\x55\xf7\x3b\x9e\x28\x49\x03\xbb\xd4\x3a\xa8\x78\x7f\xfd\x4f\xed\x9b\xc2\x57\x57\xbb\x47\xf7\x04\x87\x63\xfe\xc6\x47\xfd\xb7\x27\xf2\x6f\x22\x53\xd0\x67\x95\xf1\xbb\xef\xba\xd7\x52\x5e\xf5\xf5\x16\x43\x75\xf1\x8d\xbd\x5e\x2e\xf1\xb8\x38\x6c\xa8\xa0\xd1\x13\x26\x77\x14\x8e\x59\x3e\xc0\x29\x62\xbd\xf0\x65\x8c\xa3\x82\x68\xf5\x6f\xbe\x0f\x37\xd5\x0a\x9b\x6a\x58\x96\xe8\xea\x6c\x7c\x66\xef\x93\x78\x56\x7f\x0e\x54\xd0\x48\x4e\x97\x84\xc1\x9e\x21\xf4\x99\x99\x6f\x87\x9b\xde\x44\x98\x6d\x85\x85\x62\x1d\x0f\xea\x91\x31\xc0\xe8\x41\xc3\xee\x6b\x44\xd8\xcf\xbf\xff\x2c\x4a\x6b\x44\x7f\x31\x8d\x60\x1f\x45\x64\x04\xf6\xfa\x5a\x12\x58\x9c\x0a\x96\x9b\x6f\x42\xb9\x8c\xc2\x31\x67\x4d\x81\xfb\xf0\x26\xf8\x8e\x4f\x50\xd3\x43\x07\x00\x82\x37\x41\x09\x41\xb7\xc1\x9b\xc4\xb3\x98\xc9\x57\xaf\xbf\x25\xec\xde\x83\xe5\x0a\x1f\x68\xb6\x5f\x2d\xb7\xa5\x74\x71\x78\x38\x46\x09\x76\x25\x20\x35\xe1\xfc\xe4\xd1\x55\xa1\xf2\xbf\xf9\x61\x60\x79\xfe\xcc\x4e\xb9\x7d\x66\x8f\xc8\xaa\x0d\x1c\x2b\xf8\x4e\x2e\xe9\x30\x55\x55\x16\x62\x9e\xf9\xca\xec\xf5\x7b\x71\x4c\xf5\x41\xb2\x1d\x4e\xc3\x60\xc4\xff\xfa\x62\x68\x0d\xf6\xe8\xad\x62\x20\x60\x91\x48\xc9\xf0\x0c\xf6\x73\x04\x77\xf1\xef\xe6\x0e\x49\x97\xc8\x4e\xca\x19\xba\x3a\x5b\x46\x32\xea\x98\xcc\x11\xd1\x42\x14\xe3\x8d\xa4\x2f\x12\x5e\xc8\xdf\xfd\x9d\xbb\xa9\x99\x94\xb2\x82\xaf\x83\x85\x20\x76\xb1\x25\x91\xbb\xed\x55\x73\x94\xef\xa7\x62\xa5\xeb\x28\x5f\xde\xbe\xf4\x4a\xae\x60\xfd\xd5\x3d\x8e\x71\xc3\x7e\x4e\x98\x4d\x5d\xd2\x61\x5e\x92\x21\x19\xe2\xe1\x17\xab\x6f\xe9\x0b\x52\x17\x0d\xfe\x8b\x50\x47\xa4\x61\x54\x61\xa1\xe4\x8d\x6f\xc4\xb2\x70\xf5\xbb\x41\x4b\xa4\x5d\x93\xaf\x55\xd8\x87\xd3\xb8\x6b\x93\x4e\xd8\x2f\x23\x98\xfe\xe1\xdd\x21\xcb\xed\xb0\x76\xdf\xdf\xdf\x5e\x17\x05\x32\x72\x2f\x4c\xb4\x08\x80\xe7\x38\x16\xa5\x35\x10\xb1\x42\xd9\x35\x2f\xc0\x79\x18\x3c\xa1\xfb\xb9\x8b\xdf\x27\x68\x29\xd2\xcf\x1f\x95\x0f\x3f\x9f\x46\x99\x3f\x6e\x3f\xe3\x14\x91\xf7\x0f\x5d\xf6\x5d\xd5\xbd\x09\xa0\x4e\x4b\x93\x8f\x09\x02\x9e\x28\x5d\xc5\x12\xf7\xf3\x07\x12\x7f\x03\x45\x97\x48\xb5\x94\x42\x02\x61\xa0\x31\x22\xb7\x4c\xc5\xfc\x30\xeb\x8e\xf7\xe1\x4a\xda\xc3\xc0\x8b\x68\x3c\xd1\x15\xd1\xda\x5f\xa8\x62\x20\x48\x99\x32\x8f\x53\x40\x49\x00\xeb\x22\x8d\x98\xfd\xa8\x5e\x8a\xf4\xab\xd1\xb2\x06\x33\xd1\x52\xec\x7d\xd2\x62\x9c\x89\xdf\x0b\x38\xd7\xbf\x17\xfb\x47\x33\xb1\xf4\x7b\xef\x74\x89\xa4\x36\xf6\x68\x4f\xce\x5f\xef\x93\x4c\xc2\x74\xe3\x77\xed\xf2\x04\x46\x7b\x9f\x56\xf7\x37\x12\x03\x82\x2e\xac\x9f\x51\xf9\x54\x6d\xcd\x31\x4a\x45\x09\xb1\x2e\xf1\x4c\xd7\x04\x9a\x33\x51\x05\x5a\xde\xa9\xda\x7e\xd1\x9d\x74\xa1\x5d\x30\x65\xbe\x83\x68\xbb\xfe\xa8\x89\x1e\x7e\xc4\x5f\xfc\xfa\x8c\x25\x3a\x8a\x4b\x9d\x28\x8a\x3e\x9b\x2f\xed\x42\xa3\x08\xc0\x10\x72\xcf\xf1\xd7\x20\xe9\xf3\xfa\xf4\xc8\x42\xbd\x9a\xe5\x6b\xf9\x52\xe0\x4f\x53\xdc\x14\xfe\xed\xec\x4e\x68\x83\x57\x9a\x60\x89\xa0\xb9\xfa\xc2\x99\xda\x26\x12\x7d\xf0\xd4\xea\x62\x18\x64\x78\x10\xc7\x35\xd2\x3d\xbc\xca\xce\x4f\x75\xef\x5b\x41\x8c\xbd\xd1\x7b\x16\x22\x6c\x58\x49\x53\xd9\xd2\x00\xc8\x56\xa5\x8c\xff\xcd\xcb\x0f\xfb\x3e\x89\x43\xb1\xf6\x03\xd1\x49\xc2\x7c\xf0\xa9\x2e\xc0\x25\xe6\x92\x06\x69\xb9\x91\xf0\x3a\x7a\xea\xec\xbf\x63\x47\xc9\x33\x68\xc1\xb4\xe5\xe9\x2f\x73\x55\x92\x26\xfe\x54\x2b\x62\x54\x71\xc3\xee\xbc\x0d\xe9\x3c\x73\xe9\x47\x4f\xed\xc3\x46\x46\x0f\x98\x70\x9f\x88\xc3\x2d\xaf\xc3\x80\xa2\xd4\xba\x25\x30\xc7\xbf\xbe\x5c\x82\xc0\x33\x98\xee\x28\x50\xba\x85\x6b\xb8\xe4\x00\x58\x3c\xfd\xfb\xdc\x18\x55\xfe\x9e\xc2\x98\x20\xb9\x04\xc2\x67\x16\xa2\x3b\xb6\x85\x9a\xed\x5e\x4a\xeb\xc7\x1a\x67\x8f\x38\x86\x51\x60\x2e\xcd\x44\x2a\xd1\x27\x4f\xc5\x6d\xef\xcf\x6b\x3a\x2c\x90\xe5\x5c\xb9\x13\x0c\x7f\x35\x8d\x6b\x70\xa0\x73\x07\x94\xe8\x76\x47\x4f\x68\x3f\x26\xea\x4d\x98\x59\x8e\x06\x0a\x02\xa5\x40\x5b\xde\x5b\x67\x77\xcb\x6f\xa0\x35\xbe\xca\x27\xbc\xab\x58\x66\x33\x12\xa4\xe7\x6a\x03\x4d\xc7\x11\x79\x1b\x32\xce\x3f\x04\x42\x68\xcc\x17\xbe\x37\x71\x2c\xcb\x4f\x10\xb6\xca\xab\xbf\x34\x08\xce\x4f\x39\xc2\xf4\xdf\x96\x72\xd4\xe3\x51\xf0\x7c\xdd\xa6\xe0\x36\x41\xb8\xc7\x82\x7f\x8a\x2e\x11\x1c\x81\x0b\x98\x6d\xe2\xc0\xd8\x22\x9a\x95\x00\x2d\x2c\x62\x21\xa2\x29\x6a\x71\xb0\x9f\xd0\x57\x39\x7b\x03\x0a\x2b\xf1\xa9\xe8\x6f\x3f\x84\xa4\x81\xb3\x2c\x4b\x92\xfe\xa5\x5e\x8c\x11\xfa\x94\x0e\xf0\x6e\x45\x72\x73\x1b\xbc\xed\x4b\xea\x85\x38\xf9\xcf\x60\x36\xfa\x3c\x20\xfb\x1a\x1b\x20\x1c\x84\x41\x9b\x9c\xe8\xfa\x64\x06\x42\x35\x9c\xf0\x19\xa1\xbf\x5a\xb2\x7b\x85\x1f\xbc\x59\xa2\x3c\xb7\x39\xc6\xa6\xac\x91\x6a\xa6\xe9\xc3\xeb\x66\x5a\x12\x3d\x08\xbb\xed\xac\x89\x6c\x10\xf8\x01\x54\xba\x1e\x87\x45\xfc\xcf\x53\x86\xab\x38\x78\xe3\x4c\x16\x84\xf4\xf3\x85\xcf\xbc\x12\x80\xa5\x30\x4d\xee\xf5\x00\x9c\xce\xe1\x87\xac\x26\xff\xd8\xa4\x9f\x27\xc6\x4a\x19\x7f\xbe\xa7\x12\xbd\xc4\x73\xac\x2b\x4e\x01\xd9\x67\x5d\x9e\x9d\x0a\xcc\x1a\x2a\xb6\xc2\x94\xcb\x72\x47\x88\x3c\xad\x16\x3b\xd1\x91\x14\x3b\xb4\x0a\xd2\x34\x29\x18\xf0\x73\xc5\x00\x9d\x3f\x06\xe6\x19\x92\x0d\xcd\xe8\xcc\x68\x18\x48\xbf\xe8\x37\x47\xbf\x02\xf6\xe3\xa6\x19\x40\x6b\x82\x6b\x3f\xcd\xe2\x4e\x0b\xd7\x88\x4b\x8c\x14\x63\x4f\xca\x6d\x0d\xa3\xa4\xd1\x3a\xa5\xf6\x59\x7f\x9e\xa6\x0a\x3d\x89\x53\xb4\x1f\xbf\xca\x4b\xa3\x25\xf9\x2c\xab\x09\xe3\x8b\xac\xcb\xc3\x2f\xa0\x89\x0d\x7e\x3e\xee\xe6\x5f\xeb\xe7\xd3\x60\x27\x5f\x5f\x58\x2a\xd5\x27\x3b\xc7\x99\x95\xfc\x84\xd8\x31\x82\xe0\xb9\x4d\x16\xa3\x24\xb5\x15\x9d\x08\x6d\x45\x70\xf0\xad\x82\x9f\x2e\xe3\x3a\x63\x41\xc2\x17\xd9\x42\x4b\xee\x65\x29\xea\xfd\xaa\xfb\x21\xb3\xe3\x07\x62\x1d\x87\xe2\x8f\x37\xa5\xda\xf8\xab\x55\x72\x6e\x4e\x70\x16\x9d\x2e\xcb\xd9\xd7\x5f\x04\x31\x13\x3b\x70\x6d\x5f\x64\x7f\x9a\xea\x88\x5e\xc0\xbf\x06\x88\xca\x24\xfc\x68\x9d\xe9\x5a\xfa\xa4\x5e\xfa\xe5\xd3\x2a\x17\xa5\xf3\x16\x87\x0f\xc9\x90\x96\xd6\x08\x2f\x68\x58\xcd\xbb\x90\xa4\x61\x3d\x2b\x19\x16\x57\x9c\xb2\x34\xc3\x9b\xec\x69\xef\x62\x81\x98\x47\x24\xfd\xe0\x1d\xd1\xf4\xc9\x2a\xc6\xc2\x93\xe8\x95\x35\x22\x41\x9a\x3e\xdb\xae\x7d\x51\xf9\x25\x68\xb3\x17\xa8\x52\x1e\x5e\xe7\x88\x33\x15\xd4\x70\x0f\xc7\xa9\xc5\xc3\x9d\x9f\x7e\xb9\x29\xaa\xe0\xd9\x1b\xeb\xbc\xaa\x60\xba\xdb\x78\xbf\x60\x7e\x3c\x31\x3b\x7f\x56\xd6\xb6\x85\xca\xe8\x9a\x3e\xfd\x74\x9f\x60\x0a\x7d\x46\x6b\x74\x35\xa0\xa0\x3c\xf2\x61\x9d\x75\x05\x79\x26\x8a\xe3\xf7\x15\xf3\xb9\x66\xbd\x74\x26\x1f\x6a\x14\xdb\xa0\x82\x88\x31\x22\x67\xc8\x2d\x84\x6f\xe7\x37\x7f\xb3\xb0\xa7\x73\x91\xee\x50\xdd\xd1\x6c\x0e\x22\xbf\xdf\x2c\x94\xa4\x1e\xb3\x7c\x7f\x3c\x87\x0d\xd5\x57\xad\x18\x46\x61\x58\xd5\x11\x44\x9f\x57\x28\xaa\xb4\x2a\xdb\xdb\xdc\xef\xa8\x3e\xc0\xa0\x6a\x33\xae\x0f\x9e\x2d\x66\x4f\xa1\xdd\xe7\x79\xf6\x03\xb2\x3a\x73\xd4\xe3\x85\x23\x85\xe5\x42\x6c\x2b\xe2\x0b\xa3\x4f\x24\x3a\xe3\x51\xf2\x20\x98\xa6\x5d\x49\x8f\x5e\x49\x8a\xf3\x51\xee\x98\x4a\x99\xdd\x21\x0c\xa7\xda\x0c\x3e\x0d\xc3\x4c\x42\x7c\xe2\x58\x97\x4b\x67\x19\x6c\xfd\xe4\x17\x20\x76\xc9\x90\x71\x02\xe1\xb8\x44\x09\x02\xf4\x0b\xb0\x36\xc3\x5b\xdf\xc8\x81\x4e\x59\xeb\x9b\x71\x7a\xd3\x95\xc6\x28\x5f\xb5\x3c\x00\xc4\xa8\xc2\xa8\x68\x2a\x35\x24\x88\x29\x26\xb7\x4a\x25\xb6\x8d\x75\x26\x74\x99\x15\x04\x80\xe6\x30\x9c\xc1\x7c\x91\x98\x2a\x2d\x2c\xee\x37\x4c\x4a\x81\xdc\x1c\xbd\xd7\x18\x5e\x86\x39\x26\x9a\x2c\xd0\xc5\x0d\x55\xb5\x22\xf3\x87\xe0\x14\x21\x91\x9c\xba\x4b\xa4\xc2\x83\xa1\x25\xae\x1d\xd7\x53\x13\x4d\xc3\x29\x20\xe7\x1b\xfa\x9c\xa0\x54\x87\x87\x10\xc0\x96\x65\x5a\x0c\x2f\x84\x1d\xb7\x87\x3f\xea\xda\xdc\xf1\x16\x91\x07\x79\xd8\x46\x7a\x85\xdc\x73\x61\x85\x5c\x33\xbe\xb5\x68\x30\x42\xe0\xe6\xbb\xf1\x26\x06\x8f\xfe\xdf\xbc\x26\x2c\x10\x6d\x5f\xca\x4a\xf2\xa7\xb5\x33\x3e\x23\x9f\xd9\x5f\x27\x51\xaf\x5a\xb2\xfe\x92\xeb\xdf\x3a\xb7\x92\xc6\xeb\xf8\xe2\x14\x6f\x61\xcf\xd0\x77\xdb\x33\x14\xaf\xbf\x04\x16\x23\x81\xd9\x8d\x51\xf9\x22\xbf\x97\xd0\xb3\xc7\xb5\x65\xb2\xfa\x7e\xa1\x7e\xc5\xbf\x08\xef\x76\x4d\x5a\x9c\xe1\xea\xb1\xfa\xf5\x7d\x75\x4f\x1f\x09\x9d\x58\xd8\x38\xa8\xd3\x70\x5f\x58\xe6\xfa\x0e\x9e\xda\x56\xe4\x81\x1f\x69\xd8\xa9\xc2\x28\xde\x47\x7e\x1d\x51\x36\x6f\xa8\xd6\xdc\x73\xf9\x2d\x2e\x92\xa3\x4e\xed\x92\xf9\x9b\xfc\xdb\x17\x46\x32\xb5\xef\x9e\xc4\x18\xda\x1b\xff\xf6\x75\x8c\x88\x5f\xf8\x79\xb6\x4d\xfb\x19\x21\x81\x3b\xa3\x15\xc1\x22\x89\xb4\xc6\x16\x06\x41\x10\x45\x01\xab\xc5\x7e\x3c\x5b\xc5\x91\xd5\xd4\xfe\xaa\x33\x8f\xd6\x4e\x58\xa1\x4b\x0c\xf3\x15\xbc\x8e\x02\xbb\x35\x20\x70\xdd\xeb\xb0\x41\x03\x4f\x99\xcc\x0f\xf4\x97\xbf\xbf\x9f\x2f\x7e\xe1\x0c\xf6\x80\x51\x36\x43\x4c\x22\xea\x6f\x44\xdb\x1a\x1b\x5b\xb2\x37\xf9\x76\xc2\x9f\x27\x08\x00\x43\x62\x88\x4a\x2d\x4c\xfd\x0a\xd7\xd7\x95\xc0\x2d\x88\x82\x5b\xf4\xe2\xdf\xca\x61\xcd\x31\x5a\x02\xd1\xf1\x1d\x11\x3b\x33\x67\x85\x1b\xc2\x55\x1a\xa6\x69\x2c\x50\xfe\x49\x25\x15\xe0\x85\x48\x74\xfc\xdc\x8c\xd4\xbf\xfa\x81\x4d\x70\x96\x89\xe6\x67\x9a\x83\xb3\xbd\xf5\x3c\x7e\x4a\x6a\x45\x96\x23\xfa\x40\x33\x15\x22\xbe\x88\x66\x8c\x1e\x6a\xbc\x14\x96\x99\x0e\x6f\xa7\x59\x9e\x6e\x61\xb8\x80\x5c\x0d\xb1\x17\xc9\xbb\xd0\xae\x8c\x1e\x8e\x6f\x08\x99\xec\xbf\xcf\x93\xbd\x1d\xd9\xb5\xcf\x33\xb4\x30\x49\x1f\x91\xd6\x93\xe6\xbb\x11\x92\x5f\x94\xe5\x21\x3b\xac\xaa\x70\xb6\x5d\x9a\x68\x7e\xa0\x7f\xb5\xab\x97\x3e\x8c\x78\xb8\x0d\xad\x83\x81\x10\x6d\x79\x3f\x08\x19\x63\x89\x67\x62\x9e\x09\xb5\x60\xe9\x66\x03\x1b\xd5\x26\x93\xfe\x9d\x92\x65\x2e\xd5\x0d\xfb\x21\xd7\x2f\x9e\xdd\xc8\xbc\x79\x38\xc5\xa0\x01\x80\x40\xd2\x7a\xbf\x94\x0f\x9c\x6e\x36\xcb\x0b\x0f\x75\x68\xcf\xa1\x85\xa2\xb4\x22\xbb\x4b\xcd\x9f\x72\x44\x10\x04\x50\x2b\x37\x5b\x68\xdf\x3f\x3e\xee\xa0\x36\x4a\x83\x15\x96\xdc\x0c\x6f\x7e\x4a\x5b\xe6\x19\x9b\x97\x21\xf8\x1e\x5f\xac\xca\x0a\x66\x73\xf3\xa1\xfa\x81\x76\xcb\xe3\xae\xe9\x73\xd6\x55\x52\x21\x72\x3c\xfb\x47\xc4\x54\x0a\x37\x77\x48\xc6\x0e\xea\x34\x07\xca\xdc\x2c\xca\xa0\x36\xc9\xfe\xc1\x31\x5e\x51\x45\x05\xfd\xc5\xee\x0f\xcf\xc1\x16\x03\xf4\xf7\xe7\xf2\xbe\xa8\xa9\x35\xf1\xd4\x0b\xd0\x1a\xac\x30\x28\xb7\x37\x41\x67\x3b\x45\x7a\x10\xac\xd7\xf5\xe3\x72\x8a\x9e\x49\xf7\x4f\xe6\x8e\xfd\xbf\x03\x33\x79\x16\xc2\xcb\x1e\xae\xc9\x84\x7d\xd3\xcf\x38\x13\xf9\x80\x0a\xb2\xf1\xea\xef\x94\x5b\x8b\x2a\x3c\xdf\xaf\xec\xc0\xd6\xe8\x4d\x70\x71\x3a\x93\xea\x1a\x62\xb4\xde\x98\x1f\xc0\x39\x9a\x05\x2b\x0c\x64\xaf\x87\x38\x63\x42\x95\xd2\xdd\x28\x8a\x3d\xac\x93\x14\xb4\x35\x35\x56\x14\x6a\xf7\x2f\xe4\x45\x3e\xa8\x21\xdd\x4c\x7c\x57\xd6\xa4\xa8\x02\x8c\x37\xd3\x1b\x70\x17\xff\x1e\x6b\xd8\x87\xda\x9c\xcb\x8e\xef\xe7\x3f\x3c\x44\xd6\x60\x55\xe4\x5e\xb4\x61\xc6\x37\x3c\xfd\xdd\x48\xee\x72\x05\xda\x62\x69\xed\xf3\xea\xef\x56\x82\x20\x19\x41\x3f\xb9\xf9\x93\xc6\xaa\xeb\x77\x2a\x67\x8f\xea\xf3\x1d\x92\x21\x59\x8c\x3b\xb3\x97\x0b\x09\x1f\xd6\xe6\x98\xb0\x81\x76\xcd\xa5\x04\x67\x0b\x1a\x2a\xde\xc2\x4f\x75\x7d\xff\x7a\xd5\x57\xa1\x4b\x7e\x94\x39\x0d\xc5\x56\x1f\xbd\x1d\xa5\x8b\x35\x78\x06\xef\x4a\x3d\xf8\x39\x9c\xfb\xcb\x4f\xb0\xc4\x5d\x95\xe2\x73\x92\x62\xbd\xfa\x4b\x51\xae\x2a\x20\xa5\x35\x6e\xe8\xe7\xa9\x54\xe7\x72\x79\x21\x01\xd0\x74\x77\xf4\xc1\x33\x5b\x77\xf6\xb7\x37\x0b\xe6\x2c\xbc\x29\xa0\xe4\x2b\xc2\x5c\x67\x6b\x7c\x71\xa0\x1c\xb1\xeb\xc4\xe7\x61\xd3\xc2\xbf\xd9\x42\xe3\xab\xfe\xeb\xb9\x89\xdc\x5b\xa6\x7a\xa0\xc2\x34\x03\x41\x7f\x7a\x17\xd4\x79\x6b\xcc\xb6\x50\xc6\x01\x6b\x82\xa5\x8a\xc4\xcc\x44\xe1\x5b\x53\x51\xaa\x4f\xe3\x26\x80\x32\xdb\x24\x61\xaf\xe6\xbb\x65\x78\x1d\x8a\xbf\x7c\xff\xf9\xfd\xc5\x88\xab\x47\x3b\x37\x12\x00\x4b\x98\x43\x3a\x08\x18\x8a\x71\xc6\x32\x03\xc1\x29\x1a\x20\x72\x97\xb3\x89\xef\x61\x2b\xa5\x34\xbe\x05\xff\xf6\x28\xda\x48\xf5\x94\xfc\x1e\xf9\x8b\x53\x18\xfd\x3d\x1c\xe5\x97\xbb\x69\x38\xcc\x11\x47\x96\xc7\x18\xac\xd3\x53\xae\x04\xb0\x67\x61\x3f\xa5\xd2\x7d\xf0\xe7\x78\x53\xa5\xba\x3e\x91\xf8\x8d\x82\x20\x08\x7e\x18\x8b\xee\x64\xa1\xcb\xf4\x9b\x47\x21\x4c\xd5\x40\xf4\x84\x3f\x89\x06\x14\x3a\xf2\x35\xa9\xf0\xab\x62\x3d\xf4\xd3\x59\x4a\xbe\xf7\xeb\x6e\xf3\xee\x96\x1e\xd5\x02\xae\xc3\x80\x50\x36\x2b\x0e\x77\xdc\xf9\x2f\x4e\x79\xe2\x1d\x8b\xb2\xe3\x32\xf6\x62\x3d\xdb\xa7\x32\xe2\x43\x81\x12\x9d\x48\x8c\xd2\xcc\x53\xbb\x44\x88\x59\x79\xf4\x56\x15\x5a\xe7\x99\x15\xe1\x0f\x22\xc3\x11\x79\xa8\xa6\xa3\x86\xc2\x1a\xd1\x05\x03\x2c\x8f\xd0\x16\xeb\x09\x1d\x84\xcc\x67\xf8\x4d\x26\xf8\xf9\x8e\xaa\x2f\x16\x7f\x4e\x92\x30\x9b\x69\x66\xde\xe6\xcb\x57\xb9\x39\xda\x0a\xc7\xb4\xf1\x3e\xa0\x19\x05\x94\x96\x04\x46\x14\xaa\xed\xa8\x06\x0a\x2d\xcf\x24\xed\xad\x54\x4e\x7b\x63\x34\x73\x24\x7b\xe3\xbb\xee\x88\xd3\x20\x70\x3a\x33\x3b\x59\x35\x7e\xa4\x83\xa7\xdc\x22\x75\x35\xe2\xb5\x69\xdc\x75\xbe\x14\xa8\x7d\x1e\xbd\x66\x03\xf5\xb6\x3f\x5f\x54\x7d\xb4\xfe\xc1\x18\x16\xf1\x99\x00\x21\xf3\x06\x5a\x3f\xb0\x39\xbc\xe9\xb2\x2c\xc3\x19\xad\xb5\x44\x37\x1b\x27\xd2\x26\x58\x82\x74\xba\x87\xf0\xaa\x3b\x29\x74\xdb\xe6\xc8\x7c\x02\x73\x30\x3e\x91\xf7\x63\x61\x4c\xdd\x54\xae\x9a\x1a\x23\x28\x9f\x9f\x3f\x12\x18\x37\xb1\xe4\xe1\xc9\xa6\x94\x22\x98\x4f\x17\x1a\x4f\xe7\x1f\xe3\x4f\xd3\xa9\xe7\x25\x84\x0d\x1a\x6d\x08\x2f\xf4\x16\x86\xd7\xa1\x34\x2f\x9f\xd1\x59\xfd\x99\x15\xb4\x06\x3b\x7e\xb9\x95\x95\x1e\x0a\x02\xad\x33\x21\xd9\x70\x5d\x97\x74\xd1\xef\x17\x35\x2c\xef\x0b\xc8\x5c\x1d\x14\x47\x24\x8a\x92\x19\xad\x27\xda\xc2\x3f\x6f\x12\x36\x48\x50\xc5\x06\x77\xc7\xd4\xd7\x53\x21\x0c\xb2\xcc\x92\xab\xed\xa3\x2a\xbd\x8d\x3c\xb0\x34\x98\xd4\x59\x56\x67\x3d\x10\xef\x02\x2c\xcb\x13\x79\x54\xa1\x8d\xa9\xc2\xac\x1d\x43\x8f\x79\x41\x58\x62\x2c\x3d\x93\x78\xf6\x89\x84\xa2\x92\xdc\x8b\xc4\x9b\x02\x2c\xb6\x0e\x8d\x8a\x2f\xab\x11\x8c\xd0\x73\x94\xf3\xd2\xa7\x4b\x10\x2c\x10\x2a\x14\x58\x35\x64\xd8\xee\x61\xbf\xb3\xfb\x79\xea\x78\x75\x6b\xdf\x37\x4f\x26\x23\x43\x0c\xf4\x9a\x4f\x08\x0b\x5a\x3a\x87\xc4\x12\x2c\x5a\xb3\x1a\xe9\x8e\x29\x81\x93\x22\x8a\x69\x71\xfc\x87\x5b\x1a\x91\xfb\x4e\x18\xc3\x39\xb2\xcb\xb1\xbc\xac\xc9\x24\x06\xbe\x00\x8d\xcb\x67\xd5\xb5\x36\xfb\x91\x68\xb0\x8b\x50\xf5\xf9\x16\xb8\xf6\x05\xc8\x75\x3c\x75\x9a\xe4\xd9\xea\xc2\xf4\xd4\x90\x60\x80\x93\x18\xf1\xaf\x76\xe7\x6f\x26\x38\xbc\x7b\x67\x77\x90\x39\x40\x49\x1b\x0c\xf1\xcc\x65\x1a\x64\x85\x79\xac\x25\x7b\xde\x4b\xb3\x02\xef\xb4\x77\xf5\x2c\x9c\x58\x7b\xa9\x3e\x07\xd4\x39\xbe\xf8\x35\x6b\x34\xde\xbd\x09\xb1\x3e\xce\x22\xda\x87\x64\xc9\xfc\xe0\xc3\x5b\xe6\x42\x23\x6f\x8c\x5e\x6f\x88\x07\xc3\xfe\x00\x38\x08\x5e\x41\xb0\xb3\xfa\xbb\x2e\xc8\x4e\x16\x3f\xad\x4b\xe5\x66\xe5\x6e\x2a\x81\xd2\x0a\xfb\x51\x59\xc9\x9b\xb3\x74\x66\xbf\xa3\xf2\x10\xb1\xd4\xcf\x44\x8e\x02\x16\x5f\xe3\x47\xd9\x99\x0a\x19\xc1\x86\xd3\x25\x48\x7e\x22\x1a\x5f\x2f\x04\x00\xa4\x93\xb5\x8f\x54\xd0\xfc\x78\x7b\x49\x46\xe0\xa1\xd6\x8f\x31\x90\xdf\x85\x63\xc7\x9c\x5f\xe8\x9f\x67\xfc\x41\x7c\x84\xdb\x28\x03\x09\x36\x4c\xe0\x39\x73\x8d\xf8\x0f\x12\x7b\xf1\x6f\x0e\x02\xc8\xc1\xcf\x57\x60\x3c\x1c\x4f\xf2\xd2\xe0\x91\x58\xf2\x8c\xc1\x66\x79\x08\x82\x28\xb0\xf4\x06\xab\x36\xd2\xe6\xe3\xf7\x04\x00\xa6\x7b\x69\xd8\x3d\x2b\xc8\x7c\x5d\xc1\x2b\xd8\x40\x58\xdb\xd2\x20\xb8\xbf\x09\x92\xf4\x14\x47\x55\x7f\x7d\x3e\xa6\xe3\xf3\x2e\x84\x54\x63\x25\x5f\x5f\x28\x0e\x94\x3b\x4d\xd3\x34\x6a\x8e\xde\x59\x9e\x23\xb2\xb6\x13\xe8\x32\x7f\xe7\xa5\x6c\x28\x90\xc9\xe8\xc9\xdb\x18\x30\x3d\xb5\xa3\x66\xf4\xec\xab\xed\x1c\x41\xb0\xec\x59\xdb\x57\x8e\xc1\x0b\x98\xac\xd9\xfa\xae\xda\x9e\x10\x3f\xb2\xe1\x87\x3b\x11\x8a\xc2\x62\xd4\x06\x08\x7d\x90\x4b\x10\x64\x3f\xff\xab\x91\x20\x35\xcd\x8c\xd2\xfa\x69\x7b\x64\xc7\xbf\xde\x3c\x20\xa9\x11\xca\xa5\xd4\xdf\xcf\x79\x82\x58\x5a\x35\xac\xe6\x42\x87\x78\xd3\x9f\xcc\x40\x68\xc5\x45\xfb\x2a\xfe\x30\x4b\x9c\x9c\x3b\xcb\xb8\xbe\x26\x12\xd4\xc0\x2d\x3d\x74\xbb\x69\xb7\x78\xd9\x8e\x50\x2b\x2a\xd7\xa6\xd7\x91\x06\xc8\x5c\xcf\x50\x3c\x96\x05\x12\xf6\x89\x9d\x14\x58\xc2\xc1\xaa\xa0\x9e\x54\xb6\xbf\x3c\x84\xd2\xdc\x52\x88\xe4\xe3\x75\xb8\x45\x45\x9f\xa1\x9e\x0a\x59\xe9\x5c\xcb\x20\xc1\x96\xd1\x99\x4b\x4f\x54\xc9\xe9\x82\x3e\x09\xed\x05\x75\x60\xd5\x15\xce\x11\x89\xb2\x1d\xf9\xd8\x14\x73\x83\xf2\x44\x48\x55\xd8\x10\x91\xbd\xc6\x87\xc6\xdd\x51\x0d\x14\x3c\xcb\x00\xbc\x57\x71\xac\x12\x4b\x7c\x15\xa1\x20\x2d\x3a\x54\x29\xdf\x53\xea\x0e\x2b\x7a\x29\x02\xbf\x17\xd6\xf1\xe3\xca\x82\x69\xb8\xbe\xc1\x73\x60\x89\x8c\x20\x08\x64\x1f\xff\x40\x89\xde\xb9\x75\x74\xf2\x88\xd1\x60\x8b\xbf\xd8\x86\xe7\xc3\x8c\x2d\xb0\x31\x33\xf2\xf1\x2a\x98\x32\xdf\xda\x62\xf9\x6d\xbf\xec\x0d\x02\xa7\x3d\x03\xac\x8b\xec\x6b\xd2\xd5\x62\xfe\x8d\xd4\x67\x5e\xc2\xde\xe3\x48\xf7\x3c\xcf\x69\xd1\x5b\xed\x21\x72\x73\x64\x26\xa9\xfa\x1b\x4a\xef\x6e\xb0\xca\xd9\xc2\x2e\xe7\xd7\x57\x64\xcd\xc5\xe2\xab\xcb\x36\xfe\xee\xad\x4f\x36\xc4\xb1\xa4\xfd\x1c\x08\x08\x87\x29\xd5\x97\xbd\x2d\xb7\x9b\x97\xcf\x62\x65\xaf\xda\xbd\x21\x19\xd0\x88\x43\x70\x15\xa6\xd7\x9c\xb5\xe2\x38\x0e\xc0\x5f\x72\x39\x1a\x21\xee\x96\x94\x38\xf8\xc6\x3b\xe2\x55\x8c\x71\x11\x93\x06\x0a\x01\x72\x00\x28\xbe\x87\xb5\x91\xd2\xf5\xe4\xee\x10\x3d\x10\xfc\x71\x77\x4d\xe8\x34\x9b\xa9\x07\xb9\x73\x74\x30\x45\xd6\x05\x38\x67\xd2\xc2\xc1\x93\x04\x9e\x53\x17\xfb\x6e\x83\x7f\x5e\x16\x02\x4a\xeb\x44\xeb\xeb\x2a\x6a\xff\xd3\x7e\x58\x8d\x20\x8e\x79\xfe\x46\xc4\x1d\x6f\xbe\x5a\xec\x93\xc3\x61\xa9\xa3\x76\xb7\xfb\x15\x03\x38\x8f\x42\xb0\x38\x3f\xa3\x71\xe8\x9a\xc9\x63\x64\xfb\xf3\xb1\x3f\xfd\xdd\x9d\x14\xce\x56\xd8\x77\x50\x89\x62\xed\xd0\x80\x3d\xa2\xec\x23\xf4\x0b\x3b\xc1\x0a\x74\x5a\xe3\x4b\x09\x14\xf2\x7d\x75\xd2\x70\x67\x1f\x61\xf0\x0b\xf9\xdc\x0f\xcf\x1e\xdd\xe4\x0a\xac\xfc\xc9\x9e\xaf\x27\xe0\x56\xb6\x23\xf8\x14\xb7\x68\x6a\xbb\x9c\xfb\x26\x14\x25\x8d\x54\xf4\x45\xad\x57\x1f\x3c\xeb\x21\x37\x45\xa9\xba\xe7\x6b\x5a\xf2\x8d\x51\x05\xbc\x67\xe1\x3e\x66\x5e\x79\xc8\x02\x6f\x99\x92\xd1\x2a\x0f\x83\xdd\x54\x65\x79\x48\xbe\x9a\xf1\xe7\x2b\x3b\x90\x27\xd4\x0a\xa4\xa8\xc6\xac\x1c\x1c\xf6\x36\xe5\x8f\x8d\x1e\xea\x34\x5e\x17\xda\xb5\x3c\x04\x5a\x67\x8c\xbb\xa6\x56\xd3\x2a\x35\x0a\xcd\x1f\xd5\x26\xe6\x78\xa4\xd7\x5c\x6a\x63\x99\x2b\x8e\xa5\x7a\x7d\x80\xe8\x49\x3c\x26\xf9\xb4\xb8\xb6\xa1\x24\x05\x5a\xfc\xfd\xd5\xd8\x94\x2f\xc0\xf6\x3c\x41\x10\xa1\x40\xcb\xbb\xe5\x06\x62\x30\xa5\x9a\x85\x10\x5c\x3f\xf7\xf6\xf3\x9b\xb8\x70\x22\xf4\x19\xdf\xba\x27\xee\x14\x59\x48\x8a\xd9\x22\x64\xbe\x55\x41\x69\x5d\xd8\xd7\xca\xd1\x1c\xaa\x39\x5d\xb9\xc8\x0f\x00\x82\xd3\xa2\x34\x2c\xa3\xb0\x1f\xce\xa8\xe3\x48\xcc\x48\x90\xa6\x5b\x10\xa4\x5e\xda\x00\x6c\xd8\xb6\x2d\x3a\xdb\x3f\x19\xbe\xcd\x76\xea\xe4\x63\xec\x10\x12\x03\x1c\x7b\xf6\x84\xee\x73\x82\x20\x20\x81\x6f\xbe\xc2\x14\x28\xdc\xba\xe7\x33\x4a\x76\x83\x32\x4a\xd2\x00\x8f\x5d\x0e\xeb\x08\x81\xa8\x05\x00\x20\x9b\x8d\xb3\x67\x01\x6c\x77\x2a\xd7\xd3\x71\xcb\x29\x4a\xcc\x33\xe9\x25\xea\x35\x3f\xd3\xc6\xf8\xe2\x24\x4d\x99\x32\xff\xd7\xb3\x04\x42\x2c\x4e\x51\x18\xc2\x53\x27\xc3\x58\x21\x22\x37\x12\x4d\x4d\xfc\x4f\x8f\x80\x3f\x3a\x4b\xbe\x07\x23\xd7\x5d\x26\xfd\xf5\xfc\x00\x7e\x3e\x2b\x35\x23\x7d\x98\xfd\x22\x97\x85\xc5\x0d\xbb\x6c\xa7\x08\xc2\x92\x5f\x80\x43\x59\xe1\xe2\x2d\x10\xc7\x71\x1a\xce\x23\xbf\x0a\x5f\x0b\x3a\x51\x8c\x31\x15\xac\x34\x47\x57\x39\xf9\xbf\xb5\x44\xf2\x4d\x62\xbb\x59\x34\x92\x06\xa8\x51\x79\xe6\x3d\x93\x5e\x73\x7c\xc1\xf2\xdd\x03\xd6\xf6\x05\x36\x64\x02\x10\x2c\xa3\xe8\x27\xc4\xe3\x55\xc4\xf0\xef\x12\x60\xac\xad\x54\xd3\x95\x9b\x64\x46\x9b\xa1\x3d\xef\x42\x12\x87\x6c\x2d\x68\xf9\x93\x3a\x25\xa4\xed\x6f\x7e\xa2\x13\x1b\x0c\x04\x71\xac\xeb\x41\x1e\x18\x61\xf2\xf5\xd8\xf9\x01\x5e\xf0\x9d\x68\x07\x7d\x16\x4e\x5d\xa8\xe9\xcc\xc3\x2b\x7a\xeb\x40\xa5\xdc\xdc\xf1\xde\x95\x07\x71\xfc\xb4\x6d\x48\xb2\x55\x95\x00\x65\x04\xab\x6e\xb7\x0e\x38\x90\xd5\x37\x76\xbb\xdf\x8d\x9e\x99\x9f\xee\x0b\x35\xdf\x90\x5a\x57\x79\x80\xe0\xf8\x3d\xf3\x17\xc7\xe8\x8f\x32\x3d\x92\xf2\xe2\x14\x50\x82\xe0\x79\x9e\xb0\x62\xa2\x1c\x25\xf7\xdf\x5a\x10\x98\x5c\xe8\xc6\x54\x84\x42\x2e\x17\xde\x44\x15\x04\xdd\xfb\x2b\xea\x46\xd9\x2a\x96\xc4\x3a\x09\x73\x71\x32\x34\x75\xf3\x06\xb1\xc6\x32\xb1\xbd\xd1\x6c\x43\x18\x21\x35\xf9\x8d\xfc\xeb\x11\x93\x63\xe8\x56\x6f\xcc\x45\x72\xe4\xf1\x1e\x17\xa0\xd9\xa5\xac\xe7\x9e\xdf\x6b\x0c\x0c\x44\x2d\x86\x5a\x10\x61\xfc\x61\xa5\xef\xf8\x32\x37\xa9\x10\x99\xa3\x29\x68\x8e\x72\x2e\x3d\x7a\xab\x40\x8b\x3b\x74\x25\xbd\x11\x1e\x44\xcb\xde\xc5\x62\xa0\xfc\xb3\x42\xd5\xc3\x29\x3c\xdb\x05\xdc\x14\xbc\xf8\x76\xb1\x25\x79\x90\xc7\x4f\x20\xbe\x79\xaa\xce\x1c\x37\x7f\xa4\x1a\xfb\x3b\x0b\xcf\x08\x7a\x32\xea\xcf\xb6\x7e\xde\x64\x43\xdd\xc7\x76\x59\x41\x1c\x5e\xb5\xf9\x69\x21\xd3\x9d\x00\x42\xfe\x79\xa6\xd6\x4e\x3d\xf7\x0a\xb1\xb7\x36\xcd\x3d\x71\x9f\x8f\x31\xb8\xbf\x08\xb2\x46\x1c\x28\xd8\x53\xbc\xbf\x11\x26\xf3\x1b\x7e\x24\x43\x2c\xb1\x76\x08\xef\x9a\x71\x9c\xde\x4c\x81\xf9\x81\x96\xd9\x49\xb4\x33\xbd\xde\x24\x0d\x90\xed\x8d\x51\x36\xcb\xfb\x90\xc1\xdd\xe5\x59\x3f\x9c\xc2\x36\x92\x0a\x3d\xb1\xc0\xea\x9e\x4d\xa0\xd1\xd1\xfc\x88\x23\x81\xcc\x1a\xc1\xa0\x28\x83\x42\x7e\x9a\xd3\x3d\x9b\x8f\x75\x22\xb6\x43\x21\xc3\xbc\xf5\x90\x7d\x49\x73\xb5\x11\xe2\xa8\xad\x2e\x45\x4c\xc2\x60\xf0\xe5\x65\xe4\xba\x27\xb7\xec\x8f\x02\x77\xdb\xef\xda\x28\xec\x2b\x30\x38\xee\x1a\x62\x8f\xf1\x32\x46\xcb\x33\x6d\xad\x44\xb1\x32\xf1\x0c\xb3\xd2\x9b\x28\x9d\xc0\xb4\x0a\x49\xaf\x01\xec\x44\xd4\x15\x7e\x6e\x63\x40\xd3\x44\x32\xda\x0b\xb0\xee\x69\x31\x5b\xf9\xbd\x31\x5b\x80\x93\xc0\x96\xf9\x4d\x7f\x75\xcf\x1a\xe5\x25\x46\x8c\x37\x97\x5a\x07\x82\x75\xcf\xf0\xdc\xc3\xbf\x06\x1b\x16\x7d\xbb\x54\x26\x43\x59\xd4\xc6\x1e\x62\x35\x15\x26\x64\x17\x5b\x22\x75\xa7\xd0\x59\x04\xbb\x91\xb0\x5b\x0e\xa0\x5c\x6f\x08\xe4\x66\x04\x24\x3f\xb3\x13\x05\xc1\x73\x79\x36\x5e\x7a\x38\x7f\xa9\xb8\xaa\xe1\x99\xd9\x3e\xaa\x85\xd8\x49\x62\xb7\xe7\xef\x02\x54\x6f\xf6\x33\x56\x1a\x49\x92\x14\xaa\xf3\x6c\xb5\xd1\xfd\x66\xec\xa7\x0f\xff\x15\x2f\x44\x1d\xaa\x26\xdc\x84\xb1\x1d\xe9\x7f\x6a\x9f\x4b\xbd\xbc\x74\x62\xb3\xe9\x97\xbe\xb1\x6d\xcd\x16\xd9\x4e\x25\x8a\x71\xfa\x58\x9a\xa2\x54\xb0\xe9\x4e\xc1\x97\xb9\x2c\x69\x74\x55\x91\x3d\x2e\xdd\x13\x54\x5e\xb8\xbf\xdf\xc6\x56\x0a\x74\xfb\xd2\x0b\x94\xda\xba\xc4\x9b\xfc\x5d\xe8\x85\x29\x97\x27\x49\x92\x60\xb4\x8a\x7d\x9b\x48\x2e\x94\xd1\x14\xa0\x3e\xc5\xf8\xe0\xdf\x73\x41\x2c\x5f\xd4\x91\x7a\x25\x40\x0b\x8f\x14\x91\xa9\x84\xde\xf5\x91\xc6\xa9\xa9\x51\xd6\xcb\x16\x03\x2c\x16\x1e\xfe\x7a\x8a\x6a\x2e\x74\x06\x88\x35\x44\x5c\xbc\x34\x21\x99\x6c\xae\x8f\x9b\xd9\x80\xa4\xb1\x14\x5c\xb2\x01\x20\x59\x72\x38\x5d\x20\x0e\xc9\x92\x50\x9d\x0a\x0b\xca\x34\x68\x0d\x62\xbe\x48\x92\x86\x7d\x0d\x17\xe4\xcd\xfb\xd1\xcd\x28\xac\x01\x61\x39\x56\x58\x2d\x06\x24\x43\x30\x68\xf8\xf7\x03\x86\x86\x4d\x65\xb6\x3c\x3b\x0f\xa3\x30\xd3\xc6\xd1\x51\x56\x18\x8d\xed\x3e\xa5\x35\xbe\x0f\xfd\x3d\x64\xfe\xc1\xf5\x4e\x13\x1f\xb2\xc3\x8f\x04\x51\xbe\x5b\xd3\xfa\x49\x76\xa0\xc9\xee\x8b\x8c\xfd\x55\xea\x93\x87\x3e\xf5\x57\x07\x0a\x2b\xd1\x47\x0f\x20\xb3\x9d\x00\x28\xe7\x7a\xb4\x3b\x99\xb0\x6f\xf1\x69\x9c\xf8\xfc\x3c\xd4\xe6\x09\xb7\x95\x15\x49\x8c\x5c\x66\xf9\xee\x08\x58\x5a\xc3\x1b\xeb\xfc\x46\x9a\x37\x61\x25\x48\x3f\xa7\x61\x32\x24\x43\xd0\x1b\xa1\xcc\xd5\xea\xf3\x95\x3f\x90\xf1\xcb\x43\xe9\xcd\x0f\x43\x8c\x40\x67\x82\x62\xe9\xde\xb4\xda\xff\x88\x76\x8b\xf9\xaa\x39\x43\x4c\xc3\x28\xd3\x2f\x06\xac\xd7\xc0\x40\x81\xf8\xaa\xdf\xd4\xc9\x65\x10\x3c\x0b\xf0\xef\x5c\x4b\xd0\x27\x7f\x67\xd0\x10\xc3\x53\xec\xa6\x81\xc2\x0a\x31\xda\xc4\x9b\xbb\xd7\x09\x97\xb0\xf5\xb6\x8f\x26\x3e\xf4\xc7\x85\x36\xda\xf2\x90\xe0\x83\xb5\x4e\xca\xdb\xa5\x7c\x53\xfa\xf5\xe2\x20\xb8\xbc\x86\x52\x08\x58\x79\x6a\xdd\x13\x37\x0a\xa7\x8a\x8a\x21\xcb\xcd\xcd\xf5\xde\xbc\xf3\x33\xfd\x63\x4f\xd3\xeb\x70\xb3\xb4\x46\x18\xa0\x0a\x5e\xd8\xb5\x02\xfd\xe9\xb8\xf6\x9e\x50\x8f\x16\x5a\x21\x3e\x65\x77\xf6\x77\x6b\x6c\xdf\xe4\x40\xcb\xb3\x04\x49\xfc\xa6\x35\xd7\x1f\xad\x51\x6f\x63\xa4\xef\xaa\x4e\x75\xfd\x7e\x9c\x89\x62\x68\x77\x0d\x86\x22\xa3\xab\x1c\xce\x69\x18\x45\x6f\xbf\x28\x49\xb4\x4d\x91\xd2\xe5\x09\x13\x54\x21\x8b\x4b\xda\x4c\xfa\x85\xe0\x47\x56\xeb\x83\xc7\x33\x97\xfe\x69\xb6\x55\x7e\x11\x92\x1d\x26\xc1\x42\x01\xb0\x3c\xf6\x90\xfc\x0e\xaa\x1b\x89\xf7\xd7\x9f\x03\x8b\x9c\xee\x89\x1f\x2d\x5a\x12\x4c\xea\x95\xdb\x9b\x72\x58\x51\x38\x8a\x8f\x8f\x73\x8a\x82\x95\x20\x49\x9d\xf2\xf8\xca\x2c\x94\x63\x80\xd5\xa2\x39\x9a\x3f\xd9\x98\xcd\x1d\x92\x6d\xab\xf9\x1a\xc8\xef\xc3\x9a\xf8\x90\xde\x64\x3b\x49\x1a\x40\x76\x5f\x79\x18\xc5\xed\x6a\xc5\x94\xc7\x47\x55\x96\xc6\xc3\x29\x40\xf6\x92\x2d\xb4\x0c\x96\xb5\x1d\x56\x64\xbf\x51\x09\xae\x90\xeb\xd3\xd6\x4b\x93\xc5\xb9\x86\xf5\xbb\x65\xd2\x5d\xfd\x7e\x17\x87\xfb\x78\xfa\xa8\x63\xc3\x5a\xa5\xf6\x7a\x3d\x2a\xd8\xd5\xb3\x90\xa2\x88\x1c\xed\xc5\x1b\x30\x77\x09\x52\xf0\x1d\x3f\xa3\x39\x98\x67\x3b\x42\xaa\xc3\xe3\x5c\xb2\x0e\x5a\x2f\x0d\x00\x65\x74\x9c\xd1\x8a\x32\x8a\x0c\xd3\x65\x72\x2c\xe1\x2e\xd3\x85\xa5\x75\x5c\xac\x54\xdc\xfd\x3d\x02\x06\xe3\xd7\x65\x59\xa4\xb6\xbe\x26\x8d\x00\xc0\xc5\x90\x5b\xd2\x46\xc6\x4d\xe3\xec\x7a\xcb\xa4\x8b\x90\x29\xf0\xc5\x29\x28\xce\x02\x31\x08\x78\x67\x82\x1a\x81\x18\xe5\xb7\x7f\x9c\x8f\xde\xa6\x61\x3d\x49\x7c\xb2\x0d\x3f\x8c\xcf\xfa\x17\xc7\x98\x77\xcc\x06\xbc\xf0\x13\xc9\x59\x2c\x64\xd5\xf2\x13\xc5\xb0\xd4\x88\x67\x0a\x95\x15\x6c\x94\x64\xbe\xde\x22\xe1\xfe\xd4\x45\xc6\x29\xcb\xa7\x5a\x24\x2c\x0f\xd7\x80\x38\xc9\x2f\x6a\x7b\x30\x77\xab\x36\xf6\x35\x59\x0f\x0c\x56\x12\x04\x96\x2d\x2d\x82\xd4\x25\x8e\x71\xad\x2e\x45\xb0\x59\xeb\x6f\x1e\x7f\xc2\x4c\x52\xd0\x27\x11\x93\x27\x16\x1b\xeb\x9e\x70\x4b\xf5\x5b\xb3\xb0\x52\x46\x08\x5d\x61\x26\xff\xd3\x41\xf5\x45\x95\xb8\x42\x6b\x73\xae\x29\x8f\x50\x29\xd5\x4a\x92\x64\x7b\xec\xb3\xe7\xf1\xdf\x7f\x73\x52\xe5\xd8\xab\x20\x43\x73\x13\xf3\x1e\x7c\xba\x7f\x7e\xb2\x40\x62\x71\xd3\xed\x36\xad\xfe\x0a\xa9\x2e\x71\x92\xe3\x0a\xb3\xe6\x52\x12\x32\x2f\xc4\x91\x8d\xea\xb3\x75\x9a\xf9\x12\x9f\x46\xe9\x39\x74\xf8\xc2\x03\x42\x8f\x7f\xb5\x02\xdd\x95\x78\xb2\x83\xe4\x8d\xa3\x8f\xd5\x76\x87\x6f\x02\x1e\xa8\xd6\xbf\x1a\x9b\xad\xf5\x4d\x03\x93\xac\xd8\x06\x36\x11\x6a\x3a\xd4\x53\x2a\x82\x20\x48\x56\x22\xbd\xd0\x05\xf9\x4d\x0b\x38\x76\x7c\x66\x70\x2f\x40\xfe\x9b\xc9\x0c\x24\x71\x69\x8d\x17\xb3\x84\x96\xdc\xd6\xc8\x3a\x66\x22\x6c\xf2\x8d\x49\xd2\x0f\x2e\x40\x92\xad\x87\x96\xf4\x8b\x9b\x0a\xfb\x59\x92\x70\x0d\x16\xa4\x81\x80\x61\x98\x97\xd8\x92\x5f\xd2\x47\x06\x46\x60\x36\xd9\x9e\x7c\x23\xd1\xc4\xe4\xc7\xc6\x5e\x96\x3a\x2e\xf1\x7d\x12\xfd\x11\x14\xae\xa2\x2c\x18\xa8\xab\x64\x23\xbe\x8d\xcb\x2a\x99\x74\x6d\x80\xf8\xdd\xc4\x89\x2a\xad\x9b\x90\x62\x67\x31\xc4\xfa\x1b\x39\xd4\x04\xb5\x87\xf1\x57\xcf\x0c\x85\x2d\x08\x7c\x69\xf0\x6f\xef\x54\x97\x53\x64\x5f\x21\xda\x72\xe1\x94\x2e\x53\x84\x3e\xf8\xba\x28\xa3\x08\xa5\x69\xf0\x7c\xf1\x64\x56\xf1\xbf\x75\x46\xbd\x55\x16\x19\x04\xc1\x03\x2d\x9d\x02\x09\x17\x71\x70\x5d\x65\xcf\x27\xd8\x8f\x0d\x7b\x29\xdf\xab\xbc\x69\x62\x99\x5a\xa1\xea\x98\x2e\xb0\xcf\x0c\xa1\x77\x8f\x43\xbc\x49\xd5\xd5\x7a\xa3\x8b\xd6\xe5\x14\x25\x95\xea\xd6\x18\xdf\xf3\xb5\x4a\x10\xe3\xb1\x45\x31\x78\x1b\xb5\xc6\x16\x59\xdf\x44\xa4\x40\xab\x72\x02\x9e\x39\x3f\x6e\x3f\x2f\x1d\x3c\x24\x4d\x1a\x3d\x44\xe4\x11\xdf\x15\xef\xf2\x40\x29\x47\x02\x18\x92\x21\xd9\x48\xf3\x3d\x75\xea\x53\x0f\x92\xa7\x76\x10\x27\x8c\x56\x75\x31\x82\x7d\x58\xe0\x81\x7a\x7f\xa7\x60\xcb\x88\x06\x88\xa3\x5d\xfb\x72\x73\x1a\xaa\x5c\x61\x82\x42\x8d\xf7\x2b\xc3\x19\xb2\xaf\x05\x98\x6d\x88\x9f\x88\x35\x05\x8e\xff\x9e\x79\x9f\xf8\x11\xc6\xb4\xa9\x3a\xb8\xee\xc7\xa8\x8e\xd2\x1c\x03\xa3\x7d\x89\xf9\x02\x41\x9c\x56\x67\x43\x9d\x69\xc3\x1a\xbd\x35\x88\x0f\x33\x1d\xea\xe5\xba\x2d\xeb\xa1\x76\x8d\x43\xf8\xc9\x32\x15\xf2\x44\xb3\x33\xfa\x3d\x1c\xc8\xe0\xec\x37\x4c\x5e\x93\xbd\xcf\x0c\x00\x43\xf1\xe8\x77\x8b\x02\x0a\xcb\x36\xd5\x5d\x9d\x53\x7c\x6a\x45\xac\xea\x3c\xb5\xe2\xf0\x4c\xa6\x7b\x0a\x49\xe2\x13\xc5\xe8\xaa\x61\xd6\x80\xc0\x7d\xca\x08\x5d\xf4\x22\xb3\x43\x96\x5a\x87\x53\xf0\xf2\xbc\xb0\xd1\x27\xcb\x1d\xa1\x4b\x9d\x44\x03\xd8\xb0\xb7\x50\x63\x99\x08\xa6\xca\x53\x95\x65\x8c\x8a\xa4\xbb\x99\xb5\x50\x62\x2b\x45\xb1\xd8\xc2\xe2\xab\xca\x8f\x01\xbf\xce\x65\x1f\xd7\x7c\x8f\xef\x43\x3e\x13\xeb\xeb\x4a\x2e\xc1\x73\x7a\x6d\x8e\x46\x64\x0d\x56\x8c\xed\xb0\x78\xb3\x15\x2d\x7e\x0c\x7b\x12\x22\x0d\x26\xa7\x9f\x21\x1d\xe6\x39\x0c\xe0\x2f\x8a\x1c\xfc\xf7\x88\x2c\x06\x46\x41\x88\x30\xa4\x54\xc2\x12\x41\x19\x45\x38\x0f\xc3\x3b\xb4\x54\x51\x1c\x99\x2f\x0b\x75\x11\x2e\x0e\x54\x61\xc9\xfe\x12\x18\x89\x66\xa4\x9e\x0a\xd1\x29\xa9\xa5\xcd\xd4\x28\x98\xa9\xd6\xba\xfc\xef\xec\x64\x24\x3e\x79\xc9\x3e\x57\xa3\x54\x1f\x6e\xd2\x60\x92\x46\xd6\xce\x47\x83\xdb\xe0\x54\x55\x67\xee\xf2\x44\xf1\x9f\x42\x43\x39\x85\x6c\x63\x4e\x1e\x30\x93\xb7\x61\x31\x30\x95\xda\xfe\xb0\x17\x55\xf0\x7a\x8a\x97\x56\xff\x90\xf1\x63\xdb\x33\xd9\x60\x83\x42\x9a\xef\x9e\x47\x39\x2c\x11\x48\xdb\xe3\x40\xc1\x33\x42\x67\xd6\xa8\x85\x92\x3d\x6a\xf0\x85\x05\x11\xb9\xbe\x89\xcd\x36\x7d\x84\x1a\x50\x46\x6b\x78\xd5\x61\xd8\x97\x9c\xa4\xcd\xa6\x2e\xfa\xb5\xbe\x36\x95\xf3\xa5\x30\xda\x65\x10\x04\x8a\xa2\xbc\x3e\x76\x2e\xde\xa5\x1c\xf3\x2c\x67\x77\x1f\x62\x4b\x0c\x62\x80\xa5\x97\x7e\x33\xc7\x0e\xfa\x4a\x67\x2e\xbe\x4a\x8d\xaf\xb6\x16\x33\x37\x29\x1d\x4e\x11\x39\x83\x8e\x03\x22\x5d\x88\xc0\x2b\x1f\xed\x6b\xb5\x54\x46\xe2\x78\x7d\xdc\xa1\xfc\x4e\x10\x27\x89\x29\x14\x57\x82\xe2\x4a\xf5\xa4\x7d\x84\xc8\x1a\x5e\x6b\x44\x10\x84\x82\x0d\xec\xf5\x66\xe8\x87\x69\xaa\xda\x3d\xa9\x23\x21\xe5\x4d\x95\x62\x87\xbb\x7b\xff\xa3\xb7\x70\x7d\x08\x57\x5e\x7f\x2c\x90\x0c\xe5\x88\x51\xa1\x55\x05\x5c\xe8\xb8\x66\x66\x0c\x48\x5e\xc7\x91\x4c\xd0\x52\x27\xb3\x57\x4e\x97\x25\x12\x69\x30\xf8\x51\x6f\xa9\x05\x2c\x60\xf2\x73\x2c\x95\xea\x55\xd1\x8c\x35\x5a\x7b\xd8\x8b\x29\x6a\xaf\x16\x65\x42\xb4\x03\xde\x48\x73\x5d\x96\x79\x99\x87\xc0\xf1\x13\x69\x5f\x5d\x28\xc6\xf9\xb2\x2c\x3f\x16\xed\xf6\x98\x5f\x09\x85\x25\xb7\x52\xe2\xb1\x3f\xdc\x88\x43\xa7\x5f\xfc\x9f\x25\x45\x4c\x84\x53\xa4\xd6\x5f\x44\x71\xd1\x84\x16\x25\xf6\x70\xa5\x4e\xf5\x01\x5c\xbd\x4a\x83\xd2\x1a\x5b\x94\x05\x1b\x73\x8a\x19\x82\x1b\xcd\x08\x07\x8e\xe3\xe4\x6f\x8c\xe2\x84\xf5\xe1\x9d\xea\x9a\x18\x18\xfe\x7e\x83\x86\xfa\x71\x0b\xd5\xf8\x7a\xd5\x70\xa1\xd8\x27\x7a\xd2\xeb\xda\x5c\xac\xbe\x67\x24\x22\x14\x19\x65\x37\x00\xe7\x4a\x56\x05\x5d\x00\x9a\xdd\x6f\x12\xbb\x65\x12\x92\xf7\x89\x17\x05\x4e\xb3\x04\xd5\xf8\x69\x05\xc6\x9b\x09\xb3\xf9\x9a\x91\x21\x0d\x8f\xd9\x75\x4c\x2e\x42\x45\x3b\xa5\x16\x9b\x49\x6c\x97\x1c\x59\x83\x1d\xda\x42\x0c\x73\x13\x33\x3c\xf4\xe1\x2b\xfc\x00\x77\xd9\xfd\x54\x58\x6e\x9e\x5e\x10\xc0\x80\x63\x82\x05\x73\x44\xd6\x78\xb5\x1c\x15\x6f\xfe\x47\x14\xc2\x75\x21\xb6\xd1\xc3\x89\x4d\x96\xa8\xab\x3c\x5f\xec\xd9\xe6\x4f\xd5\x70\x82\x7d\x48\xbd\x8b\x97\xdc\xb5\x7d\x3a\x7f\x18\xb3\xbb\x67\x93\xd0\xe9\x12\x29\xed\xae\xc7\x9b\x31\xea\xc3\xa4\x56\x4f\xa2\xf6\x86\xb8\xb4\x01\xa3\x28\x26\x9f\x66\x83\x1d\xde\x04\xd1\x0b\xa5\x25\x1b\x92\xd7\x92\x10\xdb\xc9\x4f\x1f\xfb\x08\x8c\x64\xc1\x2a\xa2\x63\xd2\x3a\x10\x29\x0e\xef\xe1\x8f\xb6\xff\x51\x96\xc2\x1a\xe1\x54\x71\xcd\xbc\x4b\x44\x27\x97\x1d\x68\xba\xcf\x3d\x4b\xb9\x63\x1d\x7e\x06\xd4\xd7\xd9\xc5\x40\x49\x9a\x7c\xd8\x21\x65\x6d\x89\xdd\x33\x1f\x69\x21\x07\x03\x4a\xb9\xc5\xa9\x23\x90\x36\x98\xdb\x43\x4d\xe4\xae\x6c\x00\xc1\x34\x2d\x7f\x88\xd7\xb9\x86\xdc\xf5\x3d\x51\x4d\x1f\x6e\x91\x07\x82\x39\x16\x5f\x65\x1d\xd1\x8e\xe0\x9a\x53\x14\x88\xe8\x4e\xf7\xa3\x52\x60\x79\xc5\xab\xde\x6e\xe4\xf1\xf3\xca\x4d\x85\xba\xc2\xad\xab\x02\x24\xd1\x67\x86\x64\x34\x00\xc0\x45\x8d\x11\xc7\xba\x90\x3b\x41\x1f\x28\x4a\xd2\xc3\xe8\xcd\x95\x2f\xb1\xe7\x4a\x02\x18\x95\x19\x08\x49\x03\x67\x04\x82\xe7\x71\x3a\x1b\x98\xe1\x69\xb3\xad\xda\x8d\x51\xc5\x47\xe2\x9d\x1d\xf8\x79\x76\xc0\x6a\xb1\xa4\x7d\x82\xb0\xdb\x93\xf0\x17\xd5\x37\x10\xe7\x13\x54\x5a\x30\x5e\xf8\x72\x76\xa0\xc2\x30\x42\x00\xba\x93\xb1\xc9\x55\xb3\xbb\x7e\x76\x76\xda\xd5\x26\x96\x18\x18\x0f\xa0\xad\xeb\x7a\x84\xcc\x27\x51\x83\x72\xcd\x15\x1a\xcf\x9a\x38\xd6\xd9\x1e\x7b\x0f\x57\x5b\x01\x3f\x35\x08\x5a\x92\x2c\x8d\x7b\xc8\x3a\xaf\xfc\xd3\xda\xfc\x82\x81\x01\x5c\x46\xf3\xc7\xee\xbe\x13\x61\xc4\x79\x1b\x2c\x70\xa9\x66\x06\xf5\x93\x76\x68\x46\x93\x7f\x9d\x6a\x78\x6d\xc7\x29\x20\x43\x51\x14\x2c\x03\x75\x62\xd5\x59\x4f\x64\xf6\xa5\xc0\x13\x26\x79\x1b\x61\x98\x93\xef\x9e\xc4\x00\xc0\x72\x71\x22\xf0\x20\xf2\xe2\xec\x22\x00\xcd\x76\x04\x68\xcf\x0b\x13\xec\xe0\x3a\x68\xea\x23\x72\xf8\x54\x44\xdd\xfc\x69\x16\xb3\x9d\x48\x02\x28\x3b\xdc\x6c\x97\xcc\x82\xb3\xc0\x9e\x1f\xa7\x96\xb4\xa4\x7f\xee\xec\x3a\x08\x3c\xd9\xa0\xd4\xb0\xd2\x43\xf1\xb9\xaa\xc3\x50\xbc\x96\xb4\xf4\xd9\xea\x09\xc9\xc4\xda\xf6\x55\xce\xeb\x6e\x3d\x49\x42\x7c\x48\x96\x18\x67\xaf\xd0\x71\x2d\xef\x7d\x5f\x9a\x17\x14\xbb\x7a\x4c\x9e\xad\x10\xa3\x89\x7b\xd5\xf3\x6f\x8a\x2e\x66\xd7\xee\x34\x76\x78\x93\x09\x28\xcb\xc5\x7e\xa8\xa4\x38\xa3\x29\x10\x59\x67\x4a\xd9\xba\xfc\xe9\x8d\x1f\x04\x33\xdf\x1c\x32\xde\x02\x25\x49\x10\x94\xcf\x05\xec\x27\xcf\x87\x0d\x77\xd0\x8f\x32\x46\xf6\x79\x79\x63\xf3\xde\x34\xfe\x02\x00\x00\x0c\xa8\xc8\x21\xe2\x9d\x7b\x83\xae\x6f\xef\x0b\x67\x46\x32\x35\x23\x6b\x58\x34\x71\x84\xd3\xe1\x58\x67\x0c\x4a\x1d\x35\x41\x83\xad\x90\x94\x84\x2e\xe5\xbb\x38\xe5\xa6\x9e\x02\x1d\x35\x78\x77\x8b\x56\x28\xad\x1a\x36\x8f\x72\x84\x2e\xc0\xef\xe2\x48\x97\xcb\x2a\x52\x43\xfc\xb0\x9f\x6a\x15\x5c\xe1\x98\x8a\xcf\x32\xb7\x19\x52\xbb\xb1\xdd\x35\x38\xd0\x76\x0b\x1a\xa8\x18\xa1\x1f\xa1\x50\xe0\x48\x11\x66\x3d\xc9\x0e\x94\x53\x1b\x29\x7f\xa3\x57\x68\x58\xc9\xe8\xc7\x08\xa2\x4e\x95\xa0\x01\x22\x37\xba\xef\xda\xfb\x0b\x38\x8a\x97\xff\x59\x54\xd3\x75\x15\x45\x69\xba\x99\x2b\xdf\x78\x82\x0a\x2b\x58\xb1\x71\xb7\xcd\xcf\x54\x7f\x8f\xc8\x6c\xcb\xf3\xa5\xa6\xbc\x7a\x79\x5d\x3d\x0d\x72\x47\x48\x1a\xa7\x41\x70\xe9\x9d\x02\x4d\x5c\xbf\x56\x04\x3b\xb2\x09\xcb\x9d\x96\x65\xee\x07\x7b\x11\x6c\x53\xd0\x6b\xee\xa3\x19\xae\xf1\x3d\xab\x78\xf9\x34\xbd\xd4\x3a\x9b\x70\x65\x52\x3d\x1d\xd2\xcf\xcb\x31\x3a\xb2\xb2\x75\x45\x0e\xb8\x59\x8c\x4d\x28\x26\xe1\x3c\xbf\x35\xa7\x64\xb5\xe0\x1a\xd2\x6e\xd6\x9d\x4b\x08\xe2\x4f\x0b\x7b\x38\x46\x31\x9e\xe9\x83\x27\x4a\xee\x78\xd3\xdb\xb9\xa1\xcc\xb9\x46\x81\xd6\xfb\x45\xc5\xdb\x7e\x7c\xa0\x85\x8d\x2c\x04\xce\x11\xb7\xd3\x49\x7c\xc3\xa0\x4b\x18\x6e\x47\xb5\x60\xed\x89\x58\x9e\xdb\xe0\x92\x25\x7a\x4a\xcd\x6a\xca\x11\xfb\x7b\xe3\xbf\x9c\x25\x11\x03\xbe\xbf\x91\x5e\xd1\x2c\xfb\xca\x2a\xf4\xc4\x98\xb9\x2e\xdc\x61\x4b\x37\x28\xf4\x2e\x1e\x4f\x9e\x2a\x4f\xb9\xb5\x50\x45\x69\x42\x5f\x55\xb4\x17\xe5\x93\x9e\xd9\x17\x97\x19\x32\xc6\x4c\xef\x05\xc2\xcf\x1d\x6a\x48\xbe\xdf\xf1\xd0\x01\xe3\x44\x98\x6d\x83\x2d\x1f\xe2\x46\x90\xec\xf9\x92\xe6\x5f\xed\xfc\x64\xb1\x0a\x77\xe8\x06\x66\x06\xbd\x13\x66\xaf\x1b\x2b\xcc\xd9\x50\xa9\x3c\x6f\xc8\x22\x75\xb3\xe4\xb9\xb3\xd0\x8b\x8e\x1f\xa5\xd6\x1b\x9a\xd8\xfd\x93\x0e\xfc\x43\x35\xd3\xa6\xb1\xd7\x9b\x6c\x7f\x5c\xa7\x12\x68\xc0\xda\x5f\x99\x06\xc0\x1f\x07\x57\x2c\xd7\x80\x11\x9c\xfb\x2c\xf4\x46\x78\x54\xa5\xbc\xe0\xf8\xc1\x1e\x81\x60\xd9\xc0\x5f\x95\x75\x97\x49\x4a\x54\xbd\x65\x2e\x46\xd3\xbb\xce\xaf\x3a\x91\x59\x03\x04\x1d\x46\xf6\x5b\xde\xbd\x6a\xfb\x9c\xf2\xc1\x22\x09\x57\x5c\xa1\xd1\xc8\x12\xa4\x69\x18\xbc\xf0\xa2\x9d\x08\xa9\xa6\xbe\xc6\xda\xfc\xf5\x33\x5f\x44\xfb\x3c\xb9\x6f\x3e\xb3\x9f\xd9\x11\x1c\xc1\x03\x01\x80\x33\x4d\x0c\xdf\xd0\x8c\xc4\x29\xc0\xd3\x66\xa2\x13\xec\xfb\x03\x80\xe0\xcf\x8e\x1f\x6b\x87\x33\xde\x77\x3b\xac\x06\xeb\xe6\x8f\xbb\xb5\x28\x34\x34\xd0\x0f\xbb\x28\x8b\xbf\x9c\x09\xb1\x44\x16\xe1\x80\x21\x57\x71\xd3\x50\xac\xaa\x07\x69\x28\xde\xc4\xbf\xfa\xc7\x86\x53\xf8\x8d\x3c\xee\xcc\x0b\xae\x43\xbc\x7e\x78\xd5\x09\x36\xab\x1b\xd7\xdf\x59\xe1\x35\x7c\xf3\xaa\x3f\xd0\xf5\xc2\xcb\x72\x86\xb6\xfd\xdf\x0c\x17\x94\xc4\xe9\x3e\x82\x29\x43\x98\xff\x7f\x32\x01\xca\x79\x37\x7b\x33\x1c\xa7\x80\xf8\x3c\xc1\x9b\x2b\x1a\xc1\x65\x59\x21\x91\xa2\xf1\x40\xf9\x71\x2a\xaf\xed\xe4\xb0\x88\x1b\xbd\x1d\xed\x0e\xb0\xff\xf2\x55\x22\x39\x7d\xba\xf0\x01\x03\x79\xab\x7f\x5c\xa4\xf0\x75\xae\xae\x5f\x3c\x27\xd5\xa1\x8e\x37\xef\x21\x10\x93\xb0\x9a\xf6\xa2\xf7\xaf\xf4\x67\x4b\x31\x2d\xa9\xf5\xb9\xcf\x21\xef\x98\xe7\x25\x1e\x34\xee\xd5\xff\x7a\x29\x6e\xa9\xa4\x31\x7d\xe4\xc3\xd6\xfb\x7a\x65\x39\xf2\x34\x18\xcd\x5b\x5b\x94\x7c\xc5\x7c\x9b\x97\x3c\xb4\x66\x3a\x78\x0c\x38\xb3\x3d\xbc\xa9\x52\x06\x19\xba\x43\xbb\xd9\x9b\x29\xc0\xf3\x30\x66\x54\x91\xfc\x00\x3e\x11\xf8\x84\x55\xd9\x0d\xa9\x81\x50\x05\x60\xf8\xa4\x6a\xe4\x7b\x0d\xb3\x45\x54\x11\x6e\x1a\x5f\x89\x4e\x64\x78\x3f\xff\xe2\x38\x9d\xb5\x47\x50\xa8\xb3\x44\x49\x78\x4d\xfa\x67\x5b\x3e\x75\xbc\x87\x83\x33\xa5\x4c\xcb\x53\xba\xab\x0a\x7f\x75\xf4\x4d\x24\xb7\x30\x81\x9b\xd9\x30\x77\xcb\xf0\x19\x83\x9b\x32\x59\xd6\x37\xf6\xe3\x55\xab\xfb\xe2\x6c\x59\x50\x4e\xf1\x03\x17\x47\x6f\xd0\x1e\x86\x32\x98\xe9\x75\x38\x9c\x47\x81\x22\x41\xc8\xdf\x7e\xdc\x45\x47\xf1\xc5\xe4\x75\x34\x3b\x14\xa5\x18\xf5\x20\x9e\x19\x02\x5e\x39\x9a\x20\xfb\x38\x91\x24\xe9\xd9\x29\xef\xda\xae\xc6\x35\xd3\xf2\x49\x92\xa6\xf9\x90\xfb\x83\x53\x74\xb8\x06\x37\x23\xe8\x4e\xa3\xd7\xf6\x16\xe5\xbd\x93\x2f\x79\xe2\x46\x3d\x01\xfc\xf4\xc3\x8f\x67\xc0\xf2\x44\xa5\x7b\x4a\xa3\x75\x86\x3e\x5e\x8c\xf4\x5b\xd9\x76\x36\xea\x42\xbb\x82\xc9\x0c\xa6\xf4\x08\x25\x55\x0d\xb7\x2c\xcb\xcc\x38\x32\x91\xb1\xb0\x51\x46\x2f\x0d\x82\xe7\xdd\x3f\x78\xa2\xaa\xaa\x02\xa7\x74\x6a\xdb\x65\x2c\xd8\xc1\x43\x15\x86\xcc\x5f\x8f\x4e\x98\xfd\x5a\xb4\xdb\xd8\x52\x5f\x2e\xfe\x6b\x30\x60\x37\x0d\x04\x74\x3c\x5b\xbd\x93\x57\x6f\x1a\x6a\x23\x70\x03\xdf\x92\x53\xc5\xd7\x47\xae\xc9\x02\x2d\x30\x19\x63\x11\x41\xe9\x05\x45\x97\x98\x21\xe9\x13\x9d\xc4\x76\xce\xee\xd4\xd9\x8c\x60\x04\x01\x40\xd2\x51\xd9\x93\x08\xfb\xbf\x9c\xf8\x32\x1e\xb2\x6b\xee\x77\x07\x80\xf2\xbb\xe2\x33\xbb\xa5\x52\xa5\xf0\x0c\x50\x9a\xa3\xbd\x85\x5f\xe7\xa3\xce\x86\xc8\xa1\xdb\xa8\x3e\x25\x4a\xd2\x74\xe9\x40\xa1\xf0\x0c\x21\x7e\x64\xe3\x89\x90\xaa\x64\x55\x70\x20\xa9\xac\x96\x1f\xe8\xf7\x34\xbc\x20\x40\x37\x79\xff\xdd\x0f\x1b\x8b\x75\xdb\xe1\x85\x5b\xb7\x3b\xa3\x8d\xc3\xfb\xf3\x36\xa6\x4a\x04\x7d\x19\xe5\x39\x0d\x38\x75\xe3\xe0\xa5\x0c\xd3\x28\x09\x93\x34\x1d\x44\x11\xd5\x95\xb3\x61\xcd\xed\x44\x98\x16\x48\x62\x18\x35\x78\xea\x1d\xba\x7d\xcf\x42\x9a\xb9\x44\xb3\x42\x95\x16\xa6\xce\x14\x5e\x78\x14\xfb\xca\x54\x84\x92\xf4\x24\x68\x65\x71\x86\x6b\x8c\x20\x40\xe9\xcd\x02\xdc\x7e\x6d\x87\xed\x52\xa9\x89\xbb\x44\xaa\x71\x5f\x42\xfa\xbf\x66\x0a\xad\xf2\xb0\x18\x5d\x74\x8c\x7d\x9b\xd6\x6d\x0c\x6f\xac\xa8\xd4\x15\xe1\xe4\x17\x35\x9e\xc2\x8a\xb6\xc2\x1a\x9f\x58\x61\x1a\xd5\xd1\xd4\x07\xff\xae\x6c\x75\x7d\xd7\x9d\xef\x49\x7a\xb9\x39\x3e\x12\xe2\xba\xfd\x59\x65\xfa\x7b\x7c\xf8\xed\xe9\x9d\x22\x5a\xf1\x1b\x83\x92\x4a\x45\x94\x4b\x52\x25\xa7\x2b\xf8\xbf\x7a\xe1\x4b\x2e\x27\xb2\x40\xf2\x21\xe9\x54\xbe\x8e\x7d\x51\x7f\x12\x9d\x28\x12\xad\x7c\xee\x98\x2a\x0f\x24\x33\x47\x3f\xf3\x39\xc2\x3c\x57\xf6\xb2\x05\xd0\x8a\xa2\x08\x77\xd7\x44\xca\x19\x30\x51\xfd\x08\x06\xcb\x0d\xc9\x2d\x4e\xd1\x20\xda\xf2\x0e\x94\x04\x4e\xfa\xc4\x48\xf5\xa6\x80\x92\x61\xd1\x95\x18\xe6\x25\x52\x11\xb0\x58\x97\xb4\x66\x67\xd6\x17\xec\x86\x8a\xd2\xdf\xdf\xd4\xd7\xed\x3a\xee\xb4\x7c\xd6\xe4\x40\xed\xe5\x93\x45\x1c\x8e\xea\xa1\x26\x3e\x87\x5f\xf6\x7a\xa5\x35\x84\x79\x03\x3a\x5d\x9c\xb6\x72\x91\x4c\xeb\x60\xd8\x25\x31\x57\x24\xde\x25\x6e\x16\xdc\x9b\x50\x34\x93\x74\xfe\xd1\xbf\x3b\x49\x1f\xaf\xd8\xdc\x39\x59\x44\x03\xeb\xb8\x9b\x7e\x61\x12\x5b\xf9\x40\x61\x31\x32\x17\x88\x74\x59\x02\xad\xad\x14\xfc\x9d\x4a\xf3\x72\x0c\x64\x7e\xac\x62\x7b\xe3\x4b\x3c\xa8\xae\xdf\x9b\x6f\x71\x26\xa7\x91\xec\xd6\x4c\xf3\xfd\x8b\x57\xcc\x1b\xf4\xa2\x03\xa5\x73\xb0\xc0\xcb\x36\x4d\xd8\x7f\x3d\x2f\x52\x84\xaf\x30\x93\xaf\x2e\x3d\x10\x59\xa9\x70\xaf\x4d\x33\xd2\x3d\x84\x8a\xe4\xf4\xb6\x90\xf6\x37\xd7\x76\xd8\xf6\xc6\x30\xf1\x44\xe5\x17\x77\x1e\x42\xb8\x1b\x09\x2c\xc7\x71\x04\x58\x6f\xa3\xc7\xcd\x08\xcf\xf0\xaf\x3e\xe5\xa1\x4e\xf2\xa7\xcd\xe8\xe2\x8c\x9e\xd2\x73\x79\x29\x79\xf9\x60\x85\x47\xc7\x5f\x04\x44\xfb\x61\xa0\xd3\xe5\xf2\x80\x2f\xa9\x5d\x73\xdd\x42\x6c\x64\x51\xaa\x5d\x50\x68\xbe\xf8\xe0\x5f\xc2\x94\x6b\x3f\xb8\xbf\xa3\xfa\x71\x99\xed\x22\xac\x68\xfd\xeb\xff\x8a\x89\xbe\x7e\x41\xdc\x2e\x38\xba\xd7\xa1\x20\x00\xd8\xdf\x2f\x6b\x8a\x80\x02\x15\x26\xab\x6b\x42\xc2\x6b\xfc\xb5\xfd\xf5\xd4\xec\x30\x40\xe7\x2f\x92\x03\xce\x93\xfc\x1e\x91\x0e\x96\xe7\x1d\x07\x7d\x36\x90\xe6\xa3\x2b\x4a\xf3\x8e\x39\xd4\x33\x0c\x93\x9f\x50\x2f\xd8\x81\x2b\xd7\x57\xc1\x29\x3c\x4c\xd0\x7c\xfd\xe2\xd3\xad\xab\xcd\x76\x68\x3b\x01\xa5\x89\x49\xe9\xe9\x8e\x3b\xd3\x15\xdb\xf9\xf2\x02\xd1\x0b\xbc\x33\x44\x02\x60\xb6\x23\xb8\x5d\x7b\x50\x2e\x27\x60\xb6\x21\x41\x9f\x84\x09\x8a\x24\xc0\x5a\xa4\x82\xeb\xc6\x83\xda\x5c\x1f\xea\xef\xba\xbc\xd2\x2b\xcd\x44\x54\x54\xfd\x08\xb5\xf0\x1c\xee\x81\x7b\x91\xe0\x17\x7e\xc3\x57\x7c\x97\xf2\xa5\x01\xb0\x7c\x81\x4b\xf2\x84\xb8\xd5\x79\xe6\xfa\x3c\x9b\x26\x86\x8d\x4d\x39\xcd\xb7\x04\x01\xd0\xcd\xcd\xd1\x6a\x1f\xdc\xdf\x22\xf1\xce\x6d\x6e\x1b\x6d\x88\x67\x40\xeb\xa6\x20\x46\x4d\x4e\x71\xda\x61\x6c\xb3\xd5\xba\xcc\x1b\x49\xce\x48\x7a\x80\x4e\xed\x01\x5d\x1f\x97\xd5\x84\x89\x45\x36\x0c\xc6\x04\xd3\x25\xa2\x18\x67\x8c\xf3\xd3\xd6\x4b\x08\xda\x6c\x7f\x5e\xaa\x99\x16\xc5\x90\xde\x38\x96\x9c\x0e\x5e\x21\x69\x98\x97\xac\xc6\x0a\x51\xeb\x1f\x42\x8c\xb3\x7a\x7c\x71\xc2\xc0\x4f\x16\x04\x2c\xbe\x9e\x85\xc1\x7b\x75\xd4\x18\x50\xc5\xe0\xae\xe9\x73\x8c\xd6\xe8\x9d\xba\xa4\x5d\x0b\x3c\xdd\xa2\x5a\x9c\x2b\xf4\x29\x59\x2d\x1f\xd5\x67\x59\x86\x19\xfa\xda\xb3\xd9\x7c\x9f\xa1\x78\x51\x14\xa5\xa3\xa8\x5b\x19\x5d\xba\xcf\x0c\x05\x49\xfa\x40\x4c\x79\x60\x5a\xd8\xf0\xfc\xbf\xbc\x43\xb8\xc4\x59\x2c\x07\x38\xa3\x35\x07\x41\xa0\xcc\x03\x1e\x8e\xd4\xf7\xfb\x40\x9b\xc6\x29\xad\x82\x99\x5c\x55\x33\x02\x67\x6f\x9a\x0b\xf5\x2a\x94\x97\x11\x4b\xc5\x8f\xac\x17\xac\x09\x1e\x3f\x6d\x95\xba\xe3\x56\x09\x4c\xf5\x95\xd9\xcb\x66\xcd\xd1\x9b\x29\x70\x47\xc8\xb2\xf7\x6e\x28\x10\xed\x88\xdf\x3e\xc8\x9e\x45\x37\x05\x6a\x3b\x4a\x52\x1a\x77\xf5\x2b\x94\xbf\xdf\x47\xef\x97\x27\x61\xfa\x18\x89\x50\x00\x34\xc6\x02\x1d\x49\x67\xea\x22\xa3\x64\x1f\xc7\x95\x3d\x88\x10\x85\x53\x23\x80\x61\x15\xff\x66\x7f\xab\x4d\xdc\x2f\x2f\xa3\x9a\x90\x77\xa5\x5d\xea\x3d\xd4\xa8\x56\xe6\xcb\x5c\xba\xd9\x10\x57\x81\x4d\xcd\x1a\xef\x8d\x68\x4f\xa2\x44\x7e\x8f\x1f\x7f\x50\x16\x77\xfd\x9b\x07\x65\x4f\x82\x38\xbe\x38\xb9\xd7\x1c\xae\xe4\x51\x00\xcf\x10\x50\xb2\xc3\x47\x50\x84\xe6\x8c\x56\xc8\x15\x6e\x23\x43\xa8\x31\x58\xe0\x2f\x2e\x2b\x84\xd6\x5c\x9b\x60\x07\x78\x11\xd5\x7e\x20\xfe\x82\xca\x9b\x08\x73\x61\x8a\x5b\x3f\x22\x8d\xee\xb7\x7d\x45\xe8\x32\x35\xc8\x2f\xe3\xb0\x31\xdb\x20\x89\x3e\xb8\x3f\x7e\x1e\xf9\x19\x76\x1b\xb9\xff\x60\x64\xdb\x3f\x74\x19\xad\xf4\xdf\x54\x4c\x8d\x2a\x74\x29\x37\x9b\x81\x3c\x7e\xb9\xb1\x21\x99\x1e\x78\x86\x83\x17\xd1\x80\x1f\xd9\xb0\x94\xea\x8f\x73\x35\x1b\x12\x99\x07\xc8\xbe\x9c\x15\x9d\x88\x27\xd4\xca\x24\xa8\xef\x1e\xb3\x92\xca\x8c\xbc\xae\x32\xc1\x4f\x5f\xaf\x47\x75\x85\x41\x1e\xed\xc8\x19\xad\x13\xc7\x54\x26\x4e\x70\x5e\x0c\x19\xea\x05\x96\x37\xa1\x08\xf6\xcb\x27\x43\x32\x64\x2e\xc9\x9c\x11\x4a\xe6\x23\xef\x7d\xdb\xe6\xfb\xd3\x8d\x55\xc3\x1d\xd1\x0a\x7f\xfe\xd6\xf4\xda\x47\xc5\xc0\xb6\xb9\xe3\xad\xe6\xa4\x09\xc0\x62\x91\x51\xa4\xda\x07\x3a\xbf\x81\x8a\x48\xeb\xa9\x7e\x7f\x86\xcc\x86\x72\x29\x31\x06\x38\x95\x90\x45\xfe\x28\x66\x93\x0a\x2a\x57\xcd\x61\x10\xa4\x3a\x99\xff\x72\x57\xab\x11\x32\x98\xc6\x52\x9b\x21\xf5\xb5\x9b\x1f\x60\x75\xba\x28\xa0\x28\x08\x9e\x71\x62\x4e\xae\xc8\x74\x51\xa5\x54\x55\x6c\x48\xbb\x8d\x93\x1e\xb9\xe3\xfa\x12\x7c\x10\xf6\x15\xf4\x6b\xa3\x0f\x34\x83\x71\x42\xa7\x79\x90\xa6\x59\x6b\x6a\x9a\xd7\x95\x1c\xaa\x6c\x1f\x5e\xd9\x07\x34\x4b\x87\x79\x69\x15\xc4\xd0\xdc\x6b\x1b\x4e\xa5\x48\x95\xe5\x27\x1d\xcb\xf2\x5c\xe6\xe5\xeb\x5a\xd4\xcf\x2b\x4b\xa3\x37\x2f\x3d\x4d\xdd\xa4\x4c\x95\xe5\x89\x5e\x54\xac\x7f\x78\x47\x4a\x4c\xfa\xcc\x20\x04\xcc\x5d\xd0\xe0\xed\xde\xfd\x02\x85\x65\x5b\xf7\x7e\xc7\xa1\xb8\x4c\x11\x67\xc2\x57\xb6\x23\x84\x13\x62\xae\x06\x13\xd4\xf1\x31\xe5\xe9\x99\x9a\xae\x68\xf5\xc1\x33\x81\x73\xfd\x3b\x89\x6a\xd4\xfe\x5a\x99\x2a\xf1\x1d\xa5\x7b\x4d\xaf\xa3\x00\xdf\x89\x30\xfb\x03\xc9\x44\x19\x29\x90\x6b\xb7\x7d\x91\x15\x18\x17\x3f\xd6\xc5\xb5\x86\x3a\x5a\x61\x42\x54\xb8\x6a\xd3\xcc\xd7\xb2\x40\x4a\x80\x1b\x3f\x50\x7d\x6a\x13\xff\xcd\x46\x53\x1f\x7f\x08\x10\x11\x92\xaa\x57\x04\xc1\xf3\x71\xbb\x5a\x33\x92\xb0\xe5\x74\xf3\xcd\x0f\xb4\xfc\xd3\x6a\x63\x2b\x3b\x44\xe9\x3a\x0a\x54\x2c\x49\x80\x4b\x53\xcc\x0d\xea\x67\x20\x8e\x7a\x8d\x45\xbc\xd6\x93\xe1\xbb\xf6\x4f\xea\x3d\x89\x7b\xc6\xf7\x2b\xf3\xf7\x85\xd9\x3c\x91\x14\x60\xb4\xb0\xee\x64\xe6\xfd\x6c\x8b\x3a\xaa\x3c\x6a\x05\x96\x24\xc1\x57\x97\x61\x83\x37\x0d\x82\x96\xfc\xe2\x14\xf8\x91\x2f\x94\xdf\xd8\x0d\xcd\xd6\x06\x3f\x62\x31\x6b\x28\xb1\xaa\x95\x42\x5e\x80\x60\xe8\xb7\xbe\x03\xcb\x15\xae\x10\x19\x23\x0c\xa9\xc5\x75\x81\xcc\x01\x9c\x11\x2c\xcf\x30\x68\x10\x22\x77\x24\xbb\x42\x0e\x1a\xc4\x2e\xbe\x48\x76\x85\x09\xcc\x90\xbd\xd5\xbf\x74\x96\xe9\xb3\x4b\xf9\x25\x6c\x4a\xa7\xdb\x4f\x93\x74\x68\xe6\xde\xa9\x30\x0d\x73\x18\x18\x21\xcf\x30\x66\xe8\x48\x6c\x15\xcb\x3c\x4e\x1d\xe2\x6e\xce\xde\x66\x7b\x7e\xe7\xb6\x87\xf1\xe2\x14\x26\x9e\xf4\xeb\x2f\x81\x0e\xe7\x6a\x8c\xf8\x62\x9f\x10\x85\xd5\xea\xc8\x19\x6a\x56\xa7\x85\x4d\xea\xad\xc1\x02\x37\xe3\x15\x13\xc7\xb9\x32\xa4\xa1\x24\x90\x3e\x74\x48\xf0\x77\x54\xc8\xb1\xbc\x13\xc5\x41\x19\xc3\x05\xde\x68\x85\xfb\x67\x6e\x7d\x05\xb7\x2f\x8f\xd9\xfe\xf6\xc7\xa8\x42\xa6\x85\x4d\xe7\x6d\x98\xfe\xf9\xe8\x63\x20\x87\x63\x9d\x88\x04\x29\xc7\x4a\x61\x18\x20\x42\x83\x1f\xc0\x6f\x75\xab\xf7\x5c\xef\x0d\xd3\x92\x75\xfe\xd2\xc3\x04\x5d\xca\x6f\x7e\x44\xbc\x95\x51\xcc\x12\x0f\xf8\x79\x22\x99\x21\x4f\xaf\xd9\xce\x35\xa7\xdd\x99\x74\x6f\x21\x2b\x6a\x17\xa5\x5f\x94\xcc\x5e\xa9\xed\x89\x5d\x44\x42\x89\xdd\x09\xb6\xeb\x8c\xea\xa5\x8f\xc2\x4c\xd0\xc7\x33\xdf\x87\xfa\x5d\x1a\x26\x6c\xf0\xef\x19\x11\xf8\xf7\x9b\x15\xc0\x39\x92\xfa\xc0\x8b\x3f\xaa\x88\xd6\xfe\xaa\xf9\x6b\x11\xaf\xba\x7b\x93\xad\x2e\x13\x75\x43\x33\x00\xa3\xda\x17\xf3\x9d\xcc\x7c\xb7\x37\xdf\x91\xd6\xd9\x10\x8d\x29\xac\x68\x9a\x34\x4e\x9d\x0c\x71\x56\x5c\xa5\x7d\xfe\xc7\xd2\x79\xec\x38\x0c\x24\x49\xfb\x3e\x4f\x31\xfb\x5f\x79\xa0\x15\x0d\x30\x3b\x00\xbd\xf7\x56\xba\xd1\x7b\xef\xb9\xd8\x77\xff\xc1\x9e\xbd\x36\x5a\x2d\xa1\x58\x99\x11\x5f\xab\x2a\xb2\xa2\x09\x89\x4c\x5c\xc8\x90\x28\xb0\x20\x68\x99\x5e\xe1\xfc\x88\xe6\x0e\x9d\xf6\xcf\xb6\x24\xe1\x49\x5a\x0d\xba\xc0\x04\x59\xa6\x1d\xbf\x0e\xef\x3a\x57\xac\xa4\xdc\x69\xa7\xe5\xb1\xba\xbf\x4b\x61\x34\x0e\x84\x26\xd3\xfd\x12\xa1\xea\x96\x1e\xb7\xaa\xc0\x41\x60\xbe\x26\x50\x33\xf8\x88\xe7\x50\x7d\x43\x41\x76\xf9\xea\x5e\x2d\x84\x3a\x92\xdd\xde\x62\xc5\x1b\x31\x51\x89\x7b\x68\xd3\x9c\x68\x81\x31\x9b\x79\xbd\x87\x68\x49\x4d\x3f\xec\x8e\x3e\xa5\x07\x8a\x67\x17\xa0\x4f\x1a\xd3\xb5\xfa\xd6\xdf\xbf\x74\xb5\x19\xba\x35\x38\xc7\x97\x19\x95\x4e\x53\xa4\x9b\xf0\xb8\x72\x46\xc0\x3e\x16\x40\xe2\x2a\x7c\x5f\x60\x71\x4e\x4e\x8f\x5b\x89\x6c\x47\x99\x93\xcc\xff\xe6\x73\x40\xcd\xd2\x3c\x5a\x08\x6f\xbf\x16\x57\x5b\xb2\xb9\x30\x4a\x6c\x8c\x9e\x13\x6a\x1e\xda\x07\x08\x8a\x0d\xc9\x42\x17\xc8\x65\xf4\x87\xc5\xe9\xe3\x6e\x36\xbb\xff\xf5\xbf\xbf\xac\x34\xd0\x97\x4f\x82\x8b\x16\x18\x3c\x50\x6d\x78\x76\x00\x00\xe2\x47\x02\xec\xeb\xc3\xd9\xa4\x59\x14\xc7\x32\xbd\xaf\x1f\xe2\xb4\x5d\x04\x7b\xb6\xa2\xe6\x94\x99\x16\xf0\x72\xad\xc1\x70\xab\xfb\x20\x1f\xd4\xf9\x9a\x6e\x25\x4a\xdf\x47\x69\x9f\xd3\x06\xc3\x6f\x2d\x57\x46\xaa\x0d\xe4\x99\x9a\x92\xd9\xac\x84\xfa\x94\x3d\x91\x81\x20\x8a\x89\x25\x20\xb5\x1f\xc1\xf1\x03\xcb\x93\x24\x14\x5c\x11\x8d\x6f\xac\x07\x2c\x60\x2a\x8b\xc0\xf5\x35\x1b\xcc\x68\x4d\x1f\x2d\x25\xab\x76\x6d\xb2\x03\x9d\x4e\x3c\x0b\x97\x70\x11\xda\x5d\xab\x09\x15\xb3\xb1\x93\x53\x2c\xeb\x77\xc6\x9c\x1e\x0e\xae\x62\xfa\x43\x8a\xfe\x90\xac\xad\x64\x96\xed\x44\xda\xe6\x99\x0d\x21\x32\xe8\xc0\x75\xee\x63\x8b\x2a\xdb\x4e\x7b\xe4\x4d\xd0\xaa\x0a\x2c\xfc\x92\xdf\x1e\xb8\xce\x0f\x4b\x4c\xe2\xb7\xe2\x85\x17\x8c\x2a\x55\x80\x24\xc0\x9a\xae\xff\x03\x12\xbd\xcf\xd0\x20\x86\xdc\x24\x16\x58\x97\x95\x15\xd9\x6d\x47\x16\xbe\x55\xeb\x82\x2d\xe7\x8b\x23\x19\xc6\xd5\xc0\x8f\x24\xf3\x9f\x23\x92\x69\x6e\x8a\x2f\x97\x2c\xf3\xdf\x95\xf6\xfa\x1b\x8a\xd5\x91\x20\xd4\x4e\xac\x84\x4b\x07\xca\xd9\xab\x97\x21\x64\xf0\xda\x89\xbc\xbd\x8b\x20\x08\x7e\x08\xd0\x6a\xae\x30\x31\x7a\xd3\x1b\x71\x63\x58\x0a\x7c\x5f\x66\xe0\x68\x30\x87\xff\x89\xde\xd4\xde\x4c\x5e\xfb\x4b\x8c\x6c\x6b\x6f\x54\x5e\x4b\x98\x8b\xd0\x3f\x25\xd3\x38\xb1\xe6\x32\xf4\x99\xe5\x7b\xa4\x08\xf7\x85\x41\xa2\xfc\xa3\xc0\xe3\xfc\x98\x5e\xf7\x41\x93\x09\x3e\x16\x1c\x90\x0d\x18\x9e\x01\xee\xca\x05\xb3\xf7\xf8\xce\xf0\x72\x94\x3c\x1a\xf0\x40\x09\x52\xaa\x4f\xda\xfd\xbb\x43\x0f\xe8\x44\xde\x5d\x99\xb4\xc2\xaa\xdf\x06\x02\xe3\xd7\xb5\xab\x5a\x0e\x3c\x7f\xb2\x99\x12\x1e\xda\x1e\x55\xb6\xbf\xd6\x44\xa4\xbf\x6f\xc3\x36\xa2\xf6\x54\xee\x5c\x3a\x01\x89\xd6\xad\xe7\x79\x1e\xaa\x11\x99\x6f\x26\xf9\x70\xee\xbc\x1e\x7d\x97\x2a\x2c\x67\x70\x7a\xa6\xe6\xa4\x3f\xc7\xba\xfb\x05\x41\x02\x63\x64\xae\xcd\x70\xbc\x83\xe0\xb1\x2c\x90\x2a\x70\x9d\xbd\xab\x74\xe1\xc1\x54\x86\x66\xa8\x9b\x59\xc3\xf0\xe0\x94\xd9\xab\xf5\xb8\xb8\xd0\xf6\xa7\xf1\xf8\x90\x7e\x4a\x9d\xfd\x99\x83\x50\xf9\x01\xef\x21\x75\x6e\x60\xb6\x2f\x31\x6d\x1d\x68\x25\x53\x56\xac\xa1\xa2\x09\x05\xbc\xeb\x98\xc4\x64\xff\x55\x18\x45\x79\x35\xc6\xef\x34\x17\xdf\xc2\xe5\x48\x6e\x12\x99\x7e\x44\x0f\xe1\x03\x05\x0c\xde\x07\x23\x41\x70\xe2\x2c\x8e\x1a\x3e\xaa\x3b\xce\x72\x83\x90\xb4\xf4\x01\x8a\xc9\x19\x6c\xee\x70\x16\xd6\xab\x4c\xfa\xb3\xe7\x74\xcd\xa2\x06\x9a\x19\x01\x9a\x0a\x7e\xe4\x30\xf6\x1a\xda\xcc\xcb\x02\x9b\x66\xcd\x94\xea\x42\x1b\xb3\xad\x7a\x65\x63\x5f\x5a\xbe\x84\x53\x8d\x13\x27\x96\x45\xa6\xfc\x33\x14\x31\xb2\x35\xb4\xce\x5d\xed\xed\x98\x68\x28\x4c\x9c\x4e\xe8\xbd\xa7\x90\xb9\x25\x7d\x48\x22\xc3\x71\xaf\x85\xcd\x06\xca\xd3\x93\x93\x74\x4c\x92\x36\x02\xb8\x05\xae\x1a\x5d\xa9\xba\xd3\x41\xf9\xe8\x49\x28\xa8\x93\xc1\xf2\x15\x1d\x3c\xe8\xdb\x60\xd6\x44\xbc\x26\xb1\xa9\x4d\x8b\xb8\x30\x32\x97\x6b\xba\x2c\x9d\x86\xd6\xcd\x67\x25\x86\x67\x82\xdd\xcd\xa8\x15\xc5\x52\xee\xf5\xd0\x56\xc2\x7c\x9a\x8b\x04\xad\x61\xb8\x7f\xf4\xaa\xd5\xe8\x2f\x34\xcc\x26\x5a\x2e\x4a\x4e\x54\xcd\xf9\x86\xbb\xec\x7e\xd2\xfd\x4e\x36\x48\x9f\x3a\x7d\x9e\x19\xc4\xa4\xc4\x55\x61\x84\x5b\x4e\x4a\xdc\x6c\xa6\xad\xe9\x26\xbb\x4d\x73\x4b\xc2\x4c\x7e\x7d\xce\xfc\x65\xa6\xe6\xa6\xe5\xd2\xe7\x4a\x02\x20\x2d\x3b\xe0\xac\xa1\x41\x95\xc8\x10\xae\x34\xe2\x3d\xa5\xbd\xe5\xe6\x0b\x05\xb5\xef\x94\x0b\x89\xc6\x48\x37\x6e\xd2\x4a\xff\x67\x5e\x81\xf1\xa4\xe8\xdb\xe3\x46\xe8\xa7\xe3\x89\x53\x4a\x7e\xdc\xa4\x49\x95\xb2\xe7\xa6\x79\x10\x69\xb1\xbf\xf7\xd9\x9f\x98\xce\x47\x1a\x8c\x8d\x13\x05\xf2\xa9\x77\x1e\x1a\x02\xca\x2d\x2b\x94\x7c\x23\x20\x84\x50\xde\x80\x2b\x9a\xd8\xed\x77\x4a\x78\x44\x4b\x19\x14\x9a\x2c\x97\xdb\x12\x23\x0a\x84\xa7\x90\x42\x1b\x21\x1b\x7b\x64\x31\x90\x87\x76\x93\x95\xec\xf2\x90\xc1\xd8\x81\x2b\x93\x61\x19\x9c\x54\x8c\xcf\xf3\x3e\x8f\xeb\xe4\x6d\x8e\xce\xa7\x79\xde\xd8\xe3\x17\xcb\xfd\x2c\x13\xb1\xcf\xcb\xd2\x24\x98\xee\x8e\x4c\x8a\xab\x21\x3e\x3f\x1d\x5c\x14\x8f\xb8\xfd\xb1\xf5\x4b\x96\x2e\x35\xba\x2e\x16\x08\x77\x4d\xef\x76\x6d\xf7\x4e\x55\x81\xbd\x79\x19\xda\x2c\xcf\xc7\x30\xfa\x94\x24\x12\xd1\x84\x86\xa7\xdd\x0b\xc9\x1f\x44\x10\x2c\x46\x57\xef\x7d\xd9\x89\x60\x86\x8b\x05\x9e\xe1\x27\x93\x1c\xff\xd8\x28\x28\x93\x5c\xbd\x91\xaf\x34\x0a\xd0\xe1\xf9\x90\x85\x74\x51\x45\x20\x78\x3e\x36\x5e\xb4\xca\x38\x3b\xa2\xd1\x4c\xa2\x39\xd7\x9e\x5d\x2f\xe3\x30\xc4\xb9\x48\xa9\x87\x17\xd2\xd5\xfe\xd8\x54\x0b\x63\xcf\x8d\x4b\xcc\xe2\xae\xef\x1a\x21\xcf\x85\xfd\x22\x3a\xed\xa0\xb9\xdb\x92\x10\x26\x28\x9c\x32\xd1\x03\x21\x54\x47\x69\x2f\x2f\x54\xaa\x89\xf5\x20\xf2\x50\xeb\x59\x7d\x80\x0c\x35\x43\x5e\x65\xcf\xdd\x43\x2f\x2d\x5b\x45\x81\x73\x30\x60\x8c\x71\xe3\x72\x5d\x59\xc6\xd6\xfb\xa7\x03\x5c\x04\x6d\x51\xa0\x78\xfa\xfe\xad\x4a\xa4\x28\x26\xc8\x88\xa6\x27\xd2\xba\xbb\x4a\xce\x7b\x6a\xf1\xac\xee\xa5\x2f\xb1\x21\x44\xe9\x36\x24\xb0\xef\xc7\x5d\x2f\x27\x70\x04\x0b\x37\x66\xdc\x48\x09\xe1\x12\xcc\xc2\xd8\x57\x71\xd8\xcd\x8b\xa7\x8e\x58\x53\xae\x7c\x59\xea\x12\xd7\x94\x84\x79\x1f\x5a\xab\xe2\x3b\x31\x96\x8e\x8c\x61\x99\x49\x7d\x45\x05\x30\x25\xee\xa2\xe2\x30\xe8\x18\x9e\xf6\xb8\x54\x60\x6c\x5e\xeb\x15\xf9\x52\x95\x60\xa6\x72\xcf\x25\x3c\xe0\xf0\xa6\xf1\x73\x03\x6b\x89\x96\xa6\x8c\xd7\x31\x0f\x1b\x5e\x38\xcd\xf1\x50\x61\xbb\x9c\x4b\x15\x31\xab\x82\x4e\x9f\x8f\x48\x9f\xa0\xf5\x90\x5c\x45\x02\x12\xb3\x26\x26\x62\x89\x1c\xc7\x4a\x89\xfd\xc5\x07\xef\x83\x12\xdb\xad\xd6\xe3\xb8\x2b\xfc\xa3\x75\x37\x10\x75\xb8\xbd\x85\xc4\x0f\xa2\xe4\x1a\x37\xb6\xd9\x9e\x2c\xed\x4e\x2b\x26\xa5\xd7\x2e\x30\x2b\x5d\x66\x8c\x24\x84\x5f\xbd\xe9\x3c\x4d\x0d\xa7\x25\x26\x3b\x92\x38\x6b\x96\x30\xea\x0b\xa3\xd4\xce\x05\x6c\x51\x68\x2b\xdf\x14\xc1\xdc\x32\x59\x8d\x31\x66\x2e\xa2\xc3\xb0\x9b\xe2\x25\xc0\xc1\x3e\xe5\xca\x5c\xaa\xb0\xb9\x6f\x2c\xf5\x5e\x97\x97\x67\xf5\xe6\x8b\x76\x09\x0c\x97\xf7\xd6\xe3\x59\xb8\xe5\xd1\xf4\x91\x15\x71\x49\xc4\x72\x12\xfc\x44\xbb\xe7\x51\x64\xce\x2c\x5c\x02\x52\xb4\x5f\xfa\xfd\xac\x48\xc2\xa0\x57\x15\x73\xde\xdf\x5c\x1f\x0b\x7d\x30\x48\x4c\x1a\x16\xfb\x55\xd2\x43\x01\x8d\x0c\x58\xc3\x93\x1d\xc3\x9c\x0f\xca\xa7\xd0\x7e\x89\xf0\x8d\xeb\x07\x9d\x67\xb3\xe1\xaf\xb7\x13\x92\xc5\x03\x14\x8f\x78\x8d\xf1\x47\x0f\x04\x8d\xf9\x16\xe6\xe0\x8e\x6a\x6c\x02\x07\x47\x1b\x22\x47\x97\x9a\x8b\x6c\x89\x43\xd9\x37\x5a\x7d\xf2\xb4\xb5\xa2\xf5\x10\x08\x6d\x2c\x90\x89\x6e\x25\x3d\x47\x93\x6c\x15\x8b\x86\xa1\x8a\x08\xb1\x13\x90\x6b\x2e\x0c\x10\xab\xf1\x67\x28\x29\x75\x68\xd1\x81\x12\x63\x50\x21\x7a\x23\xbb\x7c\x26\x31\x10\x6f\x27\x08\x78\x38\x33\x45\x28\x93\x35\x11\xe6\x45\x62\x3a\xcb\x0c\x28\x08\x84\x19\x36\xb2\xa4\x75\x52\xe6\xe0\xf5\x0f\x25\x64\x51\x04\xbf\x3c\xbd\xc4\xf7\xfa\xe1\xf2\xc0\x64\x4e\x93\x34\xc3\xe5\xd5\xce\x03\x93\x10\xc2\xe8\x9f\x51\x32\x92\xf0\x05\x1f\x8a\x7a\x75\xae\x7b\xb5\xf2\xef\xee\x02\xcb\x5a\x17\xb5\x3f\x3f\x88\xfa\xe4\xc9\x30\x2b\xf8\xbc\xcc\xe8\x71\x61\x6b\x38\xf9\x78\x1e\x6a\x42\x7b\xff\xf4\xd2\x16\xaf\x35\xe1\x2f\xdd\x8b\x02\x34\xfb\x0d\x5a\xb3\x9d\xd0\xa2\xe2\x87\x0d\x19\x6c\x62\x20\x40\xd8\x29\x2e\x84\x2b\x9b\x17\x06\xfd\xe7\x35\x80\x42\x1b\xda\xce\x6c\xc1\xb8\x21\x34\x48\xa2\x74\x8b\x0f\xb5\x23\x0f\x03\x00\x00\xa1\x80\x9b\x7b\x73\xdc\x4e\x5d\x32\x13\x43\x58\x71\x6d\x59\xb3\xb9\x55\xec\x52\xc4\x25\x8c\x27\x30\xa6\x8c\x81\x05\xf8\x49\x1d\xa8\x90\x6e\x72\x5f\xc0\x62\xc0\xef\x75\x51\x35\x16\xda\x5e\x96\x4e\x28\x04\x67\x22\xf9\xbb\x8d\x0d\xab\x4c\xef\x27\x93\xc8\x67\x19\x49\x55\x9e\xf2\x03\xfd\x50\xb7\x42\x57\xe4\xb8\x9a\xc2\xb8\x06\x62\xb5\xe0\x94\xd9\x79\x63\xc0\x7d\x9f\x55\xd2\x48\x80\x63\xe8\x8b\x11\x2a\x97\x96\x6b\xd5\x16\x18\xfa\x2b\xa2\x4b\xf7\x4c\x77\x5f\x90\xaf\xd0\x8f\x2a\x5b\x76\x3b\x9a\xec\x68\xb2\x21\xe0\x85\xad\x7e\xe3\x8c\xcf\x49\x59\x2e\xb4\x12\xfa\xa3\x7b\x92\x03\xbd\x4d\x48\x16\x0e\x63\x5a\xa9\xc2\x6a\x4e\x34\xd9\xd0\x1f\xf2\xeb\xbb\xc9\xf5\x37\x29\x4e\x38\xd2\x3c\x0d\x04\xcc\x55\xab\x31\x0c\x2c\x34\x9b\x11\x97\x2a\x28\xf3\x03\xc6\xfe\xdc\x39\xa4\xbf\x3e\x27\x07\xb3\xbd\x72\xef\x68\x8c\xc5\x2a\x8a\xe5\x30\xe8\x53\x51\x59\x1c\xf7\x4b\x53\x02\xf4\x77\x6f\x91\xf8\xae\xb6\xc9\xca\x5c\x9c\x80\xf9\xf3\x5b\x41\xed\x4e\x1b\x12\xb4\x4e\x87\xd1\x3a\x00\xb0\x44\x8d\xaf\xad\x1f\x74\xf4\x44\xb6\xcf\xf5\x38\xab\x6c\x39\x32\xfa\xe0\x95\xb6\xc0\x78\x30\x41\xcd\x57\x90\xe0\x85\x13\x9b\x52\x85\x25\x20\x45\x4d\x07\x4b\x26\x33\xbe\xaf\x6f\x9f\xf8\xbb\x0b\x78\x68\x27\x28\xa5\x76\xcd\xf2\xb4\x77\x51\x96\x93\xae\x48\x6c\x1c\x04\x09\xde\x4a\x7f\x64\xe5\xa8\xb2\x3b\x91\xee\x81\xe1\xfa\x9d\x12\x05\x64\xd6\xea\x3d\xbc\x25\x1e\x44\x59\x45\xf4\x37\x72\xe0\x6e\xa7\x57\xfa\xb5\xfa\xa1\x40\xb0\xbb\xe2\xb0\x9b\x1c\xdf\xe5\x6d\x96\xb6\xd9\x32\xa0\xc7\x45\xdd\x97\x16\x2a\xdb\xaf\xec\xc6\x27\xd9\xc8\xb3\x08\x16\x47\x6b\x4a\x5c\x05\x61\xa9\xca\x66\xc3\xdf\xa1\xcc\xe0\xf6\xf9\x60\x69\xd3\xae\x9d\xe3\x7e\xfa\x86\x42\xb5\xc0\x00\x18\xcc\x21\x35\x4c\x78\x5e\x63\x87\x56\x33\x7c\x63\xb7\x0a\x6b\x71\x27\x09\x6b\xcd\x37\x61\x61\xd2\x6a\x48\xc8\xb0\xbc\xfb\x93\xae\xdf\x6a\xe2\x25\xcf\x87\x8d\xbf\x99\x50\x00\x03\xba\x94\x10\x6f\xac\xa3\xf0\xb4\x4b\x15\x07\x1a\x23\xd4\x96\x68\xcc\x8f\xe3\x39\xe7\xfe\xe8\x15\x8c\x53\xc9\x07\x28\xfa\x4a\x35\x97\x8d\x88\xd0\x57\x57\xc2\xca\x23\x14\x1f\xbe\xfe\xe6\xeb\x28\x93\xa5\xf8\x9d\xe2\x2e\xea\xbd\x32\xe5\xf9\xf5\x85\x5f\xf8\xeb\xa7\x79\x1f\x92\x0d\x19\x08\x0a\xd8\xa3\xfe\xb3\x13\xf1\xe6\xae\x83\x82\xe7\x3f\xe9\x58\x20\xbc\xb0\x4e\xcc\xb7\x3e\xb0\x7e\x04\x70\xe6\x5f\xba\x27\x48\xcd\x85\x6d\x6a\x3d\xbb\x09\xde\x37\xe3\x63\x60\xa4\x48\x4b\xcc\x99\xbe\x7f\xd6\xac\x5e\x6b\x4b\x6e\xf5\x1a\x94\xab\x30\x5a\xcc\x57\x13\xbe\x23\x57\x5b\xe9\xae\x5e\xd5\xf8\x15\x5b\xce\x88\xd4\xc9\x47\x0c\xce\x91\xa7\x40\x53\xc0\x5b\x76\xeb\xfc\x2f\xcf\x68\xc6\xff\x93\x6b\xb4\xe0\x64\xf0\xf7\xfa\xae\x2d\xdb\xef\x22\x62\xc7\x82\xe5\x07\x3b\x07\x6e\xc7\xfb\x49\xb6\x47\x10\x92\x8c\x99\x36\x67\x43\x80\x16\xc7\xf0\xa2\x13\x4c\x50\x00\xb1\xe1\x66\x35\x26\x21\xb4\x59\xa8\xe0\xc9\x88\x7a\x01\x86\xda\xb9\x04\x48\x51\xdb\xbe\x04\x38\xe5\x1f\xcc\x34\x19\xca\xfc\x5a\x82\xa8\x83\xb3\xd7\xf6\xd3\x36\xcd\xc8\x68\x8c\x28\xab\xdb\x36\xad\xdf\x00\xaf\x66\x25\xd9\x8e\x08\x4e\x1a\xdd\x17\x20\x7d\x8b\xa6\xbd\x7f\x86\x37\x41\x44\x80\xb4\x35\xae\x05\x73\xd2\x40\x7d\x07\xd5\x81\xd6\x8d\xac\xa7\x4f\x83\xfe\xd6\x09\x7d\xe9\x4a\x59\x73\xef\x9a\x2f\xc8\x89\x69\x55\x2f\xd1\x04\x2a\x41\xb8\xc1\x1b\x9c\x0d\x15\xd6\xf0\x94\x64\x54\xf7\xd3\x1c\x4f\x73\xdc\xb5\xe2\x47\x90\x33\xeb\xc1\x52\x3b\x2a\x8a\x4b\xa1\x8a\x08\xfe\xf0\xc4\x66\xab\xc6\x8d\x24\xce\x26\x4b\x16\xd1\x9d\xa2\x7a\x11\x26\xba\x39\x18\x96\xfe\x85\x0e\xef\x96\xd4\xbc\x64\xfb\xc9\x83\x2e\x6c\x3f\x50\xe7\xaf\x42\x9d\xf6\xca\x18\xf3\x15\x53\x26\x22\x91\xcd\xa4\x64\xe3\x68\x2a\x31\xb2\x57\x62\x26\x3b\xce\xf8\x1a\x1b\x22\x19\x09\x97\xb3\xdc\xfb\xee\x3d\x80\x9d\x9a\x92\xc4\xa0\x19\x42\x41\x5a\x72\x51\x00\xfa\x9b\xef\xf8\x1b\x6e\x6c\xa6\x30\xbc\x48\x16\x56\xef\x0e\xbc\xfd\x15\xab\x45\xfd\xdc\x39\x9e\x1f\xc1\x2c\x3e\x8b\x7e\x93\x5b\xa3\x33\x35\xbe\x2f\xd3\x2f\x0c\x5a\xde\x0e\x1c\x3f\x50\xaf\xdf\x0a\x24\x45\x01\x52\x41\x52\xb7\x61\x7d\x92\xb9\x65\x36\xed\x87\x75\x40\xd1\xe5\x65\xbd\x07\x73\xcb\x60\x68\x99\xe1\x56\x84\xc8\x8f\x2b\x5e\x90\xc8\x81\xf0\x21\xfc\xec\xc9\x4e\x74\x9f\x22\xe2\xb8\x07\x58\xa3\x05\xc5\x2f\xb9\xf5\x5e\x06\x9d\xe7\x7a\xc4\xb9\xc7\x84\xd0\x13\x00\x01\x4d\xa8\xdf\x26\x1f\x2e\x09\x4a\x17\x3a\x01\xc5\xa6\xa4\x75\x24\x50\xfc\xcd\x4a\x1a\x26\xd5\x6b\x3f\x7b\x72\x03\xc5\x89\x30\x24\x12\x74\x9f\xac\xd8\xd1\xdf\x46\x6c\xf7\xc7\x73\xd1\x20\x74\xaf\xd0\xe3\x2f\xfd\x73\x82\x2a\x7e\x4f\x63\x02\xf0\xa2\xfd\x63\x74\xa5\xdb\xa9\x7c\x09\xc8\x5a\xe9\xe1\xcd\xf3\x79\xfb\x47\x02\x45\xa4\x20\xd9\xf2\x81\x71\x38\x5e\x04\x5a\x10\xa0\x30\xd2\xba\xb7\x9e\x45\x2e\xdd\x25\x3e\xd2\xba\xcb\xbe\x7e\x9b\x28\x4f\x7c\x6a\x46\x4a\xfb\xd6\xe4\xd7\x28\x2c\x38\xf3\x61\xcd\x85\x8c\x6a\x17\x9e\x0f\xf9\x47\xa9\x14\x78\xdc\x1f\xce\x01\xe6\x14\x52\x1b\xe8\xe5\x17\x1f\xd6\x3d\xfd\xd1\x1f\x69\x81\x70\x53\x1a\xf1\x97\x6f\xcd\xe7\xdd\xef\x23\x2e\x56\xfd\x6f\xfe\xee\xcf\x6d\x1a\xe5\xac\x8d\x2b\x52\x58\x4b\x77\x7f\xd2\xbd\x30\x87\x2c\x3f\xa2\x17\x3f\x89\xfc\xa3\x5e\xb1\xf6\x0b\x95\x9a\x2e\x17\x31\x14\xaa\xaf\xc8\xac\x09\x89\x96\x24\x7d\xea\x5c\x11\xc1\x94\x31\x1d\xc6\x97\xfb\x73\x59\x53\x71\xd7\x2a\xbc\x15\xd1\xeb\xbc\x3f\x80\xd7\xfe\xc4\x5f\x4f\x6f\x20\x3e\x0d\xcb\x0e\xc7\x47\xf4\xb2\xf9\x01\xa2\x48\x78\xc2\x9b\x8c\xde\x4a\x2c\xb4\x2c\x7b\x8e\xea\x47\x85\x90\x13\xfb\x1a\x00\xe8\x4d\x2d\x06\x58\xdc\xe9\x30\xfc\xfd\xd3\x63\x23\xf2\x8d\x3b\x1d\x98\xa7\xec\x8e\x61\x78\xa6\x2e\x68\x76\x89\xab\xe8\x51\x2e\x13\x2e\x21\x5e\x56\x7b\xb5\x63\xae\x32\x83\xf8\x7a\xb4\x2e\x5e\xff\x19\x90\xbc\x2f\x0d\x76\xe9\xa9\xa4\x5d\xf5\xf5\x1d\x39\xe6\x24\xd2\xbf\xf3\x52\x58\x6a\xdd\x2d\x3f\x47\x4e\xee\xb8\x8a\x9b\x0e\xda\x55\x9e\x5f\xf5\x38\x40\x22\xbe\xf5\x7a\xd4\xa9\x07\x8c\xd8\x0f\x7a\xff\xe4\x0e\xdf\xe0\x7b\x72\x59\xc8\xe2\x77\x56\xa6\x1b\x4c\x55\xe1\x6c\xbf\x12\x12\x07\x92\xbe\x9b\x44\xc7\x0f\x1c\xdf\x90\xbc\x11\x52\xe6\xb8\xff\x3b\xdb\xc3\xdb\x2d\xf3\x63\x7c\xcf\xae\xe5\x5e\x8b\x96\x0e\x65\xce\xf4\xe5\x86\x8f\x5f\x7c\x93\x02\x32\xe8\xbf\x73\x59\x93\x6a\xb7\xbe\xc8\x02\xdb\x12\x87\x05\xdf\x59\x1e\x09\xfe\x90\x68\xb0\x47\x5e\x42\xa8\xe3\x7b\x72\xf4\x2e\x9c\xdb\x5f\xda\x51\x8c\xec\xcb\x1c\x1f\x2c\x1a\xb2\x77\xe8\xce\xfe\xdd\xfb\x67\xdf\x3e\x3e\x64\xc8\x34\xe8\x33\xde\x6b\x2d\xd7\x42\x58\x88\xc4\x2d\x69\xce\x74\x20\xb3\x82\x78\xf0\xab\x83\x3c\x6d\x57\x2c\x2d\x7f\xb3\xcb\x8b\x29\xe0\xf9\xa5\xa0\xd5\x3f\x81\x39\x78\x3b\xe4\xae\xd7\x07\x86\x32\xce\x26\xcd\x17\x8d\xb6\x68\xd9\x6e\x2a\x27\xf2\x17\x38\xad\xcf\x93\x3e\x35\xc5\xf3\xef\x1a\xc0\x6a\xe5\xec\x68\x71\x30\x5d\x38\xa8\x86\xab\x6d\xb5\xaf\x94\x33\xfb\x96\x89\xc4\x9c\x39\x63\xd3\x0c\xd3\xca\x88\xf1\xe4\x96\xd4\x90\xa8\xf1\xd8\x64\x66\x2e\xce\x08\xb9\x72\xde\x7c\xf2\x7c\xd8\x70\x5f\x0d\xe6\xfc\xd0\x4e\xf2\xc5\x2c\xc9\x41\xb2\x97\x7f\x82\x32\xa8\x8e\x08\x25\xde\x7d\x12\x20\x7c\x40\xeb\xe6\x59\x61\x99\xe4\xb6\x93\x1a\x13\x05\xc2\x7e\x65\x7a\x47\x74\xb6\x8c\x59\xfc\x3f\x8f\x72\x7e\x7b\xd7\x3c\xcf\x73\x3f\xb5\xdf\x51\xa0\x47\x9e\x5e\x98\x4b\xa9\x54\xe5\xe6\x61\x5c\x94\x93\x5f\xc2\xc5\xc9\x93\xff\x4e\x93\xff\x89\x4e\xfd\xed\xff\x62\xbd\x9a\x33\x7f\xc8\xb7\x0a\x1b\xb9\xd6\x8f\x0e\xdf\x8f\x45\xc5\x3d\xec\xe4\x98\xf5\xf5\x6a\xa3\x25\x4b\xee\x0b\xe8\xf1\x27\xdb\xf1\x38\xa3\x35\xcf\xf4\x44\x78\x23\xbe\xba\xc4\xe1\x9f\x6c\x47\x13\x94\xd8\x50\x17\xea\x54\xc7\x55\xed\xaa\x3b\xcb\x97\x0d\x1b\x32\x4c\xf4\x5e\x13\x10\x22\x18\xef\xdd\xab\x05\x60\x93\x80\x8b\x7e\xa4\x34\xe0\x66\xf6\x23\x6b\x88\x93\x2b\x8e\x2e\x31\xa7\x43\xf3\x9c\x03\xe1\x1b\x1f\x78\x2a\x36\xd7\xe2\x93\xed\x95\x28\x60\xd8\xea\x2a\x8b\x87\x12\x7f\x77\x59\x8f\xb3\xa3\x4c\x34\xd9\x30\xa8\x70\x0e\x4b\xfe\x69\xf2\x4f\x47\xbe\xa3\x47\x1b\x04\x95\x4f\x81\x2f\x70\x36\xe4\x5b\x4b\x23\xbf\x84\x55\x58\xc3\x04\xc1\xf1\x27\x26\xf6\x67\x4f\x9e\xf0\x7b\xf7\x9e\x1d\x2f\x29\xbc\x58\xde\x9c\x25\x3d\x53\xea\xa2\x25\x36\x46\x7e\x44\x68\x5e\xdd\xda\xbe\xbb\x67\x26\xf3\x2d\x6f\xbf\x5a\x01\x51\xba\x57\xd6\x7f\x5e\xf9\x0c\xfd\xaf\xed\x48\x1f\xe0\x98\x44\x87\xff\xc1\x26\x4a\x54\xd7\x17\x34\x0e\xf0\xaf\xfe\xfe\x32\xbf\x18\x3b\x5c\x9f\x62\x0a\xfd\x8e\x6d\x06\x85\x08\x9f\xf5\xa3\x1f\xab\x21\x4e\xc0\xbb\x76\x1f\x24\x1b\x52\x95\x7e\xfd\xf1\x6c\xa8\xa2\x3a\x2a\x95\xa8\x97\x0a\x99\x0f\xde\xf4\x7a\x62\x10\xbc\xae\x8b\x7c\x7e\xeb\xf9\xd5\x45\x9a\x16\xe3\xc4\x53\x85\x73\x87\xa9\x23\xc9\x49\x24\x36\x9c\xf6\x27\xba\xaa\x33\x5b\xc6\xea\xa0\x98\x92\xc9\xe7\xdf\xff\x58\xbb\x5f\xd8\x4d\xf8\xdf\x19\x56\x79\xbb\xe9\xf4\x82\x80\xd4\xa4\x05\xa6\x95\x45\xc6\xf6\xf4\x30\xbe\x1e\x89\x02\xc0\x82\x23\xad\x73\x11\xfa\x27\x1c\x02\x51\xd2\xb3\xbf\x8c\xcf\xd4\x12\x3f\xbb\x56\x42\x41\x8c\x03\x5f\x92\x3f\x09\xcb\x9b\x20\x50\x49\x68\x9b\xf1\x7c\xca\x82\x28\xcb\x35\xa5\x0e\x89\x18\xd2\x3c\xff\xfc\x80\x5f\xf2\x8b\xec\x94\x50\x00\x27\xe1\xbd\xfa\xec\x1a\xd4\x7a\x12\x36\x28\x26\x40\x3d\xeb\x54\x22\xfd\x75\xbd\x09\xc3\xd2\xaf\x4c\x86\x02\x55\x70\x98\xe6\x03\x47\x71\xa0\x94\x9d\x00\xd1\x0d\x4c\x78\x3e\xcc\x71\x37\xc5\xb8\xfe\x8a\x59\x32\x21\xb4\x48\x1d\xd2\x85\x07\x4e\xe5\xdf\x36\x5b\x86\x5c\xbc\x89\x83\xea\xf4\x6b\x23\xe3\x46\xb2\x21\x1f\xe7\x3e\x73\x35\x69\x6f\xc9\x75\x14\x86\x17\xd4\x89\x32\xf3\xa2\xa0\x70\x32\x4f\x2c\xf2\x2c\x1e\xb2\x10\x8d\x06\x43\x7e\xe9\x5e\x53\xc5\xd1\xde\x0d\x14\x4c\x89\x54\x1c\xe5\xf9\xd5\x55\x24\xef\x25\xae\x82\xd5\xcf\x34\xac\x01\x77\x61\xa4\xce\x71\x27\x64\x7c\x8c\x50\x28\xbf\x12\xb7\x21\x4d\xa0\x36\x77\x8d\x1b\x17\x34\xb9\xb6\xc3\xb8\x6a\xe0\x56\x9e\x1f\x57\xfd\x1f\x69\xd8\x8c\x26\xfd\x08\x25\x3d\xa2\x7b\x86\x04\xac\xe3\x65\x97\xaf\x82\xee\x17\xce\x53\xe1\x9d\x1e\xf3\x97\xb1\xc2\xd6\x62\x0c\x1e\x04\x30\x78\xdc\x53\x48\x30\x05\xa6\xb1\x21\x12\x5d\xba\x2c\x72\x9e\x7a\x65\xca\x9b\xde\x0f\x0a\xcd\x76\x34\xe8\x55\x72\x2f\xa5\x71\xe5\xb0\xbe\x33\x73\xa0\x2b\xc6\xd7\x28\x00\x74\x1c\xb7\x23\x2e\x9c\x4a\x17\xfa\x64\xe9\x5a\x6e\x35\x61\xe9\xed\x1b\x89\x7f\x46\x7f\x39\x3b\x41\x8a\x1c\x83\x16\xac\x3a\xd2\x96\xf4\x60\xa9\x21\x56\xf6\x78\x6d\x5c\xc4\x62\xa8\xee\x89\x0e\x54\x9b\xaa\x86\x49\xa6\xfa\x6e\x5a\xc7\x0e\x85\x51\x49\x81\x69\x45\x92\x9f\x7a\xdc\x89\x00\xe3\xf0\x97\x30\x9f\x35\x98\xa0\xf2\xee\xc3\x87\xa0\x76\x48\xdd\xd0\xbc\xb0\x06\x48\x65\xcf\x15\x36\x8b\x5f\xe8\xc3\x70\x76\xbc\xb4\x3f\xc7\xee\xc9\x8c\xb1\x58\x7f\x07\x05\xb6\x16\xe3\x3b\x1e\x15\x8f\xcd\xae\x57\x9b\x11\xf1\x21\x45\x9f\xb7\xb5\x29\xd3\xf2\xb2\xf3\x03\x38\xb5\x24\xae\x3a\xc1\x62\x81\xe7\xc3\xa5\xc7\xbd\x71\x46\x28\x0d\x92\xfe\x2f\xac\x2a\x1d\x98\xd3\x68\x96\x3d\xc0\xb8\x0a\x78\x7f\xe7\xd7\x68\x6b\xaf\xec\x7f\x0d\x62\xc1\x4d\xc9\xf5\xc5\xca\xf2\xe6\x49\x61\x78\xbe\x0c\xf9\x6f\xa8\x11\x1f\xe0\x98\xd9\x74\x80\xa7\x38\x6c\x77\xad\xc6\xe7\x44\x73\x99\x10\x46\x99\x61\x45\xbb\x74\x07\x8b\x03\x07\x3f\x65\xbc\xa9\xae\x3f\x28\xaf\xd5\xe8\xc9\x8e\xfb\xf5\xdb\x82\x20\x55\x48\x9f\xb3\x9a\xb4\xcf\xd0\xb9\x2e\x2b\xbf\x5c\x30\xdf\xeb\x22\xd2\x5f\x74\xf9\x50\x26\x5e\x2d\xd4\x4a\x82\x20\xde\xdc\xd7\xfe\xac\x89\x1c\xd9\x3c\x37\x67\xaf\xb6\xa4\x36\x62\x05\x70\xd8\xfa\x7d\x47\x16\xd2\xf9\xd4\x5f\xa1\x14\xa5\x1f\x9a\x9d\x19\x40\x38\xb6\x1f\x5d\x6e\x16\x4e\xb2\x5c\xb6\x95\xea\xf6\xf2\xdd\xff\xdd\x8f\x19\x23\xde\x85\x76\xad\x8c\x77\xac\x50\x50\xe0\x6f\x6e\xc8\xcc\xe5\x4e\xf3\x7f\xdf\xc3\xa5\x02\xe3\xf9\x9d\xd3\xb3\x10\x8a\x12\x84\x16\x74\x72\x3b\x6a\x75\x8d\xab\x8f\x7b\x69\x53\xda\xc0\xfd\xc3\x54\x81\xa7\x3d\x30\x55\x44\xcd\x35\xc2\xde\xae\xb7\x94\xc5\x9d\xb8\x4d\x7e\xc1\xda\x77\xef\x0c\xa0\xf2\x8f\x7f\x63\x64\x4d\xcb\xfa\x30\xdc\x3f\x4d\xf8\xa2\x86\xc8\x4d\x31\x37\x8a\xdc\x44\xf8\xe5\xfa\xad\x8e\xe2\xb5\x20\xd5\x89\xe5\x2f\x7c\x89\xd5\x98\xf4\x04\x92\xca\xe5\x40\x80\x60\x91\x2e\x01\x4e\xc8\x12\x20\x9c\xa6\x96\x1d\x28\x82\xf5\x23\xdf\x12\x9d\xe2\xfa\x0c\x6d\xf3\x86\x42\x0a\x92\x9c\x54\xb7\xde\xc8\x9c\xb3\x22\xb1\xb8\xd9\x02\xe3\x66\x0d\x46\x84\x8e\x12\xd0\x7e\xe1\x79\x13\x44\x6e\xcf\xe0\xb8\x7e\x27\xfb\x86\xbc\x89\x23\x14\x08\x4e\xd8\x0d\x2d\x0f\x55\x2d\xd9\xfd\x71\x74\x95\x8f\xf8\x3d\xd0\xd8\x60\x35\x18\xa0\xe3\xe7\x3e\xbb\x25\xce\xd3\xb8\x2c\x00\x6e\x55\x32\x41\x2f\x29\x4f\x0a\xf8\x16\x57\x9d\x18\x69\xe0\x54\x7e\xc1\xdf\xc8\x4d\x17\x15\x39\x70\xca\xec\x3f\x7b\x32\x18\x3f\x90\x9b\x5e\xaf\x73\x7d\xd7\xc8\xf2\x20\xca\x84\x4c\xc0\xa1\x67\x95\x9b\xdd\x44\x90\xca\x8a\x65\xe5\x5b\xef\x14\xa6\x21\xaa\x34\x69\x2f\xdd\xe3\x8b\xc9\xff\x0b\xfb\xae\x46\xdc\xe4\x98\x12\x12\xf3\x21\xfc\xc5\xe0\xc1\x71\x1c\x58\xec\x68\x7e\x64\xfb\x93\x77\xce\x65\xfb\x43\x86\x84\x13\x2b\xff\x44\xa7\x7b\x7b\x5e\x60\x9d\x1a\x97\xf2\x95\x5c\x6b\x39\xba\x81\x5f\xc9\xbe\x38\xbe\xb2\xd7\x48\xae\xe5\xda\x1a\x1e\x0c\x92\x2e\xcd\x9c\x10\xe0\xe5\x0a\xe7\xbb\x86\x5b\x52\xaf\x23\xce\x61\xf6\x5e\x9a\xec\x93\x4f\x01\x33\xda\xbc\xc2\xe6\x43\xfd\x01\x21\x63\x89\x81\xd7\x5c\xe1\x34\x5b\x37\xbf\x0f\x05\x10\x19\x34\x07\xda\x02\x23\x04\xdc\x2b\x9a\xf9\xec\x84\x8f\x67\x26\xe7\x60\x64\xaf\x8c\xa9\x1f\xb0\x76\x26\xe7\x02\x42\xc0\x04\xf5\xc8\x8f\x0e\x84\x16\x24\xd6\x10\x9e\x99\x43\x0c\xf7\xd6\x2b\xdc\x5b\x0f\x16\xc4\x1e\x75\x70\x2c\xe7\x50\x58\x43\xf7\x4f\x1f\x7e\x88\xd3\xfa\x7a\x80\xa8\x6c\xe9\xe3\x96\xfa\x28\x8c\x23\x30\x27\x99\x0f\x8a\xe8\xc8\x11\x68\x5f\xeb\xb7\xb7\x3c\x38\x8b\x3f\x2a\xff\x97\xab\xd5\x4d\x58\x6e\x35\xde\x04\x51\x49\x22\x5e\x7b\xb2\xfc\x9d\x2b\xfc\xa4\xc0\xcf\xee\x7e\xe3\xa4\x2a\xde\x44\x2e\x71\x3b\x28\x77\x4a\x98\xd7\x0a\xb8\xfa\xc9\x59\x20\x86\x61\x54\x0c\xe1\x66\x3d\xce\xf2\x16\x12\xdf\xd4\x8a\x46\xff\x24\xb8\x3e\xa4\xb4\x29\x93\x28\x10\x44\x51\x82\xaa\x5b\x1f\x8d\xc3\x72\x64\xb9\x09\x99\x54\x1e\x03\x9b\x0b\x23\xf3\xce\x9f\x07\xd2\x0e\xb8\x9f\x53\x6e\xc3\x5f\x5e\x6b\x24\x5c\x33\x1c\x6f\xaf\x09\x89\x17\xfe\xea\xc8\x23\x3b\xa4\xe1\x39\x47\x5f\x3b\xf0\x3a\x8e\x2f\xdb\x5f\xe2\x60\x41\x2f\xe2\xad\x01\xa9\xb9\xa8\xc8\x34\x9b\xe1\x83\xa7\x36\x86\xff\x90\x94\xb9\x40\xf0\x78\x3e\x6f\x9d\xbf\x6f\x8c\x5e\xdf\x44\x3e\x1b\xf7\xe5\xf2\x2f\x99\x9b\x71\x34\xf9\x02\xe3\x65\xa5\xfa\xd9\x9e\xba\x1c\x55\x85\x3e\x82\x73\x63\x78\xf9\x10\xe8\xd2\x17\x0c\x3a\x2f\x9e\x0f\x49\xe5\x44\x1c\x75\x9f\x74\xce\x7b\x67\x8c\xb5\xea\x65\xcf\xf7\xbd\xbc\xbc\x75\x98\xd1\x9e\xb2\x5c\xf9\xf1\x3c\xef\x20\xd9\x12\xb3\x79\x46\xe8\x8f\xf8\xd8\x23\xcf\xc7\x66\x6d\xaa\x63\x9d\x99\x83\x52\x82\x05\x8a\x6b\xbf\x5a\x97\xcb\x8a\xe6\x45\x2f\x98\x61\xa3\x7f\x7e\xd7\x61\x4c\x3e\xcc\xd8\x19\x3f\x46\x41\xed\xea\xf4\xe5\x91\x7c\xc8\xca\x6a\x85\x12\x14\x16\x0a\x55\x1c\x9b\x06\xda\x5c\x18\x28\x35\x15\x9c\x04\x5d\xdc\xbb\xbd\xae\xb1\xd8\x9e\x18\x08\x80\xef\xc4\xe6\xe5\xe0\xe9\x0b\x2a\x52\xb3\x37\x98\xa6\xcc\x15\x71\xf3\x13\x5d\xd6\x38\x5a\x0f\x05\xde\x0a\x5d\x95\xe7\xd7\xf0\xdb\xd0\xe2\xe8\x53\xff\xf6\x46\x63\xa1\x0f\x86\x99\x52\x65\xbf\x1f\xde\x0f\x16\x3c\x55\x1f\xe5\x2f\x03\x69\x9c\x05\x1a\x8f\x7b\xa5\x1e\x49\xc7\xfb\x00\xc5\x50\x61\x39\x8f\x26\xdb\x69\xd7\xa4\xbf\x19\x5b\x12\xc0\x01\x02\xc7\x5b\xe4\x4e\xfe\xc6\xc8\xb6\xae\xb2\xe3\x88\x40\xb2\xdf\xb1\x5f\xb9\xfc\x85\x20\x77\xaf\x8b\x4a\x0d\x99\xc6\xf3\x4e\xff\xdb\xe3\xde\xfb\x6d\xae\x0f\x5b\x7f\x59\x47\x89\x4e\xb5\xfa\x5e\xe8\x7d\x62\xcc\xb0\x3b\x25\x21\x75\x20\xc3\x07\x61\xb1\x76\xef\x59\x68\x75\x39\x5d\x7f\x31\x47\x85\x0a\x72\x52\xdd\xb6\x6e\xfd\xca\xf2\x70\x6a\x2b\xec\x59\x38\xb7\xe6\x8b\x98\x96\xd4\x20\x59\xf7\x4b\xbb\x76\xad\x92\x44\xff\x0c\xba\x1a\x98\x7d\x25\xe7\xd2\x4e\xf4\x9f\x2d\x47\x74\x81\xb1\x7d\x72\x70\xaa\xf0\x52\x0d\xe7\xb0\x60\x2a\xf0\xb1\x5e\x41\x32\xc9\xf1\x83\x2a\x56\xc6\x99\x78\x77\xd2\x88\x17\xe0\x87\x74\xf9\x5a\x3e\x12\x94\x02\xad\xe1\xd1\x1f\xf9\x0e\x37\xbb\x51\x26\xfd\xf3\x25\x63\xbd\x91\x6f\xfd\xdd\x1f\x7e\x34\xf1\x76\xcc\xef\xfb\x10\xeb\x21\x32\xcd\xf3\x02\x80\x07\xa9\x48\xa3\x21\x8c\x2f\xd3\x44\xc4\x6f\xed\x63\xe0\x58\x70\xb1\xfa\xc6\xc6\x21\xc8\x1d\x7f\xa5\x91\x60\x7a\xed\xa7\xff\xf5\xbf\xbf\x63\x52\xe4\xa3\x07\x62\xc8\x30\x3d\xfb\x20\x2e\x5f\xc9\xee\x16\x2e\x7f\x3c\x0e\x1e\x0c\xce\x96\xa3\x55\x83\x99\x08\x2b\xf3\x8c\x6c\x59\x24\x5c\x71\x38\x36\x9e\x40\x15\x28\x35\xfc\x90\x8c\xc8\xd1\xf5\xf5\xd1\x41\x3d\xba\x55\x1c\xc0\x59\xe4\x07\x82\x13\x09\x0f\x89\x67\xfa\xe0\x33\xfb\x1e\xc4\xe9\xa3\x3f\xba\xc6\xda\xad\xc2\x9d\xe0\x81\x24\xe6\x60\x8e\x3f\xcb\xf6\xc5\xca\x35\xf2\xf0\x7d\xb6\xd8\x6e\x35\x13\x74\xff\xc6\x71\x39\xbe\xd3\xc6\xf0\xba\xe7\x40\x91\x14\x2e\x42\xaf\x09\x37\xd8\xa7\x5c\x1d\x9b\x17\x2f\x30\x7e\x14\x75\xb5\x2d\x97\x03\x9d\xfe\xe5\x68\x1d\xc3\xf3\x79\xa2\xcb\x8e\x1c\x38\x2b\x0e\x84\x70\xd6\x5c\xaa\xb0\x54\xe2\xcf\x8f\x18\x08\x76\x2c\xf3\xbb\x06\xb5\x36\xc3\xf7\x1d\x54\x77\xde\xd4\xdd\x40\xa1\x0d\x1f\xc1\xc8\x67\x9a\x34\xcf\x35\x91\x2e\xdc\x10\x1b\x03\x21\x52\xd7\x44\x17\x1f\x66\xc6\x16\xc0\x4a\xd7\xa1\x79\xde\x76\x2e\xdc\x60\xab\x20\xde\x42\xf6\x1b\xb1\xc6\x90\xb2\xe7\xc3\x6a\x57\xbe\x78\x13\x09\xf6\xcf\xef\xa0\xc0\x02\x22\x56\x15\x53\x98\xd4\x8f\xdc\x27\x77\x14\x99\xe1\xe5\xb1\x57\xea\xac\xc7\xb3\x78\xa3\x65\xb1\x09\x44\x30\x82\x28\x59\xe5\xca\x5b\xaf\xe5\xe6\xa4\xac\xb8\x6b\x9b\xcb\xf6\x25\xbf\x46\xdb\xda\xa5\x3d\x36\x5e\x96\xf9\x03\x50\x66\xe5\xf8\x9d\xe2\xfe\xfa\x5f\xff\xae\x61\x16\xf9\x30\x1e\x0f\xfc\x97\xa3\x75\xce\x86\xb7\x44\x7c\x70\x2a\x5f\xd1\x9f\x5e\xda\xaf\x2c\xa4\xa8\xf1\x44\x01\x9c\xa9\x8b\x1e\x33\x7e\x30\x8c\xcc\xa6\xb6\xa5\x3c\xd6\xbd\xfc\xad\x7a\xfe\xdb\xec\xba\x5c\x6e\xcc\x68\x30\xb3\xe1\x94\xad\xcd\xf8\xd1\x30\x59\x9b\xc6\x97\xbe\xe0\x38\x45\x0d\x9e\x22\x8f\x50\x50\xbc\x47\x90\xb2\x96\x34\x4d\xd3\x8c\xe2\xf8\x1f\x7e\x69\x95\xb2\x2c\xff\xfb\xbf\xff\xdf\x3f\xc1\x7f\xff\xe3\x7f\xfe\x27\x1f\xb2\xff\xfd\xdf\x7f\xfc\xab\x42\xff\x6d\xc5\x65\xfe\x4f\x63\xdc\xfe\x29\x8c\xfb\x90\xfd\xd7\x7f\xfd\x0b\xac\xd0\x7f\xff\xe3\x5f\x60\x9a\x0f\x5b\xbe\xfc\xfb\x1f\xff\xf8\x17\x98\x8c\xd9\xfd\xfe\xa8\xda\xfa\xee\xdf\xff\x3f\x00\x00\xff\xff\x47\x48\x01\x97\x4d\x2e\x01\x00"),
-		},
-	}
-	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/views"].(os.FileInfo),
-	}
-	fs["/views"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/views/_404.gohtml"].(os.FileInfo),
-	}
-
-	return fs
-}()
-
-type vfsgen۰FS map[string]interface{}
-
-func (fs vfsgen۰FS) Open(path string) (http.File, error) {
-	path = pathpkg.Clean("/" + path)
-	f, ok := fs[path]
-	if !ok {
-		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
-	}
-
-	switch f := f.(type) {
-	case *vfsgen۰CompressedFileInfo:
-		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			// This should never happen because we generate the gzip bytes such that they are always valid.
-			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
-		}
-		return &vfsgen۰CompressedFile{
-			vfsgen۰CompressedFileInfo: f,
-			gr: gr,
-		}, nil
-	case *vfsgen۰DirInfo:
-		return &vfsgen۰Dir{
-			vfsgen۰DirInfo: f,
-		}, nil
-	default:
-		// This should never happen because we generate only the above types.
-		panic(fmt.Sprintf("unexpected type %T", f))
-	}
-}
-
-// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
-type vfsgen۰CompressedFileInfo struct {
-	name              string
-	modTime           time.Time
-	compressedContent []byte
-	uncompressedSize  int64
-}
-
-func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
-}
-func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
-
-func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
-	return f.compressedContent
-}
-
-func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
-func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
-func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰CompressedFile is an opened compressedFile instance.
-type vfsgen۰CompressedFile struct {
-	*vfsgen۰CompressedFileInfo
-	gr      *gzip.Reader
-	grPos   int64 // Actual gr uncompressed position.
-	seekPos int64 // Seek uncompressed position.
-}
-
-func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
-	if f.grPos > f.seekPos {
-		// Rewind to beginning.
-		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = 0
-	}
-	if f.grPos < f.seekPos {
-		// Fast-forward.
-		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = f.seekPos
-	}
-	n, err = f.gr.Read(p)
-	f.grPos += int64(n)
-	f.seekPos = f.grPos
-	return n, err
-}
-func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
-	switch whence {
-	case io.SeekStart:
-		f.seekPos = 0 + offset
-	case io.SeekCurrent:
-		f.seekPos += offset
-	case io.SeekEnd:
-		f.seekPos = f.uncompressedSize + offset
-	default:
-		panic(fmt.Errorf("invalid whence value: %v", whence))
-	}
-	return f.seekPos, nil
-}
-func (f *vfsgen۰CompressedFile) Close() error {
-	return f.gr.Close()
-}
-
-// vfsgen۰DirInfo is a static definition of a directory.
-type vfsgen۰DirInfo struct {
-	name    string
-	modTime time.Time
-	entries []os.FileInfo
-}
-
-func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
-	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
-}
-func (d *vfsgen۰DirInfo) Close() error               { return nil }
-func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
-
-func (d *vfsgen۰DirInfo) Name() string       { return d.name }
-func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
-func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
-func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
-func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
-func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰Dir is an opened dir instance.
-type vfsgen۰Dir struct {
-	*vfsgen۰DirInfo
-	pos int // Position within entries for Seek and Readdir.
-}
-
-func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
-	if offset == 0 && whence == io.SeekStart {
-		d.pos = 0
-		return 0, nil
-	}
-	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
-}
-
-func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
-	if d.pos >= len(d.entries) && count > 0 {
-		return nil, io.EOF
-	}
-	if count <= 0 || count > len(d.entries)-d.pos {
-		count = len(d.entries) - d.pos
-	}
-	e := d.entries[d.pos : d.pos+count]
-	d.pos += count
-	return e, nil
-}
@@ -1,15 +1,21 @@
 package paleolithic
 
 import (
+	"embed"
 	"net/http"
-	"path"
 
 	"github.com/gocaveman/caveman/filesystem/fsutil"
 	"github.com/gocaveman/caveman/tmpl"
 	"github.com/gocaveman/caveman/tmpl/tmplregistry"
 )
 
-//go:generate go run embed-gogen.go
+// cavegen:begin generated sum=4a85078eb8d38fc9
+
+// EmbeddedAssets has the files of this package's views includes directories,
+// built in with go:embed (the "all:" includes files starting with "_" or ".").
+//
+//go:embed all:views all:includes
+var EmbeddedAssets embed.FS
 
 func init() {
 
@@ -20,22 +26,18 @@ func init() {
 }
 
 func NewViewsFS() http.FileSystem {
-	return fsutil.NewHTTPFuncFS(func(name string) (http.File, error) {
-		return EmbeddedAssets.Open("/views" + path.Clean("/"+name))
-	})
+	return fsutil.MustHTTPSubFS(EmbeddedAssets, "views")
 }
 
 func NewIncludesFS() http.FileSystem {
-	return fsutil.NewHTTPFuncFS(func(name string) (http.File, error) {
-		return EmbeddedAssets.Open("/includes" + path.Clean("/"+name))
-	})
+	return fsutil.MustHTTPSubFS(EmbeddedAssets, "includes")
 }
 
 func NewTmplStore() tmpl.Store {
-	return &tmpl.HFSStore{
-		FileSystems: map[string]http.FileSystem{
-			tmpl.ViewsCategory:    NewViewsFS(),
-			tmpl.IncludesCategory: NewIncludesFS(),
-		},
-	}
+	return tmpl.NewFSStore(EmbeddedAssets, map[string]string{
+		tmpl.ViewsCategory:    "views",
+		tmpl.IncludesCategory: "includes",
+	})
 }
+
+// cavegen:end generated
@@ -6,14 +6,34 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sync"
+	"time"
 
 	"github.com/shurcooL/httpfs/vfsutil"
 )
 
 // HFSStore implements read-only Store on top of a set of http.FileSystem.
+// It also implements WatchableStore by periodically walking the file systems
+// and comparing modification times and sizes, so changes made to the underlying
+// files (e.g. editing views during development) are reported.
 type HFSStore struct {
 	FileExtMimeTypes map[string]string
 	FileSystems      map[string]http.FileSystem // key is category, value FileSystem to expose
+	PollInterval     time.Duration              // how often to check for changes when watched, DefaultPollInterval if not set
+
+	watchOnce sync.Once
+	watchers  *Watchers
+	snapshot  map[hfsFileKey]hfsFileStamp
+}
+
+type hfsFileKey struct {
+	category string
+	fileName string
+}
+
+type hfsFileStamp struct {
+	modTime time.Time
+	size    int64
 }
 
 // CreateTemplate returns ErrWriteNotSupported
@@ -126,3 +146,69 @@ func (s *HFSStore) FindByPrefix(category, fileNamePrefix string, limit int) ([]s
 
 	return ret, nil
 }
+
+// Watch implements WatchableStore.  Polling for changes runs only while there is at least one watcher.
+func (s *HFSStore) Watch(fn WatchFunc) (unwatch func(), err error) {
+	s.watchOnce.Do(func() {
+		s.watchers = &Watchers{
+			PollInterval: s.PollInterval,
+			Start: func() (err error) {
+				s.snapshot, err = s.takeSnapshot()
+				return err
+			},
+			Poll: s.poll,
+		}
+	})
+	return s.watchers.Watch(fn)
+}
+
+// poll compares the file systems to the last snapshot and returns what changed.
+func (s *HFSStore) poll() ([]Event, error) {
+
+	newSnapshot, err := s.takeSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []Event
+	for k, stamp := range newSnapshot {
+		oldStamp, ok := s.snapshot[k]
+		if !ok {
+			ret = append(ret, Event{Type: CreateEvent, Category: k.category, FileName: k.fileName})
+		} else if oldStamp != stamp {
+			ret = append(ret, Event{Type: UpdateEvent, Category: k.category, FileName: k.fileName})
+		}
+	}
+	for k := range s.snapshot {
+		if _, ok := newSnapshot[k]; !ok {
+			ret = append(ret, Event{Type: DeleteEvent, Category: k.category, FileName: k.fileName})
+		}
+	}
+
+	s.snapshot = newSnapshot
+
+	return ret, nil
+}
+
+func (s *HFSStore) takeSnapshot() (map[hfsFileKey]hfsFileStamp, error) {
+
+	ret := make(map[hfsFileKey]hfsFileStamp)
+
+	for cat, fs := range s.FileSystems {
+		err := vfsutil.Walk(fs, "/", func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				return nil
+			}
+			ret[hfsFileKey{category: cat, fileName: path}] = hfsFileStamp{modTime: fi.ModTime(), size: fi.Size()}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -95,3 +96,28 @@ func TestHFSStoreReadTemplate(t *testing.T) {
 	assert.True(os.IsNotExist(err), "err=%#v", err)
 
 }
+
+func TestHFSStoreWatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	viewsTmpdir, includesTmpdir, s := hfsStoreTestSetup(t)
+	defer os.RemoveAll(viewsTmpdir)
+	defer os.RemoveAll(includesTmpdir)
+	s.PollInterval = 10 * time.Millisecond
+
+	evch := make(chan Event, 10)
+	unwatch, err := s.Watch(func(ev Event) { evch <- ev })
+	assert.NoError(err)
+	defer unwatch()
+
+	assert.NoError(ioutil.WriteFile(filepath.Join(viewsTmpdir, "test1", "file3.gohtml"), []byte(`file3`), 0644))
+	assert.Equal(Event{Type: CreateEvent, Category: ViewsCategory, FileName: "/test1/file3.gohtml"}, <-evch)
+
+	assert.NoError(ioutil.WriteFile(filepath.Join(viewsTmpdir, "test1", "file3.gohtml"), []byte(`file3 is longer now`), 0644))
+	assert.Equal(Event{Type: UpdateEvent, Category: ViewsCategory, FileName: "/test1/file3.gohtml"}, <-evch)
+
+	assert.NoError(os.Remove(filepath.Join(includesTmpdir, "someinclude.gohtml")))
+	assert.Equal(Event{Type: DeleteEvent, Category: IncludesCategory, FileName: "/someinclude.gohtml"}, <-evch)
+
+}
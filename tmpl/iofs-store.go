@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"io/fs"
+	"net/http"
+
+	"github.com/gocaveman/caveman/filesystem/fsutil"
+)
+
+// NewFSStore returns a read-only Store for an fs.FS, usually an embed.FS, with the templates
+// of each category in a directory.  The key of categoryDirs is the category and the value
+// the directory, e.g. {tmpl.ViewsCategory: "views", tmpl.IncludesCategory: "includes"}.
+// Categories whose directory does not exist are left out.  The Store is an HFSStore, so
+// templates can have the same YAML meta at the top.
+func NewFSStore(fsys fs.FS, categoryDirs map[string]string) *HFSStore {
+	ret := &HFSStore{FileSystems: make(map[string]http.FileSystem, len(categoryDirs))}
+	for category, dir := range categoryDirs {
+		if fi, err := fs.Stat(fsys, dir); err != nil || !fi.IsDir() {
+			continue
+		}
+		ret.FileSystems[category] = fsutil.MustHTTPSubFS(fsys, dir)
+	}
+	return ret
+}
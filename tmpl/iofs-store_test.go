@@ -0,0 +1,45 @@
+package tmpl
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFSStore(t *testing.T) {
+
+	assert := assert.New(t)
+
+	s := NewFSStore(fstest.MapFS{
+		"views/test1/file1.gohtml": &fstest.MapFile{Data: []byte("---\ntestkey1: testvalue1\n---\n{{template \"test1\" .}}\n")},
+		"views/_404.gohtml":        &fstest.MapFile{Data: []byte("not found\n")},
+		"other/file.txt":           &fstest.MapFile{Data: []byte("other\n")},
+	}, map[string]string{
+		ViewsCategory:    "views",
+		IncludesCategory: "includes", // not there, left out
+	})
+
+	cats, err := s.Categories()
+	assert.NoError(err)
+	assert.Equal([]string{ViewsCategory}, cats)
+
+	names, err := s.FindByPrefix(ViewsCategory, "/", -1)
+	assert.NoError(err)
+	assert.Equal([]string{"/_404.gohtml", "/test1/file1.gohtml"}, names)
+
+	body, mimeType, meta, err := s.ReadTemplate(ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	assert.Equal(GoTemplateHtmlMimeType, mimeType)
+	assert.Equal("testvalue1", meta["testkey1"].(string))
+	assert.Contains(string(body), `{{template "test1" .}}`)
+
+	_, _, _, err = s.ReadTemplate(ViewsCategory, "/test1/doesnotexist.gohtml")
+	assert.True(os.IsNotExist(err), "err=%#v", err)
+	_, _, _, err = s.ReadTemplate(ViewsCategory, "/test1")
+	assert.True(os.IsNotExist(err), "err=%#v", err)
+	_, _, _, err = s.ReadTemplate(IncludesCategory, "/file.txt")
+	assert.True(os.IsNotExist(err), "err=%#v", err)
+
+}
@@ -0,0 +1,418 @@
+package tmpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Operators for use in Cond.
+const (
+	OpEq       = "="        // value equals
+	OpContains = "contains" // value is a list with an element equal to
+	OpLt       = "<"
+	OpLte      = "<="
+	OpGt       = ">"
+	OpGte      = ">="
+)
+
+// Cond is a condition on a meta value.  Equality (OpEq and OpContains) compares
+// the string form of both values, so 5 and "5" are equal.  The range operators
+// compare numerically if Value is a number, by time if Value is a time.Time and
+// otherwise as strings.  Meta strings in a date format ("2006-01-02" or RFC3339)
+// are treated as times.  Templates without the meta key never match.
+type Cond struct {
+	Key   string
+	Op    string
+	Value interface{}
+}
+
+// SortField is a meta key to sort on.  Templates without the key sort first.
+type SortField struct {
+	Key  string
+	Desc bool
+}
+
+// Query describes a search of templates by meta.  Results are file names in
+// the order given by Sort, then by file name.
+type Query struct {
+	Category       string      // required
+	FileNamePrefix string      // same as for FindByPrefix, empty means "/"
+	Conds          []Cond      // all must match
+	Sort           []SortField // sequence of keys to sort by
+	Limit          int         // <= 0 means no limit
+	Offset         int         // number of results to skip
+}
+
+// QueryableStore is implemented by Stores which can efficiently search
+// templates by meta.
+type QueryableStore interface {
+	Store
+	Query(q Query) ([]string, error)
+}
+
+// QueryStore runs a Query against a Store.  If it implements QueryableStore
+// that is used, otherwise it falls back to MemQuery.
+func QueryStore(s Store, q Query) ([]string, error) {
+	if qs, ok := s.(QueryableStore); ok {
+		return qs.Query(q)
+	}
+	return MemQuery(s, q)
+}
+
+// MemQuery runs a Query by reading each template matching the prefix and
+// filtering in memory.  This works with any Store but reads every template
+// each time, for larger sets of templates see the tmplindex package.
+func MemQuery(s Store, q Query) ([]string, error) {
+
+	if err := q.IsValid(); err != nil {
+		return nil, err
+	}
+
+	prefix := q.FileNamePrefix
+	if prefix == "" {
+		prefix = "/"
+	}
+
+	names, err := s.FindByPrefix(q.Category, prefix, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]MetaItem, 0, len(names))
+	for _, name := range names {
+		_, _, meta, err := s.ReadTemplate(q.Category, name)
+		if err == ErrNotFound { // removed since FindByPrefix, just skip it
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, MetaItem{Name: name, Meta: meta})
+	}
+
+	items = FilterMetaItems(items, q.Conds, q.Sort, q.Offset, q.Limit)
+
+	ret := make([]string, 0, len(items))
+	for _, item := range items {
+		ret = append(ret, item.Name)
+	}
+	return ret, nil
+}
+
+// IsValid returns an error if the Query is not usable.
+func (q Query) IsValid() error {
+	if q.Category == "" {
+		return fmt.Errorf("query has no category")
+	}
+	for _, c := range q.Conds {
+		if err := c.IsValid(); err != nil {
+			return err
+		}
+	}
+	for _, sf := range q.Sort {
+		if sf.Key == "" {
+			return fmt.Errorf("query sort has no key")
+		}
+	}
+	return nil
+}
+
+// IsValid returns an error if the Cond has no key or an unknown operator.
+func (c Cond) IsValid() error {
+	if c.Key == "" {
+		return fmt.Errorf("query condition has no key")
+	}
+	switch c.Op {
+	case OpEq, OpContains, OpLt, OpLte, OpGt, OpGte:
+		return nil
+	}
+	return fmt.Errorf("query condition on %q has unknown operator %q", c.Key, c.Op)
+}
+
+// ParseSortFields converts keys to SortFields, a "-" prefix means descending.
+func ParseSortFields(keys ...string) []SortField {
+	ret := make([]SortField, 0, len(keys))
+	for _, k := range keys {
+		if strings.HasPrefix(k, "-") {
+			ret = append(ret, SortField{Key: k[1:], Desc: true})
+			continue
+		}
+		ret = append(ret, SortField{Key: k})
+	}
+	return ret
+}
+
+// MetaItem is a name with its meta, see FilterMetaItems.
+type MetaItem struct {
+	Name string
+	Meta map[string]interface{}
+}
+
+// FilterMetaItems returns the items which match all conds, sorted and with
+// offset and limit applied (limit <= 0 means no limit).  It implements the
+// matching rules described on Cond and SortField and can be used by
+// implementations which have the meta in memory.
+func FilterMetaItems(items []MetaItem, conds []Cond, sortFields []SortField, offset, limit int) []MetaItem {
+
+	ret := make([]MetaItem, 0, len(items))
+	for _, item := range items {
+		if MatchMeta(item.Meta, conds) {
+			ret = append(ret, item)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		for _, sf := range sortFields {
+			c := compareSortValues(ret[i].Meta[sf.Key], ret[j].Meta[sf.Key])
+			if sf.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return ret[i].Name < ret[j].Name
+	})
+
+	if offset > 0 {
+		if offset >= len(ret) {
+			return ret[:0]
+		}
+		ret = ret[offset:]
+	}
+	if limit > 0 && limit < len(ret) {
+		ret = ret[:limit]
+	}
+	return ret
+}
+
+// MatchMeta returns true if meta matches all of the conds.
+func MatchMeta(meta map[string]interface{}, conds []Cond) bool {
+	for _, c := range conds {
+		if !c.Match(meta) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match returns true if meta matches the condition.
+func (c Cond) Match(meta map[string]interface{}) bool {
+
+	v, ok := meta[c.Key]
+	if !ok || v == nil {
+		return false
+	}
+
+	list, isList := MetaList(v)
+
+	switch c.Op {
+
+	case OpEq:
+		return !isList && MetaString(v) == MetaString(c.Value)
+
+	case OpContains:
+		if !isList {
+			return false
+		}
+		want := MetaString(c.Value)
+		for _, el := range list {
+			if MetaString(el) == want {
+				return true
+			}
+		}
+		return false
+
+	case OpLt, OpLte, OpGt, OpGte:
+		if isList {
+			return false
+		}
+		var cmp int
+		if cn, ok := MetaNumber(c.Value); ok {
+			n, ok := MetaNumber(v)
+			if !ok {
+				return false
+			}
+			cmp = compareFloat(n, cn)
+		} else if ct, ok := c.Value.(time.Time); ok {
+			t, ok := MetaTime(v)
+			if !ok {
+				return false
+			}
+			cmp = compareTime(t, ct)
+		} else {
+			cmp = strings.Compare(MetaString(v), MetaString(c.Value))
+		}
+		switch c.Op {
+		case OpLt:
+			return cmp < 0
+		case OpLte:
+			return cmp <= 0
+		case OpGt:
+			return cmp > 0
+		case OpGte:
+			return cmp >= 0
+		}
+	}
+
+	return false
+}
+
+// MetaList returns the elements if v is a list ([]interface{} or []string).
+func MetaList(v interface{}) ([]interface{}, bool) {
+	switch l := v.(type) {
+	case []interface{}:
+		return l, true
+	case []string:
+		ret := make([]interface{}, 0, len(l))
+		for _, s := range l {
+			ret = append(ret, s)
+		}
+		return ret, true
+	}
+	return nil, false
+}
+
+// MetaNumber returns v as a float64 if it is a number type.  Strings are not converted.
+func MetaNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// metaTimeFormats are the string formats MetaTime recognizes.
+var metaTimeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// MetaTime returns v as a time.Time if it is one or is a string in a
+// recognized date format.
+func MetaTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		for _, f := range metaTimeFormats {
+			if pt, err := time.Parse(f, t); err == nil {
+				return pt, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// MetaString returns the string form of a meta value used for equality comparisons.
+// Times are formatted as RFC3339 in UTC.
+func MetaString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	if n, ok := MetaNumber(v); ok {
+		return fmt.Sprint(n)
+	}
+	return fmt.Sprint(v)
+}
+
+// compareSortValues orders two meta values: missing values and lists first, then
+// numbers, then times, then strings.
+func compareSortValues(a, b interface{}) int {
+	ra, rb := sortRank(a), sortRank(b)
+	if ra != rb {
+		return compareInt(ra, rb)
+	}
+	switch ra {
+	case 1:
+		an, _ := MetaNumber(a)
+		bn, _ := MetaNumber(b)
+		return compareFloat(an, bn)
+	case 2:
+		at, _ := MetaTime(a)
+		bt, _ := MetaTime(b)
+		return compareTime(at, bt)
+	case 3:
+		return strings.Compare(MetaString(a), MetaString(b))
+	}
+	return 0
+}
+
+func sortRank(v interface{}) int {
+	if v == nil {
+		return 0
+	}
+	if _, ok := MetaList(v); ok {
+		return 0
+	}
+	if _, ok := MetaNumber(v); ok {
+		return 1
+	}
+	if _, ok := MetaTime(v); ok {
+		return 2
+	}
+	return 3
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+package tmpl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemQuery(t *testing.T) {
+
+	assert := assert.New(t)
+
+	tmpDir, err := ioutil.TempDir("", "TestMemQuery")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	assert.NoError(os.Mkdir(filepath.Join(tmpDir, "blog"), 0755))
+	files := map[string]string{
+		"blog/post1.gohtml": "---\ntitle: Post 1\ndate: 2018-01-10\ntags: [go, web]\nweight: 10\n---\npost1",
+		"blog/post2.gohtml": "---\ntitle: Post 2\ndate: 2018-03-01\ntags: [go]\nweight: 2\n---\npost2",
+		"blog/post3.gohtml": "---\ntitle: Post 3\ndate: 2018-02-15\ntags: [python]\n---\npost3",
+		"about.gohtml":      "---\ntitle: About\n---\nabout",
+	}
+	for name, contents := range files {
+		assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(contents), 0644))
+	}
+
+	s := &HFSStore{FileSystems: map[string]http.FileSystem{ViewsCategory: http.Dir(tmpDir)}}
+
+	names, err := QueryStore(s, Query{
+		Category:       ViewsCategory,
+		FileNamePrefix: "/blog/",
+		Conds:          []Cond{{Key: "tags", Op: OpContains, Value: "go"}},
+		Sort:           ParseSortFields("-date"),
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post2.gohtml", "/blog/post1.gohtml"}, names)
+
+	names, err = MemQuery(s, Query{
+		Category: ViewsCategory,
+		Conds:    []Cond{{Key: "date", Op: OpGte, Value: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)}},
+		Sort:     ParseSortFields("date"),
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post3.gohtml", "/blog/post2.gohtml"}, names)
+
+	// numeric range and sort, missing values sort first
+	names, err = MemQuery(s, Query{
+		Category: ViewsCategory,
+		Conds:    []Cond{{Key: "weight", Op: OpLt, Value: 5}},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post2.gohtml"}, names)
+	names, err = MemQuery(s, Query{Category: ViewsCategory, Sort: ParseSortFields("weight"), Offset: 1, Limit: 2})
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post3.gohtml", "/blog/post2.gohtml"}, names)
+
+	names, err = MemQuery(s, Query{Category: ViewsCategory, Conds: []Cond{{Key: "title", Op: OpEq, Value: "About"}}})
+	assert.NoError(err)
+	assert.Equal([]string{"/about.gohtml"}, names)
+
+	_, err = MemQuery(s, Query{Category: ViewsCategory, Conds: []Cond{{Key: "title", Op: "like", Value: "x"}}})
+	assert.Error(err)
+
+}
@@ -5,7 +5,9 @@ package tmpl
 // anything other than ErrWriteNotSupported.  The common case is to have your
 // writable Store as the first in the slice and other read-only Stores can be added
 // after in order to make additional templates available.  This tends to provide
-// the most useful behavior.
+// the most useful behavior.  Updating a template which only exists in a Store
+// further down the stack will create it in the first writable Store (with the
+// existing meta merged in), so edits override the original without modifying it.
 type StackedStore []Store
 
 func (ss StackedStore) CreateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
@@ -19,8 +21,11 @@ func (ss StackedStore) CreateTemplate(category, fileName string, body []byte, mi
 }
 
 func (ss StackedStore) UpdateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
-	for _, s := range ss {
+	for i, s := range ss {
 		err := s.UpdateTemplate(category, fileName, body, mimeType, meta)
+		if err == ErrNotFound {
+			return ss.copyUp(i, category, fileName, body, mimeType, meta)
+		}
 		if err != ErrWriteNotSupported {
 			return err
 		}
@@ -28,6 +33,33 @@ func (ss StackedStore) UpdateTemplate(category, fileName string, body []byte, mi
 	return ErrWriteNotSupported
 }
 
+// copyUp looks for a template in the Stores after ss[i] and if found
+// creates it in ss[i] with the existing meta merged with the meta provided.
+func (ss StackedStore) copyUp(i int, category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
+	for _, s := range ss[i+1:] {
+		_, _, oldMeta, err := s.ReadTemplate(category, fileName)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		newMeta := make(map[string]interface{}, len(oldMeta)+len(meta))
+		for k, v := range oldMeta {
+			newMeta[k] = v
+		}
+		for k, v := range meta {
+			if v == nil {
+				delete(newMeta, k)
+				continue
+			}
+			newMeta[k] = v
+		}
+		return ss[i].CreateTemplate(category, fileName, body, mimeType, newMeta)
+	}
+	return ErrNotFound
+}
+
 func (ss StackedStore) DeleteTemplate(category, fileName string) error {
 	for _, s := range ss {
 		err := s.DeleteTemplate(category, fileName)
@@ -93,3 +125,40 @@ func (ss StackedStore) FindByPrefix(category, fileNamePrefix string, limit int)
 
 	return ret, nil
 }
+
+// Watch implements WatchableStore by watching each Store in the stack which
+// implements WatchableStore.  Stores which do not are skipped.
+// ErrWatchNotSupported is returned if none of them can be watched.
+// Events are passed through as-is, a change in one Store may or may not be
+// visible through the stack depending on what the Stores above it contain.
+func (ss StackedStore) Watch(fn WatchFunc) (unwatch func(), err error) {
+
+	var unwatches []func()
+	unwatchAll := func() {
+		for _, uw := range unwatches {
+			uw()
+		}
+	}
+
+	for _, s := range ss {
+		ws, ok := s.(WatchableStore)
+		if !ok {
+			continue
+		}
+		uw, err := ws.Watch(fn)
+		if err == ErrWatchNotSupported {
+			continue
+		}
+		if err != nil {
+			unwatchAll()
+			return nil, err
+		}
+		unwatches = append(unwatches, uw)
+	}
+
+	if len(unwatches) == 0 {
+		return nil, ErrWatchNotSupported
+	}
+
+	return unwatchAll, nil
+}
@@ -1,6 +1,14 @@
 package tmpl
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestStackedStore(t *testing.T) {
 
@@ -8,3 +16,40 @@ func TestStackedStore(t *testing.T) {
 	t.SkipNow()
 
 }
+
+func TestStackedStoreWatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	viewsTmpdir1, includesTmpdir1, s1 := hfsStoreTestSetup(t)
+	defer os.RemoveAll(viewsTmpdir1)
+	defer os.RemoveAll(includesTmpdir1)
+	s1.PollInterval = 10 * time.Millisecond
+	viewsTmpdir2, includesTmpdir2, s2 := hfsStoreTestSetup(t)
+	defer os.RemoveAll(viewsTmpdir2)
+	defer os.RemoveAll(includesTmpdir2)
+	s2.PollInterval = 10 * time.Millisecond
+
+	_, err := StackedStore{&SyncStore{}}.Watch(func(Event) {})
+	assert.Equal(ErrWatchNotSupported, err)
+
+	evch := make(chan Event, 10)
+	ss := NewSyncStore(StackedStore{s1, s2})
+	unwatch, err := ss.Watch(func(ev Event) { evch <- ev })
+	assert.NoError(err)
+
+	assert.NoError(ioutil.WriteFile(filepath.Join(viewsTmpdir2, "file4.gohtml"), []byte(`file4`), 0644))
+	assert.Equal(Event{Type: CreateEvent, Category: ViewsCategory, FileName: "/file4.gohtml"}, <-evch)
+
+	// replacing the store notifies and moves watching to the new one
+	ss.SetStore(s1)
+	assert.Equal(Event{Type: UpdateEvent}, <-evch)
+	assert.NoError(ioutil.WriteFile(filepath.Join(viewsTmpdir2, "file5.gohtml"), []byte(`file5`), 0644))
+	assert.NoError(ioutil.WriteFile(filepath.Join(viewsTmpdir1, "file6.gohtml"), []byte(`file6`), 0644))
+	assert.Equal(Event{Type: CreateEvent, Category: ViewsCategory, FileName: "/file6.gohtml"}, <-evch)
+
+	unwatch()
+	assert.Equal(0, s1.watchers.Len())
+	assert.Equal(0, s2.watchers.Len())
+
+}
@@ -13,13 +13,66 @@ func NewSyncStore(s Store) *SyncStore {
 type SyncStore struct {
 	sync.RWMutex
 	Store Store
+
+	watchOnce    sync.Once
+	watchers     *Watchers
+	unwatchStore func() // non-nil while we are watching Store
 }
 
 // SetStore safely assigns the value of Store within Lock/Unlock calls.
+// If the SyncStore is being watched, watching moves to the new Store and
+// an UpdateEvent for all templates is sent.
 func (s *SyncStore) SetStore(store Store) {
 	s.Lock()
-	defer s.Unlock()
 	s.Store = store
+	watching := s.unwatchStore != nil
+	if watching {
+		s.unwatchStore()
+		s.unwatchStore = nil
+		if ws, ok := store.(WatchableStore); ok {
+			uw, err := ws.Watch(s.watchers.Notify)
+			if err == nil {
+				s.unwatchStore = uw
+			}
+		}
+	}
+	s.Unlock()
+
+	if watching {
+		s.watchers.Notify(Event{Type: UpdateEvent})
+	}
+}
+
+// Watch implements WatchableStore by watching the underlying Store.  ErrWatchNotSupported
+// is returned if the underlying Store does not implement WatchableStore.
+func (s *SyncStore) Watch(fn WatchFunc) (unwatch func(), err error) {
+	s.watchOnce.Do(func() {
+		s.watchers = &Watchers{
+			Start: func() error {
+				s.Lock()
+				defer s.Unlock()
+				ws, ok := s.Store.(WatchableStore)
+				if !ok {
+					return ErrWatchNotSupported
+				}
+				uw, err := ws.Watch(s.watchers.Notify)
+				if err != nil {
+					return err
+				}
+				s.unwatchStore = uw
+				return nil
+			},
+			Stop: func() {
+				s.Lock()
+				defer s.Unlock()
+				if s.unwatchStore != nil {
+					s.unwatchStore()
+					s.unwatchStore = nil
+				}
+			},
+		}
+	})
+	return s.watchers.Watch(fn)
 }
 
 // CreateTemplate acquires an RLock() and then delegates to Store.
@@ -63,3 +116,11 @@ func (s *SyncStore) FindByPrefix(category, fileNamePrefix string, limit int) ([]
 	defer s.RUnlock()
 	return s.Store.FindByPrefix(category, fileNamePrefix, limit)
 }
+
+// Query acquires an RLock() and then runs the query with QueryStore, so an
+// underlying QueryableStore is used if available.
+func (s *SyncStore) Query(q Query) ([]string, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return QueryStore(s.Store, q)
+}
@@ -0,0 +1,631 @@
+// Database persistence for templates, with revision history.
+package tmpldbr
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gocaveman/caveman/autowire"
+	"github.com/gocaveman/caveman/dbutil"
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migrateregistry"
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/gocaveman/caveman/webutil"
+	"github.com/gocraft/dbr"
+)
+
+// DefaultTmplMigrations is all of our migrations for this store.
+var DefaultTmplMigrations migrate.MigrationList
+
+func init() {
+
+	// register in migrateregistry and with autowire for all 3 databases
+	reg := func(m *migrate.SQLTmplMigration) {
+		var rm migrate.Migration
+		rm = m.NewWithDriverName("sqlite3")
+		DefaultTmplMigrations = append(DefaultTmplMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+		rm = m.NewWithDriverName("mysql")
+		DefaultTmplMigrations = append(DefaultTmplMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+		rm = m.NewWithDriverName("postgres")
+		DefaultTmplMigrations = append(DefaultTmplMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+	}
+
+	reg(&migrate.SQLTmplMigration{
+		// DriverNameValue set by reg
+		CategoryValue: "tmpldbr",
+		VersionValue:  "0001_tmpl_create", // must be unique and indicates sequence
+		UpSQL: []string{
+			`CREATE TABLE {{.TablePrefix}}tmpl (
+				category VARCHAR(128),
+				file_name VARCHAR(255),
+				body {{if eq .DriverNameValue "mysql"}}MEDIUMTEXT{{else}}TEXT{{end}},
+				mime_type VARCHAR(255),
+				meta TEXT,
+				revision INTEGER,
+				PRIMARY KEY (category, file_name)
+			)`,
+			`CREATE TABLE {{.TablePrefix}}tmpl_revision (
+				category VARCHAR(128),
+				file_name VARCHAR(255),
+				revision INTEGER,
+				body {{if eq .DriverNameValue "mysql"}}MEDIUMTEXT{{else}}TEXT{{end}},
+				mime_type VARCHAR(255),
+				meta TEXT,
+				deleted {{if eq .DriverNameValue "postgres"}}BOOLEAN{{else}}INTEGER{{end}},
+				author VARCHAR(255),
+				create_time {{if eq .DriverNameValue "postgres"}}TIMESTAMP{{else}}DATETIME{{end}},
+				PRIMARY KEY (category, file_name, revision)
+			)`,
+		},
+		DownSQL: []string{
+			`DROP TABLE {{.TablePrefix}}tmpl_revision`,
+			`DROP TABLE {{.TablePrefix}}tmpl`,
+		},
+	})
+
+}
+
+// Revision is one saved version of a template.  Every write to a DBStore
+// (create, update, delete and rollback) records a new Revision.
+type Revision struct {
+	Category   string                      `db:"category" json:"category"`
+	FileName   string                      `db:"file_name" json:"file_name"`
+	Revision   int64                       `db:"revision" json:"revision"`
+	Body       []byte                      `db:"body" json:"body,omitempty"` // not populated by Revisions()
+	MimeType   string                      `db:"mime_type" json:"mime_type"`
+	Meta       webutil.SimpleStringDataMap `db:"meta" json:"meta"`
+	Deleted    bool                        `db:"deleted" json:"deleted"` // true if this revision records the template being deleted
+	Author     string                      `db:"author" json:"author"`
+	CreateTime time.Time                   `db:"create_time" json:"create_time"`
+}
+
+// dbTmpl corresponds to a row in the tmpl table.
+type dbTmpl struct {
+	Category string                      `db:"category"`
+	FileName string                      `db:"file_name"`
+	Body     []byte                      `db:"body"`
+	MimeType string                      `db:"mime_type"`
+	Meta     webutil.SimpleStringDataMap `db:"meta"`
+	Revision int64                       `db:"revision"`
+}
+
+// type check
+var _ tmpl.WatchableStore = &DBStore{}
+
+// DBStore implements tmpl.Store against a database table and keeps a revision
+// history of every change.  The current version of each template is kept in the
+// "tmpl" table and each revision in "tmpl_revision".  A DBStore is writable
+// and is intended to be the first item in a tmpl.StackedStore, above read-only
+// stores such as embedded themes, so templates created here override the
+// shipped ones with the same name.
+//
+// DBStore implements tmpl.WatchableStore.  Changes made through this DBStore
+// are always reported; set PollInterval to also check the database for changes
+// made by other servers in a cluster.
+type DBStore struct {
+	DBDriver    string `autowire:"db.DriverName"`
+	DBDSN       string `autowire:"db.DataSourceName"`
+	TablePrefix string `autowire:"db.TablePrefix,optional"`
+
+	PollInterval time.Duration // if > 0, how often to check for changes from other servers while watched
+
+	author string // recorded on each revision, see WithAuthor
+	conn   *dbr.Connection
+	watch  *dbWatch
+}
+
+// pollOverlap is how far back each poll looks for new revisions, to allow for
+// clock differences between servers and transactions which commit late.
+const pollOverlap = 30 * time.Second
+
+type revKey struct {
+	category string
+	fileName string
+	revision int64
+}
+
+// dbWatch is the watch state, shared by copies made with WithAuthor.
+type dbWatch struct {
+	tmpl.Watchers
+
+	mu       sync.Mutex
+	pollTime time.Time            // latest create_time seen
+	seen     map[revKey]time.Time // revisions already reported
+}
+
+// AfterWire opens the database connection and must be called before use.
+func (s *DBStore) AfterWire() error {
+	var err error
+	s.conn, err = dbr.Open(s.DBDriver, s.DBDSN, nil)
+	if err != nil {
+		return err
+	}
+	s.watch = &dbWatch{seen: make(map[revKey]time.Time)}
+	if s.PollInterval > 0 {
+		s.watch.PollInterval = s.PollInterval
+		s.watch.Start = func() error {
+			// skip anything that happened before we started watching
+			s.watch.mu.Lock()
+			s.watch.pollTime = time.Now().UTC()
+			s.watch.mu.Unlock()
+			_, err := s.poll()
+			return err
+		}
+		s.watch.Poll = s.poll
+	}
+	return nil
+}
+
+// WithAuthor returns a copy of this store which records the specified author
+// on every revision it writes.  The copy shares the same database connection.
+func (s *DBStore) WithAuthor(author string) *DBStore {
+	ret := *s
+	ret.author = author
+	return &ret
+}
+
+// CreateTemplate writes a new template, will return tmpl.ErrAlreadyExists for existing template.
+func (s *DBStore) CreateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	newMeta := webutil.SimpleStringDataMap(meta)
+	if newMeta == nil {
+		newMeta = webutil.SimpleStringDataMap{}
+	}
+
+	// inserting the row first locks it for the revision number (see writeRevision),
+	// the revision is set once known
+	_, err = tx.InsertInto(s.TablePrefix+"tmpl").
+		Columns("category", "file_name", "body", "mime_type", "meta", "revision").
+		Values(category, fileName, body, mimeType, newMeta, 0).
+		Exec()
+	if err != nil {
+		tx.Rollback()
+		// the error for a duplicate key differs by driver, see if that's what happened
+		if _, _, _, rerr := s.ReadTemplate(category, fileName); rerr == nil {
+			return tmpl.ErrAlreadyExists
+		}
+		return err
+	}
+
+	rev, err := s.writeRevision(tx, category, fileName, body, mimeType, newMeta, false)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateCurrent(tx, category, fileName, body, mimeType, newMeta, rev)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	s.notify(tmpl.CreateEvent, category, fileName, rev)
+	return nil
+}
+
+// ReadTemplate returns the current version of a template.
+// tmpl.ErrNotFound will be returned if it doesn't exist.
+func (s *DBStore) ReadTemplate(category, fileName string) (body []byte, mimeType string, meta map[string]interface{}, err error) {
+
+	sess := s.conn.NewSession(nil)
+	var t dbTmpl
+	err = sess.Select("*").From(s.TablePrefix+"tmpl").
+		Where("category=? AND file_name=?", category, fileName).
+		LoadOne(&t)
+	if err == dbr.ErrNotFound {
+		return nil, "", nil, tmpl.ErrNotFound
+	}
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return t.Body, t.MimeType, map[string]interface{}(t.Meta), nil
+}
+
+// UpdateTemplate writes a new revision of an existing template.  The meta provided
+// is merged into the existing meta; a key with a nil value removes it.
+// tmpl.ErrNotFound will be returned if the template doesn't exist.
+func (s *DBStore) UpdateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	err = s.lockCurrent(tx, category, fileName)
+	if err != nil {
+		return err
+	}
+
+	var t dbTmpl
+	err = tx.Select("*").From(s.TablePrefix+"tmpl").
+		Where("category=? AND file_name=?", category, fileName).
+		LoadOne(&t)
+	if err != nil {
+		return err
+	}
+
+	newMeta := make(webutil.SimpleStringDataMap, len(t.Meta)+len(meta))
+	for k, v := range t.Meta {
+		newMeta.Set(k, v)
+	}
+	for k, v := range meta {
+		newMeta.Set(k, v)
+	}
+
+	rev, err := s.writeRevision(tx, category, fileName, body, mimeType, newMeta, false)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateCurrent(tx, category, fileName, body, mimeType, newMeta, rev)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	s.notify(tmpl.UpdateEvent, category, fileName, rev)
+	return nil
+}
+
+// DeleteTemplate removes a template.  The history is kept and a revision marked
+// as deleted is recorded, so a deleted template can be brought back with Rollback.
+// If it did not exist, tmpl.ErrNotFound will be returned.
+func (s *DBStore) DeleteTemplate(category, fileName string) error {
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	res, err := tx.DeleteFrom(s.TablePrefix+"tmpl").
+		Where("category=? AND file_name=?", category, fileName).
+		Exec()
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return tmpl.ErrNotFound
+	}
+
+	rev, err := s.writeRevision(tx, category, fileName, nil, "", nil, true)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	s.notify(tmpl.DeleteEvent, category, fileName, rev)
+	return nil
+}
+
+// Categories returns the list of categories which have at least one template.
+func (s *DBStore) Categories() ([]string, error) {
+	sess := s.conn.NewSession(nil)
+	var ret []string
+	_, err := sess.Select("category").Distinct().From(s.TablePrefix + "tmpl").
+		OrderAsc("category").
+		Load(&ret)
+	return ret, err
+}
+
+// FindByPrefix will return a slice of file names for the specified category
+// that begin with a prefix, up to an indicated limit.  Limit <= 0 means all.
+// Unlike file system stores, prefixes which do not end in a slash match partial
+// file names.
+func (s *DBStore) FindByPrefix(category, fileNamePrefix string, limit int) ([]string, error) {
+
+	sess := s.conn.NewSession(nil)
+	stmt := sess.Select("file_name").From(s.TablePrefix+"tmpl").
+		Where("category=? AND file_name LIKE ? ESCAPE '!'", category, dbutil.LikePrefix(fileNamePrefix)).
+		OrderAsc("file_name")
+	if limit > 0 {
+		stmt = stmt.Limit(uint64(limit))
+	}
+
+	var ret []string
+	_, err := stmt.Load(&ret)
+	return ret, err
+}
+
+// Revisions returns the revision history for a template, newest first.
+// The Body field is not populated, use ReadRevision to get it.
+// Templates which have been deleted still have their history returned.
+func (s *DBStore) Revisions(category, fileName string) ([]Revision, error) {
+	sess := s.conn.NewSession(nil)
+	var ret []Revision
+	_, err := sess.Select("category", "file_name", "revision", "mime_type", "meta", "deleted", "author", "create_time").
+		From(s.TablePrefix+"tmpl_revision").
+		Where("category=? AND file_name=?", category, fileName).
+		OrderDesc("revision").
+		Load(&ret)
+	return ret, err
+}
+
+// ReadRevision returns a specific revision of a template, including its Body.
+// tmpl.ErrNotFound will be returned if it doesn't exist.
+func (s *DBStore) ReadRevision(category, fileName string, revision int64) (*Revision, error) {
+	sess := s.conn.NewSession(nil)
+	var ret Revision
+	err := sess.Select("*").From(s.TablePrefix+"tmpl_revision").
+		Where("category=? AND file_name=? AND revision=?", category, fileName, revision).
+		LoadOne(&ret)
+	if err == dbr.ErrNotFound {
+		return nil, tmpl.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// Rollback makes the contents of an earlier revision current again.  The history
+// is not rewritten, instead a new revision is recorded with the old contents.
+// Rolling back to a revision marked as deleted deletes the template and rolling
+// back a deleted template to an earlier revision restores it.
+func (s *DBStore) Rollback(category, fileName string, revision int64) error {
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	// the current row may or may not be there, depending on if the template is currently deleted
+	// (this is done first, see writeRevision)
+	res, err := tx.DeleteFrom(s.TablePrefix+"tmpl").
+		Where("category=? AND file_name=?", category, fileName).
+		Exec()
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	existed := n > 0
+
+	var old Revision
+	err = tx.Select("*").From(s.TablePrefix+"tmpl_revision").
+		Where("category=? AND file_name=? AND revision=?", category, fileName, revision).
+		LoadOne(&old)
+	if err == dbr.ErrNotFound {
+		return tmpl.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	rev, err := s.writeRevision(tx, category, fileName, old.Body, old.MimeType, old.Meta, old.Deleted)
+	if err != nil {
+		return err
+	}
+
+	if !old.Deleted {
+		_, err = tx.InsertInto(s.TablePrefix+"tmpl").
+			Columns("category", "file_name", "body", "mime_type", "meta", "revision").
+			Values(category, fileName, old.Body, old.MimeType, old.Meta, rev).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case old.Deleted && existed:
+		s.notify(tmpl.DeleteEvent, category, fileName, rev)
+	case old.Deleted:
+		// was already deleted, nothing visible changed
+	case existed:
+		s.notify(tmpl.UpdateEvent, category, fileName, rev)
+	default:
+		s.notify(tmpl.CreateEvent, category, fileName, rev)
+	}
+	return nil
+}
+
+// Watch implements tmpl.WatchableStore.
+func (s *DBStore) Watch(fn tmpl.WatchFunc) (unwatch func(), err error) {
+	return s.watch.Watch(fn)
+}
+
+// notify reports a change made through this DBStore and records it as seen
+// so polling does not report it again.
+func (s *DBStore) notify(evType tmpl.EventType, category, fileName string, rev int64) {
+	s.watch.mu.Lock()
+	s.watch.seen[revKey{category: category, fileName: fileName, revision: rev}] = time.Now().UTC()
+	s.watch.mu.Unlock()
+	s.watch.Notify(tmpl.Event{Type: evType, Category: category, FileName: fileName})
+}
+
+// poll returns events for revisions written since the last poll which have not already been reported.
+func (s *DBStore) poll() ([]tmpl.Event, error) {
+
+	s.watch.mu.Lock()
+	defer s.watch.mu.Unlock()
+
+	// each revision with whether the one before it exists and was a delete
+	var revs []struct {
+		Category     string        `db:"category"`
+		FileName     string        `db:"file_name"`
+		Revision     int64         `db:"revision"`
+		Deleted      bool          `db:"deleted"`
+		CreateTime   time.Time     `db:"create_time"`
+		PrevRevision sql.NullInt64 `db:"prev_revision"`
+		PrevDeleted  sql.NullBool  `db:"prev_deleted"`
+	}
+	sess := s.conn.NewSession(nil)
+	_, err := sess.Select("r.category", "r.file_name", "r.revision", "r.deleted", "r.create_time",
+		"p.revision AS prev_revision", "p.deleted AS prev_deleted").
+		From(dbr.I(s.TablePrefix+"tmpl_revision").As("r")).
+		LeftJoin(dbr.I(s.TablePrefix+"tmpl_revision").As("p"),
+			"p.category=r.category AND p.file_name=r.file_name AND p.revision=r.revision-1").
+		Where("r.create_time >= ?", s.watch.pollTime.Add(-pollOverlap)).
+		OrderAsc("r.create_time").
+		Load(&revs)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []tmpl.Event
+	for _, rev := range revs {
+
+		if rev.CreateTime.After(s.watch.pollTime) {
+			s.watch.pollTime = rev.CreateTime
+		}
+
+		k := revKey{category: rev.Category, fileName: rev.FileName, revision: rev.Revision}
+		if _, ok := s.watch.seen[k]; ok {
+			continue
+		}
+		s.watch.seen[k] = rev.CreateTime
+
+		// the previous revision says whether it existed before, a template can be
+		// deleted and created again
+		existed := rev.PrevRevision.Valid && !rev.PrevDeleted.Bool
+		ev := tmpl.Event{Category: rev.Category, FileName: rev.FileName}
+		switch {
+		case rev.Deleted && existed:
+			ev.Type = tmpl.DeleteEvent
+		case rev.Deleted:
+			continue // was already deleted (a rollback), nothing visible changed
+		case existed:
+			ev.Type = tmpl.UpdateEvent
+		default:
+			ev.Type = tmpl.CreateEvent
+		}
+		ret = append(ret, ev)
+	}
+
+	// forget about anything old enough that the next poll won't select it
+	for k, t := range s.watch.seen {
+		if t.Before(s.watch.pollTime.Add(-pollOverlap)) {
+			delete(s.watch.seen, k)
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *DBStore) updateCurrent(tx *dbr.Tx, category, fileName string, body []byte, mimeType string, meta webutil.SimpleStringDataMap, rev int64) error {
+
+	res, err := tx.Update(s.TablePrefix+"tmpl").
+		Set("body", body).
+		Set("mime_type", mimeType).
+		Set("meta", meta).
+		Set("revision", rev).
+		Where("category=? AND file_name=?", category, fileName).
+		Exec()
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("updating template (category=%q, fileName=%q) affected %d rows (expected 1)", category, fileName, n)
+	}
+	return nil
+}
+
+// lockCurrent locks the current row of a template for the rest of the transaction,
+// returning tmpl.ErrNotFound if there isn't one.
+func (s *DBStore) lockCurrent(tx *dbr.Tx, category, fileName string) error {
+
+	// SQLite3 has no SELECT ... FOR UPDATE, a no-op update locks the database instead
+	// (RowsAffected can't be used for the others, MySQL only counts changed rows)
+	if s.DBDriver == "sqlite3" {
+		res, err := tx.Update(s.TablePrefix+"tmpl").
+			Set("revision", dbr.Expr("revision")).
+			Where("category=? AND file_name=?", category, fileName).
+			Exec()
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return tmpl.ErrNotFound
+		}
+		return nil
+	}
+
+	var rev int64
+	err := tx.SelectBySql("SELECT revision FROM "+s.TablePrefix+"tmpl WHERE category=? AND file_name=? FOR UPDATE",
+		category, fileName).LoadOne(&rev)
+	if err == dbr.ErrNotFound {
+		return tmpl.ErrNotFound
+	}
+	return err
+}
+
+// writeRevision records the next revision number for a template and returns it.
+// Two transactions must not pick the same number, so before this is called the
+// transaction must have locked the template's row in the tmpl table, by inserting or
+// deleting it or with lockCurrent.  In SQLite3 that locks the whole database, and doing
+// it first means waiting for (rather than deadlocking with) another writer.
+func (s *DBStore) writeRevision(tx *dbr.Tx, category, fileName string, body []byte, mimeType string, meta webutil.SimpleStringDataMap, deleted bool) (int64, error) {
+
+	var maxRev sql.NullInt64
+	err := tx.Select("MAX(revision)").From(s.TablePrefix+"tmpl_revision").
+		Where("category=? AND file_name=?", category, fileName).
+		LoadOne(&maxRev)
+	if err != nil && err != dbr.ErrNotFound {
+		return 0, err
+	}
+
+	rev := maxRev.Int64 + 1
+
+	if meta == nil {
+		meta = webutil.SimpleStringDataMap{}
+	}
+
+	_, err = tx.InsertInto(s.TablePrefix+"tmpl_revision").
+		Columns("category", "file_name", "revision", "body", "mime_type", "meta", "deleted", "author", "create_time").
+		Values(category, fileName, rev, body, mimeType, meta, deleted, s.author, time.Now().UTC()).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return rev, nil
+}
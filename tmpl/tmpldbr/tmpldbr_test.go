@@ -0,0 +1,282 @@
+package tmpldbr
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migratedbr"
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func initDBTest(t *testing.T, name string) *DBStore {
+
+	assert := assert.New(t)
+
+	driver, dsn := "sqlite3", "file:"+name+"?mode=memory&cache=shared"
+
+	s := &DBStore{
+		DBDriver: driver,
+		DBDSN:    dsn,
+	}
+	assert.NoError(s.AfterWire())
+
+	ver, err := migratedbr.New(driver, dsn)
+	assert.NoError(err)
+	runner := migrate.NewRunner(driver, dsn, ver, DefaultTmplMigrations)
+	assert.NoError(runner.RunAllUpToLatest())
+
+	return s
+}
+
+func TestDBStore(t *testing.T) {
+
+	assert := assert.New(t)
+
+	s := initDBTest(t, "TestDBStore").WithAuthor("joe")
+
+	assert.NoError(s.CreateTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml", []byte(`{{template "test1" .}}`),
+		tmpl.GoTemplateHtmlMimeType, map[string]interface{}{"title": "Test 1"}))
+	assert.Equal(tmpl.ErrAlreadyExists, s.CreateTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml", nil, "", nil))
+	assert.NoError(s.CreateTemplate(tmpl.ViewsCategory, "/test1/file2.gohtml", []byte(`file2`), tmpl.GoTemplateHtmlMimeType, nil))
+	assert.NoError(s.CreateTemplate(tmpl.IncludesCategory, "/someinclude.gohtml", []byte(`include`), tmpl.GoTemplateHtmlMimeType, nil))
+
+	body, mimeType, meta, err := s.ReadTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	assert.Equal(`{{template "test1" .}}`, string(body))
+	assert.Equal(tmpl.GoTemplateHtmlMimeType, mimeType)
+	assert.Equal("Test 1", meta["title"])
+
+	_, _, _, err = s.ReadTemplate(tmpl.ViewsCategory, "/test1/doesnotexist.gohtml")
+	assert.Equal(tmpl.ErrNotFound, err)
+
+	cats, err := s.Categories()
+	assert.NoError(err)
+	assert.Equal([]string{tmpl.IncludesCategory, tmpl.ViewsCategory}, cats)
+
+	names, err := s.FindByPrefix(tmpl.ViewsCategory, "/test1/", -1)
+	assert.NoError(err)
+	assert.Equal([]string{"/test1/file1.gohtml", "/test1/file2.gohtml"}, names)
+	names, err = s.FindByPrefix(tmpl.ViewsCategory, "/", 1)
+	assert.NoError(err)
+	assert.Equal([]string{"/test1/file1.gohtml"}, names)
+	names, err = s.FindByPrefix(tmpl.ViewsCategory, "/test_", -1)
+	assert.NoError(err)
+	assert.Len(names, 0)
+
+	// update merges meta
+	assert.NoError(s.WithAuthor("bob").UpdateTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml", []byte(`updated`),
+		tmpl.GoTemplateHtmlMimeType, map[string]interface{}{"tags": "go"}))
+	body, _, meta, err = s.ReadTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	assert.Equal(`updated`, string(body))
+	assert.Equal("Test 1", meta["title"])
+	assert.Equal("go", meta["tags"])
+	assert.Equal(tmpl.ErrNotFound, s.UpdateTemplate(tmpl.ViewsCategory, "/test1/doesnotexist.gohtml", nil, "", nil))
+
+	assert.NoError(s.DeleteTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml"))
+	assert.Equal(tmpl.ErrNotFound, s.DeleteTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml"))
+	_, _, _, err = s.ReadTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.Equal(tmpl.ErrNotFound, err)
+
+	revs, err := s.Revisions(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	if assert.Len(revs, 3) {
+		assert.Equal(int64(3), revs[0].Revision)
+		assert.True(revs[0].Deleted)
+		assert.Equal("bob", revs[1].Author)
+		assert.Equal("joe", revs[2].Author)
+		assert.False(revs[2].CreateTime.IsZero())
+	}
+
+	rev, err := s.ReadRevision(tmpl.ViewsCategory, "/test1/file1.gohtml", 1)
+	assert.NoError(err)
+	assert.Equal(`{{template "test1" .}}`, string(rev.Body))
+	_, err = s.ReadRevision(tmpl.ViewsCategory, "/test1/file1.gohtml", 10)
+	assert.Equal(tmpl.ErrNotFound, err)
+
+	// bring back the deleted template as it was originally
+	assert.NoError(s.Rollback(tmpl.ViewsCategory, "/test1/file1.gohtml", 1))
+	body, _, meta, err = s.ReadTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	assert.Equal(`{{template "test1" .}}`, string(body))
+	assert.Nil(meta["tags"])
+
+	// and rolling back to the deleted revision deletes it again
+	assert.NoError(s.Rollback(tmpl.ViewsCategory, "/test1/file1.gohtml", 3))
+	_, _, _, err = s.ReadTemplate(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.Equal(tmpl.ErrNotFound, err)
+
+	revs, err = s.Revisions(tmpl.ViewsCategory, "/test1/file1.gohtml")
+	assert.NoError(err)
+	assert.Len(revs, 5)
+
+}
+
+func TestDBStoreStacked(t *testing.T) {
+
+	assert := assert.New(t)
+
+	s := initDBTest(t, "TestDBStoreStacked")
+
+	tmpDir, err := ioutil.TempDir("", "TestDBStoreStacked")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+	assert.NoError(ioutil.WriteFile(filepath.Join(tmpDir, "page.gohtml"), []byte(`---
+title: Shipped
+---
+shipped`), 0644))
+
+	ss := tmpl.StackedStore{s, &tmpl.HFSStore{
+		FileSystems: map[string]http.FileSystem{
+			tmpl.ViewsCategory: http.Dir(tmpDir),
+		},
+	}}
+
+	body, _, _, err := ss.ReadTemplate(tmpl.ViewsCategory, "/page.gohtml")
+	assert.NoError(err)
+	assert.Contains(string(body), "shipped")
+
+	// editing the shipped template creates an override in the database
+	assert.NoError(ss.UpdateTemplate(tmpl.ViewsCategory, "/page.gohtml", []byte(`edited`),
+		tmpl.GoTemplateHtmlMimeType, map[string]interface{}{"description": "Edited"}))
+	body, _, meta, err := ss.ReadTemplate(tmpl.ViewsCategory, "/page.gohtml")
+	assert.NoError(err)
+	assert.Equal("edited", string(body))
+	assert.Equal("Shipped", meta["title"])
+	assert.Equal("Edited", meta["description"])
+
+	names, err := ss.FindByPrefix(tmpl.ViewsCategory, "/", -1)
+	assert.NoError(err)
+	assert.Equal([]string{"/page.gohtml"}, names)
+
+	// removing the override reveals the shipped version again
+	assert.NoError(ss.DeleteTemplate(tmpl.ViewsCategory, "/page.gohtml"))
+	body, _, _, err = ss.ReadTemplate(tmpl.ViewsCategory, "/page.gohtml")
+	assert.NoError(err)
+	assert.Contains(string(body), "shipped")
+
+	assert.Equal(tmpl.ErrNotFound, ss.UpdateTemplate(tmpl.ViewsCategory, "/nothere.gohtml", nil, "", nil))
+
+}
+
+func TestDBStoreWatch(t *testing.T) {
+
+	assert := assert.New(t)
+
+	s1 := initDBTest(t, "TestDBStoreWatch")
+	s1.PollInterval = 10 * time.Millisecond
+	assert.NoError(s1.AfterWire())
+	// another server using the same database
+	s2 := initDBTest(t, "TestDBStoreWatch")
+
+	assert.NoError(s2.CreateTemplate(tmpl.ViewsCategory, "/before.gohtml", nil, "", nil))
+
+	evch := make(chan tmpl.Event, 10)
+	unwatch, err := s1.Watch(func(ev tmpl.Event) { evch <- ev })
+	assert.NoError(err)
+	defer unwatch()
+
+	// local changes are reported right away
+	assert.NoError(s1.CreateTemplate(tmpl.ViewsCategory, "/local.gohtml", nil, "", nil))
+	assert.Equal(tmpl.Event{Type: tmpl.CreateEvent, Category: tmpl.ViewsCategory, FileName: "/local.gohtml"}, <-evch)
+
+	// and changes from elsewhere are found by polling
+	assert.NoError(s2.UpdateTemplate(tmpl.ViewsCategory, "/before.gohtml", []byte("x"), "", nil))
+	assert.Equal(tmpl.Event{Type: tmpl.UpdateEvent, Category: tmpl.ViewsCategory, FileName: "/before.gohtml"}, <-evch)
+	assert.NoError(s2.DeleteTemplate(tmpl.ViewsCategory, "/local.gohtml"))
+	assert.Equal(tmpl.Event{Type: tmpl.DeleteEvent, Category: tmpl.ViewsCategory, FileName: "/local.gohtml"}, <-evch)
+	// created again after being deleted is a create, even though it's not the first revision
+	assert.NoError(s2.CreateTemplate(tmpl.ViewsCategory, "/local.gohtml", nil, "", nil))
+	assert.Equal(tmpl.Event{Type: tmpl.CreateEvent, Category: tmpl.ViewsCategory, FileName: "/local.gohtml"}, <-evch)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(evch, 0)
+
+}
+
+func TestDBStoreConcurrentWrites(t *testing.T) {
+
+	assert := assert.New(t)
+
+	// a file, so each connection has its own lock (a shared cache memory database fails
+	// right away with "table is locked" instead of waiting)
+	tmpDir, err := ioutil.TempDir("", "TestDBStoreConcurrentWrites")
+	assert.NoError(err)
+	defer os.RemoveAll(tmpDir)
+	driver, dsn := "sqlite3", filepath.Join(tmpDir, "test.db")
+	s := &DBStore{DBDriver: driver, DBDSN: dsn}
+	assert.NoError(s.AfterWire())
+	ver, err := migratedbr.New(driver, dsn)
+	assert.NoError(err)
+	assert.NoError(migrate.NewRunner(driver, dsn, ver, DefaultTmplMigrations).RunAllUpToLatest())
+
+	assert.NoError(s.CreateTemplate(tmpl.ViewsCategory, "/page.gohtml", []byte("0"), "", nil))
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n*2)
+	for i := 1; i <= n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- s.UpdateTemplate(tmpl.ViewsCategory, "/page.gohtml", []byte(fmt.Sprint(i)), "", nil)
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		assert.NoError(<-errs)
+	}
+
+	revs, err := s.Revisions(tmpl.ViewsCategory, "/page.gohtml")
+	assert.NoError(err)
+	if assert.Len(revs, n+1) {
+		for i, rev := range revs {
+			assert.Equal(int64(n+1-i), rev.Revision)
+		}
+	}
+
+	// only one of two creates of the same (deleted) template works
+	assert.NoError(s.DeleteTemplate(tmpl.ViewsCategory, "/page.gohtml"))
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.CreateTemplate(tmpl.ViewsCategory, "/page.gohtml", nil, "", nil)
+		}()
+	}
+	wg.Wait()
+	err1, err2 := <-errs, <-errs
+	if err1 != nil {
+		err1, err2 = err2, err1
+	}
+	assert.NoError(err1)
+	assert.Equal(tmpl.ErrAlreadyExists, err2)
+
+}
+
+func TestMigrationColumnTypes(t *testing.T) {
+
+	assert := assert.New(t)
+
+	// deleted is written as a bool, which Postgres only accepts for a BOOLEAN column
+	for _, m := range DefaultTmplMigrations.WithCategory("tmpldbr") {
+		stmts, err := m.(*migrate.SQLTmplMigration).UpStmts()
+		assert.NoError(err)
+		if m.DriverName() == "postgres" {
+			assert.Contains(stmts[1], "deleted BOOLEAN,", m.DriverName())
+		} else {
+			assert.Contains(stmts[1], "deleted INTEGER,", m.DriverName())
+		}
+	}
+
+}
@@ -0,0 +1,412 @@
+// Database index of template meta, for efficient meta queries against any tmpl.Store.
+package tmplindex
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gocaveman/caveman/autowire"
+	"github.com/gocaveman/caveman/dbutil"
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migrateregistry"
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/gocraft/dbr"
+)
+
+// DefaultIndexMigrations is all of our migrations for the index tables.
+var DefaultIndexMigrations migrate.MigrationList
+
+func init() {
+
+	// register in migrateregistry and with autowire for all 3 databases
+	reg := func(m *migrate.SQLTmplMigration) {
+		var rm migrate.Migration
+		rm = m.NewWithDriverName("sqlite3")
+		DefaultIndexMigrations = append(DefaultIndexMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+		rm = m.NewWithDriverName("mysql")
+		DefaultIndexMigrations = append(DefaultIndexMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+		rm = m.NewWithDriverName("postgres")
+		DefaultIndexMigrations = append(DefaultIndexMigrations, rm)
+		autowire.Populate(migrateregistry.MustRegister(rm))
+	}
+
+	reg(&migrate.SQLTmplMigration{
+		// DriverNameValue set by reg
+		CategoryValue: "tmplindex",
+		VersionValue:  "0001_tmpl_index_create", // must be unique and indicates sequence
+		UpSQL: []string{
+			`CREATE TABLE {{.TablePrefix}}tmpl_index (
+				category VARCHAR(128),
+				file_name VARCHAR(255),
+				PRIMARY KEY (category, file_name)
+			)`,
+			`CREATE TABLE {{.TablePrefix}}tmpl_index_meta (
+				category VARCHAR(128),
+				file_name VARCHAR(255),
+				meta_key VARCHAR(255),
+				seq INTEGER,
+				is_list {{if eq .DriverNameValue "postgres"}}BOOLEAN{{else}}INTEGER{{end}},
+				value_rank INTEGER,
+				value_str TEXT,
+				value_num {{if eq .DriverNameValue "postgres"}}DOUBLE PRECISION{{else}}DOUBLE{{end}},
+				value_time VARCHAR(64),
+				PRIMARY KEY (category, file_name, meta_key, seq)
+			)`,
+			`CREATE INDEX {{.TablePrefix}}tmpl_index_meta_key ON {{.TablePrefix}}tmpl_index_meta (category, meta_key)`,
+		},
+		DownSQL: []string{
+			`DROP TABLE {{.TablePrefix}}tmpl_index_meta`,
+			`DROP TABLE {{.TablePrefix}}tmpl_index`,
+		},
+	})
+
+}
+
+// indexTimeFormat is fixed width in UTC so times sort correctly as strings.
+const indexTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+// type check
+var _ tmpl.QueryableStore = &IndexedStore{}
+
+// IndexedStore wraps a tmpl.Store and implements tmpl.QueryableStore by keeping
+// the meta of each template in database tables.  The matching and sorting rules
+// are the same as tmpl.MemQuery.  The index is built in full on the first Query
+// and after that templates written through the IndexedStore are re-indexed as
+// they are changed.  If Store implements tmpl.WatchableStore, changes made
+// elsewhere are picked up as they are reported, otherwise call Reindex as needed.
+type IndexedStore struct {
+	tmpl.Store `autowire:"tmpl.Store"`
+
+	DBDriver    string `autowire:"db.DriverName"`
+	DBDSN       string `autowire:"db.DataSourceName"`
+	TablePrefix string `autowire:"db.TablePrefix,optional"`
+
+	IndexCategories []string // categories to index, all if empty; queries on others use tmpl.MemQuery
+
+	conn      *dbr.Connection
+	startOnce sync.Once
+	startErr  error
+	unwatch   func()
+}
+
+func (s *IndexedStore) AfterWire() error {
+	var err error
+	s.conn, err = dbr.Open(s.DBDriver, s.DBDSN, nil)
+	return err
+}
+
+// Close stops watching the underlying Store.
+func (s *IndexedStore) Close() error {
+	if s.unwatch != nil {
+		s.unwatch()
+	}
+	return nil
+}
+
+// start does the initial index and watches for changes, the first time it is called.
+func (s *IndexedStore) start() error {
+	s.startOnce.Do(func() {
+		if ws, ok := s.Store.(tmpl.WatchableStore); ok {
+			uw, err := ws.Watch(s.handleEvent)
+			if err != nil && err != tmpl.ErrWatchNotSupported {
+				s.startErr = err
+				return
+			}
+			s.unwatch = uw
+		}
+		s.startErr = s.Reindex()
+	})
+	return s.startErr
+}
+
+func (s *IndexedStore) handleEvent(ev tmpl.Event) {
+	var err error
+	if ev.Category == "" || ev.FileName == "" {
+		err = s.Reindex()
+	} else {
+		err = s.reindexTemplate(ev.Category, ev.FileName)
+	}
+	if err != nil {
+		// no way to return it, the next Reindex will correct the index
+		log.Printf("tmplindex: error updating index for %+v: %v", ev, err)
+	}
+}
+
+func (s *IndexedStore) indexCategory(category string) bool {
+	if len(s.IndexCategories) == 0 {
+		return true
+	}
+	for _, c := range s.IndexCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTemplate delegates to Store and updates the index.
+func (s *IndexedStore) CreateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
+	err := s.Store.CreateTemplate(category, fileName, body, mimeType, meta)
+	if err != nil {
+		return err
+	}
+	return s.reindexTemplate(category, fileName)
+}
+
+// UpdateTemplate delegates to Store and updates the index.
+func (s *IndexedStore) UpdateTemplate(category, fileName string, body []byte, mimeType string, meta map[string]interface{}) error {
+	err := s.Store.UpdateTemplate(category, fileName, body, mimeType, meta)
+	if err != nil {
+		return err
+	}
+	return s.reindexTemplate(category, fileName)
+}
+
+// DeleteTemplate delegates to Store and updates the index.
+func (s *IndexedStore) DeleteTemplate(category, fileName string) error {
+	err := s.Store.DeleteTemplate(category, fileName)
+	if err != nil {
+		return err
+	}
+	// in a StackedStore another version can be revealed, so read it again rather than just removing it
+	return s.reindexTemplate(category, fileName)
+}
+
+// Watch delegates to Store if it implements tmpl.WatchableStore.
+func (s *IndexedStore) Watch(fn tmpl.WatchFunc) (unwatch func(), err error) {
+	ws, ok := s.Store.(tmpl.WatchableStore)
+	if !ok {
+		return nil, tmpl.ErrWatchNotSupported
+	}
+	return ws.Watch(fn)
+}
+
+// Reindex rebuilds the index from the contents of Store.
+func (s *IndexedStore) Reindex() error {
+
+	cats := s.IndexCategories
+	if len(cats) == 0 {
+		var err error
+		cats, err = s.Store.Categories()
+		if err != nil {
+			return err
+		}
+	}
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.DeleteFrom(s.TablePrefix + "tmpl_index_meta").Exec()
+	if err != nil {
+		return err
+	}
+	_, err = tx.DeleteFrom(s.TablePrefix + "tmpl_index").Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, cat := range cats {
+		names, err := s.Store.FindByPrefix(cat, "/", -1)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			_, _, meta, err := s.Store.ReadTemplate(cat, name)
+			if err == tmpl.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			err = s.insertTemplate(tx, cat, name, meta)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// reindexTemplate reads a template from Store and replaces its index entries.
+func (s *IndexedStore) reindexTemplate(category, fileName string) error {
+
+	if !s.indexCategory(category) {
+		return nil
+	}
+
+	_, _, meta, err := s.Store.ReadTemplate(category, fileName)
+	exists := err == nil
+	if err == tmpl.ErrNotFound {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sess := s.conn.NewSession(nil)
+	tx, err := sess.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.DeleteFrom(s.TablePrefix+"tmpl_index_meta").
+		Where("category=? AND file_name=?", category, fileName).Exec()
+	if err != nil {
+		return err
+	}
+	_, err = tx.DeleteFrom(s.TablePrefix+"tmpl_index").
+		Where("category=? AND file_name=?", category, fileName).Exec()
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		err = s.insertTemplate(tx, category, fileName, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *IndexedStore) insertTemplate(tx *dbr.Tx, category, fileName string, meta map[string]interface{}) error {
+
+	_, err := tx.InsertInto(s.TablePrefix+"tmpl_index").
+		Columns("category", "file_name").
+		Values(category, fileName).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for k, v := range meta {
+		if v == nil {
+			continue
+		}
+		list, isList := tmpl.MetaList(v)
+		if !isList {
+			list = []interface{}{v}
+		}
+		for i, el := range list {
+			rank, num, tm := indexValue(el)
+			_, err := tx.InsertInto(s.TablePrefix+"tmpl_index_meta").
+				Columns("category", "file_name", "meta_key", "seq", "is_list",
+					"value_rank", "value_str", "value_num", "value_time").
+				Values(category, fileName, k, i, isList,
+					rank, tmpl.MetaString(el), num, tm).
+				Exec()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// indexValue returns the sort rank and the numeric and time columns for a meta value,
+// matching the sort order used by tmpl.FilterMetaItems (numbers, then times, then strings).
+func indexValue(v interface{}) (rank int, num *float64, tm *string) {
+	if n, ok := tmpl.MetaNumber(v); ok {
+		return 1, &n, nil
+	}
+	if t, ok := tmpl.MetaTime(v); ok {
+		ts := t.UTC().Format(indexTimeFormat)
+		return 2, nil, &ts
+	}
+	return 3, nil, nil
+}
+
+// Query implements tmpl.QueryableStore.
+func (s *IndexedStore) Query(q tmpl.Query) ([]string, error) {
+
+	if err := q.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if !s.indexCategory(q.Category) {
+		return tmpl.MemQuery(s.Store, q)
+	}
+
+	err := s.start()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := q.FileNamePrefix
+	if prefix == "" {
+		prefix = "/"
+	}
+
+	metaTable := s.TablePrefix + "tmpl_index_meta"
+
+	sess := s.conn.NewSession(nil)
+	stmt := sess.Select("i.file_name").From(dbr.I(s.TablePrefix+"tmpl_index").As("i")).
+		Where("i.category=? AND i.file_name LIKE ? ESCAPE '!'", q.Category, dbutil.LikePrefix(prefix))
+
+	for _, c := range q.Conds {
+
+		where := "m.meta_key=? AND m.is_list=? AND "
+		args := []interface{}{c.Key, c.Op == tmpl.OpContains}
+
+		switch c.Op {
+		case tmpl.OpEq, tmpl.OpContains:
+			where += "m.value_str=?"
+			args = append(args, tmpl.MetaString(c.Value))
+		default:
+			if n, ok := tmpl.MetaNumber(c.Value); ok {
+				where += "m.value_num" + c.Op + "?"
+				args = append(args, n)
+			} else if t, ok := c.Value.(time.Time); ok {
+				where += "m.value_time" + c.Op + "?"
+				args = append(args, t.UTC().Format(indexTimeFormat))
+			} else {
+				where += "m.value_str" + c.Op + "?"
+				args = append(args, tmpl.MetaString(c.Value))
+			}
+		}
+
+		stmt = stmt.Where("EXISTS (SELECT 1 FROM "+metaTable+" m WHERE m.category=i.category AND m.file_name=i.file_name AND "+where+")", args...)
+	}
+
+	for n, sf := range q.Sort {
+		alias := fmt.Sprintf("s%d", n)
+		stmt = stmt.LeftJoin(dbr.I(metaTable).As(alias),
+			dbr.Expr(alias+".category=i.category AND "+alias+".file_name=i.file_name AND "+alias+".meta_key=? AND "+alias+".is_list=?", sf.Key, false))
+		dir := " ASC"
+		if sf.Desc {
+			dir = " DESC"
+		}
+		stmt = stmt.OrderBy("COALESCE(" + alias + ".value_rank, 0)" + dir).
+			OrderBy(alias + ".value_num" + dir).
+			OrderBy(alias + ".value_time" + dir).
+			OrderBy(alias + ".value_str" + dir)
+	}
+	stmt = stmt.OrderAsc("i.file_name")
+
+	if q.Limit > 0 {
+		stmt = stmt.Limit(uint64(q.Limit))
+	}
+	if q.Offset > 0 {
+		if q.Limit <= 0 { // some databases require a limit with an offset
+			stmt = stmt.Limit(math.MaxInt64)
+		}
+		stmt = stmt.Offset(uint64(q.Offset))
+	}
+
+	ret := []string{}
+	_, err = stmt.Load(&ret)
+	return ret, err
+}
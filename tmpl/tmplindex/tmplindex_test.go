@@ -0,0 +1,104 @@
+package tmplindex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocaveman/caveman/migrate"
+	"github.com/gocaveman/caveman/migrate/migratedbr"
+	"github.com/gocaveman/caveman/tmpl"
+	"github.com/gocaveman/caveman/tmpl/tmpldbr"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestIndexedStore(t *testing.T) {
+
+	assert := assert.New(t)
+
+	driver, dsn := "sqlite3", "file:TestIndexedStore?mode=memory&cache=shared"
+
+	ver, err := migratedbr.New(driver, dsn)
+	assert.NoError(err)
+	var ml migrate.MigrationList
+	ml = append(ml, tmpldbr.DefaultTmplMigrations...)
+	ml = append(ml, DefaultIndexMigrations...)
+	assert.NoError(migrate.NewRunner(driver, dsn, ver, ml).RunAllUpToLatest())
+
+	dbs := &tmpldbr.DBStore{DBDriver: driver, DBDSN: dsn}
+	assert.NoError(dbs.AfterWire())
+
+	// existing content before the index is built
+	assert.NoError(dbs.CreateTemplate(tmpl.ViewsCategory, "/blog/post1.gohtml", nil, "",
+		map[string]interface{}{"title": "Post 1", "date": "2018-01-10", "tags": []interface{}{"go", "web"}, "weight": 10}))
+	assert.NoError(dbs.CreateTemplate(tmpl.ViewsCategory, "/blog/post2.gohtml", nil, "",
+		map[string]interface{}{"title": "Post 2", "date": "2018-03-01", "tags": []interface{}{"go"}, "weight": 2}))
+
+	s := &IndexedStore{Store: dbs, DBDriver: driver, DBDSN: dsn}
+	assert.NoError(s.AfterWire())
+	defer s.Close()
+
+	assert.NoError(s.CreateTemplate(tmpl.ViewsCategory, "/blog/post3.gohtml", nil, "",
+		map[string]interface{}{"title": "Post 3", "date": "2018-02-15", "tags": []interface{}{"python"}}))
+	assert.NoError(s.CreateTemplate(tmpl.ViewsCategory, "/about.gohtml", nil, "",
+		map[string]interface{}{"title": "About"}))
+
+	// the index must give the same results as reading everything
+	queries := []tmpl.Query{
+		{Category: tmpl.ViewsCategory, FileNamePrefix: "/blog/",
+			Conds: []tmpl.Cond{{Key: "tags", Op: tmpl.OpContains, Value: "go"}}, Sort: tmpl.ParseSortFields("-date")},
+		{Category: tmpl.ViewsCategory,
+			Conds: []tmpl.Cond{{Key: "date", Op: tmpl.OpGte, Value: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)}}, Sort: tmpl.ParseSortFields("date")},
+		{Category: tmpl.ViewsCategory, Conds: []tmpl.Cond{{Key: "weight", Op: tmpl.OpLt, Value: 5}}},
+		{Category: tmpl.ViewsCategory, Sort: tmpl.ParseSortFields("weight"), Offset: 1, Limit: 2},
+		{Category: tmpl.ViewsCategory, Sort: tmpl.ParseSortFields("-weight", "title"), Offset: 1},
+		{Category: tmpl.ViewsCategory, Conds: []tmpl.Cond{{Key: "title", Op: tmpl.OpEq, Value: "About"}}},
+		{Category: tmpl.ViewsCategory, Conds: []tmpl.Cond{{Key: "tags", Op: tmpl.OpEq, Value: "go"}}},
+		{Category: tmpl.ViewsCategory, Conds: []tmpl.Cond{{Key: "title", Op: tmpl.OpGt, Value: "Post 1"}}},
+	}
+	for _, q := range queries {
+		expected, err := tmpl.MemQuery(dbs, q)
+		assert.NoError(err)
+		names, err := s.Query(q)
+		assert.NoError(err)
+		assert.Equal(expected, names, "query: %+v", q)
+	}
+
+	names, err := s.Query(queries[0])
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post2.gohtml", "/blog/post1.gohtml"}, names)
+
+	// changes through the IndexedStore
+	assert.NoError(s.UpdateTemplate(tmpl.ViewsCategory, "/blog/post2.gohtml", nil, "", map[string]interface{}{"tags": nil}))
+	names, err = s.Query(queries[0])
+	assert.NoError(err)
+	assert.Equal([]string{"/blog/post1.gohtml"}, names)
+
+	// and directly on the underlying store, reported by watching it
+	assert.NoError(dbs.DeleteTemplate(tmpl.ViewsCategory, "/blog/post1.gohtml"))
+	names, err = s.Query(queries[0])
+	assert.NoError(err)
+	assert.Len(names, 0)
+
+	_, err = s.Query(tmpl.Query{Category: tmpl.ViewsCategory, Conds: []tmpl.Cond{{Key: "x", Op: "like"}}})
+	assert.Error(err)
+
+}
+
+func TestMigrationColumnTypes(t *testing.T) {
+
+	assert := assert.New(t)
+
+	// is_list is written and queried with bools, which Postgres only accepts for a BOOLEAN column
+	for _, m := range DefaultIndexMigrations.WithCategory("tmplindex") {
+		stmts, err := m.(*migrate.SQLTmplMigration).UpStmts()
+		assert.NoError(err)
+		if m.DriverName() == "postgres" {
+			assert.Contains(stmts[1], "is_list BOOLEAN,", m.DriverName())
+		} else {
+			assert.Contains(stmts[1], "is_list INTEGER,", m.DriverName())
+		}
+	}
+
+}
@@ -0,0 +1,182 @@
+package tmpl
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// ErrWatchNotSupported indicates the Store cannot report changes.
+var ErrWatchNotSupported = errors.New("watch not supported")
+
+// EventType is the kind of change an Event describes.
+type EventType int
+
+const (
+	CreateEvent EventType = iota + 1 // template was created
+	UpdateEvent                      // template was modified
+	DeleteEvent                      // template was removed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case CreateEvent:
+		return "create"
+	case UpdateEvent:
+		return "update"
+	case DeleteEvent:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Event describes a change to a template.  An UpdateEvent with an empty Category
+// and FileName means any template may have changed (e.g. the Store in a SyncStore
+// was replaced).  Events are hints for things like cache invalidation, the same
+// change may be reported more than once and consumers should read the template
+// again rather than rely on the exact sequence of events.
+type Event struct {
+	Type     EventType
+	Category string
+	FileName string
+}
+
+// WatchFunc is called for each Event.  It is called from a separate goroutine and
+// should return promptly.
+type WatchFunc func(ev Event)
+
+// WatchableStore is implemented by Stores which can notify of changes to their templates.
+// Renderer caches, page indexes and live-reload in development can use this to find
+// out when to read templates again.
+type WatchableStore interface {
+	Store
+
+	// Watch registers fn to be called on each change.  The returned function
+	// removes it again.  ErrWatchNotSupported is returned if changes cannot be reported.
+	Watch(fn WatchFunc) (unwatch func(), err error)
+}
+
+// DefaultPollInterval is how often changes are checked for when polling and no interval is set.
+var DefaultPollInterval = 2 * time.Second
+
+// Watchers is a helper for implementing WatchableStore.  It keeps the list of
+// WatchFuncs and, if Poll is set, calls it every PollInterval as long as there is
+// at least one watcher.  Start is called when the first watcher is added and Stop
+// when the last one is removed.  Changes made directly (e.g. by a write method on
+// the Store) can be reported with Notify.  Start, Poll and Stop are never called
+// concurrently with each other.  The zero value is ready to use.
+type Watchers struct {
+	PollInterval time.Duration           // how often to call Poll, DefaultPollInterval if not set
+	Poll         func() ([]Event, error) // return changes since the last call, optional
+	Start        func() error            // called before the first watcher is added, optional
+	Stop         func()                  // called after the last watcher is removed, optional
+
+	mu       sync.Mutex
+	pollMu   sync.Mutex // serializes calls to Start, Poll and Stop
+	fns      map[int]WatchFunc
+	nextID   int
+	stopPoll chan struct{}
+}
+
+// Watch adds fn to the list and returns a func to remove it.
+func (w *Watchers) Watch(fn WatchFunc) (unwatch func(), err error) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.fns) == 0 {
+		if w.Start != nil {
+			w.pollMu.Lock()
+			err := w.Start()
+			w.pollMu.Unlock()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if w.Poll != nil {
+			interval := w.PollInterval
+			if interval <= 0 {
+				interval = DefaultPollInterval
+			}
+			w.stopPoll = make(chan struct{})
+			go w.pollLoop(interval, w.stopPoll)
+		}
+	}
+
+	if w.fns == nil {
+		w.fns = make(map[int]WatchFunc)
+	}
+	id := w.nextID
+	w.nextID++
+	w.fns[id] = fn
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { w.remove(id) })
+	}, nil
+}
+
+func (w *Watchers) remove(id int) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.fns, id)
+	if len(w.fns) > 0 {
+		return
+	}
+
+	if w.stopPoll != nil {
+		close(w.stopPoll)
+		w.stopPoll = nil
+	}
+	if w.Stop != nil {
+		w.pollMu.Lock()
+		w.Stop()
+		w.pollMu.Unlock()
+	}
+}
+
+// Len returns the number of watchers.
+func (w *Watchers) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.fns)
+}
+
+// Notify calls each watcher with the Event.
+func (w *Watchers) Notify(ev Event) {
+	w.mu.Lock()
+	fns := make([]WatchFunc, 0, len(w.fns))
+	for _, fn := range w.fns {
+		fns = append(fns, fn)
+	}
+	w.mu.Unlock()
+
+	for _, fn := range fns {
+		fn(ev)
+	}
+}
+
+func (w *Watchers) pollLoop(interval time.Duration, stop chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			w.pollMu.Lock()
+			evs, err := w.Poll()
+			w.pollMu.Unlock()
+			if err != nil {
+				log.Printf("tmpl.Watchers: error while polling for changes: %v", err)
+				continue
+			}
+			for _, ev := range evs {
+				w.Notify(ev)
+			}
+		}
+	}
+}
@@ -27,11 +27,15 @@ package webutil
 import (
 	"bufio"
 	"bytes"
+	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"runtime"
 	"strings"
+
+	"github.com/gocraft/dbr"
 )
 
 // ErrNotFound is a generic "not found" error.  Useful to communicate that generic concept
@@ -41,6 +45,22 @@ var ErrNotFound = os.ErrNotExist
 
 var ErrAlreadyExists = os.ErrExist
 
+// IsNotFound returns true if err means something was not found: ErrNotFound (os.ErrNotExist),
+// sql.ErrNoRows or dbr.ErrNotFound, also when wrapped.  Errors with a Cause() method are unwrapped.
+func IsNotFound(err error) bool {
+	for err != nil {
+		if errors.Is(err, dbr.ErrNotFound) || errors.Is(err, sql.ErrNoRows) || errors.Is(err, os.ErrNotExist) {
+			return true
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return false
+}
+
 // MainOnly checks the call stack to ensure that the caller is in the main package.
 // Used to defend against inexperienced developers trying to read from a registry anywhere
 // but in the main package.  The argument says how many levels of the stack to remove.
@@ -0,0 +1,28 @@
+package webutil
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gocraft/dbr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotFound(t *testing.T) {
+
+	assert := assert.New(t)
+
+	assert.True(IsNotFound(ErrNotFound))
+	assert.True(IsNotFound(dbr.ErrNotFound))
+	assert.True(IsNotFound(sql.ErrNoRows))
+	assert.True(IsNotFound(fmt.Errorf("loading todo list: %w", dbr.ErrNotFound)))
+	_, err := os.Open("/does-not-exist")
+	assert.True(IsNotFound(err))
+
+	assert.False(IsNotFound(nil))
+	assert.False(IsNotFound(ErrAlreadyExists))
+	assert.False(IsNotFound(fmt.Errorf("not found")))
+
+}